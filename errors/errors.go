@@ -1,11 +1,264 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"regexp"
+	"strings"
 
-var EmptyIdError = errors.New("id is empty")
+	"github.com/go-sql-driver/mysql"
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
 
-var NilArgumentError = errors.New("argument is nil")
+var EmptyIdError error = &ArgumentError{msg: "id is empty"}
+
+var NilArgumentError error = &ArgumentError{msg: "argument is nil"}
 
 var NoTransactionError = errors.New("transaction is not opened")
 
+// NoDBError is returned by the generated servers without database running
+// the operations with the gorm repositories.
+var NoDBError = status.Error(codes.Internal, "the server has no database for its gorm repositories")
+
 var BadRepeatedFieldMaskTpl = "unexpected fieldmask count %d for objects count %d"
+
+// ArgumentError reports an invalid request argument, it has the
+// InvalidArgument status code.
+type ArgumentError struct {
+	msg string
+}
+
+func (e *ArgumentError) Error() string { return e.msg }
+
+func (e *ArgumentError) GRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, e.msg)
+}
+
+// NotFoundError reports that a record doesn't exist, it has the NotFound
+// status code.
+type NotFoundError struct {
+	Err error
+}
+
+func (e *NotFoundError) Error() string { return e.Err.Error() }
+
+func (e *NotFoundError) Unwrap() error { return e.Err }
+
+func (e *NotFoundError) GRPCStatus() *status.Status {
+	return status.New(codes.NotFound, "record not found")
+}
+
+// AlreadyExistsError reports the violation of a unique constraint, it has
+// the AlreadyExists status code. Constraint and Column are set when the
+// database reports them.
+type AlreadyExistsError struct {
+	Constraint string
+	Column     string
+	Err        error
+}
+
+func (e *AlreadyExistsError) Error() string { return e.Err.Error() }
+
+func (e *AlreadyExistsError) Unwrap() error { return e.Err }
+
+func (e *AlreadyExistsError) GRPCStatus() *status.Status {
+	switch {
+	case e.Constraint != "":
+		return status.Newf(codes.AlreadyExists, "unique constraint %q violated", e.Constraint)
+	case e.Column != "":
+		return status.Newf(codes.AlreadyExists, "duplicate value of %q", e.Column)
+	default:
+		return status.New(codes.AlreadyExists, "record already exists")
+	}
+}
+
+// ForeignKeyError reports the violation of a foreign key constraint, it has
+// the FailedPrecondition status code. Constraint is set when the database
+// reports it.
+type ForeignKeyError struct {
+	Constraint string
+	Err        error
+}
+
+func (e *ForeignKeyError) Error() string { return e.Err.Error() }
+
+func (e *ForeignKeyError) Unwrap() error { return e.Err }
+
+func (e *ForeignKeyError) GRPCStatus() *status.Status {
+	if e.Constraint != "" {
+		return status.Newf(codes.FailedPrecondition, "foreign key constraint %q violated", e.Constraint)
+	}
+	return status.New(codes.FailedPrecondition, "foreign key constraint violated")
+}
+
+// SerializationError reports a transaction aborted by the database because
+// of a serialization failure or a deadlock, it has the Aborted status code.
+// The transaction can be retried.
+type SerializationError struct {
+	Err error
+}
+
+func (e *SerializationError) Error() string { return e.Err.Error() }
+
+func (e *SerializationError) Unwrap() error { return e.Err }
+
+func (e *SerializationError) GRPCStatus() *status.Status {
+	return status.New(codes.Aborted, "transaction aborted, retry")
+}
+
+// FieldViolation is a constraint violated by the value of a field, Field is
+// the path of the field in the object, like "emails[1].address".
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// ValidationError lists the constraint violations of an object, it has the
+// InvalidArgument status code with a BadRequest detail listing them.
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+// Add adds a violation of field.
+func (e *ValidationError) Add(field, description string) {
+	e.Violations = append(e.Violations, FieldViolation{Field: field, Description: description})
+}
+
+// Nest adds the violations of err, the validation error of the object in
+// field, with their paths prefixed by field. Other errors are added as a
+// violation of field.
+func (e *ValidationError) Nest(field string, err error) {
+	if err == nil {
+		return
+	}
+	nested, ok := err.(*ValidationError)
+	if !ok {
+		e.Add(field, err.Error())
+		return
+	}
+	for _, v := range nested.Violations {
+		e.Add(field+"."+v.Field, v.Description)
+	}
+}
+
+// Err returns e when it has violations and nil otherwise.
+func (e *ValidationError) Err() error {
+	if len(e.Violations) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		msgs[i] = v.Field + " " + v.Description
+	}
+	return "invalid argument: " + strings.Join(msgs, "; ")
+}
+
+func (e *ValidationError) GRPCStatus() *status.Status {
+	br := &errdetails.BadRequest{}
+	for _, v := range e.Violations {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+	s := status.New(codes.InvalidArgument, e.Error())
+	if withDetails, err := s.WithDetails(br); err == nil {
+		return withDetails
+	}
+	return s
+}
+
+var (
+	pqKeyPattern           = regexp.MustCompile(`^Key \(([^)]+)\)=`)
+	mysqlKeyPattern        = regexp.MustCompile(`for key '([^']+)'`)
+	mysqlConstraintPattern = regexp.MustCompile("CONSTRAINT `([^`]+)`")
+)
+
+// Translate returns err as one of the typed errors of this package when it
+// is a record not found error of gorm or a unique, foreign key,
+// serialization or deadlock error of Postgres, MySQL or SQLite. Other errors,
+// and errors which already have a gRPC status, are returned as is.
+func Translate(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+		return err
+	}
+	if gorm.IsRecordNotFoundError(err) {
+		return &NotFoundError{Err: err}
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return translatePostgres(pqErr, err)
+	}
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		return translateMySQL(mysqlErr, err)
+	}
+	return translateSQLite(err)
+}
+
+func translatePostgres(pqErr *pq.Error, err error) error {
+	switch pqErr.Code {
+	case "23505": // unique_violation
+		column := pqErr.Column
+		if m := pqKeyPattern.FindStringSubmatch(pqErr.Detail); column == "" && m != nil {
+			column = m[1]
+		}
+		return &AlreadyExistsError{Constraint: pqErr.Constraint, Column: column, Err: err}
+	case "23503": // foreign_key_violation
+		return &ForeignKeyError{Constraint: pqErr.Constraint, Err: err}
+	case "40001", "40P01": // serialization_failure, deadlock_detected
+		return &SerializationError{Err: err}
+	}
+	return err
+}
+
+func translateMySQL(mysqlErr *mysql.MySQLError, err error) error {
+	switch mysqlErr.Number {
+	case 1062: // ER_DUP_ENTRY
+		var constraint string
+		if m := mysqlKeyPattern.FindStringSubmatch(mysqlErr.Message); m != nil {
+			// MySQL 8 prefixes the key with its table.
+			constraint = m[1][strings.LastIndex(m[1], ".")+1:]
+		}
+		return &AlreadyExistsError{Constraint: constraint, Err: err}
+	case 1451, 1452: // ER_ROW_IS_REFERENCED_2, ER_NO_REFERENCED_ROW_2
+		var constraint string
+		if m := mysqlConstraintPattern.FindStringSubmatch(mysqlErr.Message); m != nil {
+			constraint = m[1]
+		}
+		return &ForeignKeyError{Constraint: constraint, Err: err}
+	case 1213: // ER_LOCK_DEADLOCK
+		return &SerializationError{Err: err}
+	}
+	return err
+}
+
+// translateSQLite recognizes the errors of SQLite by their message, the
+// driver requires cgo and isn't imported.
+func translateSQLite(err error) error {
+	msg := err.Error()
+	switch {
+	case strings.HasPrefix(msg, "UNIQUE constraint failed: "):
+		columns := strings.Split(strings.TrimPrefix(msg, "UNIQUE constraint failed: "), ", ")
+		for i, column := range columns {
+			columns[i] = column[strings.LastIndex(column, ".")+1:]
+		}
+		return &AlreadyExistsError{Column: strings.Join(columns, ","), Err: err}
+	case msg == "FOREIGN KEY constraint failed":
+		return &ForeignKeyError{Err: err}
+	case strings.HasPrefix(msg, "database is locked"), strings.HasPrefix(msg, "database table is locked"):
+		return &SerializationError{Err: err}
+	}
+	return err
+}
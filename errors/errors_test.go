@@ -0,0 +1,94 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTranslate(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		err     error
+		code    codes.Code
+		message string
+	}{
+		{"nil", nil, codes.OK, ""},
+		{"not found", gorm.ErrRecordNotFound, codes.NotFound, "record not found"},
+		{"empty id", EmptyIdError, codes.InvalidArgument, "id is empty"},
+		{"nil argument", NilArgumentError, codes.InvalidArgument, "argument is nil"},
+		{"status", status.Error(codes.Unauthenticated, "no token"), codes.Unauthenticated, "no token"},
+
+		{"postgres unique", &pq.Error{Code: "23505", Constraint: "users_email_key", Detail: "Key (email)=(a@b.c) already exists."},
+			codes.AlreadyExists, `unique constraint "users_email_key" violated`},
+		{"postgres foreign key", &pq.Error{Code: "23503", Constraint: "posts_user_id_fkey"},
+			codes.FailedPrecondition, `foreign key constraint "posts_user_id_fkey" violated`},
+		{"postgres serialization", &pq.Error{Code: "40001"}, codes.Aborted, "transaction aborted, retry"},
+		{"postgres deadlock", &pq.Error{Code: "40P01"}, codes.Aborted, "transaction aborted, retry"},
+		{"postgres other", &pq.Error{Code: "42P01", Message: "undefined table"}, codes.Unknown, "pq: undefined table"},
+
+		{"mysql duplicate", &mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'a@b.c' for key 'users.idx_email'"},
+			codes.AlreadyExists, `unique constraint "idx_email" violated`},
+		{"mysql foreign key", &mysql.MySQLError{Number: 1452, Message: "Cannot add or update a child row: a foreign key constraint fails " +
+			"(`db`.`posts`, CONSTRAINT `fk_posts_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`))"},
+			codes.FailedPrecondition, `foreign key constraint "fk_posts_user" violated`},
+		{"mysql deadlock", &mysql.MySQLError{Number: 1213}, codes.Aborted, "transaction aborted, retry"},
+
+		{"sqlite unique", errors.New("UNIQUE constraint failed: users.first, users.last"),
+			codes.AlreadyExists, `duplicate value of "first,last"`},
+		{"sqlite foreign key", errors.New("FOREIGN KEY constraint failed"),
+			codes.FailedPrecondition, "foreign key constraint violated"},
+		{"sqlite locked", errors.New("database is locked"), codes.Aborted, "transaction aborted, retry"},
+
+		{"wrapped", fmt.Errorf("create: %w", &pq.Error{Code: "23505"}), codes.AlreadyExists, "record already exists"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := status.Convert(Translate(tc.err))
+			if s.Code() != tc.code || s.Message() != tc.message {
+				t.Errorf("got %s %q; want %s %q", s.Code(), s.Message(), tc.code, tc.message)
+			}
+		})
+	}
+}
+
+func TestTranslateUnwrap(t *testing.T) {
+	pqErr := &pq.Error{Code: "23505", Constraint: "users_email_key", Detail: "Key (email)=(a@b.c) already exists."}
+	var exists *AlreadyExistsError
+	if err := Translate(pqErr); !errors.As(err, &exists) || exists.Column != "email" || !errors.Is(err, pqErr) {
+		t.Errorf("unexpected translation %#v", err)
+	}
+}
+
+func TestValidationError(t *testing.T) {
+	child := &ValidationError{}
+	child.Add("address", "is required")
+
+	v := &ValidationError{}
+	if v.Err() != nil {
+		t.Error("expected no error without violations")
+	}
+	v.Add("name", "must be at most 8 characters")
+	v.Nest("emails[1]", child.Err())
+	v.Nest("profile", nil)
+	v.Nest("user", errors.New("is broken"))
+
+	want := "invalid argument: name must be at most 8 characters; emails[1].address is required; user is broken"
+	if err := v.Err(); err == nil || err.Error() != want {
+		t.Errorf("got error %v; want %s", err, want)
+	}
+	s := status.Convert(v.Err())
+	if s.Code() != codes.InvalidArgument || len(s.Details()) != 1 {
+		t.Fatalf("unexpected status %v", s)
+	}
+	br, ok := s.Details()[0].(*errdetails.BadRequest)
+	if !ok || len(br.GetFieldViolations()) != 3 || br.GetFieldViolations()[1].GetField() != "emails[1].address" {
+		t.Errorf("unexpected details %v", s.Details())
+	}
+}
@@ -3,12 +3,26 @@ package example
 import (
 	context "context"
 	fmt "fmt"
+	errors "github.com/acanseco/protoc-gen-gorm/errors"
+	fixture "github.com/acanseco/protoc-gen-gorm/runtime/fixture"
+	insert "github.com/acanseco/protoc-gen-gorm/runtime/insert"
+	memory "github.com/acanseco/protoc-gen-gorm/runtime/memory"
+	paging "github.com/acanseco/protoc-gen-gorm/runtime/paging"
+	preload "github.com/acanseco/protoc-gen-gorm/runtime/preload"
+	transaction "github.com/acanseco/protoc-gen-gorm/runtime/transaction"
 	gateway "github.com/infobloxopen/atlas-app-toolkit/gateway"
 	gorm1 "github.com/infobloxopen/atlas-app-toolkit/gorm"
-	errors "github.com/acanseco/protoc-gen-gorm/errors"
+	query "github.com/infobloxopen/atlas-app-toolkit/query"
 	gorm "github.com/jinzhu/gorm"
 	go_uuid "github.com/satori/go.uuid"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	proto "google.golang.org/protobuf/proto"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	strings "strings"
+	time "time"
+	utf8 "unicode/utf8"
 )
 
 type ExternalChildORM struct {
@@ -57,6 +71,16 @@ func (m *ExternalChildORM) ToPB(ctx context.Context) (ExternalChild, error) {
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *ExternalChild) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type ExternalChild the arg will be the target, the caller the one being converted from
 
@@ -81,9 +105,11 @@ type ExternalChildWithAfterToPB interface {
 }
 
 type BlogPostORM struct {
-	Author string
-	Id     uint64
-	Title  string
+	Author    string
+	CreatedAt *time.Time
+	Id        uint64
+	Slug      string `gorm:"unique_index:idx_blog_post_slug"`
+	Title     string
 }
 
 // TableName overrides the default tablename generated by GORM
@@ -104,6 +130,11 @@ func (m *BlogPost) ToORM(ctx context.Context) (BlogPostORM, error) {
 	to.Id = m.Id
 	to.Title = m.Title
 	to.Author = m.Author
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	to.Slug = m.Slug
 	if posthook, ok := interface{}(m).(BlogPostWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -123,12 +154,26 @@ func (m *BlogPostORM) ToPB(ctx context.Context) (BlogPost, error) {
 	to.Id = m.Id
 	to.Title = m.Title
 	to.Author = m.Author
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	to.Slug = m.Slug
 	if posthook, ok := interface{}(m).(BlogPostWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *BlogPost) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type BlogPost the arg will be the target, the caller the one being converted from
 
@@ -152,11 +197,202 @@ type BlogPostWithAfterToPB interface {
 	AfterToPB(context.Context, *BlogPost) error
 }
 
+type ThreadORM struct {
+	Id      uint64
+	Replies []*ReplyORM `gorm:"foreignkey:ThreadId;association_foreignkey:Id"`
+	Title   string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (ThreadORM) TableName() string {
+	return "threads"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Thread) ToORM(ctx context.Context) (ThreadORM, error) {
+	to := ThreadORM{}
+	var err error
+	if prehook, ok := interface{}(m).(ThreadWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Title = m.Title
+	for _, v := range m.Replies {
+		if v != nil {
+			if tempReplies, cErr := v.ToORM(ctx); cErr == nil {
+				to.Replies = append(to.Replies, &tempReplies)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Replies = append(to.Replies, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(ThreadWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *ThreadORM) ToPB(ctx context.Context) (Thread, error) {
+	to := Thread{}
+	var err error
+	if prehook, ok := interface{}(m).(ThreadWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Title = m.Title
+	for _, v := range m.Replies {
+		if v != nil {
+			if tempReplies, cErr := v.ToPB(ctx); cErr == nil {
+				to.Replies = append(to.Replies, &tempReplies)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Replies = append(to.Replies, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(ThreadWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *Thread) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	for i, e := range m.Replies {
+		v.Nest(fmt.Sprintf("replies[%d]", i), e.Validate())
+	}
+	return v.Err()
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Thread the arg will be the target, the caller the one being converted from
+
+// ThreadBeforeToORM called before default ToORM code
+type ThreadWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ThreadORM) error
+}
+
+// ThreadAfterToORM called after default ToORM code
+type ThreadWithAfterToORM interface {
+	AfterToORM(context.Context, *ThreadORM) error
+}
+
+// ThreadBeforeToPB called before default ToPB code
+type ThreadWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Thread) error
+}
+
+// ThreadAfterToPB called after default ToPB code
+type ThreadWithAfterToPB interface {
+	AfterToPB(context.Context, *Thread) error
+}
+
+type ReplyORM struct {
+	Code     string `gorm:"size:4;unique"`
+	Id       uint64
+	ThreadId uint64 `gorm:"not null"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (ReplyORM) TableName() string {
+	return "replies"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Reply) ToORM(ctx context.Context) (ReplyORM, error) {
+	to := ReplyORM{}
+	var err error
+	if prehook, ok := interface{}(m).(ReplyWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Code = m.Code
+	if posthook, ok := interface{}(m).(ReplyWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *ReplyORM) ToPB(ctx context.Context) (Reply, error) {
+	to := Reply{}
+	var err error
+	if prehook, ok := interface{}(m).(ReplyWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Code = m.Code
+	if posthook, ok := interface{}(m).(ReplyWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *Reply) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	if utf8.RuneCountInString(m.Code) > 4 {
+		v.Add("code", "must be at most 4 characters")
+	}
+	return v.Err()
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Reply the arg will be the target, the caller the one being converted from
+
+// ReplyBeforeToORM called before default ToORM code
+type ReplyWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ReplyORM) error
+}
+
+// ReplyAfterToORM called after default ToORM code
+type ReplyWithAfterToORM interface {
+	AfterToORM(context.Context, *ReplyORM) error
+}
+
+// ReplyBeforeToPB called before default ToPB code
+type ReplyWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Reply) error
+}
+
+// ReplyAfterToPB called after default ToPB code
+type ReplyWithAfterToPB interface {
+	AfterToPB(context.Context, *Reply) error
+}
+
 // DefaultCreateExternalChild executes a basic gorm create call
 func DefaultCreateExternalChild(ctx context.Context, in *ExternalChild, db *gorm.DB) (*ExternalChild, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
@@ -185,6 +421,75 @@ type ExternalChildORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateExternalChildSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateExternalChildSet(ctx context.Context, in []*ExternalChild, db *gorm.DB, batchSize int) ([]*ExternalChild, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*ExternalChildORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&ExternalChildORM{})).(ExternalChildORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(ExternalChildORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(ExternalChildORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&ExternalChildORM{})).(ExternalChildORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*ExternalChild, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type ExternalChildORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*ExternalChildORM, *gorm.DB) (*gorm.DB, error)
+}
+type ExternalChildORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*ExternalChildORM, *gorm.DB) error
+}
+
 func DefaultReadExternalChild(ctx context.Context, in *ExternalChild, db *gorm.DB) (*ExternalChild, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
@@ -201,7 +506,7 @@ func DefaultReadExternalChild(ctx context.Context, in *ExternalChild, db *gorm.D
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &ExternalChildORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelectionEx(ctx, db, nil, &ExternalChildORM{}, preload.NewConverter(&ExternalChild{})); err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithBeforeReadFind); ok {
@@ -308,6 +613,9 @@ func DefaultStrictUpdateExternalChild(ctx context.Context, in *ExternalChild, db
 	if in == nil {
 		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateExternalChild")
 	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
@@ -459,7 +767,7 @@ func DefaultListExternalChild(ctx context.Context, db *gorm.DB) ([]*ExternalChil
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &ExternalChildORM{}, &ExternalChild{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &ExternalChildORM{}, preload.NewConverter(&ExternalChild{}), nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -500,358 +808,3318 @@ type ExternalChildORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]ExternalChildORM) error
 }
 
-// DefaultCreateBlogPost executes a basic gorm create call
-func DefaultCreateBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) (*BlogPost, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
+// DefaultCountExternalChild returns the number of rows DefaultListExternalChild pages through
+func DefaultCountExternalChild(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := ExternalChild{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
 	}
-	if hook, ok := interface{}(&ormObj).(BlogPostORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
 		}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	return paging.Count(db.Where(&ormObj), &ExternalChildORM{}, strategy)
 }
 
-type BlogPostORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+type ExternalChildORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type BlogPostORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+
+// ExternalChildConflictTargets maps the conflict targets accepted by DefaultUpsertExternalChild to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var ExternalChildConflictTargets = map[string][]string{
+	"id": {"id"},
 }
 
-func DefaultReadBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) (*BlogPost, error) {
+// DefaultUpsertExternalChild inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertExternalChild(ctx context.Context, in *ExternalChild, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*ExternalChild, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
+	if err := in.Validate(); err != nil {
 		return nil, err
 	}
-	if ormObj.Id == 0 {
-		return nil, errors.EmptyIdError
+	conflict := insert.OnConflict{
+		Columns:   ExternalChildConflictTargets[target],
+		UpdateAll: updateMask == nil,
 	}
-	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeReadApplyQuery); ok {
-		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for ExternalChild", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertExternalChildColumns(updateMask)
+		if err != nil {
 			return nil, err
 		}
+		conflict.Update = columns
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &BlogPostORM{}); err != nil {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeReadFind); ok {
-		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := BlogPostORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(BlogPostORMWithAfterReadFind); ok {
-		if err = hook.AfterReadFind(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := ormResponse.ToPB(ctx)
+	pbResponse, err := ormObj.ToPB(ctx)
 	return &pbResponse, err
 }
 
-type BlogPostORMWithBeforeReadApplyQuery interface {
-	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+type ExternalChildORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type BlogPostORMWithBeforeReadFind interface {
-	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+type ExternalChildORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
 }
-type BlogPostORMWithAfterReadFind interface {
-	AfterReadFind(context.Context, *gorm.DB) error
+
+// upsertExternalChildColumns returns the columns of the fields of updateMask the upserts of
+// ExternalChild overwrite, the immutable fields and the paths of no column being rejected
+func upsertExternalChildColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
+		}
+	}
+	return columns, nil
 }
 
-func DefaultDeleteBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
+// ExternalChildRepository runs the Default operations of ExternalChild, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type ExternalChildRepository interface {
+	Create(ctx context.Context, in *ExternalChild) (*ExternalChild, error)
+	CreateSet(ctx context.Context, in []*ExternalChild, batchSize int) ([]*ExternalChild, error)
+	Read(ctx context.Context, in *ExternalChild, fs *query.FieldSelection) (*ExternalChild, error)
+	StrictUpdate(ctx context.Context, in *ExternalChild) (*ExternalChild, error)
+	Patch(ctx context.Context, in *ExternalChild, updateMask *field_mask.FieldMask) (*ExternalChild, error)
+	PatchSet(ctx context.Context, objects []*ExternalChild, updateMasks []*field_mask.FieldMask) ([]*ExternalChild, error)
+	Delete(ctx context.Context, in *ExternalChild) error
+	DeleteSet(ctx context.Context, in []*ExternalChild) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*ExternalChild, error)
+	PageToken(ctx context.Context, last *ExternalChild, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *ExternalChild, target string, updateMask *field_mask.FieldMask) (*ExternalChild, error)
+}
+
+// GormExternalChildRepository is the ExternalChildRepository calling the Default handlers with DB
+type GormExternalChildRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormExternalChildRepository returns the ExternalChildRepository running the operations with db
+func NewGormExternalChildRepository(db *gorm.DB) *GormExternalChildRepository {
+	return &GormExternalChildRepository{DB: db}
+}
+
+func (r *GormExternalChildRepository) Create(ctx context.Context, in *ExternalChild) (*ExternalChild, error) {
+	return DefaultCreateExternalChild(ctx, in, r.DB)
+}
+
+func (r *GormExternalChildRepository) CreateSet(ctx context.Context, in []*ExternalChild, batchSize int) ([]*ExternalChild, error) {
+	return DefaultCreateExternalChildSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormExternalChildRepository) Read(ctx context.Context, in *ExternalChild, fs *query.FieldSelection) (*ExternalChild, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of ExternalChild doesn't support field selection")
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return err
+	return DefaultReadExternalChild(ctx, in, r.DB)
+}
+
+func (r *GormExternalChildRepository) StrictUpdate(ctx context.Context, in *ExternalChild) (*ExternalChild, error) {
+	return DefaultStrictUpdateExternalChild(ctx, in, r.DB)
+}
+
+func (r *GormExternalChildRepository) Patch(ctx context.Context, in *ExternalChild, updateMask *field_mask.FieldMask) (*ExternalChild, error) {
+	return DefaultPatchExternalChild(ctx, in, updateMask, r.DB)
+}
+
+func (r *GormExternalChildRepository) PatchSet(ctx context.Context, objects []*ExternalChild, updateMasks []*field_mask.FieldMask) ([]*ExternalChild, error) {
+	return DefaultPatchSetExternalChild(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormExternalChildRepository) Delete(ctx context.Context, in *ExternalChild) error {
+	return DefaultDeleteExternalChild(ctx, in, r.DB)
+}
+
+func (r *GormExternalChildRepository) DeleteSet(ctx context.Context, in []*ExternalChild) error {
+	return DefaultDeleteExternalChildSet(ctx, in, r.DB)
+}
+
+func (r *GormExternalChildRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*ExternalChild, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of ExternalChild doesn't support filtering")
 	}
-	if ormObj.Id == 0 {
-		return errors.EmptyIdError
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of ExternalChild doesn't support sorting")
 	}
-	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeDelete_); ok {
-		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
-			return err
-		}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of ExternalChild doesn't support pagination")
 	}
-	err = db.Where(&ormObj).Delete(&BlogPostORM{}).Error
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of ExternalChild doesn't support field selection")
+	}
+	return DefaultListExternalChild(ctx, r.DB)
+}
+
+func (r *GormExternalChildRepository) PageToken(ctx context.Context, last *ExternalChild, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
 	if err != nil {
-		return err
+		return "", err
 	}
-	if hook, ok := interface{}(&ormObj).(BlogPostORMWithAfterDelete_); ok {
-		err = hook.AfterDelete_(ctx, db)
+	keyset, err := paging.NewKeyset(r.DB, &ExternalChildORM{}, s, f)
+	if err != nil {
+		return "", err
 	}
-	return err
+	return keyset.Token(&ormObj)
 }
 
-type BlogPostORMWithBeforeDelete_ interface {
-	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+func (r *GormExternalChildRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of ExternalChild doesn't support filtering")
+	}
+	return DefaultCountExternalChild(ctx, r.DB, strategy)
 }
-type BlogPostORMWithAfterDelete_ interface {
-	AfterDelete_(context.Context, *gorm.DB) error
+
+func (r *GormExternalChildRepository) Upsert(ctx context.Context, in *ExternalChild, target string, updateMask *field_mask.FieldMask) (*ExternalChild, error) {
+	return DefaultUpsertExternalChild(ctx, in, target, updateMask, r.DB)
 }
 
-func DefaultDeleteBlogPostSet(ctx context.Context, in []*BlogPost, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
+// MemoryExternalChildRepository is the ExternalChildRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryExternalChildRepository struct {
+	table memory.Table
+}
+
+// NewMemoryExternalChildRepository returns an empty MemoryExternalChildRepository
+func NewMemoryExternalChildRepository() *MemoryExternalChildRepository {
+	return &MemoryExternalChildRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryExternalChildRepository) row(ctx context.Context, in, stored *ExternalChild) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
 	}
-	var err error
-	keys := []uint64{}
-	for _, obj := range in {
-		ormObj, err := obj.ToORM(ctx)
-		if err != nil {
-			return err
-		}
-		if ormObj.Id == 0 {
-			return errors.EmptyIdError
-		}
-		keys = append(keys, ormObj.Id)
-	}
-	if hook, ok := (interface{}(&BlogPostORM{})).(BlogPostORMWithBeforeDeleteSet); ok {
-		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
-			return err
-		}
-	}
-	err = db.Where("id in (?)", keys).Delete(&BlogPostORM{}).Error
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
 	if err != nil {
-		return err
-	}
-	if hook, ok := (interface{}(&BlogPostORM{})).(BlogPostORMWithAfterDeleteSet); ok {
-		err = hook.AfterDeleteSet(ctx, in, db)
+		return memory.Row{}, err
 	}
-	return err
+	return memory.Row{Tenant: nil, Key: ormObj.Id, Object: &out}, nil
 }
 
-type BlogPostORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*BlogPost, *gorm.DB) (*gorm.DB, error)
-}
-type BlogPostORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*BlogPost, *gorm.DB) error
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryExternalChildRepository) tenant(ctx context.Context) (interface{}, error) {
+	_, err := (&ExternalChild{}).ToORM(ctx)
+	return nil, err
 }
 
-// DefaultStrictUpdateBlogPost clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) (*BlogPost, error) {
+func (r *MemoryExternalChildRepository) Create(ctx context.Context, in *ExternalChild) (*ExternalChild, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateBlogPost")
+		return nil, errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
 	if err != nil {
 		return nil, err
 	}
-	var count int64
-	lockedRow := &BlogPostORM{}
-	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeStrictUpdateCleanup); ok {
-		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*ExternalChild), nil
+}
+
+func (r *MemoryExternalChildRepository) CreateSet(ctx context.Context, in []*ExternalChild, batchSize int) ([]*ExternalChild, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*ExternalChild, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
 			return nil, err
 		}
-	}
-	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeStrictUpdateSave); ok {
-		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
 			return nil, err
 		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*ExternalChild))
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	if err := r.table.Insert(rows...); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(BlogPostORMWithAfterStrictUpdateSave); ok {
-		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
-			return nil, err
-		}
+	return out, nil
+}
+
+func (r *MemoryExternalChildRepository) Read(ctx context.Context, in *ExternalChild, fs *query.FieldSelection) (*ExternalChild, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
+	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if count == 0 {
-		err = gateway.SetCreated(ctx, "")
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
 	}
-	return &pbResponse, err
-}
-
-type BlogPostORMWithBeforeStrictUpdateCleanup interface {
-	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type BlogPostORMWithBeforeStrictUpdateSave interface {
-	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type BlogPostORMWithAfterStrictUpdateSave interface {
-	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+	out, ok := r.table.Get(nil, ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*ExternalChild), nil
 }
 
-// DefaultPatchBlogPost executes a basic gorm update call with patch behavior
-func DefaultPatchBlogPost(ctx context.Context, in *BlogPost, updateMask *field_mask.FieldMask, db *gorm.DB) (*BlogPost, error) {
+func (r *MemoryExternalChildRepository) StrictUpdate(ctx context.Context, in *ExternalChild) (*ExternalChild, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj BlogPost
-	var err error
-	if hook, ok := interface{}(&pbObj).(BlogPostWithBeforePatchRead); ok {
-		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	if err := in.Validate(); err != nil {
+		return nil, err
 	}
-	pbReadRes, err := DefaultReadBlogPost(ctx, &BlogPost{Id: in.GetId()}, db)
+	row, err := r.row(ctx, in, nil)
 	if err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(BlogPostWithBeforePatchApplyFieldMask); ok {
-		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	if _, err := DefaultApplyFieldMaskBlogPost(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err = r.table.Save(row); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(BlogPostWithBeforePatchSave); ok {
-		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	return row.Object.(*ExternalChild), nil
+}
+
+func (r *MemoryExternalChildRepository) Patch(ctx context.Context, in *ExternalChild, updateMask *field_mask.FieldMask) (*ExternalChild, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
 	}
-	pbResponse, err := DefaultStrictUpdateBlogPost(ctx, &pbObj, db)
+	patchee, err := r.Read(ctx, &ExternalChild{Id: in.GetId()}, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(BlogPostWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	if patchee, err = DefaultApplyFieldMaskExternalChild(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
 	}
-	return pbResponse, nil
-}
-
-type BlogPostWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *BlogPost, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type BlogPostWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *BlogPost, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type BlogPostWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *BlogPost, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type BlogPostWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *BlogPost, *field_mask.FieldMask, *gorm.DB) error
+	return r.StrictUpdate(ctx, patchee)
 }
 
-// DefaultPatchSetBlogPost executes a bulk gorm update call with patch behavior
-func DefaultPatchSetBlogPost(ctx context.Context, objects []*BlogPost, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*BlogPost, error) {
+func (r *MemoryExternalChildRepository) PatchSet(ctx context.Context, objects []*ExternalChild, updateMasks []*field_mask.FieldMask) ([]*ExternalChild, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*BlogPost, 0, len(objects))
+	out := make([]*ExternalChild, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchBlogPost(ctx, patcher, updateMasks[i], db)
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
+		out = append(out, patched)
 	}
+	return out, nil
+}
 
-	return results, nil
+func (r *MemoryExternalChildRepository) Delete(ctx context.Context, in *ExternalChild) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(nil, ormObj.Id)
+	return nil
 }
 
-// DefaultApplyFieldMaskBlogPost patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskBlogPost(ctx context.Context, patchee *BlogPost, patcher *BlogPost, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*BlogPost, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
+func (r *MemoryExternalChildRepository) DeleteSet(ctx context.Context, in []*ExternalChild) error {
+	if in == nil {
+		return errors.NilArgumentError
 	}
-	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
-		}
-		if f == prefix+"Title" {
-			patchee.Title = patcher.Title
-			continue
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
 		}
-		if f == prefix+"Author" {
-			patchee.Author = patcher.Author
-			continue
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
 		}
+		keys = append(keys, ormObj.Id)
 	}
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryExternalChildRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*ExternalChild, error) {
+	tenant, err := r.tenant(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return patchee, nil
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*ExternalChild, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*ExternalChild))
+	}
+	return out, nil
 }
 
-// DefaultListBlogPost executes a gorm list call
-func DefaultListBlogPost(ctx context.Context, db *gorm.DB) ([]*BlogPost, error) {
-	in := BlogPost{}
-	ormObj, err := in.ToORM(ctx)
+func (r *MemoryExternalChildRepository) PageToken(ctx context.Context, last *ExternalChild, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryExternalChildRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
 	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryExternalChildRepository) Upsert(ctx context.Context, in *ExternalChild, target string, updateMask *field_mask.FieldMask) (*ExternalChild, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := ExternalChildConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for ExternalChild", target)
+	}
+	if _, err := upsertExternalChildColumns(updateMask); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &BlogPostORM{}, &BlogPost{}, nil, nil, nil, nil)
+	found, ok, err := r.table.Conflicting(nil, func(m proto.Message) bool {
+		other, err := m.(*ExternalChild).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+	if !ok {
+		return r.Create(ctx, in)
+	}
+	existing := found.(*ExternalChild)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskExternalChild(ctx, existing, in, updateMask, "", nil)
+		if err != nil {
 			return nil, err
 		}
+		return r.StrictUpdate(ctx, patched)
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []BlogPostORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*ExternalChild)
+	updated.Id = existing.Id
+	return r.StrictUpdate(ctx, updated)
+}
+
+// ExternalChildFixtureOption customizes the object returned by NewExternalChildFixture
+type ExternalChildFixtureOption func(*ExternalChild)
+
+// NewExternalChildFixture returns a ExternalChild with random valid values in its fields and
+// its required associations, customized by opts
+func NewExternalChildFixture(opts ...ExternalChildFixtureOption) *ExternalChild {
+	m := &ExternalChild{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CreateExternalChildFixture creates the object returned by NewExternalChildFixture and its
+// associations with DefaultCreateExternalChild
+func CreateExternalChildFixture(ctx context.Context, db *gorm.DB, opts ...ExternalChildFixtureOption) (*ExternalChild, error) {
+	return DefaultCreateExternalChild(ctx, NewExternalChildFixture(opts...), db)
+}
+
+// DefaultCreateBlogPost executes a basic gorm create call
+func DefaultCreateBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) (*BlogPost, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(BlogPostORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// output only fields are managed by the server
+	blank := BlogPostORM{}
+	ormObj.CreatedAt = blank.CreatedAt
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*BlogPost{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
-		if err != nil {
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
 	}
-	return pbResponse, nil
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
 }
 
-type BlogPostORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+type BlogPostORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type BlogPostORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+type BlogPostORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
 }
-type BlogPostORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]BlogPostORM) error
+
+// DefaultCreateBlogPostSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateBlogPostSet(ctx context.Context, in []*BlogPost, db *gorm.DB, batchSize int) ([]*BlogPost, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*BlogPostORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// output only fields are managed by the server
+		blank := BlogPostORM{}
+		ormObj.CreatedAt = blank.CreatedAt
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&BlogPostORM{})).(BlogPostORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(BlogPostORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(BlogPostORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&BlogPostORM{})).(BlogPostORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*BlogPost, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type BlogPostORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*BlogPostORM, *gorm.DB) (*gorm.DB, error)
+}
+type BlogPostORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*BlogPostORM, *gorm.DB) error
+}
+
+func DefaultReadBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) (*BlogPost, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelectionEx(ctx, db, nil, &BlogPostORM{}, preload.NewConverter(&BlogPost{})); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := BlogPostORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(BlogPostORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type BlogPostORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BlogPostORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BlogPostORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&BlogPostORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type BlogPostORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BlogPostORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteBlogPostSet(ctx context.Context, in []*BlogPost, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&BlogPostORM{})).(BlogPostORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&BlogPostORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&BlogPostORM{})).(BlogPostORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type BlogPostORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*BlogPost, *gorm.DB) (*gorm.DB, error)
+}
+type BlogPostORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*BlogPost, *gorm.DB) error
+}
+
+// DefaultStrictUpdateBlogPost clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) (*BlogPost, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateBlogPost")
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &BlogPostORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if count > 0 {
+		// output only and immutable fields keep their stored values
+		ormObj.CreatedAt = lockedRow.CreatedAt
+		ormObj.Slug = lockedRow.Slug
+	} else {
+		blank := BlogPostORM{}
+		ormObj.CreatedAt = blank.CreatedAt
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type BlogPostORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BlogPostORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BlogPostORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchBlogPost executes a basic gorm update call with patch behavior
+func DefaultPatchBlogPost(ctx context.Context, in *BlogPost, updateMask *field_mask.FieldMask, db *gorm.DB) (*BlogPost, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj BlogPost
+	var err error
+	if hook, ok := interface{}(&pbObj).(BlogPostWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadBlogPost(ctx, &BlogPost{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(BlogPostWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskBlogPost(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(BlogPostWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateBlogPost(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(BlogPostWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type BlogPostWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *BlogPost, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type BlogPostWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *BlogPost, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type BlogPostWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *BlogPost, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type BlogPostWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *BlogPost, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetBlogPost executes a bulk gorm update call with patch behavior
+func DefaultPatchSetBlogPost(ctx context.Context, objects []*BlogPost, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*BlogPost, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*BlogPost, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchBlogPost(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskBlogPost patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskBlogPost(ctx context.Context, patchee *BlogPost, patcher *BlogPost, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*BlogPost, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Title" {
+			patchee.Title = patcher.Title
+			continue
+		}
+		if f == prefix+"Author" {
+			patchee.Author = patcher.Author
+			continue
+		}
+		if f == prefix+"CreatedAt" || strings.HasPrefix(f, prefix+"CreatedAt.") {
+			// output only fields are ignored
+			continue
+		}
+		if f == prefix+"Slug" || strings.HasPrefix(f, prefix+"Slug.") {
+			return nil, status.Errorf(codes.InvalidArgument, "field %q is immutable", f)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListBlogPost executes a gorm list call
+func DefaultListBlogPost(ctx context.Context, db *gorm.DB, f *query.Filtering, s *query.Sorting, p *query.Pagination) ([]*BlogPost, error) {
+	in := BlogPost{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db, f, s, p); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &BlogPostORM{}, preload.NewConverter(&BlogPost{}), f, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db, f, s, p); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	keyset, err := paging.NewKeyset(db, &BlogPostORM{}, s, f)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = keyset.Apply(db, p.GetPageToken()); err != nil {
+		return nil, err
+	}
+	if p.GetLimit() > 0 {
+		db = db.Limit(p.GetLimit())
+	}
+	ormResponse := []BlogPostORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse, f, s, p); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*BlogPost{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type BlogPostORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB, *query.Filtering, *query.Sorting, *query.Pagination) (*gorm.DB, error)
+}
+type BlogPostORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB, *query.Filtering, *query.Sorting, *query.Pagination) (*gorm.DB, error)
+}
+type BlogPostORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]BlogPostORM, *query.Filtering, *query.Sorting, *query.Pagination) error
+}
+
+// DefaultCountBlogPost returns the number of rows DefaultListBlogPost pages through
+func DefaultCountBlogPost(ctx context.Context, db *gorm.DB, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	in := BlogPost{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db, f); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db, f, nil, nil); err != nil {
+			return 0, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &BlogPostORM{}, &BlogPost{}, f, nil, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db, f, nil, nil); err != nil {
+			return 0, err
+		}
+	}
+	return paging.Count(db.Where(&ormObj), &BlogPostORM{}, strategy)
+}
+
+type BlogPostORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB, *query.Filtering) (*gorm.DB, error)
+}
+
+// DefaultStreamBlogPost executes gorm list calls of preload.BatchSize rows and sends the rows one by
+// one. Each batch is read and its cursor closed before its associations are loaded, the drivers
+// not running a query while a cursor is open in a transaction, so that the rows written by
+// others between batches may be skipped or sent twice outside a repeatable read transaction
+func DefaultStreamBlogPost(ctx context.Context, db *gorm.DB, f *query.Filtering, s *query.Sorting, fs *query.FieldSelection, send func(*BlogPost) error) error {
+	in := BlogPost{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeStream); ok {
+		if db, err = hook.BeforeStream(ctx, db, f, s, fs); err != nil {
+			return err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &BlogPostORM{}, preload.NewConverter(&BlogPost{}), f, s, nil, fs)
+	if err != nil {
+		return err
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	offset, limit := 0, 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		size := preload.BatchSize
+		if limit > 0 && limit < size {
+			size = limit
+		}
+		batch := make([]BlogPostORM, 0, size)
+		if err := db.Offset(offset).Limit(size).Find(&batch).Error; err != nil {
+			return err
+		}
+		for _, row := range batch {
+			pbRow, err := row.ToPB(ctx)
+			if err != nil {
+				return err
+			}
+			if err := send(&pbRow); err != nil {
+				return err
+			}
+		}
+		if len(batch) < size || limit == size {
+			return nil
+		}
+		offset += size
+		if limit > 0 {
+			limit -= size
+		}
+	}
+}
+
+type BlogPostORMWithBeforeStream interface {
+	BeforeStream(context.Context, *gorm.DB, *query.Filtering, *query.Sorting, *query.FieldSelection) (*gorm.DB, error)
+}
+
+// BlogPostConflictTargets maps the conflict targets accepted by DefaultUpsertBlogPost to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var BlogPostConflictTargets = map[string][]string{
+	"id":                 {"id"},
+	"idx_blog_post_slug": {"slug"},
+}
+
+// DefaultUpsertBlogPost inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertBlogPost(ctx context.Context, in *BlogPost, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*BlogPost, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	conflict := insert.OnConflict{
+		Columns:   BlogPostConflictTargets[target],
+		UpdateAll: updateMask == nil,
+		Keep:      []string{"created_at", "slug"},
+	}
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for BlogPost", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertBlogPostColumns(updateMask)
+		if err != nil {
+			return nil, err
+		}
+		conflict.Update = columns
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// output only fields are managed by the server
+	blank := BlogPostORM{}
+	ormObj.CreatedAt = blank.CreatedAt
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type BlogPostORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type BlogPostORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// upsertBlogPostColumns returns the columns of the fields of updateMask the upserts of
+// BlogPost overwrite, the immutable fields and the paths of no column being rejected
+func upsertBlogPostColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		case "Title":
+			columns = append(columns, "title")
+		case "Author":
+			columns = append(columns, "author")
+		case "Slug":
+			return nil, status.Errorf(codes.InvalidArgument, "field %q is immutable", f)
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id", "CreatedAt":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
+		}
+	}
+	return columns, nil
+}
+
+// BlogPostRepository runs the Default operations of BlogPost, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type BlogPostRepository interface {
+	Create(ctx context.Context, in *BlogPost) (*BlogPost, error)
+	CreateSet(ctx context.Context, in []*BlogPost, batchSize int) ([]*BlogPost, error)
+	Read(ctx context.Context, in *BlogPost, fs *query.FieldSelection) (*BlogPost, error)
+	StrictUpdate(ctx context.Context, in *BlogPost) (*BlogPost, error)
+	Patch(ctx context.Context, in *BlogPost, updateMask *field_mask.FieldMask) (*BlogPost, error)
+	PatchSet(ctx context.Context, objects []*BlogPost, updateMasks []*field_mask.FieldMask) ([]*BlogPost, error)
+	Delete(ctx context.Context, in *BlogPost) error
+	DeleteSet(ctx context.Context, in []*BlogPost) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*BlogPost, error)
+	PageToken(ctx context.Context, last *BlogPost, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Stream(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection, send func(*BlogPost) error) error
+	Upsert(ctx context.Context, in *BlogPost, target string, updateMask *field_mask.FieldMask) (*BlogPost, error)
+}
+
+// GormBlogPostRepository is the BlogPostRepository calling the Default handlers with DB
+type GormBlogPostRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormBlogPostRepository returns the BlogPostRepository running the operations with db
+func NewGormBlogPostRepository(db *gorm.DB) *GormBlogPostRepository {
+	return &GormBlogPostRepository{DB: db}
+}
+
+func (r *GormBlogPostRepository) Create(ctx context.Context, in *BlogPost) (*BlogPost, error) {
+	return DefaultCreateBlogPost(ctx, in, r.DB)
+}
+
+func (r *GormBlogPostRepository) CreateSet(ctx context.Context, in []*BlogPost, batchSize int) ([]*BlogPost, error) {
+	return DefaultCreateBlogPostSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormBlogPostRepository) Read(ctx context.Context, in *BlogPost, fs *query.FieldSelection) (*BlogPost, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of BlogPost doesn't support field selection")
+	}
+	return DefaultReadBlogPost(ctx, in, r.DB)
+}
+
+func (r *GormBlogPostRepository) StrictUpdate(ctx context.Context, in *BlogPost) (*BlogPost, error) {
+	return DefaultStrictUpdateBlogPost(ctx, in, r.DB)
+}
+
+func (r *GormBlogPostRepository) Patch(ctx context.Context, in *BlogPost, updateMask *field_mask.FieldMask) (*BlogPost, error) {
+	return DefaultPatchBlogPost(ctx, in, updateMask, r.DB)
+}
+
+func (r *GormBlogPostRepository) PatchSet(ctx context.Context, objects []*BlogPost, updateMasks []*field_mask.FieldMask) ([]*BlogPost, error) {
+	return DefaultPatchSetBlogPost(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormBlogPostRepository) Delete(ctx context.Context, in *BlogPost) error {
+	return DefaultDeleteBlogPost(ctx, in, r.DB)
+}
+
+func (r *GormBlogPostRepository) DeleteSet(ctx context.Context, in []*BlogPost) error {
+	return DefaultDeleteBlogPostSet(ctx, in, r.DB)
+}
+
+func (r *GormBlogPostRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*BlogPost, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of BlogPost doesn't support field selection")
+	}
+	return DefaultListBlogPost(ctx, r.DB, f, s, p)
+}
+
+func (r *GormBlogPostRepository) PageToken(ctx context.Context, last *BlogPost, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	keyset, err := paging.NewKeyset(r.DB, &BlogPostORM{}, s, f)
+	if err != nil {
+		return "", err
+	}
+	return keyset.Token(&ormObj)
+}
+
+func (r *GormBlogPostRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	return DefaultCountBlogPost(ctx, r.DB, f, strategy)
+}
+
+func (r *GormBlogPostRepository) Stream(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection, send func(*BlogPost) error) error {
+	if p != nil {
+		return status.Errorf(codes.InvalidArgument, "Stream of BlogPost doesn't support pagination")
+	}
+	return DefaultStreamBlogPost(ctx, r.DB, f, s, fs, send)
+}
+
+func (r *GormBlogPostRepository) Upsert(ctx context.Context, in *BlogPost, target string, updateMask *field_mask.FieldMask) (*BlogPost, error) {
+	return DefaultUpsertBlogPost(ctx, in, target, updateMask, r.DB)
+}
+
+// MemoryBlogPostRepository is the BlogPostRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryBlogPostRepository struct {
+	table memory.Table
+}
+
+// NewMemoryBlogPostRepository returns an empty MemoryBlogPostRepository
+func NewMemoryBlogPostRepository() *MemoryBlogPostRepository {
+	return &MemoryBlogPostRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryBlogPostRepository) row(ctx context.Context, in, stored *BlogPost) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	if stored != nil {
+		storedObj, err := stored.ToORM(ctx)
+		if err != nil {
+			return memory.Row{}, err
+		}
+		ormObj.CreatedAt = storedObj.CreatedAt
+		ormObj.Slug = storedObj.Slug
+	} else {
+		blank := BlogPostORM{}
+		ormObj.CreatedAt = blank.CreatedAt
+	}
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: nil, Key: ormObj.Id, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryBlogPostRepository) tenant(ctx context.Context) (interface{}, error) {
+	_, err := (&BlogPost{}).ToORM(ctx)
+	return nil, err
+}
+
+func (r *MemoryBlogPostRepository) Create(ctx context.Context, in *BlogPost) (*BlogPost, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*BlogPost), nil
+}
+
+func (r *MemoryBlogPostRepository) CreateSet(ctx context.Context, in []*BlogPost, batchSize int) ([]*BlogPost, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*BlogPost, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*BlogPost))
+	}
+	if err := r.table.Insert(rows...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *MemoryBlogPostRepository) Read(ctx context.Context, in *BlogPost, fs *query.FieldSelection) (*BlogPost, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	out, ok := r.table.Get(nil, ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*BlogPost), nil
+}
+
+func (r *MemoryBlogPostRepository) StrictUpdate(ctx context.Context, in *BlogPost) (*BlogPost, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var stored *BlogPost
+	if object, ok := r.table.Get(nil, ormObj.Id); ok {
+		stored = object.(*BlogPost)
+	}
+	row, err := r.row(ctx, in, stored)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.table.Save(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*BlogPost), nil
+}
+
+func (r *MemoryBlogPostRepository) Patch(ctx context.Context, in *BlogPost, updateMask *field_mask.FieldMask) (*BlogPost, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	patchee, err := r.Read(ctx, &BlogPost{Id: in.GetId()}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if patchee, err = DefaultApplyFieldMaskBlogPost(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
+	}
+	return r.StrictUpdate(ctx, patchee)
+}
+
+func (r *MemoryBlogPostRepository) PatchSet(ctx context.Context, objects []*BlogPost, updateMasks []*field_mask.FieldMask) ([]*BlogPost, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	out := make([]*BlogPost, 0, len(objects))
+	for i, patcher := range objects {
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, patched)
+	}
+	return out, nil
+}
+
+func (r *MemoryBlogPostRepository) Delete(ctx context.Context, in *BlogPost) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(nil, ormObj.Id)
+	return nil
+}
+
+func (r *MemoryBlogPostRepository) DeleteSet(ctx context.Context, in []*BlogPost) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryBlogPostRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*BlogPost, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*BlogPost, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*BlogPost))
+	}
+	return out, nil
+}
+
+func (r *MemoryBlogPostRepository) PageToken(ctx context.Context, last *BlogPost, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryBlogPostRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryBlogPostRepository) Stream(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection, send func(*BlogPost) error) error {
+	objects, err := r.List(ctx, f, s, p, fs)
+	if err != nil {
+		return err
+	}
+	for _, object := range objects {
+		if err = send(object); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *MemoryBlogPostRepository) Upsert(ctx context.Context, in *BlogPost, target string, updateMask *field_mask.FieldMask) (*BlogPost, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := BlogPostConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for BlogPost", target)
+	}
+	if _, err := upsertBlogPostColumns(updateMask); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found, ok, err := r.table.Conflicting(nil, func(m proto.Message) bool {
+		other, err := m.(*BlogPost).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r.Create(ctx, in)
+	}
+	existing := found.(*BlogPost)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskBlogPost(ctx, existing, in, updateMask, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		return r.StrictUpdate(ctx, patched)
+	}
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*BlogPost)
+	updated.Id = existing.Id
+	updated.CreatedAt = existing.CreatedAt
+	updated.Slug = existing.Slug
+	return r.StrictUpdate(ctx, updated)
+}
+
+// BlogPostFixtureOption customizes the object returned by NewBlogPostFixture
+type BlogPostFixtureOption func(*BlogPost)
+
+// NewBlogPostFixture returns a BlogPost with random valid values in its fields and
+// its required associations, customized by opts
+func NewBlogPostFixture(opts ...BlogPostFixtureOption) *BlogPost {
+	m := &BlogPost{}
+	m.Title = fixture.String(0)
+	m.Author = fixture.String(0)
+	m.CreatedAt = timestamppb.New(fixture.Time())
+	m.Slug = fixture.String(0)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CreateBlogPostFixture creates the object returned by NewBlogPostFixture and its
+// associations with DefaultCreateBlogPost
+func CreateBlogPostFixture(ctx context.Context, db *gorm.DB, opts ...BlogPostFixtureOption) (*BlogPost, error) {
+	return DefaultCreateBlogPost(ctx, NewBlogPostFixture(opts...), db)
+}
+
+// DefaultCreateThread executes a basic gorm create call
+func DefaultCreateThread(ctx context.Context, in *Thread, db *gorm.DB) (*Thread, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ThreadORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ThreadORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ThreadORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ThreadORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateThreadSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateThreadSet(ctx context.Context, in []*Thread, db *gorm.DB, batchSize int) ([]*Thread, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*ThreadORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&ThreadORM{})).(ThreadORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(ThreadORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(ThreadORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&ThreadORM{})).(ThreadORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*Thread, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type ThreadORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*ThreadORM, *gorm.DB) (*gorm.DB, error)
+}
+type ThreadORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*ThreadORM, *gorm.DB) error
+}
+
+func DefaultReadThread(ctx context.Context, in *Thread, db *gorm.DB) (*Thread, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ThreadORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelectionEx(ctx, db, nil, &ThreadORM{}, preload.NewConverter(&Thread{})); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ThreadORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := ThreadORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(ThreadORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ThreadORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ThreadORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ThreadORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteThread(ctx context.Context, in *Thread, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ThreadORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&ThreadORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(ThreadORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type ThreadORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ThreadORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteThreadSet(ctx context.Context, in []*Thread, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&ThreadORM{})).(ThreadORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&ThreadORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&ThreadORM{})).(ThreadORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type ThreadORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Thread, *gorm.DB) (*gorm.DB, error)
+}
+type ThreadORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Thread, *gorm.DB) error
+}
+
+// DefaultStrictUpdateThread clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateThread(ctx context.Context, in *Thread, db *gorm.DB) (*Thread, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateThread")
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &ThreadORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(ThreadORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterReplies := ReplyORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterReplies.ThreadId = ormObj.Id
+	if err = db.Where(filterReplies).Delete(ReplyORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ThreadORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ThreadORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type ThreadORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ThreadORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ThreadORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchThread executes a basic gorm update call with patch behavior
+func DefaultPatchThread(ctx context.Context, in *Thread, updateMask *field_mask.FieldMask, db *gorm.DB) (*Thread, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Thread
+	var err error
+	if hook, ok := interface{}(&pbObj).(ThreadWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadThread(ctx, &Thread{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(ThreadWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskThread(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(ThreadWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateThread(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(ThreadWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type ThreadWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Thread, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ThreadWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Thread, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ThreadWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Thread, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ThreadWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Thread, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetThread executes a bulk gorm update call with patch behavior
+func DefaultPatchSetThread(ctx context.Context, objects []*Thread, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Thread, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Thread, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchThread(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskThread patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskThread(ctx context.Context, patchee *Thread, patcher *Thread, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Thread, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Title" {
+			patchee.Title = patcher.Title
+			continue
+		}
+		if f == prefix+"Replies" {
+			patchee.Replies = patcher.Replies
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListThread executes a gorm list call
+func DefaultListThread(ctx context.Context, db *gorm.DB) ([]*Thread, error) {
+	in := Thread{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ThreadORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &ThreadORM{}, preload.NewConverter(&Thread{}), nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ThreadORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []ThreadORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ThreadORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Thread{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type ThreadORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ThreadORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ThreadORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ThreadORM) error
+}
+
+// DefaultCountThread returns the number of rows DefaultListThread pages through
+func DefaultCountThread(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := Thread{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(ThreadORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ThreadORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ThreadORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	return paging.Count(db.Where(&ormObj), &ThreadORM{}, strategy)
+}
+
+type ThreadORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// ThreadConflictTargets maps the conflict targets accepted by DefaultUpsertThread to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var ThreadConflictTargets = map[string][]string{
+	"id": {"id"},
+}
+
+// DefaultUpsertThread inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertThread(ctx context.Context, in *Thread, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*Thread, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	conflict := insert.OnConflict{
+		Columns:   ThreadConflictTargets[target],
+		UpdateAll: updateMask == nil,
+	}
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for Thread", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertThreadColumns(updateMask)
+		if err != nil {
+			return nil, err
+		}
+		conflict.Update = columns
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ThreadORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ThreadORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ThreadORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ThreadORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// upsertThreadColumns returns the columns of the fields of updateMask the upserts of
+// Thread overwrite, the immutable fields and the paths of no column being rejected
+func upsertThreadColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		case "Title":
+			columns = append(columns, "title")
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
+		}
+	}
+	return columns, nil
+}
+
+// ThreadRepository runs the Default operations of Thread, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type ThreadRepository interface {
+	Create(ctx context.Context, in *Thread) (*Thread, error)
+	CreateSet(ctx context.Context, in []*Thread, batchSize int) ([]*Thread, error)
+	Read(ctx context.Context, in *Thread, fs *query.FieldSelection) (*Thread, error)
+	StrictUpdate(ctx context.Context, in *Thread) (*Thread, error)
+	Patch(ctx context.Context, in *Thread, updateMask *field_mask.FieldMask) (*Thread, error)
+	PatchSet(ctx context.Context, objects []*Thread, updateMasks []*field_mask.FieldMask) ([]*Thread, error)
+	Delete(ctx context.Context, in *Thread) error
+	DeleteSet(ctx context.Context, in []*Thread) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Thread, error)
+	PageToken(ctx context.Context, last *Thread, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *Thread, target string, updateMask *field_mask.FieldMask) (*Thread, error)
+}
+
+// GormThreadRepository is the ThreadRepository calling the Default handlers with DB
+type GormThreadRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormThreadRepository returns the ThreadRepository running the operations with db
+func NewGormThreadRepository(db *gorm.DB) *GormThreadRepository {
+	return &GormThreadRepository{DB: db}
+}
+
+func (r *GormThreadRepository) Create(ctx context.Context, in *Thread) (*Thread, error) {
+	return DefaultCreateThread(ctx, in, r.DB)
+}
+
+func (r *GormThreadRepository) CreateSet(ctx context.Context, in []*Thread, batchSize int) ([]*Thread, error) {
+	return DefaultCreateThreadSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormThreadRepository) Read(ctx context.Context, in *Thread, fs *query.FieldSelection) (*Thread, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of Thread doesn't support field selection")
+	}
+	return DefaultReadThread(ctx, in, r.DB)
+}
+
+func (r *GormThreadRepository) StrictUpdate(ctx context.Context, in *Thread) (*Thread, error) {
+	return DefaultStrictUpdateThread(ctx, in, r.DB)
+}
+
+func (r *GormThreadRepository) Patch(ctx context.Context, in *Thread, updateMask *field_mask.FieldMask) (*Thread, error) {
+	return DefaultPatchThread(ctx, in, updateMask, r.DB)
+}
+
+func (r *GormThreadRepository) PatchSet(ctx context.Context, objects []*Thread, updateMasks []*field_mask.FieldMask) ([]*Thread, error) {
+	return DefaultPatchSetThread(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormThreadRepository) Delete(ctx context.Context, in *Thread) error {
+	return DefaultDeleteThread(ctx, in, r.DB)
+}
+
+func (r *GormThreadRepository) DeleteSet(ctx context.Context, in []*Thread) error {
+	return DefaultDeleteThreadSet(ctx, in, r.DB)
+}
+
+func (r *GormThreadRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Thread, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Thread doesn't support filtering")
+	}
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Thread doesn't support sorting")
+	}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Thread doesn't support pagination")
+	}
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Thread doesn't support field selection")
+	}
+	return DefaultListThread(ctx, r.DB)
+}
+
+func (r *GormThreadRepository) PageToken(ctx context.Context, last *Thread, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	keyset, err := paging.NewKeyset(r.DB, &ThreadORM{}, s, f)
+	if err != nil {
+		return "", err
+	}
+	return keyset.Token(&ormObj)
+}
+
+func (r *GormThreadRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of Thread doesn't support filtering")
+	}
+	return DefaultCountThread(ctx, r.DB, strategy)
+}
+
+func (r *GormThreadRepository) Upsert(ctx context.Context, in *Thread, target string, updateMask *field_mask.FieldMask) (*Thread, error) {
+	return DefaultUpsertThread(ctx, in, target, updateMask, r.DB)
+}
+
+// MemoryThreadRepository is the ThreadRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryThreadRepository struct {
+	table memory.Table
+}
+
+// NewMemoryThreadRepository returns an empty MemoryThreadRepository
+func NewMemoryThreadRepository() *MemoryThreadRepository {
+	return &MemoryThreadRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryThreadRepository) row(ctx context.Context, in, stored *Thread) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: nil, Key: ormObj.Id, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryThreadRepository) tenant(ctx context.Context) (interface{}, error) {
+	_, err := (&Thread{}).ToORM(ctx)
+	return nil, err
+}
+
+func (r *MemoryThreadRepository) Create(ctx context.Context, in *Thread) (*Thread, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*Thread), nil
+}
+
+func (r *MemoryThreadRepository) CreateSet(ctx context.Context, in []*Thread, batchSize int) ([]*Thread, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*Thread, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*Thread))
+	}
+	if err := r.table.Insert(rows...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *MemoryThreadRepository) Read(ctx context.Context, in *Thread, fs *query.FieldSelection) (*Thread, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	out, ok := r.table.Get(nil, ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*Thread), nil
+}
+
+func (r *MemoryThreadRepository) StrictUpdate(ctx context.Context, in *Thread) (*Thread, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.table.Save(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*Thread), nil
+}
+
+func (r *MemoryThreadRepository) Patch(ctx context.Context, in *Thread, updateMask *field_mask.FieldMask) (*Thread, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	patchee, err := r.Read(ctx, &Thread{Id: in.GetId()}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if patchee, err = DefaultApplyFieldMaskThread(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
+	}
+	return r.StrictUpdate(ctx, patchee)
+}
+
+func (r *MemoryThreadRepository) PatchSet(ctx context.Context, objects []*Thread, updateMasks []*field_mask.FieldMask) ([]*Thread, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	out := make([]*Thread, 0, len(objects))
+	for i, patcher := range objects {
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, patched)
+	}
+	return out, nil
+}
+
+func (r *MemoryThreadRepository) Delete(ctx context.Context, in *Thread) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(nil, ormObj.Id)
+	return nil
+}
+
+func (r *MemoryThreadRepository) DeleteSet(ctx context.Context, in []*Thread) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryThreadRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Thread, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*Thread, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*Thread))
+	}
+	return out, nil
+}
+
+func (r *MemoryThreadRepository) PageToken(ctx context.Context, last *Thread, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryThreadRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryThreadRepository) Upsert(ctx context.Context, in *Thread, target string, updateMask *field_mask.FieldMask) (*Thread, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := ThreadConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for Thread", target)
+	}
+	if _, err := upsertThreadColumns(updateMask); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found, ok, err := r.table.Conflicting(nil, func(m proto.Message) bool {
+		other, err := m.(*Thread).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r.Create(ctx, in)
+	}
+	existing := found.(*Thread)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskThread(ctx, existing, in, updateMask, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		return r.StrictUpdate(ctx, patched)
+	}
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*Thread)
+	updated.Id = existing.Id
+	return r.StrictUpdate(ctx, updated)
+}
+
+// ThreadFixtureOption customizes the object returned by NewThreadFixture
+type ThreadFixtureOption func(*Thread)
+
+// NewThreadFixture returns a Thread with random valid values in its fields and
+// its required associations, customized by opts
+func NewThreadFixture(opts ...ThreadFixtureOption) *Thread {
+	m := &Thread{}
+	m.Title = fixture.String(0)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CreateThreadFixture creates the object returned by NewThreadFixture and its
+// associations with DefaultCreateThread
+func CreateThreadFixture(ctx context.Context, db *gorm.DB, opts ...ThreadFixtureOption) (*Thread, error) {
+	return DefaultCreateThread(ctx, NewThreadFixture(opts...), db)
+}
+
+// DefaultCreateReply executes a basic gorm create call
+func DefaultCreateReply(ctx context.Context, in *Reply, db *gorm.DB) (*Reply, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ReplyORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ReplyORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ReplyORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ReplyORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateReplySet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateReplySet(ctx context.Context, in []*Reply, db *gorm.DB, batchSize int) ([]*Reply, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*ReplyORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&ReplyORM{})).(ReplyORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(ReplyORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(ReplyORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&ReplyORM{})).(ReplyORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*Reply, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type ReplyORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*ReplyORM, *gorm.DB) (*gorm.DB, error)
+}
+type ReplyORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*ReplyORM, *gorm.DB) error
+}
+
+func DefaultReadReply(ctx context.Context, in *Reply, db *gorm.DB) (*Reply, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ReplyORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelectionEx(ctx, db, nil, &ReplyORM{}, preload.NewConverter(&Reply{})); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ReplyORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := ReplyORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(ReplyORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ReplyORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ReplyORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ReplyORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteReply(ctx context.Context, in *Reply, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ReplyORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&ReplyORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(ReplyORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type ReplyORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ReplyORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteReplySet(ctx context.Context, in []*Reply, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&ReplyORM{})).(ReplyORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&ReplyORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&ReplyORM{})).(ReplyORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type ReplyORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Reply, *gorm.DB) (*gorm.DB, error)
+}
+type ReplyORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Reply, *gorm.DB) error
+}
+
+// DefaultStrictUpdateReply clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateReply(ctx context.Context, in *Reply, db *gorm.DB) (*Reply, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateReply")
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &ReplyORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(ReplyORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ReplyORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ReplyORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type ReplyORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ReplyORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ReplyORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchReply executes a basic gorm update call with patch behavior
+func DefaultPatchReply(ctx context.Context, in *Reply, updateMask *field_mask.FieldMask, db *gorm.DB) (*Reply, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Reply
+	var err error
+	if hook, ok := interface{}(&pbObj).(ReplyWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadReply(ctx, &Reply{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(ReplyWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskReply(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(ReplyWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateReply(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(ReplyWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type ReplyWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Reply, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ReplyWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Reply, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ReplyWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Reply, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ReplyWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Reply, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetReply executes a bulk gorm update call with patch behavior
+func DefaultPatchSetReply(ctx context.Context, objects []*Reply, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Reply, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Reply, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchReply(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskReply patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskReply(ctx context.Context, patchee *Reply, patcher *Reply, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Reply, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Code" {
+			patchee.Code = patcher.Code
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListReply executes a gorm list call
+func DefaultListReply(ctx context.Context, db *gorm.DB) ([]*Reply, error) {
+	in := Reply{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ReplyORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &ReplyORM{}, preload.NewConverter(&Reply{}), nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ReplyORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []ReplyORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ReplyORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Reply{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type ReplyORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ReplyORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ReplyORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ReplyORM) error
+}
+
+// DefaultCountReply returns the number of rows DefaultListReply pages through
+func DefaultCountReply(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := Reply{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(ReplyORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ReplyORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ReplyORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	return paging.Count(db.Where(&ormObj), &ReplyORM{}, strategy)
+}
+
+type ReplyORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// ReplyConflictTargets maps the conflict targets accepted by DefaultUpsertReply to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var ReplyConflictTargets = map[string][]string{
+	"code": {"code"},
+	"id":   {"id"},
+}
+
+// DefaultUpsertReply inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertReply(ctx context.Context, in *Reply, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*Reply, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	conflict := insert.OnConflict{
+		Columns:   ReplyConflictTargets[target],
+		UpdateAll: updateMask == nil,
+	}
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for Reply", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertReplyColumns(updateMask)
+		if err != nil {
+			return nil, err
+		}
+		conflict.Update = columns
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ReplyORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ReplyORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ReplyORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ReplyORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// upsertReplyColumns returns the columns of the fields of updateMask the upserts of
+// Reply overwrite, the immutable fields and the paths of no column being rejected
+func upsertReplyColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		case "Code":
+			columns = append(columns, "code")
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
+		}
+	}
+	return columns, nil
+}
+
+// ReplyRepository runs the Default operations of Reply, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type ReplyRepository interface {
+	Create(ctx context.Context, in *Reply) (*Reply, error)
+	CreateSet(ctx context.Context, in []*Reply, batchSize int) ([]*Reply, error)
+	Read(ctx context.Context, in *Reply, fs *query.FieldSelection) (*Reply, error)
+	StrictUpdate(ctx context.Context, in *Reply) (*Reply, error)
+	Patch(ctx context.Context, in *Reply, updateMask *field_mask.FieldMask) (*Reply, error)
+	PatchSet(ctx context.Context, objects []*Reply, updateMasks []*field_mask.FieldMask) ([]*Reply, error)
+	Delete(ctx context.Context, in *Reply) error
+	DeleteSet(ctx context.Context, in []*Reply) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Reply, error)
+	PageToken(ctx context.Context, last *Reply, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *Reply, target string, updateMask *field_mask.FieldMask) (*Reply, error)
+}
+
+// GormReplyRepository is the ReplyRepository calling the Default handlers with DB
+type GormReplyRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormReplyRepository returns the ReplyRepository running the operations with db
+func NewGormReplyRepository(db *gorm.DB) *GormReplyRepository {
+	return &GormReplyRepository{DB: db}
+}
+
+func (r *GormReplyRepository) Create(ctx context.Context, in *Reply) (*Reply, error) {
+	return DefaultCreateReply(ctx, in, r.DB)
+}
+
+func (r *GormReplyRepository) CreateSet(ctx context.Context, in []*Reply, batchSize int) ([]*Reply, error) {
+	return DefaultCreateReplySet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormReplyRepository) Read(ctx context.Context, in *Reply, fs *query.FieldSelection) (*Reply, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of Reply doesn't support field selection")
+	}
+	return DefaultReadReply(ctx, in, r.DB)
+}
+
+func (r *GormReplyRepository) StrictUpdate(ctx context.Context, in *Reply) (*Reply, error) {
+	return DefaultStrictUpdateReply(ctx, in, r.DB)
+}
+
+func (r *GormReplyRepository) Patch(ctx context.Context, in *Reply, updateMask *field_mask.FieldMask) (*Reply, error) {
+	return DefaultPatchReply(ctx, in, updateMask, r.DB)
+}
+
+func (r *GormReplyRepository) PatchSet(ctx context.Context, objects []*Reply, updateMasks []*field_mask.FieldMask) ([]*Reply, error) {
+	return DefaultPatchSetReply(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormReplyRepository) Delete(ctx context.Context, in *Reply) error {
+	return DefaultDeleteReply(ctx, in, r.DB)
+}
+
+func (r *GormReplyRepository) DeleteSet(ctx context.Context, in []*Reply) error {
+	return DefaultDeleteReplySet(ctx, in, r.DB)
+}
+
+func (r *GormReplyRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Reply, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Reply doesn't support filtering")
+	}
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Reply doesn't support sorting")
+	}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Reply doesn't support pagination")
+	}
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Reply doesn't support field selection")
+	}
+	return DefaultListReply(ctx, r.DB)
+}
+
+func (r *GormReplyRepository) PageToken(ctx context.Context, last *Reply, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	keyset, err := paging.NewKeyset(r.DB, &ReplyORM{}, s, f)
+	if err != nil {
+		return "", err
+	}
+	return keyset.Token(&ormObj)
+}
+
+func (r *GormReplyRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of Reply doesn't support filtering")
+	}
+	return DefaultCountReply(ctx, r.DB, strategy)
+}
+
+func (r *GormReplyRepository) Upsert(ctx context.Context, in *Reply, target string, updateMask *field_mask.FieldMask) (*Reply, error) {
+	return DefaultUpsertReply(ctx, in, target, updateMask, r.DB)
+}
+
+// MemoryReplyRepository is the ReplyRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryReplyRepository struct {
+	table memory.Table
+}
+
+// NewMemoryReplyRepository returns an empty MemoryReplyRepository
+func NewMemoryReplyRepository() *MemoryReplyRepository {
+	return &MemoryReplyRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryReplyRepository) row(ctx context.Context, in, stored *Reply) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: nil, Key: ormObj.Id, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryReplyRepository) tenant(ctx context.Context) (interface{}, error) {
+	_, err := (&Reply{}).ToORM(ctx)
+	return nil, err
+}
+
+func (r *MemoryReplyRepository) Create(ctx context.Context, in *Reply) (*Reply, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*Reply), nil
+}
+
+func (r *MemoryReplyRepository) CreateSet(ctx context.Context, in []*Reply, batchSize int) ([]*Reply, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*Reply, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*Reply))
+	}
+	if err := r.table.Insert(rows...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *MemoryReplyRepository) Read(ctx context.Context, in *Reply, fs *query.FieldSelection) (*Reply, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	out, ok := r.table.Get(nil, ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*Reply), nil
+}
+
+func (r *MemoryReplyRepository) StrictUpdate(ctx context.Context, in *Reply) (*Reply, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.table.Save(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*Reply), nil
+}
+
+func (r *MemoryReplyRepository) Patch(ctx context.Context, in *Reply, updateMask *field_mask.FieldMask) (*Reply, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	patchee, err := r.Read(ctx, &Reply{Id: in.GetId()}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if patchee, err = DefaultApplyFieldMaskReply(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
+	}
+	return r.StrictUpdate(ctx, patchee)
+}
+
+func (r *MemoryReplyRepository) PatchSet(ctx context.Context, objects []*Reply, updateMasks []*field_mask.FieldMask) ([]*Reply, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	out := make([]*Reply, 0, len(objects))
+	for i, patcher := range objects {
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, patched)
+	}
+	return out, nil
+}
+
+func (r *MemoryReplyRepository) Delete(ctx context.Context, in *Reply) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(nil, ormObj.Id)
+	return nil
+}
+
+func (r *MemoryReplyRepository) DeleteSet(ctx context.Context, in []*Reply) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryReplyRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Reply, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*Reply, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*Reply))
+	}
+	return out, nil
+}
+
+func (r *MemoryReplyRepository) PageToken(ctx context.Context, last *Reply, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryReplyRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryReplyRepository) Upsert(ctx context.Context, in *Reply, target string, updateMask *field_mask.FieldMask) (*Reply, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := ReplyConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for Reply", target)
+	}
+	if _, err := upsertReplyColumns(updateMask); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found, ok, err := r.table.Conflicting(nil, func(m proto.Message) bool {
+		other, err := m.(*Reply).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r.Create(ctx, in)
+	}
+	existing := found.(*Reply)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskReply(ctx, existing, in, updateMask, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		return r.StrictUpdate(ctx, patched)
+	}
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*Reply)
+	updated.Id = existing.Id
+	return r.StrictUpdate(ctx, updated)
+}
+
+// ReplyFixtureOption customizes the object returned by NewReplyFixture
+type ReplyFixtureOption func(*Reply)
+
+// NewReplyFixture returns a Reply with random valid values in its fields and
+// its required associations, customized by opts
+func NewReplyFixture(opts ...ReplyFixtureOption) *Reply {
+	m := &Reply{}
+	m.Code = fixture.String(4)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CreateReplyFixture creates the object returned by NewReplyFixture and its
+// associations as one of the Replies of the Thread created by CreateThreadFixture, which
+// sets its not null foreign key
+func CreateReplyFixture(ctx context.Context, db *gorm.DB, opts ...ReplyFixtureOption) (*Reply, error) {
+	m := NewReplyFixture(opts...)
+	parent, err := CreateThreadFixture(ctx, db, func(parent *Thread) {
+		parent.Replies = append(parent.Replies, m)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parent.Replies[len(parent.Replies)-1], nil
 }
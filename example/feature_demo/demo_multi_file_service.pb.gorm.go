@@ -2,11 +2,34 @@ package example
 
 import (
 	context "context"
+	errors "github.com/acanseco/protoc-gen-gorm/errors"
+	dbresolver "github.com/acanseco/protoc-gen-gorm/runtime/dbresolver"
+	metrics "github.com/acanseco/protoc-gen-gorm/runtime/metrics"
+	paging "github.com/acanseco/protoc-gen-gorm/runtime/paging"
+	query "github.com/infobloxopen/atlas-app-toolkit/query"
 	gorm "github.com/jinzhu/gorm"
+	math "math"
+	time "time"
 )
 
 type BlogPostServiceDefaultServer struct {
 	DB *gorm.DB
+	// ReaderDB is used by the read methods when it is set, a replica of DB for instance
+	ReaderDB *gorm.DB
+	// DBResolver picks the database of each request, DB or ReaderDB are used when it is nil
+	DBResolver dbresolver.Resolver
+	// Metrics records the requests, nothing is recorded when it is nil
+	Metrics metrics.Metrics
+	// NewBlogPostRepository returns the repository the methods run the operations on BlogPost with,
+	// NewGormBlogPostRepository when it is nil
+	NewBlogPostRepository func(*gorm.DB) BlogPostRepository
+}
+
+func (m *BlogPostServiceDefaultServer) blogPostRepository(db *gorm.DB) BlogPostRepository {
+	if m.NewBlogPostRepository != nil {
+		return m.NewBlogPostRepository(db)
+	}
+	return NewGormBlogPostRepository(db)
 }
 
 // Read ...
@@ -14,3 +37,214 @@ func (m *BlogPostServiceDefaultServer) Read(ctx context.Context, in *ReadAccount
 	out := &ReadBlogPostsResponse{}
 	return out, nil
 }
+
+// List ...
+func (m *BlogPostServiceDefaultServer) List(ctx context.Context, in *ListBlogPostRequest) (result *ListBlogPostResponse, resultErr error) {
+	start := time.Now()
+	defer func() {
+		if m.Metrics == nil {
+			return
+		}
+		rows := len(result.GetResults())
+		m.Metrics.Observe(ctx, metrics.Observation{
+			FullMethod: "/example.BlogPostService/List",
+			Verb:       "List",
+			Type:       "BlogPost",
+			Latency:    time.Since(start),
+			Rows:       rows,
+			ErrorClass: metrics.ErrorClass(resultErr),
+		})
+	}()
+	db := m.DB
+	if m.ReaderDB != nil && !dbresolver.UsePrimary(ctx) {
+		db = dbresolver.Replica(m.ReaderDB)
+	}
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.BlogPostService/List", ReadOnly: true, Primary: dbresolver.UsePrimary(ctx)}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewBlogPostRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
+	if custom, ok := interface{}(in).(BlogPostServiceBlogPostWithBeforeList); ok {
+		var err error
+		if db, err = custom.BeforeList(ctx, db); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	pagedRequest := false
+	page := in.GetPaging()
+	if page.GetLimit() >= 1 {
+		// one more row tells whether there is a next page, the request is
+		// left unchanged for the retries of the transaction
+		page = &query.Pagination{PageToken: page.GetPageToken(), Offset: page.GetOffset(), Limit: page.GetLimit() + 1}
+		pagedRequest = true
+	}
+	res, err := m.blogPostRepository(db).List(ctx, in.Filter, in.OrderBy, page, nil)
+	if err != nil {
+		return nil, errors.Translate(err)
+	}
+	var resPaging *query.PageInfo
+	if pagedRequest {
+		resPaging = &query.PageInfo{}
+		if size := int32(len(res)); size == page.GetLimit() {
+			res = res[:size-1]
+			if resPaging.PageToken, err = m.blogPostRepository(db).PageToken(ctx, res[size-2], in.GetOrderBy(), in.GetFilter()); err != nil {
+				return nil, errors.Translate(err)
+			}
+		}
+	}
+	count, err := m.blogPostRepository(db).Count(ctx, in.Filter, paging.EstimatedCount)
+	if err != nil {
+		return nil, errors.Translate(err)
+	}
+	if count > math.MaxInt32 {
+		count = math.MaxInt32
+	}
+	if resPaging == nil {
+		resPaging = &query.PageInfo{}
+	}
+	resPaging.Size = int32(count)
+	out := &ListBlogPostResponse{Results: res, PageInfo: resPaging, NextPageToken: resPaging.GetPageToken()}
+	if custom, ok := interface{}(in).(BlogPostServiceBlogPostWithAfterList); ok {
+		var err error
+		if err = custom.AfterList(ctx, out, db); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	return out, nil
+}
+
+// BlogPostServiceBlogPostWithBeforeList called before DefaultListBlogPost in the default List handler
+type BlogPostServiceBlogPostWithBeforeList interface {
+	BeforeList(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// BlogPostServiceBlogPostWithAfterList called before DefaultListBlogPost in the default List handler
+type BlogPostServiceBlogPostWithAfterList interface {
+	AfterList(context.Context, *ListBlogPostResponse, *gorm.DB) error
+}
+
+// StreamBlogPosts ...
+func (m *BlogPostServiceDefaultServer) StreamBlogPosts(in *StreamBlogPostsRequest, stream BlogPostService_StreamBlogPostsServer) (resultErr error) {
+	ctx := stream.Context()
+	start := time.Now()
+	rows := 0
+	defer func() {
+		if m.Metrics == nil {
+			return
+		}
+		m.Metrics.Observe(ctx, metrics.Observation{
+			FullMethod: "/example.BlogPostService/StreamBlogPosts",
+			Verb:       "Stream",
+			Type:       "BlogPost",
+			Latency:    time.Since(start),
+			Rows:       rows,
+			ErrorClass: metrics.ErrorClass(resultErr),
+		})
+	}()
+	db := m.DB
+	if m.ReaderDB != nil && !dbresolver.UsePrimary(ctx) {
+		db = dbresolver.Replica(m.ReaderDB)
+	}
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.BlogPostService/StreamBlogPosts", ReadOnly: true, Primary: dbresolver.UsePrimary(ctx)}); err != nil {
+			return errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewBlogPostRepository == nil {
+		return errors.Translate(errors.NoDBError)
+	}
+	if custom, ok := interface{}(in).(BlogPostServiceBlogPostWithBeforeStreamBlogPosts); ok {
+		var err error
+		if db, err = custom.BeforeStreamBlogPosts(ctx, db); err != nil {
+			return errors.Translate(err)
+		}
+	}
+	err := m.blogPostRepository(db).Stream(ctx, in.Filter, in.OrderBy, nil, in.Fields, func(out *BlogPost) error {
+		rows++
+		return stream.Send(out)
+	})
+	if err != nil {
+		return errors.Translate(err)
+	}
+	if custom, ok := interface{}(in).(BlogPostServiceBlogPostWithAfterStreamBlogPosts); ok {
+		if err = custom.AfterStreamBlogPosts(ctx, db); err != nil {
+			return errors.Translate(err)
+		}
+	}
+	return nil
+}
+
+// BlogPostServiceBlogPostWithBeforeStreamBlogPosts called before DefaultStreamBlogPost in the default StreamBlogPosts handler
+type BlogPostServiceBlogPostWithBeforeStreamBlogPosts interface {
+	BeforeStreamBlogPosts(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// BlogPostServiceBlogPostWithAfterStreamBlogPosts called after DefaultStreamBlogPost in the default StreamBlogPosts handler
+type BlogPostServiceBlogPostWithAfterStreamBlogPosts interface {
+	AfterStreamBlogPosts(context.Context, *gorm.DB) error
+}
+
+// Upsert ...
+func (m *BlogPostServiceDefaultServer) Upsert(ctx context.Context, in *UpsertBlogPostRequest) (result *UpsertBlogPostResponse, resultErr error) {
+	start := time.Now()
+	defer func() {
+		if m.Metrics == nil {
+			return
+		}
+		rows := 0
+		if resultErr == nil {
+			rows = 1
+		}
+		m.Metrics.Observe(ctx, metrics.Observation{
+			FullMethod: "/example.BlogPostService/Upsert",
+			Verb:       "Upsert",
+			Type:       "BlogPost",
+			Latency:    time.Since(start),
+			Rows:       rows,
+			ErrorClass: metrics.ErrorClass(resultErr),
+		})
+	}()
+	db := m.DB
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.BlogPostService/Upsert", ReadOnly: false, Primary: true}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewBlogPostRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
+	if custom, ok := interface{}(in).(BlogPostServiceBlogPostWithBeforeUpsert); ok {
+		var err error
+		if db, err = custom.BeforeUpsert(ctx, db); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	res, err := m.blogPostRepository(db).Upsert(ctx, in.GetPayload(), "idx_blog_post_slug", in.GetUpdateMask())
+	if err != nil {
+		return nil, errors.Translate(err)
+	}
+	out := &UpsertBlogPostResponse{Result: res}
+	if custom, ok := interface{}(in).(BlogPostServiceBlogPostWithAfterUpsert); ok {
+		var err error
+		if err = custom.AfterUpsert(ctx, out, db); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	return out, nil
+}
+
+// BlogPostServiceBlogPostWithBeforeUpsert called before DefaultUpsertBlogPost in the default Upsert handler
+type BlogPostServiceBlogPostWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// BlogPostServiceBlogPostWithAfterUpsert called before DefaultUpsertBlogPost in the default Upsert handler
+type BlogPostServiceBlogPostWithAfterUpsert interface {
+	AfterUpsert(context.Context, *UpsertBlogPostResponse, *gorm.DB) error
+}
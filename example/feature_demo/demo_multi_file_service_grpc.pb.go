@@ -19,6 +19,12 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type BlogPostServiceClient interface {
 	Read(ctx context.Context, in *ReadAccountRequest, opts ...grpc.CallOption) (*ReadBlogPostsResponse, error)
+	// List pages through the posts ordered by the sort keys and id
+	List(ctx context.Context, in *ListBlogPostRequest, opts ...grpc.CallOption) (*ListBlogPostResponse, error)
+	// StreamBlogPosts sends the posts one by one, for exports
+	StreamBlogPosts(ctx context.Context, in *StreamBlogPostsRequest, opts ...grpc.CallOption) (BlogPostService_StreamBlogPostsClient, error)
+	// Upsert creates the post or updates the one with the same slug
+	Upsert(ctx context.Context, in *UpsertBlogPostRequest, opts ...grpc.CallOption) (*UpsertBlogPostResponse, error)
 }
 
 type blogPostServiceClient struct {
@@ -38,11 +44,67 @@ func (c *blogPostServiceClient) Read(ctx context.Context, in *ReadAccountRequest
 	return out, nil
 }
 
+func (c *blogPostServiceClient) List(ctx context.Context, in *ListBlogPostRequest, opts ...grpc.CallOption) (*ListBlogPostResponse, error) {
+	out := new(ListBlogPostResponse)
+	err := c.cc.Invoke(ctx, "/example.BlogPostService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blogPostServiceClient) StreamBlogPosts(ctx context.Context, in *StreamBlogPostsRequest, opts ...grpc.CallOption) (BlogPostService_StreamBlogPostsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BlogPostService_ServiceDesc.Streams[0], "/example.BlogPostService/StreamBlogPosts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blogPostServiceStreamBlogPostsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlogPostService_StreamBlogPostsClient interface {
+	Recv() (*BlogPost, error)
+	grpc.ClientStream
+}
+
+type blogPostServiceStreamBlogPostsClient struct {
+	grpc.ClientStream
+}
+
+func (x *blogPostServiceStreamBlogPostsClient) Recv() (*BlogPost, error) {
+	m := new(BlogPost)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blogPostServiceClient) Upsert(ctx context.Context, in *UpsertBlogPostRequest, opts ...grpc.CallOption) (*UpsertBlogPostResponse, error) {
+	out := new(UpsertBlogPostResponse)
+	err := c.cc.Invoke(ctx, "/example.BlogPostService/Upsert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BlogPostServiceServer is the server API for BlogPostService service.
 // All implementations must embed UnimplementedBlogPostServiceServer
 // for forward compatibility
 type BlogPostServiceServer interface {
 	Read(context.Context, *ReadAccountRequest) (*ReadBlogPostsResponse, error)
+	// List pages through the posts ordered by the sort keys and id
+	List(context.Context, *ListBlogPostRequest) (*ListBlogPostResponse, error)
+	// StreamBlogPosts sends the posts one by one, for exports
+	StreamBlogPosts(*StreamBlogPostsRequest, BlogPostService_StreamBlogPostsServer) error
+	// Upsert creates the post or updates the one with the same slug
+	Upsert(context.Context, *UpsertBlogPostRequest) (*UpsertBlogPostResponse, error)
 	mustEmbedUnimplementedBlogPostServiceServer()
 }
 
@@ -53,6 +115,15 @@ type UnimplementedBlogPostServiceServer struct {
 func (UnimplementedBlogPostServiceServer) Read(context.Context, *ReadAccountRequest) (*ReadBlogPostsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
 }
+func (UnimplementedBlogPostServiceServer) List(context.Context, *ListBlogPostRequest) (*ListBlogPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedBlogPostServiceServer) StreamBlogPosts(*StreamBlogPostsRequest, BlogPostService_StreamBlogPostsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBlogPosts not implemented")
+}
+func (UnimplementedBlogPostServiceServer) Upsert(context.Context, *UpsertBlogPostRequest) (*UpsertBlogPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upsert not implemented")
+}
 func (UnimplementedBlogPostServiceServer) mustEmbedUnimplementedBlogPostServiceServer() {}
 
 // UnsafeBlogPostServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -84,6 +155,63 @@ func _BlogPostService_Read_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlogPostService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlogPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogPostServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.BlogPostService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogPostServiceServer).List(ctx, req.(*ListBlogPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlogPostService_StreamBlogPosts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBlogPostsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlogPostServiceServer).StreamBlogPosts(m, &blogPostServiceStreamBlogPostsServer{stream})
+}
+
+type BlogPostService_StreamBlogPostsServer interface {
+	Send(*BlogPost) error
+	grpc.ServerStream
+}
+
+type blogPostServiceStreamBlogPostsServer struct {
+	grpc.ServerStream
+}
+
+func (x *blogPostServiceStreamBlogPostsServer) Send(m *BlogPost) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlogPostService_Upsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertBlogPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlogPostServiceServer).Upsert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.BlogPostService/Upsert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlogPostServiceServer).Upsert(ctx, req.(*UpsertBlogPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BlogPostService_ServiceDesc is the grpc.ServiceDesc for BlogPostService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -95,7 +223,21 @@ var BlogPostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Read",
 			Handler:    _BlogPostService_Read_Handler,
 		},
+		{
+			MethodName: "List",
+			Handler:    _BlogPostService_List_Handler,
+		},
+		{
+			MethodName: "Upsert",
+			Handler:    _BlogPostService_Upsert_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBlogPosts",
+			Handler:       _BlogPostService_StreamBlogPosts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "feature_demo/demo_multi_file_service.proto",
 }
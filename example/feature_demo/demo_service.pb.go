@@ -7,8 +7,8 @@
 package example
 
 import (
-	query "github.com/infobloxopen/atlas-app-toolkit/query"
 	_ "github.com/acanseco/protoc-gen-gorm/options"
+	query "github.com/infobloxopen/atlas-app-toolkit/query"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -25,7 +25,7 @@ const (
 )
 
 // IntPoint is a basic message type representing a single cartesian point
-// that we want to store in a database
+// that we want to store in a database, read through the cache
 type IntPoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -186,6 +186,102 @@ func (x *CreateIntPointResponse) GetResult() *IntPoint {
 	return nil
 }
 
+type CreateSetIntPointRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Convention requires the objects to be created in a repeated field named 'objects'
+	Objects []*IntPoint `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+}
+
+func (x *CreateSetIntPointRequest) Reset() {
+	*x = CreateSetIntPointRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feature_demo_demo_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSetIntPointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSetIntPointRequest) ProtoMessage() {}
+
+func (x *CreateSetIntPointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feature_demo_demo_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSetIntPointRequest.ProtoReflect.Descriptor instead.
+func (*CreateSetIntPointRequest) Descriptor() ([]byte, []int) {
+	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateSetIntPointRequest) GetObjects() []*IntPoint {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+type CreateSetIntPointResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Convention also requires the created objects in a repeated field named 'results'
+	Results []*IntPoint `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *CreateSetIntPointResponse) Reset() {
+	*x = CreateSetIntPointResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feature_demo_demo_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSetIntPointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSetIntPointResponse) ProtoMessage() {}
+
+func (x *CreateSetIntPointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feature_demo_demo_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSetIntPointResponse.ProtoReflect.Descriptor instead.
+func (*CreateSetIntPointResponse) Descriptor() ([]byte, []int) {
+	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateSetIntPointResponse) GetResults() []*IntPoint {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 type ReadIntPointRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -199,7 +295,7 @@ type ReadIntPointRequest struct {
 func (x *ReadIntPointRequest) Reset() {
 	*x = ReadIntPointRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_service_proto_msgTypes[3]
+		mi := &file_feature_demo_demo_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -212,7 +308,7 @@ func (x *ReadIntPointRequest) String() string {
 func (*ReadIntPointRequest) ProtoMessage() {}
 
 func (x *ReadIntPointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_service_proto_msgTypes[3]
+	mi := &file_feature_demo_demo_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -225,7 +321,7 @@ func (x *ReadIntPointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadIntPointRequest.ProtoReflect.Descriptor instead.
 func (*ReadIntPointRequest) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{3}
+	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ReadIntPointRequest) GetId() uint32 {
@@ -254,7 +350,7 @@ type ReadIntPointResponse struct {
 func (x *ReadIntPointResponse) Reset() {
 	*x = ReadIntPointResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_service_proto_msgTypes[4]
+		mi := &file_feature_demo_demo_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -267,7 +363,7 @@ func (x *ReadIntPointResponse) String() string {
 func (*ReadIntPointResponse) ProtoMessage() {}
 
 func (x *ReadIntPointResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_service_proto_msgTypes[4]
+	mi := &file_feature_demo_demo_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -280,7 +376,7 @@ func (x *ReadIntPointResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadIntPointResponse.ProtoReflect.Descriptor instead.
 func (*ReadIntPointResponse) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{4}
+	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ReadIntPointResponse) GetResult() *IntPoint {
@@ -302,7 +398,7 @@ type UpdateIntPointRequest struct {
 func (x *UpdateIntPointRequest) Reset() {
 	*x = UpdateIntPointRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_service_proto_msgTypes[5]
+		mi := &file_feature_demo_demo_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -315,7 +411,7 @@ func (x *UpdateIntPointRequest) String() string {
 func (*UpdateIntPointRequest) ProtoMessage() {}
 
 func (x *UpdateIntPointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_service_proto_msgTypes[5]
+	mi := &file_feature_demo_demo_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -328,7 +424,7 @@ func (x *UpdateIntPointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateIntPointRequest.ProtoReflect.Descriptor instead.
 func (*UpdateIntPointRequest) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{5}
+	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateIntPointRequest) GetPayload() *IntPoint {
@@ -356,7 +452,7 @@ type UpdateIntPointResponse struct {
 func (x *UpdateIntPointResponse) Reset() {
 	*x = UpdateIntPointResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_service_proto_msgTypes[6]
+		mi := &file_feature_demo_demo_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -369,7 +465,7 @@ func (x *UpdateIntPointResponse) String() string {
 func (*UpdateIntPointResponse) ProtoMessage() {}
 
 func (x *UpdateIntPointResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_service_proto_msgTypes[6]
+	mi := &file_feature_demo_demo_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -382,7 +478,7 @@ func (x *UpdateIntPointResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateIntPointResponse.ProtoReflect.Descriptor instead.
 func (*UpdateIntPointResponse) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{6}
+	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateIntPointResponse) GetResult() *IntPoint {
@@ -404,7 +500,7 @@ type UpdateSetIntPointRequest struct {
 func (x *UpdateSetIntPointRequest) Reset() {
 	*x = UpdateSetIntPointRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_service_proto_msgTypes[7]
+		mi := &file_feature_demo_demo_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -417,7 +513,7 @@ func (x *UpdateSetIntPointRequest) String() string {
 func (*UpdateSetIntPointRequest) ProtoMessage() {}
 
 func (x *UpdateSetIntPointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_service_proto_msgTypes[7]
+	mi := &file_feature_demo_demo_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -430,7 +526,7 @@ func (x *UpdateSetIntPointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSetIntPointRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSetIntPointRequest) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{7}
+	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateSetIntPointRequest) GetObjects() []*IntPoint {
@@ -458,7 +554,7 @@ type UpdateSetIntPointResponse struct {
 func (x *UpdateSetIntPointResponse) Reset() {
 	*x = UpdateSetIntPointResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_service_proto_msgTypes[8]
+		mi := &file_feature_demo_demo_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -471,7 +567,7 @@ func (x *UpdateSetIntPointResponse) String() string {
 func (*UpdateSetIntPointResponse) ProtoMessage() {}
 
 func (x *UpdateSetIntPointResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_service_proto_msgTypes[8]
+	mi := &file_feature_demo_demo_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -484,7 +580,7 @@ func (x *UpdateSetIntPointResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSetIntPointResponse.ProtoReflect.Descriptor instead.
 func (*UpdateSetIntPointResponse) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{8}
+	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateSetIntPointResponse) GetResults() []*IntPoint {
@@ -506,7 +602,7 @@ type DeleteIntPointRequest struct {
 func (x *DeleteIntPointRequest) Reset() {
 	*x = DeleteIntPointRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_service_proto_msgTypes[9]
+		mi := &file_feature_demo_demo_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -519,7 +615,7 @@ func (x *DeleteIntPointRequest) String() string {
 func (*DeleteIntPointRequest) ProtoMessage() {}
 
 func (x *DeleteIntPointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_service_proto_msgTypes[9]
+	mi := &file_feature_demo_demo_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +628,7 @@ func (x *DeleteIntPointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteIntPointRequest.ProtoReflect.Descriptor instead.
 func (*DeleteIntPointRequest) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{9}
+	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeleteIntPointRequest) GetId() uint32 {
@@ -554,7 +650,7 @@ type DeleteIntPointsRequest struct {
 func (x *DeleteIntPointsRequest) Reset() {
 	*x = DeleteIntPointsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_service_proto_msgTypes[10]
+		mi := &file_feature_demo_demo_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -567,7 +663,7 @@ func (x *DeleteIntPointsRequest) String() string {
 func (*DeleteIntPointsRequest) ProtoMessage() {}
 
 func (x *DeleteIntPointsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_service_proto_msgTypes[10]
+	mi := &file_feature_demo_demo_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -580,7 +676,7 @@ func (x *DeleteIntPointsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteIntPointsRequest.ProtoReflect.Descriptor instead.
 func (*DeleteIntPointsRequest) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{10}
+	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteIntPointsRequest) GetIds() []uint32 {
@@ -601,7 +697,7 @@ type DeleteIntPointResponse struct {
 func (x *DeleteIntPointResponse) Reset() {
 	*x = DeleteIntPointResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_service_proto_msgTypes[11]
+		mi := &file_feature_demo_demo_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -614,7 +710,7 @@ func (x *DeleteIntPointResponse) String() string {
 func (*DeleteIntPointResponse) ProtoMessage() {}
 
 func (x *DeleteIntPointResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_service_proto_msgTypes[11]
+	mi := &file_feature_demo_demo_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -627,7 +723,7 @@ func (x *DeleteIntPointResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteIntPointResponse.ProtoReflect.Descriptor instead.
 func (*DeleteIntPointResponse) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{11}
+	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{13}
 }
 
 type ListIntPointResponse struct {
@@ -643,7 +739,7 @@ type ListIntPointResponse struct {
 func (x *ListIntPointResponse) Reset() {
 	*x = ListIntPointResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_service_proto_msgTypes[12]
+		mi := &file_feature_demo_demo_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -656,7 +752,7 @@ func (x *ListIntPointResponse) String() string {
 func (*ListIntPointResponse) ProtoMessage() {}
 
 func (x *ListIntPointResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_service_proto_msgTypes[12]
+	mi := &file_feature_demo_demo_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -669,7 +765,7 @@ func (x *ListIntPointResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIntPointResponse.ProtoReflect.Descriptor instead.
 func (*ListIntPointResponse) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{12}
+	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListIntPointResponse) GetResults() []*IntPoint {
@@ -699,7 +795,7 @@ type ListSomethingResponse struct {
 func (x *ListSomethingResponse) Reset() {
 	*x = ListSomethingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_service_proto_msgTypes[13]
+		mi := &file_feature_demo_demo_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -712,7 +808,7 @@ func (x *ListSomethingResponse) String() string {
 func (*ListSomethingResponse) ProtoMessage() {}
 
 func (x *ListSomethingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_service_proto_msgTypes[13]
+	mi := &file_feature_demo_demo_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,7 +821,7 @@ func (x *ListSomethingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSomethingResponse.ProtoReflect.Descriptor instead.
 func (*ListSomethingResponse) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{13}
+	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListSomethingResponse) GetResults() []*Something {
@@ -754,7 +850,7 @@ type Something struct {
 func (x *Something) Reset() {
 	*x = Something{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_service_proto_msgTypes[14]
+		mi := &file_feature_demo_demo_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -767,7 +863,7 @@ func (x *Something) String() string {
 func (*Something) ProtoMessage() {}
 
 func (x *Something) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_service_proto_msgTypes[14]
+	mi := &file_feature_demo_demo_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -780,7 +876,7 @@ func (x *Something) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Something.ProtoReflect.Descriptor instead.
 func (*Something) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{14}
+	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Something) GetField() string {
@@ -804,7 +900,7 @@ type ListIntPointRequest struct {
 func (x *ListIntPointRequest) Reset() {
 	*x = ListIntPointRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_service_proto_msgTypes[15]
+		mi := &file_feature_demo_demo_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -817,7 +913,7 @@ func (x *ListIntPointRequest) String() string {
 func (*ListIntPointRequest) ProtoMessage() {}
 
 func (x *ListIntPointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_service_proto_msgTypes[15]
+	mi := &file_feature_demo_demo_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -830,7 +926,7 @@ func (x *ListIntPointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIntPointRequest.ProtoReflect.Descriptor instead.
 func (*ListIntPointRequest) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{15}
+	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListIntPointRequest) GetFilter() *query.Filtering {
@@ -872,7 +968,7 @@ type Circle struct {
 func (x *Circle) Reset() {
 	*x = Circle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_service_proto_msgTypes[16]
+		mi := &file_feature_demo_demo_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -885,7 +981,7 @@ func (x *Circle) String() string {
 func (*Circle) ProtoMessage() {}
 
 func (x *Circle) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_service_proto_msgTypes[16]
+	mi := &file_feature_demo_demo_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -898,7 +994,7 @@ func (x *Circle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Circle.ProtoReflect.Descriptor instead.
 func (*Circle) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{16}
+	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Circle) GetR() uint32 {
@@ -917,7 +1013,7 @@ type ListCircleRequest struct {
 func (x *ListCircleRequest) Reset() {
 	*x = ListCircleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_service_proto_msgTypes[17]
+		mi := &file_feature_demo_demo_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -930,7 +1026,7 @@ func (x *ListCircleRequest) String() string {
 func (*ListCircleRequest) ProtoMessage() {}
 
 func (x *ListCircleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_service_proto_msgTypes[17]
+	mi := &file_feature_demo_demo_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -943,7 +1039,7 @@ func (x *ListCircleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCircleRequest.ProtoReflect.Descriptor instead.
 func (*ListCircleRequest) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{17}
+	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{19}
 }
 
 type ListCircleResponse struct {
@@ -957,7 +1053,7 @@ type ListCircleResponse struct {
 func (x *ListCircleResponse) Reset() {
 	*x = ListCircleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_service_proto_msgTypes[18]
+		mi := &file_feature_demo_demo_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -970,7 +1066,7 @@ func (x *ListCircleResponse) String() string {
 func (*ListCircleResponse) ProtoMessage() {}
 
 func (x *ListCircleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_service_proto_msgTypes[18]
+	mi := &file_feature_demo_demo_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -983,7 +1079,7 @@ func (x *ListCircleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCircleResponse.ProtoReflect.Descriptor instead.
 func (*ListCircleResponse) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{18}
+	return file_feature_demo_demo_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ListCircleResponse) GetResults() []*Circle {
@@ -1006,261 +1102,281 @@ var file_feature_demo_demo_service_proto_rawDesc = []byte{
 	0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x29, 0x61,
 	0x74, 0x6c, 0x61, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
 	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3e, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x50,
+	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x40, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x50,
 	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
 	0x52, 0x02, 0x69, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
 	0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79,
-	0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x44, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x49, 0x6e, 0x74,
-	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x43,
-	0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x22, 0x5d, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f,
-	0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x06, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x74, 0x6c,
-	0x61, 0x73, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x22, 0x41, 0x0a, 0x14, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
+	0x3a, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x08, 0x01, 0x40, 0x01, 0x22, 0x44, 0x0a, 0x15, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x49,
+	0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x22, 0x43, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
 	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65,
 	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x78, 0x61,
 	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x47, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x49, 0x6e, 0x74,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x48,
+	0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x5d, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x64,
 	0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x2b, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x50, 0x6f,
-	0x69, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x43, 0x0a, 0x0f,
-	0x67, 0x65, 0x72, 0x6f, 0x67, 0x65, 0x72, 0x69, 0x5f, 0x67, 0x65, 0x67, 0x65, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73,
-	0x6b, 0x52, 0x0e, 0x67, 0x65, 0x72, 0x6f, 0x67, 0x65, 0x72, 0x69, 0x47, 0x65, 0x67, 0x65, 0x67,
-	0x65, 0x22, 0x43, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f,
-	0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x79, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x49, 0x6e,
-	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12,
-	0x30, 0x0a, 0x05, 0x6d, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x6d, 0x61, 0x73, 0x6b,
-	0x73, 0x22, 0x48, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x49, 0x6e,
-	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
-	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x36, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x61, 0x74, 0x6c, 0x61, 0x73, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x41, 0x0a, 0x14, 0x52, 0x65, 0x61, 0x64, 0x49,
+	0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x29, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
-	0x6e, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x27, 0x0a, 0x15, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x2a, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e,
-	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
-	0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x03, 0x69, 0x64, 0x73,
-	0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7a, 0x0a, 0x14, 0x4c, 0x69,
-	0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x49, 0x6e,
-	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
-	0x35, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x74, 0x6c, 0x61, 0x73, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x61,
-	0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x7c, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f,
-	0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x74,
-	0x68, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x35, 0x0a,
-	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x61, 0x74, 0x6c, 0x61, 0x73, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x22, 0x29, 0x0a, 0x09, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e,
-	0x67, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22,
-	0xe8, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x74, 0x6c, 0x61, 0x73, 0x2e,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x08, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61,
-	0x74, 0x6c, 0x61, 0x73, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f,
-	0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x36,
-	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
-	0x2e, 0x61, 0x74, 0x6c, 0x61, 0x73, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x74, 0x6c, 0x61, 0x73, 0x2e, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x22, 0x1e, 0x0a, 0x06, 0x43, 0x69,
-	0x72, 0x63, 0x6c, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x01, 0x72, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69,
-	0x73, 0x74, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x3f, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
-	0x32, 0xbc, 0x05, 0x0a, 0x0f, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1e,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x15, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x43, 0x0a, 0x0f, 0x67, 0x65, 0x72, 0x6f, 0x67, 0x65, 0x72, 0x69, 0x5f, 0x67, 0x65,
+	0x67, 0x65, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0e, 0x67, 0x65, 0x72, 0x6f, 0x67, 0x65, 0x72, 0x69,
+	0x47, 0x65, 0x67, 0x65, 0x67, 0x65, 0x22, 0x43, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x79, 0x0a, 0x18, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x6d, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52,
+	0x05, 0x6d, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x48, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x49,
+	0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x22, 0x27, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2a, 0x0a, 0x16, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49,
 	0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x45, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x7a, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x74, 0x6c, 0x61, 0x73, 0x2e,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x7c, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x74, 0x6c, 0x61, 0x73, 0x2e, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x29, 0x0a, 0x09, 0x53, 0x6f, 0x6d,
+	0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x06, 0xba, 0xb9,
+	0x19, 0x02, 0x08, 0x01, 0x22, 0xe8, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61,
+	0x74, 0x6c, 0x61, 0x73, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x32, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x61, 0x74, 0x6c, 0x61, 0x73, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x79, 0x12, 0x36, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x74, 0x6c, 0x61, 0x73, 0x2e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x74,
+	0x6c, 0x61, 0x73, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x22,
+	0x1e, 0x0a, 0x06, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x72, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22,
+	0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x69, 0x72, 0x63,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x32, 0xde, 0x06, 0x0a, 0x0f, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x74, 0x12, 0x21, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x07, 0xba, 0xb9, 0x19, 0x03,
+	0x10, 0xf4, 0x03, 0x12, 0x45, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1c, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d,
 	0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x65, 0x74, 0x12, 0x21, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x04, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x06, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x74, 0x12, 0x21, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a,
+	0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x28, 0x01, 0x12, 0x49, 0x0a, 0x0d, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
 	0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x49, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68,
-	0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a,
-	0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0e, 0xba, 0xb9, 0x19, 0x0a, 0x0a, 0x08,
-	0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x22, 0x0e, 0xba, 0xb9, 0x19, 0x0a, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x40, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0f, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x2e,
-	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e,
-	0x67, 0x1a, 0x12, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65,
-	0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x1a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x32,
-	0xfc, 0x04, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x78, 0x6e, 0x12,
-	0x4b, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x04,
-	0x52, 0x65, 0x61, 0x64, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52,
-	0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61,
-	0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e,
-	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e,
-	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e,
-	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x45, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x74,
-	0x12, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
-	0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x1a, 0x12, 0x2e, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67,
-	0x22, 0x00, 0x1a, 0x0a, 0xba, 0xb9, 0x19, 0x06, 0x08, 0x01, 0x10, 0x01, 0x18, 0x01, 0x32, 0x5a,
-	0x0a, 0x0d, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x41, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x1a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x32, 0xf4, 0x07, 0x0a, 0x16, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x41, 0x75,
-	0x74, 0x6f, 0x47, 0x65, 0x6e, 0x12, 0x4c, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6d, 0x65,
+	0x74, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x1a, 0x12, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x12,
+	0x3d, 0x0a, 0x0d, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67,
+	0x12, 0x12, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x74,
+	0x68, 0x69, 0x6e, 0x67, 0x1a, 0x12, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53,
+	0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x1a, 0x06,
+	0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x32, 0xfe, 0x04, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x54, 0x78, 0x6e, 0x12, 0x4b, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x12, 0x1e,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x46, 0x0a, 0x05, 0x52, 0x65, 0x61, 0x64, 0x41, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x05, 0x52, 0x65, 0x61,
-	0x64, 0x42, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61,
-	0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49,
-	0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x4c, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x12, 0x1e, 0x2e, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74,
-	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74,
-	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x4c, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f,
-	0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f,
-	0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a,
-	0x05, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x05, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x12, 0x1c,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74,
-	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f,
-	0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a,
-	0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1c, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x06, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e,
+	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a,
+	0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
 	0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e,
 	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
 	0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e,
 	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x0a,
-	0x09, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x5b, 0x0a, 0x07, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x42, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x0a, 0x09, 0x69, 0x6e,
-	0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x5f, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x53, 0x65, 0x74, 0x41, 0x12, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52,
+	0x09, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x09, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x0a,
+	0x09, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0c, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0f,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12,
+	0x12, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x74, 0x68,
+	0x69, 0x6e, 0x67, 0x1a, 0x12, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f,
+	0x6d, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x00, 0x1a, 0x0c, 0xba, 0xb9, 0x19, 0x08, 0x08,
+	0x01, 0x10, 0x01, 0x18, 0x01, 0x20, 0x01, 0x32, 0x5a, 0x0a, 0x0d, 0x43, 0x69, 0x72, 0x63, 0x6c,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x1a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x69, 0x72, 0x63, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x1a, 0x06, 0xba, 0xb9, 0x19,
+	0x02, 0x08, 0x01, 0x32, 0x8e, 0x08, 0x0a, 0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x47, 0x65, 0x6e, 0x12, 0x4c,
+	0x0a, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x07,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x05, 0x52, 0x65,
+	0x61, 0x64, 0x41, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x50, 0x01, 0x12, 0x4f, 0x0a, 0x05, 0x52, 0x65, 0x61, 0x64,
+	0x42, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e,
+	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x09,
+	0xba, 0xb9, 0x19, 0x05, 0x38, 0x01, 0x40, 0x88, 0x27, 0x12, 0x4c, 0x0a, 0x07, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x42, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x09, 0xba, 0xb9, 0x19, 0x05, 0x30, 0x03, 0x48, 0xe8, 0x07, 0x12, 0x46,
+	0x0a, 0x05, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x05, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x12,
+	0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e,
+	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b,
+	0x0a, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xba, 0xb9, 0x19, 0x0b,
+	0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x5b, 0x0a, 0x07, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x12, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
 	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x0a, 0x09, 0x69,
 	0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x5f, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x53, 0x65, 0x74, 0x42, 0x12, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x65, 0x74, 0x41, 0x12, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
 	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
 	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x0a, 0x09,
-	0x69, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x1a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08,
-	0x01, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x69, 0x6e, 0x66, 0x6f, 0x62, 0x6c, 0x6f, 0x78, 0x6f, 0x70, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x72, 0x6d, 0x2f, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x64, 0x65, 0x6d,
-	0x6f, 0x3b, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x69, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x5f, 0x0a, 0x0a, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x65, 0x74, 0x42, 0x12, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x0a,
+	0x09, 0x69, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x1a, 0x08, 0xba, 0xb9, 0x19, 0x04,
+	0x08, 0x01, 0x28, 0x01, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x61, 0x63, 0x61, 0x6e, 0x73, 0x65, 0x63, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x72, 0x6d, 0x2f, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x64, 0x65, 0x6d, 0x6f,
+	0x3b, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1275,120 +1391,128 @@ func file_feature_demo_demo_service_proto_rawDescGZIP() []byte {
 	return file_feature_demo_demo_service_proto_rawDescData
 }
 
-var file_feature_demo_demo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_feature_demo_demo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_feature_demo_demo_service_proto_goTypes = []interface{}{
 	(*IntPoint)(nil),                  // 0: example.IntPoint
 	(*CreateIntPointRequest)(nil),     // 1: example.CreateIntPointRequest
 	(*CreateIntPointResponse)(nil),    // 2: example.CreateIntPointResponse
-	(*ReadIntPointRequest)(nil),       // 3: example.ReadIntPointRequest
-	(*ReadIntPointResponse)(nil),      // 4: example.ReadIntPointResponse
-	(*UpdateIntPointRequest)(nil),     // 5: example.UpdateIntPointRequest
-	(*UpdateIntPointResponse)(nil),    // 6: example.UpdateIntPointResponse
-	(*UpdateSetIntPointRequest)(nil),  // 7: example.UpdateSetIntPointRequest
-	(*UpdateSetIntPointResponse)(nil), // 8: example.UpdateSetIntPointResponse
-	(*DeleteIntPointRequest)(nil),     // 9: example.DeleteIntPointRequest
-	(*DeleteIntPointsRequest)(nil),    // 10: example.DeleteIntPointsRequest
-	(*DeleteIntPointResponse)(nil),    // 11: example.DeleteIntPointResponse
-	(*ListIntPointResponse)(nil),      // 12: example.ListIntPointResponse
-	(*ListSomethingResponse)(nil),     // 13: example.ListSomethingResponse
-	(*Something)(nil),                 // 14: example.Something
-	(*ListIntPointRequest)(nil),       // 15: example.ListIntPointRequest
-	(*Circle)(nil),                    // 16: example.Circle
-	(*ListCircleRequest)(nil),         // 17: example.ListCircleRequest
-	(*ListCircleResponse)(nil),        // 18: example.ListCircleResponse
-	(*query.FieldSelection)(nil),      // 19: atlas.query.v1.FieldSelection
-	(*fieldmaskpb.FieldMask)(nil),     // 20: google.protobuf.FieldMask
-	(*query.PageInfo)(nil),            // 21: atlas.query.v1.PageInfo
-	(*query.Filtering)(nil),           // 22: atlas.query.v1.Filtering
-	(*query.Sorting)(nil),             // 23: atlas.query.v1.Sorting
-	(*query.Pagination)(nil),          // 24: atlas.query.v1.Pagination
-	(*emptypb.Empty)(nil),             // 25: google.protobuf.Empty
+	(*CreateSetIntPointRequest)(nil),  // 3: example.CreateSetIntPointRequest
+	(*CreateSetIntPointResponse)(nil), // 4: example.CreateSetIntPointResponse
+	(*ReadIntPointRequest)(nil),       // 5: example.ReadIntPointRequest
+	(*ReadIntPointResponse)(nil),      // 6: example.ReadIntPointResponse
+	(*UpdateIntPointRequest)(nil),     // 7: example.UpdateIntPointRequest
+	(*UpdateIntPointResponse)(nil),    // 8: example.UpdateIntPointResponse
+	(*UpdateSetIntPointRequest)(nil),  // 9: example.UpdateSetIntPointRequest
+	(*UpdateSetIntPointResponse)(nil), // 10: example.UpdateSetIntPointResponse
+	(*DeleteIntPointRequest)(nil),     // 11: example.DeleteIntPointRequest
+	(*DeleteIntPointsRequest)(nil),    // 12: example.DeleteIntPointsRequest
+	(*DeleteIntPointResponse)(nil),    // 13: example.DeleteIntPointResponse
+	(*ListIntPointResponse)(nil),      // 14: example.ListIntPointResponse
+	(*ListSomethingResponse)(nil),     // 15: example.ListSomethingResponse
+	(*Something)(nil),                 // 16: example.Something
+	(*ListIntPointRequest)(nil),       // 17: example.ListIntPointRequest
+	(*Circle)(nil),                    // 18: example.Circle
+	(*ListCircleRequest)(nil),         // 19: example.ListCircleRequest
+	(*ListCircleResponse)(nil),        // 20: example.ListCircleResponse
+	(*query.FieldSelection)(nil),      // 21: atlas.query.v1.FieldSelection
+	(*fieldmaskpb.FieldMask)(nil),     // 22: google.protobuf.FieldMask
+	(*query.PageInfo)(nil),            // 23: atlas.query.v1.PageInfo
+	(*query.Filtering)(nil),           // 24: atlas.query.v1.Filtering
+	(*query.Sorting)(nil),             // 25: atlas.query.v1.Sorting
+	(*query.Pagination)(nil),          // 26: atlas.query.v1.Pagination
+	(*emptypb.Empty)(nil),             // 27: google.protobuf.Empty
 }
 var file_feature_demo_demo_service_proto_depIdxs = []int32{
 	0,  // 0: example.CreateIntPointRequest.payload:type_name -> example.IntPoint
 	0,  // 1: example.CreateIntPointResponse.result:type_name -> example.IntPoint
-	19, // 2: example.ReadIntPointRequest.fields:type_name -> atlas.query.v1.FieldSelection
-	0,  // 3: example.ReadIntPointResponse.result:type_name -> example.IntPoint
-	0,  // 4: example.UpdateIntPointRequest.payload:type_name -> example.IntPoint
-	20, // 5: example.UpdateIntPointRequest.gerogeri_gegege:type_name -> google.protobuf.FieldMask
-	0,  // 6: example.UpdateIntPointResponse.result:type_name -> example.IntPoint
-	0,  // 7: example.UpdateSetIntPointRequest.objects:type_name -> example.IntPoint
-	20, // 8: example.UpdateSetIntPointRequest.masks:type_name -> google.protobuf.FieldMask
-	0,  // 9: example.UpdateSetIntPointResponse.results:type_name -> example.IntPoint
-	0,  // 10: example.ListIntPointResponse.results:type_name -> example.IntPoint
-	21, // 11: example.ListIntPointResponse.page_info:type_name -> atlas.query.v1.PageInfo
-	14, // 12: example.ListSomethingResponse.results:type_name -> example.Something
-	21, // 13: example.ListSomethingResponse.page_info:type_name -> atlas.query.v1.PageInfo
-	22, // 14: example.ListIntPointRequest.filter:type_name -> atlas.query.v1.Filtering
-	23, // 15: example.ListIntPointRequest.order_by:type_name -> atlas.query.v1.Sorting
-	19, // 16: example.ListIntPointRequest.fields:type_name -> atlas.query.v1.FieldSelection
-	24, // 17: example.ListIntPointRequest.paging:type_name -> atlas.query.v1.Pagination
-	16, // 18: example.ListCircleResponse.results:type_name -> example.Circle
-	1,  // 19: example.IntPointService.Create:input_type -> example.CreateIntPointRequest
-	3,  // 20: example.IntPointService.Read:input_type -> example.ReadIntPointRequest
-	5,  // 21: example.IntPointService.Update:input_type -> example.UpdateIntPointRequest
-	7,  // 22: example.IntPointService.UpdateSet:input_type -> example.UpdateSetIntPointRequest
-	15, // 23: example.IntPointService.List:input_type -> example.ListIntPointRequest
-	25, // 24: example.IntPointService.ListSomething:input_type -> google.protobuf.Empty
-	9,  // 25: example.IntPointService.Delete:input_type -> example.DeleteIntPointRequest
-	25, // 26: example.IntPointService.CustomMethod:input_type -> google.protobuf.Empty
-	14, // 27: example.IntPointService.CreateSomething:input_type -> example.Something
-	1,  // 28: example.IntPointTxn.Create:input_type -> example.CreateIntPointRequest
-	3,  // 29: example.IntPointTxn.Read:input_type -> example.ReadIntPointRequest
-	5,  // 30: example.IntPointTxn.Update:input_type -> example.UpdateIntPointRequest
-	15, // 31: example.IntPointTxn.List:input_type -> example.ListIntPointRequest
-	9,  // 32: example.IntPointTxn.Delete:input_type -> example.DeleteIntPointRequest
-	10, // 33: example.IntPointTxn.DeleteSet:input_type -> example.DeleteIntPointsRequest
-	25, // 34: example.IntPointTxn.CustomMethod:input_type -> google.protobuf.Empty
-	14, // 35: example.IntPointTxn.CreateSomething:input_type -> example.Something
-	17, // 36: example.CircleService.List:input_type -> example.ListCircleRequest
-	1,  // 37: example.MultipleMethodsAutoGen.CreateA:input_type -> example.CreateIntPointRequest
-	1,  // 38: example.MultipleMethodsAutoGen.CreateB:input_type -> example.CreateIntPointRequest
-	3,  // 39: example.MultipleMethodsAutoGen.ReadA:input_type -> example.ReadIntPointRequest
-	3,  // 40: example.MultipleMethodsAutoGen.ReadB:input_type -> example.ReadIntPointRequest
-	5,  // 41: example.MultipleMethodsAutoGen.UpdateA:input_type -> example.UpdateIntPointRequest
-	5,  // 42: example.MultipleMethodsAutoGen.UpdateB:input_type -> example.UpdateIntPointRequest
-	15, // 43: example.MultipleMethodsAutoGen.ListA:input_type -> example.ListIntPointRequest
-	15, // 44: example.MultipleMethodsAutoGen.ListB:input_type -> example.ListIntPointRequest
-	9,  // 45: example.MultipleMethodsAutoGen.DeleteA:input_type -> example.DeleteIntPointRequest
-	9,  // 46: example.MultipleMethodsAutoGen.DeleteB:input_type -> example.DeleteIntPointRequest
-	10, // 47: example.MultipleMethodsAutoGen.DeleteSetA:input_type -> example.DeleteIntPointsRequest
-	10, // 48: example.MultipleMethodsAutoGen.DeleteSetB:input_type -> example.DeleteIntPointsRequest
-	2,  // 49: example.IntPointService.Create:output_type -> example.CreateIntPointResponse
-	4,  // 50: example.IntPointService.Read:output_type -> example.ReadIntPointResponse
-	6,  // 51: example.IntPointService.Update:output_type -> example.UpdateIntPointResponse
-	8,  // 52: example.IntPointService.UpdateSet:output_type -> example.UpdateSetIntPointResponse
-	12, // 53: example.IntPointService.List:output_type -> example.ListIntPointResponse
-	13, // 54: example.IntPointService.ListSomething:output_type -> example.ListSomethingResponse
-	11, // 55: example.IntPointService.Delete:output_type -> example.DeleteIntPointResponse
-	25, // 56: example.IntPointService.CustomMethod:output_type -> google.protobuf.Empty
-	14, // 57: example.IntPointService.CreateSomething:output_type -> example.Something
-	2,  // 58: example.IntPointTxn.Create:output_type -> example.CreateIntPointResponse
-	4,  // 59: example.IntPointTxn.Read:output_type -> example.ReadIntPointResponse
-	6,  // 60: example.IntPointTxn.Update:output_type -> example.UpdateIntPointResponse
-	12, // 61: example.IntPointTxn.List:output_type -> example.ListIntPointResponse
-	11, // 62: example.IntPointTxn.Delete:output_type -> example.DeleteIntPointResponse
-	11, // 63: example.IntPointTxn.DeleteSet:output_type -> example.DeleteIntPointResponse
-	25, // 64: example.IntPointTxn.CustomMethod:output_type -> google.protobuf.Empty
-	14, // 65: example.IntPointTxn.CreateSomething:output_type -> example.Something
-	18, // 66: example.CircleService.List:output_type -> example.ListCircleResponse
-	2,  // 67: example.MultipleMethodsAutoGen.CreateA:output_type -> example.CreateIntPointResponse
-	2,  // 68: example.MultipleMethodsAutoGen.CreateB:output_type -> example.CreateIntPointResponse
-	4,  // 69: example.MultipleMethodsAutoGen.ReadA:output_type -> example.ReadIntPointResponse
-	4,  // 70: example.MultipleMethodsAutoGen.ReadB:output_type -> example.ReadIntPointResponse
-	6,  // 71: example.MultipleMethodsAutoGen.UpdateA:output_type -> example.UpdateIntPointResponse
-	6,  // 72: example.MultipleMethodsAutoGen.UpdateB:output_type -> example.UpdateIntPointResponse
-	12, // 73: example.MultipleMethodsAutoGen.ListA:output_type -> example.ListIntPointResponse
-	12, // 74: example.MultipleMethodsAutoGen.ListB:output_type -> example.ListIntPointResponse
-	11, // 75: example.MultipleMethodsAutoGen.DeleteA:output_type -> example.DeleteIntPointResponse
-	11, // 76: example.MultipleMethodsAutoGen.DeleteB:output_type -> example.DeleteIntPointResponse
-	11, // 77: example.MultipleMethodsAutoGen.DeleteSetA:output_type -> example.DeleteIntPointResponse
-	11, // 78: example.MultipleMethodsAutoGen.DeleteSetB:output_type -> example.DeleteIntPointResponse
-	49, // [49:79] is the sub-list for method output_type
-	19, // [19:49] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	0,  // 2: example.CreateSetIntPointRequest.objects:type_name -> example.IntPoint
+	0,  // 3: example.CreateSetIntPointResponse.results:type_name -> example.IntPoint
+	21, // 4: example.ReadIntPointRequest.fields:type_name -> atlas.query.v1.FieldSelection
+	0,  // 5: example.ReadIntPointResponse.result:type_name -> example.IntPoint
+	0,  // 6: example.UpdateIntPointRequest.payload:type_name -> example.IntPoint
+	22, // 7: example.UpdateIntPointRequest.gerogeri_gegege:type_name -> google.protobuf.FieldMask
+	0,  // 8: example.UpdateIntPointResponse.result:type_name -> example.IntPoint
+	0,  // 9: example.UpdateSetIntPointRequest.objects:type_name -> example.IntPoint
+	22, // 10: example.UpdateSetIntPointRequest.masks:type_name -> google.protobuf.FieldMask
+	0,  // 11: example.UpdateSetIntPointResponse.results:type_name -> example.IntPoint
+	0,  // 12: example.ListIntPointResponse.results:type_name -> example.IntPoint
+	23, // 13: example.ListIntPointResponse.page_info:type_name -> atlas.query.v1.PageInfo
+	16, // 14: example.ListSomethingResponse.results:type_name -> example.Something
+	23, // 15: example.ListSomethingResponse.page_info:type_name -> atlas.query.v1.PageInfo
+	24, // 16: example.ListIntPointRequest.filter:type_name -> atlas.query.v1.Filtering
+	25, // 17: example.ListIntPointRequest.order_by:type_name -> atlas.query.v1.Sorting
+	21, // 18: example.ListIntPointRequest.fields:type_name -> atlas.query.v1.FieldSelection
+	26, // 19: example.ListIntPointRequest.paging:type_name -> atlas.query.v1.Pagination
+	18, // 20: example.ListCircleResponse.results:type_name -> example.Circle
+	1,  // 21: example.IntPointService.Create:input_type -> example.CreateIntPointRequest
+	3,  // 22: example.IntPointService.CreateSet:input_type -> example.CreateSetIntPointRequest
+	5,  // 23: example.IntPointService.Read:input_type -> example.ReadIntPointRequest
+	7,  // 24: example.IntPointService.Update:input_type -> example.UpdateIntPointRequest
+	9,  // 25: example.IntPointService.UpdateSet:input_type -> example.UpdateSetIntPointRequest
+	17, // 26: example.IntPointService.List:input_type -> example.ListIntPointRequest
+	27, // 27: example.IntPointService.ListSomething:input_type -> google.protobuf.Empty
+	11, // 28: example.IntPointService.Delete:input_type -> example.DeleteIntPointRequest
+	27, // 29: example.IntPointService.CustomMethod:input_type -> google.protobuf.Empty
+	16, // 30: example.IntPointService.CreateSomething:input_type -> example.Something
+	16, // 31: example.IntPointService.SyncSomething:input_type -> example.Something
+	1,  // 32: example.IntPointTxn.Create:input_type -> example.CreateIntPointRequest
+	5,  // 33: example.IntPointTxn.Read:input_type -> example.ReadIntPointRequest
+	7,  // 34: example.IntPointTxn.Update:input_type -> example.UpdateIntPointRequest
+	17, // 35: example.IntPointTxn.List:input_type -> example.ListIntPointRequest
+	11, // 36: example.IntPointTxn.Delete:input_type -> example.DeleteIntPointRequest
+	12, // 37: example.IntPointTxn.DeleteSet:input_type -> example.DeleteIntPointsRequest
+	27, // 38: example.IntPointTxn.CustomMethod:input_type -> google.protobuf.Empty
+	16, // 39: example.IntPointTxn.CreateSomething:input_type -> example.Something
+	19, // 40: example.CircleService.List:input_type -> example.ListCircleRequest
+	1,  // 41: example.MultipleMethodsAutoGen.CreateA:input_type -> example.CreateIntPointRequest
+	1,  // 42: example.MultipleMethodsAutoGen.CreateB:input_type -> example.CreateIntPointRequest
+	5,  // 43: example.MultipleMethodsAutoGen.ReadA:input_type -> example.ReadIntPointRequest
+	5,  // 44: example.MultipleMethodsAutoGen.ReadB:input_type -> example.ReadIntPointRequest
+	7,  // 45: example.MultipleMethodsAutoGen.UpdateA:input_type -> example.UpdateIntPointRequest
+	7,  // 46: example.MultipleMethodsAutoGen.UpdateB:input_type -> example.UpdateIntPointRequest
+	17, // 47: example.MultipleMethodsAutoGen.ListA:input_type -> example.ListIntPointRequest
+	17, // 48: example.MultipleMethodsAutoGen.ListB:input_type -> example.ListIntPointRequest
+	11, // 49: example.MultipleMethodsAutoGen.DeleteA:input_type -> example.DeleteIntPointRequest
+	11, // 50: example.MultipleMethodsAutoGen.DeleteB:input_type -> example.DeleteIntPointRequest
+	12, // 51: example.MultipleMethodsAutoGen.DeleteSetA:input_type -> example.DeleteIntPointsRequest
+	12, // 52: example.MultipleMethodsAutoGen.DeleteSetB:input_type -> example.DeleteIntPointsRequest
+	2,  // 53: example.IntPointService.Create:output_type -> example.CreateIntPointResponse
+	4,  // 54: example.IntPointService.CreateSet:output_type -> example.CreateSetIntPointResponse
+	6,  // 55: example.IntPointService.Read:output_type -> example.ReadIntPointResponse
+	8,  // 56: example.IntPointService.Update:output_type -> example.UpdateIntPointResponse
+	10, // 57: example.IntPointService.UpdateSet:output_type -> example.UpdateSetIntPointResponse
+	14, // 58: example.IntPointService.List:output_type -> example.ListIntPointResponse
+	15, // 59: example.IntPointService.ListSomething:output_type -> example.ListSomethingResponse
+	13, // 60: example.IntPointService.Delete:output_type -> example.DeleteIntPointResponse
+	27, // 61: example.IntPointService.CustomMethod:output_type -> google.protobuf.Empty
+	16, // 62: example.IntPointService.CreateSomething:output_type -> example.Something
+	16, // 63: example.IntPointService.SyncSomething:output_type -> example.Something
+	2,  // 64: example.IntPointTxn.Create:output_type -> example.CreateIntPointResponse
+	6,  // 65: example.IntPointTxn.Read:output_type -> example.ReadIntPointResponse
+	8,  // 66: example.IntPointTxn.Update:output_type -> example.UpdateIntPointResponse
+	14, // 67: example.IntPointTxn.List:output_type -> example.ListIntPointResponse
+	13, // 68: example.IntPointTxn.Delete:output_type -> example.DeleteIntPointResponse
+	13, // 69: example.IntPointTxn.DeleteSet:output_type -> example.DeleteIntPointResponse
+	27, // 70: example.IntPointTxn.CustomMethod:output_type -> google.protobuf.Empty
+	16, // 71: example.IntPointTxn.CreateSomething:output_type -> example.Something
+	20, // 72: example.CircleService.List:output_type -> example.ListCircleResponse
+	2,  // 73: example.MultipleMethodsAutoGen.CreateA:output_type -> example.CreateIntPointResponse
+	2,  // 74: example.MultipleMethodsAutoGen.CreateB:output_type -> example.CreateIntPointResponse
+	6,  // 75: example.MultipleMethodsAutoGen.ReadA:output_type -> example.ReadIntPointResponse
+	6,  // 76: example.MultipleMethodsAutoGen.ReadB:output_type -> example.ReadIntPointResponse
+	8,  // 77: example.MultipleMethodsAutoGen.UpdateA:output_type -> example.UpdateIntPointResponse
+	8,  // 78: example.MultipleMethodsAutoGen.UpdateB:output_type -> example.UpdateIntPointResponse
+	14, // 79: example.MultipleMethodsAutoGen.ListA:output_type -> example.ListIntPointResponse
+	14, // 80: example.MultipleMethodsAutoGen.ListB:output_type -> example.ListIntPointResponse
+	13, // 81: example.MultipleMethodsAutoGen.DeleteA:output_type -> example.DeleteIntPointResponse
+	13, // 82: example.MultipleMethodsAutoGen.DeleteB:output_type -> example.DeleteIntPointResponse
+	13, // 83: example.MultipleMethodsAutoGen.DeleteSetA:output_type -> example.DeleteIntPointResponse
+	13, // 84: example.MultipleMethodsAutoGen.DeleteSetB:output_type -> example.DeleteIntPointResponse
+	53, // [53:85] is the sub-list for method output_type
+	21, // [21:53] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_feature_demo_demo_service_proto_init() }
@@ -1434,7 +1558,7 @@ func file_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_feature_demo_demo_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadIntPointRequest); i {
+			switch v := v.(*CreateSetIntPointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1446,7 +1570,7 @@ func file_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_feature_demo_demo_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadIntPointResponse); i {
+			switch v := v.(*CreateSetIntPointResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1458,7 +1582,7 @@ func file_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_feature_demo_demo_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateIntPointRequest); i {
+			switch v := v.(*ReadIntPointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1470,7 +1594,7 @@ func file_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_feature_demo_demo_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateIntPointResponse); i {
+			switch v := v.(*ReadIntPointResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1482,7 +1606,7 @@ func file_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_feature_demo_demo_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSetIntPointRequest); i {
+			switch v := v.(*UpdateIntPointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1494,7 +1618,7 @@ func file_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_feature_demo_demo_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSetIntPointResponse); i {
+			switch v := v.(*UpdateIntPointResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1506,7 +1630,7 @@ func file_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_feature_demo_demo_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteIntPointRequest); i {
+			switch v := v.(*UpdateSetIntPointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1518,7 +1642,7 @@ func file_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_feature_demo_demo_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteIntPointsRequest); i {
+			switch v := v.(*UpdateSetIntPointResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1530,7 +1654,7 @@ func file_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_feature_demo_demo_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteIntPointResponse); i {
+			switch v := v.(*DeleteIntPointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1542,7 +1666,7 @@ func file_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_feature_demo_demo_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListIntPointResponse); i {
+			switch v := v.(*DeleteIntPointsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1554,7 +1678,7 @@ func file_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_feature_demo_demo_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSomethingResponse); i {
+			switch v := v.(*DeleteIntPointResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1566,7 +1690,7 @@ func file_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_feature_demo_demo_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Something); i {
+			switch v := v.(*ListIntPointResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1578,7 +1702,7 @@ func file_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_feature_demo_demo_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListIntPointRequest); i {
+			switch v := v.(*ListSomethingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1590,7 +1714,7 @@ func file_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_feature_demo_demo_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Circle); i {
+			switch v := v.(*Something); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1602,7 +1726,7 @@ func file_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_feature_demo_demo_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCircleRequest); i {
+			switch v := v.(*ListIntPointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1614,6 +1738,30 @@ func file_feature_demo_demo_service_proto_init() {
 			}
 		}
 		file_feature_demo_demo_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Circle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feature_demo_demo_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCircleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feature_demo_demo_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListCircleResponse); i {
 			case 0:
 				return &v.state
@@ -1632,7 +1780,7 @@ func file_feature_demo_demo_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_feature_demo_demo_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   4,
 		},
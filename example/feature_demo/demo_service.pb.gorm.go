@@ -2,16 +2,32 @@ package example
 
 import (
 	context "context"
+	sql "database/sql"
 	json "encoding/json"
 	fmt "fmt"
+	errors "github.com/acanseco/protoc-gen-gorm/errors"
+	cache "github.com/acanseco/protoc-gen-gorm/runtime/cache"
+	dbresolver "github.com/acanseco/protoc-gen-gorm/runtime/dbresolver"
+	fixture "github.com/acanseco/protoc-gen-gorm/runtime/fixture"
+	insert "github.com/acanseco/protoc-gen-gorm/runtime/insert"
+	memory "github.com/acanseco/protoc-gen-gorm/runtime/memory"
+	metrics "github.com/acanseco/protoc-gen-gorm/runtime/metrics"
+	paging "github.com/acanseco/protoc-gen-gorm/runtime/paging"
+	preload "github.com/acanseco/protoc-gen-gorm/runtime/preload"
+	retry "github.com/acanseco/protoc-gen-gorm/runtime/retry"
+	transaction "github.com/acanseco/protoc-gen-gorm/runtime/transaction"
 	gateway "github.com/infobloxopen/atlas-app-toolkit/gateway"
 	gorm1 "github.com/infobloxopen/atlas-app-toolkit/gorm"
 	query "github.com/infobloxopen/atlas-app-toolkit/query"
-	errors "github.com/acanseco/protoc-gen-gorm/errors"
 	gorm "github.com/jinzhu/gorm"
 	trace "go.opencensus.io/trace"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	proto "google.golang.org/protobuf/proto"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	math "math"
+	time "time"
 )
 
 type IntPointORM struct {
@@ -25,6 +41,11 @@ func (IntPointORM) TableName() string {
 	return "int_points"
 }
 
+// cacheKey returns the key of the object in the cache, empty when it isn't cached
+func (m *IntPointORM) cacheKey() string {
+	return cache.Key("IntPoint", nil, m.Id)
+}
+
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
 func (m *IntPoint) ToORM(ctx context.Context) (IntPointORM, error) {
@@ -63,6 +84,16 @@ func (m *IntPointORM) ToPB(ctx context.Context) (IntPoint, error) {
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *IntPoint) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type IntPoint the arg will be the target, the caller the one being converted from
 
@@ -129,6 +160,16 @@ func (m *SomethingORM) ToPB(ctx context.Context) (Something, error) {
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *Something) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type Something the arg will be the target, the caller the one being converted from
 
@@ -195,6 +236,16 @@ func (m *CircleORM) ToPB(ctx context.Context) (Circle, error) {
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *Circle) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type Circle the arg will be the target, the caller the one being converted from
 
@@ -223,6 +274,9 @@ func DefaultCreateIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB) (*Int
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
@@ -251,6 +305,75 @@ type IntPointORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateIntPointSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateIntPointSet(ctx context.Context, in []*IntPoint, db *gorm.DB, batchSize int) ([]*IntPoint, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*IntPointORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&IntPointORM{})).(IntPointORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(IntPointORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(IntPointORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&IntPointORM{})).(IntPointORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*IntPoint, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type IntPointORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*IntPointORM, *gorm.DB) (*gorm.DB, error)
+}
+type IntPointORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*IntPointORM, *gorm.DB) error
+}
+
 func DefaultReadIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB, fs *query.FieldSelection) (*IntPoint, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
@@ -262,12 +385,21 @@ func DefaultReadIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB, fs *que
 	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
+	cacheKey := ormObj.cacheKey()
+	cacheable := fs == nil && cache.Unfiltered(db, &IntPointORM{})
+	switch interface{}(&ormObj).(type) {
+	case IntPointORMWithBeforeReadApplyQuery, IntPointORMWithBeforeReadFind, IntPointORMWithAfterReadFind:
+		cacheable = false
+	}
+	if cached := (&IntPoint{}); cacheable && cache.Get(ctx, cacheKey, cached) {
+		return cached, nil
+	}
 	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db, fs); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, fs, &IntPointORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelectionEx(ctx, db, fs, &IntPointORM{}, preload.NewConverter(&IntPoint{})); err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeReadFind); ok {
@@ -285,6 +417,12 @@ func DefaultReadIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB, fs *que
 		}
 	}
 	pbResponse, err := ormResponse.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cacheable && !dbresolver.IsReplica(db) {
+		cache.Set(ctx, cacheKey, &pbResponse)
+	}
 	return &pbResponse, err
 }
 
@@ -318,6 +456,9 @@ func DefaultDeleteIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB) error
 	if err != nil {
 		return err
 	}
+	if err = cache.Invalidate(ctx, ormObj.cacheKey()); err != nil {
+		return err
+	}
 	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
@@ -337,6 +478,7 @@ func DefaultDeleteIntPointSet(ctx context.Context, in []*IntPoint, db *gorm.DB)
 	}
 	var err error
 	keys := []uint32{}
+	cacheKeys := []string{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
@@ -346,6 +488,7 @@ func DefaultDeleteIntPointSet(ctx context.Context, in []*IntPoint, db *gorm.DB)
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
+		cacheKeys = append(cacheKeys, ormObj.cacheKey())
 	}
 	if hook, ok := (interface{}(&IntPointORM{})).(IntPointORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
@@ -356,6 +499,9 @@ func DefaultDeleteIntPointSet(ctx context.Context, in []*IntPoint, db *gorm.DB)
 	if err != nil {
 		return err
 	}
+	if err = cache.Invalidate(ctx, cacheKeys...); err != nil {
+		return err
+	}
 	if hook, ok := (interface{}(&IntPointORM{})).(IntPointORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
@@ -374,6 +520,9 @@ func DefaultStrictUpdateIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB)
 	if in == nil {
 		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateIntPoint")
 	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
@@ -394,6 +543,9 @@ func DefaultStrictUpdateIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB)
 	if err = db.Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
+	if err = cache.Invalidate(ctx, ormObj.cacheKey()); err != nil {
+		return nil, err
+	}
 	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
@@ -533,7 +685,7 @@ func DefaultListIntPoint(ctx context.Context, db *gorm.DB, f *query.Filtering, s
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &IntPointORM{}, &IntPoint{}, f, s, p, fs)
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &IntPointORM{}, preload.NewConverter(&IntPoint{}), f, s, p, fs)
 	if err != nil {
 		return nil, err
 	}
@@ -574,208 +726,1009 @@ type IntPointORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]IntPointORM, *query.Filtering, *query.Sorting, *query.Pagination, *query.FieldSelection) error
 }
 
-// DefaultCreateSomething executes a basic gorm create call
-func DefaultCreateSomething(ctx context.Context, in *Something, db *gorm.DB) (*Something, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
+// DefaultCountIntPoint returns the number of rows DefaultListIntPoint pages through
+func DefaultCountIntPoint(ctx context.Context, db *gorm.DB, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	in := IntPoint{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	if hook, ok := interface{}(&ormObj).(SomethingORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db, f); err != nil {
+			return 0, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db, f, nil, nil, nil); err != nil {
+			return 0, err
+		}
 	}
-	if hook, ok := interface{}(&ormObj).(SomethingORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &IntPointORM{}, &IntPoint{}, f, nil, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db, f, nil, nil, nil); err != nil {
+			return 0, err
 		}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	return paging.Count(db.Where(&ormObj), &IntPointORM{}, strategy)
 }
 
-type SomethingORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+type IntPointORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB, *query.Filtering) (*gorm.DB, error)
 }
-type SomethingORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+
+// IntPointConflictTargets maps the conflict targets accepted by DefaultUpsertIntPoint to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var IntPointConflictTargets = map[string][]string{
+	"id": {"id"},
 }
 
-// DefaultApplyFieldMaskSomething patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskSomething(ctx context.Context, patchee *Something, patcher *Something, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Something, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
+// DefaultUpsertIntPoint inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertIntPoint(ctx context.Context, in *IntPoint, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*IntPoint, error) {
+	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"Field" {
-			patchee.Field = patcher.Field
-			continue
-		}
-	}
-	if err != nil {
+	if err := in.Validate(); err != nil {
 		return nil, err
 	}
-	return patchee, nil
-}
-
-// DefaultListSomething executes a gorm list call
-func DefaultListSomething(ctx context.Context, db *gorm.DB) ([]*Something, error) {
-	in := Something{}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+	conflict := insert.OnConflict{
+		Columns:   IntPointConflictTargets[target],
+		UpdateAll: updateMask == nil,
 	}
-	if hook, ok := interface{}(&ormObj).(SomethingORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for IntPoint", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertIntPointColumns(updateMask)
+		if err != nil {
 			return nil, err
 		}
+		conflict.Update = columns
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &SomethingORM{}, &Something{}, nil, nil, nil, nil)
+	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(SomethingORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
-	ormResponse := []SomethingORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(SomethingORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+	if err = cache.Invalidate(ctx, ormObj.cacheKey()); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*Something{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
-		if err != nil {
-			return nil, err
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type IntPointORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type IntPointORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// upsertIntPointColumns returns the columns of the fields of updateMask the upserts of
+// IntPoint overwrite, the immutable fields and the paths of no column being rejected
+func upsertIntPointColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		case "X":
+			columns = append(columns, "x")
+		case "Y":
+			columns = append(columns, "y")
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
 		}
-		pbResponse = append(pbResponse, &temp)
 	}
-	return pbResponse, nil
+	return columns, nil
 }
 
-type SomethingORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+// IntPointRepository runs the Default operations of IntPoint, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type IntPointRepository interface {
+	Create(ctx context.Context, in *IntPoint) (*IntPoint, error)
+	CreateSet(ctx context.Context, in []*IntPoint, batchSize int) ([]*IntPoint, error)
+	Read(ctx context.Context, in *IntPoint, fs *query.FieldSelection) (*IntPoint, error)
+	StrictUpdate(ctx context.Context, in *IntPoint) (*IntPoint, error)
+	Patch(ctx context.Context, in *IntPoint, updateMask *field_mask.FieldMask) (*IntPoint, error)
+	PatchSet(ctx context.Context, objects []*IntPoint, updateMasks []*field_mask.FieldMask) ([]*IntPoint, error)
+	Delete(ctx context.Context, in *IntPoint) error
+	DeleteSet(ctx context.Context, in []*IntPoint) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*IntPoint, error)
+	PageToken(ctx context.Context, last *IntPoint, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *IntPoint, target string, updateMask *field_mask.FieldMask) (*IntPoint, error)
 }
-type SomethingORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+
+// GormIntPointRepository is the IntPointRepository calling the Default handlers with DB
+type GormIntPointRepository struct {
+	DB *gorm.DB
 }
-type SomethingORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]SomethingORM) error
+
+// NewGormIntPointRepository returns the IntPointRepository running the operations with db
+func NewGormIntPointRepository(db *gorm.DB) *GormIntPointRepository {
+	return &GormIntPointRepository{DB: db}
 }
 
-// DefaultCreateCircle executes a basic gorm create call
-func DefaultCreateCircle(ctx context.Context, in *Circle, db *gorm.DB) (*Circle, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
+func (r *GormIntPointRepository) Create(ctx context.Context, in *IntPoint) (*IntPoint, error) {
+	return DefaultCreateIntPoint(ctx, in, r.DB)
+}
+
+func (r *GormIntPointRepository) CreateSet(ctx context.Context, in []*IntPoint, batchSize int) ([]*IntPoint, error) {
+	return DefaultCreateIntPointSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormIntPointRepository) Read(ctx context.Context, in *IntPoint, fs *query.FieldSelection) (*IntPoint, error) {
+	return DefaultReadIntPoint(ctx, in, r.DB, fs)
+}
+
+func (r *GormIntPointRepository) StrictUpdate(ctx context.Context, in *IntPoint) (*IntPoint, error) {
+	return DefaultStrictUpdateIntPoint(ctx, in, r.DB)
+}
+
+func (r *GormIntPointRepository) Patch(ctx context.Context, in *IntPoint, updateMask *field_mask.FieldMask) (*IntPoint, error) {
+	return DefaultPatchIntPoint(ctx, in, updateMask, r.DB)
+}
+
+func (r *GormIntPointRepository) PatchSet(ctx context.Context, objects []*IntPoint, updateMasks []*field_mask.FieldMask) ([]*IntPoint, error) {
+	return DefaultPatchSetIntPoint(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormIntPointRepository) Delete(ctx context.Context, in *IntPoint) error {
+	return DefaultDeleteIntPoint(ctx, in, r.DB)
+}
+
+func (r *GormIntPointRepository) DeleteSet(ctx context.Context, in []*IntPoint) error {
+	return DefaultDeleteIntPointSet(ctx, in, r.DB)
+}
+
+func (r *GormIntPointRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*IntPoint, error) {
+	return DefaultListIntPoint(ctx, r.DB, f, s, p, fs)
+}
+
+func (r *GormIntPointRepository) PageToken(ctx context.Context, last *IntPoint, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
 	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(CircleORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+		return "", err
 	}
-	if hook, ok := interface{}(&ormObj).(CircleORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
-		}
+	keyset, err := paging.NewKeyset(r.DB, &IntPointORM{}, s, f)
+	if err != nil {
+		return "", err
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	return keyset.Token(&ormObj)
 }
 
-type CircleORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+func (r *GormIntPointRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	return DefaultCountIntPoint(ctx, r.DB, f, strategy)
 }
-type CircleORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+
+func (r *GormIntPointRepository) Upsert(ctx context.Context, in *IntPoint, target string, updateMask *field_mask.FieldMask) (*IntPoint, error) {
+	return DefaultUpsertIntPoint(ctx, in, target, updateMask, r.DB)
 }
 
-// DefaultApplyFieldMaskCircle patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskCircle(ctx context.Context, patchee *Circle, patcher *Circle, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Circle, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
-	}
-	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"R" {
-			patchee.R = patcher.R
-			continue
-		}
+// MemoryIntPointRepository is the IntPointRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryIntPointRepository struct {
+	table memory.Table
+}
+
+// NewMemoryIntPointRepository returns an empty MemoryIntPointRepository
+func NewMemoryIntPointRepository() *MemoryIntPointRepository {
+	return &MemoryIntPointRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryIntPointRepository) row(ctx context.Context, in, stored *IntPoint) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
 	}
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
 	if err != nil {
-		return nil, err
+		return memory.Row{}, err
 	}
-	return patchee, nil
+	return memory.Row{Tenant: nil, Key: ormObj.Id, Object: &out}, nil
 }
 
-// DefaultListCircle executes a gorm list call
-func DefaultListCircle(ctx context.Context, db *gorm.DB) ([]*Circle, error) {
-	in := Circle{}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryIntPointRepository) tenant(ctx context.Context) (interface{}, error) {
+	_, err := (&IntPoint{}).ToORM(ctx)
+	return nil, err
+}
+
+func (r *MemoryIntPointRepository) Create(ctx context.Context, in *IntPoint) (*IntPoint, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
 	}
-	if hook, ok := interface{}(&ormObj).(CircleORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
+	if err := in.Validate(); err != nil {
+		return nil, err
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &CircleORM{}, &Circle{}, nil, nil, nil, nil)
+	row, err := r.row(ctx, in, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(CircleORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	db = db.Where(&ormObj)
-	ormResponse := []CircleORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
+	if err = r.table.Insert(row); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(CircleORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+	return row.Object.(*IntPoint), nil
+}
+
+func (r *MemoryIntPointRepository) CreateSet(ctx context.Context, in []*IntPoint, batchSize int) ([]*IntPoint, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*IntPoint, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
 			return nil, err
 		}
-	}
-	pbResponse := []*Circle{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
+		row, err := r.row(ctx, object, nil)
 		if err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
+		rows = append(rows, row)
+		out = append(out, row.Object.(*IntPoint))
 	}
-	return pbResponse, nil
+	if err := r.table.Insert(rows...); err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type CircleORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+func (r *MemoryIntPointRepository) Read(ctx context.Context, in *IntPoint, fs *query.FieldSelection) (*IntPoint, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	out, ok := r.table.Get(nil, ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*IntPoint), nil
+}
+
+func (r *MemoryIntPointRepository) StrictUpdate(ctx context.Context, in *IntPoint) (*IntPoint, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.table.Save(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*IntPoint), nil
+}
+
+func (r *MemoryIntPointRepository) Patch(ctx context.Context, in *IntPoint, updateMask *field_mask.FieldMask) (*IntPoint, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	patchee, err := r.Read(ctx, &IntPoint{Id: in.GetId()}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if patchee, err = DefaultApplyFieldMaskIntPoint(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
+	}
+	return r.StrictUpdate(ctx, patchee)
+}
+
+func (r *MemoryIntPointRepository) PatchSet(ctx context.Context, objects []*IntPoint, updateMasks []*field_mask.FieldMask) ([]*IntPoint, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	out := make([]*IntPoint, 0, len(objects))
+	for i, patcher := range objects {
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, patched)
+	}
+	return out, nil
+}
+
+func (r *MemoryIntPointRepository) Delete(ctx context.Context, in *IntPoint) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(nil, ormObj.Id)
+	return nil
+}
+
+func (r *MemoryIntPointRepository) DeleteSet(ctx context.Context, in []*IntPoint) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryIntPointRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*IntPoint, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*IntPoint, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*IntPoint))
+	}
+	return out, nil
+}
+
+func (r *MemoryIntPointRepository) PageToken(ctx context.Context, last *IntPoint, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryIntPointRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryIntPointRepository) Upsert(ctx context.Context, in *IntPoint, target string, updateMask *field_mask.FieldMask) (*IntPoint, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := IntPointConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for IntPoint", target)
+	}
+	if _, err := upsertIntPointColumns(updateMask); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found, ok, err := r.table.Conflicting(nil, func(m proto.Message) bool {
+		other, err := m.(*IntPoint).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r.Create(ctx, in)
+	}
+	existing := found.(*IntPoint)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskIntPoint(ctx, existing, in, updateMask, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		return r.StrictUpdate(ctx, patched)
+	}
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*IntPoint)
+	updated.Id = existing.Id
+	return r.StrictUpdate(ctx, updated)
+}
+
+// IntPointFixtureOption customizes the object returned by NewIntPointFixture
+type IntPointFixtureOption func(*IntPoint)
+
+// NewIntPointFixture returns a IntPoint with random valid values in its fields and
+// its required associations, customized by opts
+func NewIntPointFixture(opts ...IntPointFixtureOption) *IntPoint {
+	m := &IntPoint{}
+	m.X = int32(fixture.Int(1000000))
+	m.Y = int32(fixture.Int(1000000))
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CreateIntPointFixture creates the object returned by NewIntPointFixture and its
+// associations with DefaultCreateIntPoint
+func CreateIntPointFixture(ctx context.Context, db *gorm.DB, opts ...IntPointFixtureOption) (*IntPoint, error) {
+	return DefaultCreateIntPoint(ctx, NewIntPointFixture(opts...), db)
+}
+
+// DefaultCreateSomething executes a basic gorm create call
+func DefaultCreateSomething(ctx context.Context, in *Something, db *gorm.DB) (*Something, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SomethingORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SomethingORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type SomethingORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SomethingORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateSomethingSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateSomethingSet(ctx context.Context, in []*Something, db *gorm.DB, batchSize int) ([]*Something, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*SomethingORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&SomethingORM{})).(SomethingORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(SomethingORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(SomethingORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&SomethingORM{})).(SomethingORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*Something, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type SomethingORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*SomethingORM, *gorm.DB) (*gorm.DB, error)
+}
+type SomethingORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*SomethingORM, *gorm.DB) error
+}
+
+// DefaultApplyFieldMaskSomething patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskSomething(ctx context.Context, patchee *Something, patcher *Something, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Something, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Field" {
+			patchee.Field = patcher.Field
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListSomething executes a gorm list call
+func DefaultListSomething(ctx context.Context, db *gorm.DB) ([]*Something, error) {
+	in := Something{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SomethingORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &SomethingORM{}, preload.NewConverter(&Something{}), nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SomethingORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	ormResponse := []SomethingORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SomethingORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Something{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type SomethingORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SomethingORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SomethingORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]SomethingORM) error
+}
+
+// DefaultCountSomething returns the number of rows DefaultListSomething pages through
+func DefaultCountSomething(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := Something{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(SomethingORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(SomethingORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(SomethingORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	return paging.Count(db.Where(&ormObj), &SomethingORM{}, strategy)
+}
+
+type SomethingORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// SomethingRepository runs the Default operations of Something, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type SomethingRepository interface {
+	Create(ctx context.Context, in *Something) (*Something, error)
+	CreateSet(ctx context.Context, in []*Something, batchSize int) ([]*Something, error)
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Something, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+}
+
+// GormSomethingRepository is the SomethingRepository calling the Default handlers with DB
+type GormSomethingRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormSomethingRepository returns the SomethingRepository running the operations with db
+func NewGormSomethingRepository(db *gorm.DB) *GormSomethingRepository {
+	return &GormSomethingRepository{DB: db}
+}
+
+func (r *GormSomethingRepository) Create(ctx context.Context, in *Something) (*Something, error) {
+	return DefaultCreateSomething(ctx, in, r.DB)
+}
+
+func (r *GormSomethingRepository) CreateSet(ctx context.Context, in []*Something, batchSize int) ([]*Something, error) {
+	return DefaultCreateSomethingSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormSomethingRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Something, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Something doesn't support filtering")
+	}
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Something doesn't support sorting")
+	}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Something doesn't support pagination")
+	}
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Something doesn't support field selection")
+	}
+	return DefaultListSomething(ctx, r.DB)
+}
+
+func (r *GormSomethingRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of Something doesn't support filtering")
+	}
+	return DefaultCountSomething(ctx, r.DB, strategy)
+}
+
+// MemorySomethingRepository is the SomethingRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemorySomethingRepository struct {
+	table memory.Table
+}
+
+// NewMemorySomethingRepository returns an empty MemorySomethingRepository
+func NewMemorySomethingRepository() *MemorySomethingRepository {
+	return &MemorySomethingRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemorySomethingRepository) row(ctx context.Context, in, stored *Something) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: nil, Key: nil, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemorySomethingRepository) tenant(ctx context.Context) (interface{}, error) {
+	_, err := (&Something{}).ToORM(ctx)
+	return nil, err
+}
+
+func (r *MemorySomethingRepository) Create(ctx context.Context, in *Something) (*Something, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*Something), nil
+}
+
+func (r *MemorySomethingRepository) CreateSet(ctx context.Context, in []*Something, batchSize int) ([]*Something, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*Something, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*Something))
+	}
+	if err := r.table.Insert(rows...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *MemorySomethingRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Something, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*Something, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*Something))
+	}
+	return out, nil
+}
+
+func (r *MemorySomethingRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+// SomethingFixtureOption customizes the object returned by NewSomethingFixture
+type SomethingFixtureOption func(*Something)
+
+// NewSomethingFixture returns a Something with random valid values in its fields and
+// its required associations, customized by opts
+func NewSomethingFixture(opts ...SomethingFixtureOption) *Something {
+	m := &Something{}
+	m.Field = fixture.String(0)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CreateSomethingFixture creates the object returned by NewSomethingFixture and its
+// associations with DefaultCreateSomething
+func CreateSomethingFixture(ctx context.Context, db *gorm.DB, opts ...SomethingFixtureOption) (*Something, error) {
+	return DefaultCreateSomething(ctx, NewSomethingFixture(opts...), db)
+}
+
+// DefaultCreateCircle executes a basic gorm create call
+func DefaultCreateCircle(ctx context.Context, in *Circle, db *gorm.DB) (*Circle, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CircleORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CircleORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type CircleORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CircleORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateCircleSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateCircleSet(ctx context.Context, in []*Circle, db *gorm.DB, batchSize int) ([]*Circle, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*CircleORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&CircleORM{})).(CircleORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(CircleORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(CircleORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&CircleORM{})).(CircleORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*Circle, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type CircleORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*CircleORM, *gorm.DB) (*gorm.DB, error)
+}
+type CircleORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*CircleORM, *gorm.DB) error
+}
+
+// DefaultApplyFieldMaskCircle patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskCircle(ctx context.Context, patchee *Circle, patcher *Circle, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Circle, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"R" {
+			patchee.R = patcher.R
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListCircle executes a gorm list call
+func DefaultListCircle(ctx context.Context, db *gorm.DB) ([]*Circle, error) {
+	in := Circle{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CircleORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &CircleORM{}, preload.NewConverter(&Circle{}), nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CircleORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	ormResponse := []CircleORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CircleORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Circle{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type CircleORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 type CircleORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
@@ -783,65 +1736,355 @@ type CircleORMWithBeforeListFind interface {
 type CircleORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]CircleORM) error
 }
+
+// DefaultCountCircle returns the number of rows DefaultListCircle pages through
+func DefaultCountCircle(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := Circle{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(CircleORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(CircleORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(CircleORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	return paging.Count(db.Where(&ormObj), &CircleORM{}, strategy)
+}
+
+type CircleORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// CircleRepository runs the Default operations of Circle, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type CircleRepository interface {
+	Create(ctx context.Context, in *Circle) (*Circle, error)
+	CreateSet(ctx context.Context, in []*Circle, batchSize int) ([]*Circle, error)
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Circle, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+}
+
+// GormCircleRepository is the CircleRepository calling the Default handlers with DB
+type GormCircleRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormCircleRepository returns the CircleRepository running the operations with db
+func NewGormCircleRepository(db *gorm.DB) *GormCircleRepository {
+	return &GormCircleRepository{DB: db}
+}
+
+func (r *GormCircleRepository) Create(ctx context.Context, in *Circle) (*Circle, error) {
+	return DefaultCreateCircle(ctx, in, r.DB)
+}
+
+func (r *GormCircleRepository) CreateSet(ctx context.Context, in []*Circle, batchSize int) ([]*Circle, error) {
+	return DefaultCreateCircleSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormCircleRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Circle, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Circle doesn't support filtering")
+	}
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Circle doesn't support sorting")
+	}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Circle doesn't support pagination")
+	}
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Circle doesn't support field selection")
+	}
+	return DefaultListCircle(ctx, r.DB)
+}
+
+func (r *GormCircleRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of Circle doesn't support filtering")
+	}
+	return DefaultCountCircle(ctx, r.DB, strategy)
+}
+
+// MemoryCircleRepository is the CircleRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryCircleRepository struct {
+	table memory.Table
+}
+
+// NewMemoryCircleRepository returns an empty MemoryCircleRepository
+func NewMemoryCircleRepository() *MemoryCircleRepository {
+	return &MemoryCircleRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryCircleRepository) row(ctx context.Context, in, stored *Circle) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: nil, Key: nil, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryCircleRepository) tenant(ctx context.Context) (interface{}, error) {
+	_, err := (&Circle{}).ToORM(ctx)
+	return nil, err
+}
+
+func (r *MemoryCircleRepository) Create(ctx context.Context, in *Circle) (*Circle, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*Circle), nil
+}
+
+func (r *MemoryCircleRepository) CreateSet(ctx context.Context, in []*Circle, batchSize int) ([]*Circle, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*Circle, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*Circle))
+	}
+	if err := r.table.Insert(rows...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *MemoryCircleRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Circle, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*Circle, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*Circle))
+	}
+	return out, nil
+}
+
+func (r *MemoryCircleRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+// CircleFixtureOption customizes the object returned by NewCircleFixture
+type CircleFixtureOption func(*Circle)
+
+// NewCircleFixture returns a Circle with random valid values in its fields and
+// its required associations, customized by opts
+func NewCircleFixture(opts ...CircleFixtureOption) *Circle {
+	m := &Circle{}
+	m.R = uint32(fixture.Uint(1000000))
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CreateCircleFixture creates the object returned by NewCircleFixture and its
+// associations with DefaultCreateCircle
+func CreateCircleFixture(ctx context.Context, db *gorm.DB, opts ...CircleFixtureOption) (*Circle, error) {
+	return DefaultCreateCircle(ctx, NewCircleFixture(opts...), db)
+}
+
 type IntPointServiceDefaultServer struct {
 	DB *gorm.DB
+	// ReaderDB is used by the read methods when it is set, a replica of DB for instance
+	ReaderDB *gorm.DB
+	// DBResolver picks the database of each request, DB or ReaderDB are used when it is nil
+	DBResolver dbresolver.Resolver
+	// NewIntPointRepository returns the repository the methods run the operations on IntPoint with,
+	// NewGormIntPointRepository when it is nil
+	NewIntPointRepository func(*gorm.DB) IntPointRepository
+	// NewSomethingRepository returns the repository the methods run the operations on Something with,
+	// NewGormSomethingRepository when it is nil
+	NewSomethingRepository func(*gorm.DB) SomethingRepository
+}
+
+func (m *IntPointServiceDefaultServer) intPointRepository(db *gorm.DB) IntPointRepository {
+	if m.NewIntPointRepository != nil {
+		return m.NewIntPointRepository(db)
+	}
+	return NewGormIntPointRepository(db)
+}
+
+func (m *IntPointServiceDefaultServer) somethingRepository(db *gorm.DB) SomethingRepository {
+	if m.NewSomethingRepository != nil {
+		return m.NewSomethingRepository(db)
+	}
+	return NewGormSomethingRepository(db)
 }
 
 // Create ...
 func (m *IntPointServiceDefaultServer) Create(ctx context.Context, in *CreateIntPointRequest) (*CreateIntPointResponse, error) {
 	db := m.DB
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.IntPointService/Create", ReadOnly: false, Primary: true}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewIntPointRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeCreate); ok {
 		var err error
-		if db, err = custom.BeforeCreate(ctx, db); err != nil {
-			return nil, err
+		if db, err = custom.BeforeCreate(ctx, db); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	res, err := m.intPointRepository(db).Create(ctx, in.GetPayload())
+	if err != nil {
+		return nil, errors.Translate(err)
+	}
+	out := &CreateIntPointResponse{Result: res}
+	err = gateway.SetCreated(ctx, "")
+	if err != nil {
+		return nil, errors.Translate(err)
+	}
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterCreate); ok {
+		var err error
+		if err = custom.AfterCreate(ctx, out, db); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	return out, nil
+}
+
+// IntPointServiceIntPointWithBeforeCreate called before DefaultCreateIntPoint in the default Create handler
+type IntPointServiceIntPointWithBeforeCreate interface {
+	BeforeCreate(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointServiceIntPointWithAfterCreate called before DefaultCreateIntPoint in the default Create handler
+type IntPointServiceIntPointWithAfterCreate interface {
+	AfterCreate(context.Context, *CreateIntPointResponse, *gorm.DB) error
+}
+
+// CreateSet ...
+func (m *IntPointServiceDefaultServer) CreateSet(ctx context.Context, in *CreateSetIntPointRequest) (*CreateSetIntPointResponse, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	db := m.DB
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.IntPointService/CreateSet", ReadOnly: false, Primary: true}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewIntPointRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeCreateSet); ok {
+		var err error
+		if db, err = custom.BeforeCreateSet(ctx, db); err != nil {
+			return nil, errors.Translate(err)
 		}
 	}
-	res, err := DefaultCreateIntPoint(ctx, in.GetPayload(), db)
+	res, err := m.intPointRepository(db).CreateSet(ctx, in.GetObjects(), 500)
 	if err != nil {
-		return nil, err
+		return nil, errors.Translate(err)
 	}
-	out := &CreateIntPointResponse{Result: res}
+	out := &CreateSetIntPointResponse{Results: res}
 	err = gateway.SetCreated(ctx, "")
 	if err != nil {
-		return nil, err
+		return nil, errors.Translate(err)
 	}
-	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterCreate); ok {
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterCreateSet); ok {
 		var err error
-		if err = custom.AfterCreate(ctx, out, db); err != nil {
-			return nil, err
+		if err = custom.AfterCreateSet(ctx, out, db); err != nil {
+			return nil, errors.Translate(err)
 		}
 	}
 	return out, nil
 }
 
-// IntPointServiceIntPointWithBeforeCreate called before DefaultCreateIntPoint in the default Create handler
-type IntPointServiceIntPointWithBeforeCreate interface {
-	BeforeCreate(context.Context, *gorm.DB) (*gorm.DB, error)
+// IntPointServiceIntPointWithBeforeCreateSet called before DefaultCreateSetIntPoint in the default CreateSet handler
+type IntPointServiceIntPointWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// IntPointServiceIntPointWithAfterCreate called before DefaultCreateIntPoint in the default Create handler
-type IntPointServiceIntPointWithAfterCreate interface {
-	AfterCreate(context.Context, *CreateIntPointResponse, *gorm.DB) error
+// IntPointServiceIntPointWithAfterCreateSet called before DefaultCreateSetIntPoint in the default CreateSet handler
+type IntPointServiceIntPointWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, *CreateSetIntPointResponse, *gorm.DB) error
 }
 
 // Read ...
 func (m *IntPointServiceDefaultServer) Read(ctx context.Context, in *ReadIntPointRequest) (*ReadIntPointResponse, error) {
 	db := m.DB
+	if m.ReaderDB != nil && !dbresolver.UsePrimary(ctx) {
+		db = dbresolver.Replica(m.ReaderDB)
+	}
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.IntPointService/Read", ReadOnly: true, Primary: dbresolver.UsePrimary(ctx)}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewIntPointRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeRead); ok {
 		var err error
 		if db, err = custom.BeforeRead(ctx, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
-	res, err := DefaultReadIntPoint(ctx, &IntPoint{Id: in.GetId()}, db, in.Fields)
+	res, err := m.intPointRepository(db).Read(ctx, &IntPoint{Id: in.GetId()}, in.Fields)
 	if err != nil {
-		return nil, err
+		return nil, errors.Translate(err)
 	}
 	out := &ReadIntPointResponse{Result: res}
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterRead); ok {
 		var err error
 		if err = custom.AfterRead(ctx, out, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
 	return out, nil
@@ -862,25 +2105,34 @@ func (m *IntPointServiceDefaultServer) Update(ctx context.Context, in *UpdateInt
 	var err error
 	var res *IntPoint
 	db := m.DB
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.IntPointService/Update", ReadOnly: false, Primary: true}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewIntPointRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeUpdate); ok {
 		var err error
 		if db, err = custom.BeforeUpdate(ctx, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
 	if in.GetGerogeriGegege() == nil {
-		res, err = DefaultStrictUpdateIntPoint(ctx, in.GetPayload(), db)
+		res, err = m.intPointRepository(db).StrictUpdate(ctx, in.GetPayload())
 	} else {
-		res, err = DefaultPatchIntPoint(ctx, in.GetPayload(), in.GetGerogeriGegege(), db)
+		res, err = m.intPointRepository(db).Patch(ctx, in.GetPayload(), in.GetGerogeriGegege())
 	}
 	if err != nil {
-		return nil, err
+		return nil, errors.Translate(err)
 	}
 	out := &UpdateIntPointResponse{Result: res}
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterUpdate); ok {
 		var err error
 		if err = custom.AfterUpdate(ctx, out, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
 	return out, nil
@@ -903,17 +2155,26 @@ func (m *IntPointServiceDefaultServer) UpdateSet(ctx context.Context, in *Update
 	}
 
 	db := m.DB
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.IntPointService/UpdateSet", ReadOnly: false, Primary: true}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewIntPointRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
 
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeUpdateSet); ok {
 		var err error
 		if db, err = custom.BeforeUpdateSet(ctx, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
 
-	res, err := DefaultPatchSetIntPoint(ctx, in.GetObjects(), in.GetMasks(), db)
+	res, err := m.intPointRepository(db).PatchSet(ctx, in.GetObjects(), in.GetMasks())
 	if err != nil {
-		return nil, err
+		return nil, errors.Translate(err)
 	}
 
 	out := &UpdateSetIntPointResponse{Results: res}
@@ -921,7 +2182,7 @@ func (m *IntPointServiceDefaultServer) UpdateSet(ctx context.Context, in *Update
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterUpdateSet); ok {
 		var err error
 		if err = custom.AfterUpdateSet(ctx, out, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
 
@@ -941,37 +2202,63 @@ type IntPointServiceIntPointWithAfterUpdateSet interface {
 // List ...
 func (m *IntPointServiceDefaultServer) List(ctx context.Context, in *ListIntPointRequest) (*ListIntPointResponse, error) {
 	db := m.DB
+	if m.ReaderDB != nil && !dbresolver.UsePrimary(ctx) {
+		db = dbresolver.Replica(m.ReaderDB)
+	}
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.IntPointService/List", ReadOnly: true, Primary: dbresolver.UsePrimary(ctx)}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewIntPointRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeList); ok {
 		var err error
 		if db, err = custom.BeforeList(ctx, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
 	pagedRequest := false
-	if in.GetPaging().GetLimit() >= 1 {
-		in.Paging.Limit++
+	page := in.GetPaging()
+	if page.GetLimit() >= 1 {
+		// one more row tells whether there is a next page, the request is
+		// left unchanged for the retries of the transaction
+		page = &query.Pagination{PageToken: page.GetPageToken(), Offset: page.GetOffset(), Limit: page.GetLimit() + 1}
 		pagedRequest = true
 	}
-	res, err := DefaultListIntPoint(ctx, db, in.Filter, in.OrderBy, in.Paging, in.Fields)
+	res, err := m.intPointRepository(db).List(ctx, in.Filter, in.OrderBy, page, in.Fields)
 	if err != nil {
-		return nil, err
+		return nil, errors.Translate(err)
 	}
 	var resPaging *query.PageInfo
 	if pagedRequest {
 		var offset int32
 		var size int32 = int32(len(res))
-		if size == in.GetPaging().GetLimit() {
+		if size == page.GetLimit() {
 			size--
 			res = res[:size]
 			offset = in.GetPaging().GetOffset() + size
 		}
 		resPaging = &query.PageInfo{Offset: offset}
 	}
+	count, err := m.intPointRepository(db).Count(ctx, in.Filter, paging.ExactCount)
+	if err != nil {
+		return nil, errors.Translate(err)
+	}
+	if count > math.MaxInt32 {
+		count = math.MaxInt32
+	}
+	if resPaging == nil {
+		resPaging = &query.PageInfo{}
+	}
+	resPaging.Size = int32(count)
 	out := &ListIntPointResponse{Results: res, PageInfo: resPaging}
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterList); ok {
 		var err error
 		if err = custom.AfterList(ctx, out, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
 	return out, nil
@@ -990,21 +2277,33 @@ type IntPointServiceIntPointWithAfterList interface {
 // ListSomething ...
 func (m *IntPointServiceDefaultServer) ListSomething(ctx context.Context, in *emptypb.Empty) (*ListSomethingResponse, error) {
 	db := m.DB
+	if m.ReaderDB != nil && !dbresolver.UsePrimary(ctx) {
+		db = dbresolver.Replica(m.ReaderDB)
+	}
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.IntPointService/ListSomething", ReadOnly: true, Primary: dbresolver.UsePrimary(ctx)}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewSomethingRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
 	if custom, ok := interface{}(in).(IntPointServiceSomethingWithBeforeListSomething); ok {
 		var err error
 		if db, err = custom.BeforeListSomething(ctx, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
-	res, err := DefaultListSomething(ctx, db)
+	res, err := m.somethingRepository(db).List(ctx, nil, nil, nil, nil)
 	if err != nil {
-		return nil, err
+		return nil, errors.Translate(err)
 	}
 	out := &ListSomethingResponse{Results: res}
 	if custom, ok := interface{}(in).(IntPointServiceSomethingWithAfterListSomething); ok {
 		var err error
 		if err = custom.AfterListSomething(ctx, out, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
 	return out, nil
@@ -1023,21 +2322,30 @@ type IntPointServiceSomethingWithAfterListSomething interface {
 // Delete ...
 func (m *IntPointServiceDefaultServer) Delete(ctx context.Context, in *DeleteIntPointRequest) (*DeleteIntPointResponse, error) {
 	db := m.DB
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.IntPointService/Delete", ReadOnly: false, Primary: true}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewIntPointRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeDelete); ok {
 		var err error
 		if db, err = custom.BeforeDelete(ctx, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
-	err := DefaultDeleteIntPoint(ctx, &IntPoint{Id: in.GetId()}, db)
+	err := m.intPointRepository(db).Delete(ctx, &IntPoint{Id: in.GetId()})
 	if err != nil {
-		return nil, err
+		return nil, errors.Translate(err)
 	}
 	out := &DeleteIntPointResponse{}
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterDelete); ok {
 		var err error
 		if err = custom.AfterDelete(ctx, out, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
 	return out, nil
@@ -1065,9 +2373,25 @@ func (m *IntPointServiceDefaultServer) CreateSomething(ctx context.Context, in *
 	return out, nil
 }
 
+// SyncSomething ...
+func (m *IntPointServiceDefaultServer) SyncSomething(stream IntPointService_SyncSomethingServer) error {
+	return status.Error(codes.Unimplemented, "SyncSomething isn't implemented")
+}
+
 type IntPointTxnDefaultServer struct {
+	// Metrics records the requests, nothing is recorded when it is nil
+	Metrics metrics.Metrics
+	// NewIntPointRepository returns the repository the methods run the operations on IntPoint with,
+	// NewGormIntPointRepository when it is nil
+	NewIntPointRepository func(*gorm.DB) IntPointRepository
 }
 
+func (m *IntPointTxnDefaultServer) intPointRepository(db *gorm.DB) IntPointRepository {
+	if m.NewIntPointRepository != nil {
+		return m.NewIntPointRepository(db)
+	}
+	return NewGormIntPointRepository(db)
+}
 func (m *IntPointTxnDefaultServer) spanCreate(ctx context.Context, in interface{}, methodName string) (*trace.Span, error) {
 	_, span := trace.StartSpan(ctx, fmt.Sprint("IntPointTxnDefaultServer.", methodName))
 	raw, err := json.Marshal(in)
@@ -1098,7 +2422,25 @@ func (m *IntPointTxnDefaultServer) spanResult(span *trace.Span, out interface{})
 }
 
 // Create ...
-func (m *IntPointTxnDefaultServer) Create(ctx context.Context, in *CreateIntPointRequest) (*CreateIntPointResponse, error) {
+func (m *IntPointTxnDefaultServer) Create(ctx context.Context, in *CreateIntPointRequest) (result *CreateIntPointResponse, resultErr error) {
+	start := time.Now()
+	defer func() {
+		if m.Metrics == nil {
+			return
+		}
+		rows := 0
+		if resultErr == nil {
+			rows = 1
+		}
+		m.Metrics.Observe(ctx, metrics.Observation{
+			FullMethod: "/example.IntPointTxn/Create",
+			Verb:       "Create",
+			Type:       "IntPoint",
+			Latency:    time.Since(start),
+			Rows:       rows,
+			ErrorClass: metrics.ErrorClass(resultErr),
+		})
+	}()
 	span, errSpanCreate := m.spanCreate(ctx, in, "Create")
 	if errSpanCreate != nil {
 		return nil, errSpanCreate
@@ -1115,27 +2457,27 @@ func (m *IntPointTxnDefaultServer) Create(ctx context.Context, in *CreateIntPoin
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeCreate); ok {
 		var err error
 		if db, err = custom.BeforeCreate(ctx, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, errors.Translate(err))
 		}
 	}
-	res, err := DefaultCreateIntPoint(ctx, in.GetPayload(), db)
+	res, err := m.intPointRepository(db).Create(ctx, in.GetPayload())
 	if err != nil {
-		return nil, m.spanError(span, err)
+		return nil, m.spanError(span, errors.Translate(err))
 	}
 	out := &CreateIntPointResponse{Result: res}
 	err = gateway.SetCreated(ctx, "")
 	if err != nil {
-		return nil, m.spanError(span, err)
+		return nil, m.spanError(span, errors.Translate(err))
 	}
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterCreate); ok {
 		var err error
 		if err = custom.AfterCreate(ctx, out, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, errors.Translate(err))
 		}
 	}
 	errSpanResult := m.spanResult(span, out)
 	if errSpanResult != nil {
-		return nil, m.spanError(span, errSpanResult)
+		return nil, m.spanError(span, errors.Translate(errSpanResult))
 	}
 	return out, nil
 }
@@ -1151,7 +2493,25 @@ type IntPointTxnIntPointWithAfterCreate interface {
 }
 
 // Read ...
-func (m *IntPointTxnDefaultServer) Read(ctx context.Context, in *ReadIntPointRequest) (*ReadIntPointResponse, error) {
+func (m *IntPointTxnDefaultServer) Read(ctx context.Context, in *ReadIntPointRequest) (result *ReadIntPointResponse, resultErr error) {
+	start := time.Now()
+	defer func() {
+		if m.Metrics == nil {
+			return
+		}
+		rows := 0
+		if resultErr == nil {
+			rows = 1
+		}
+		m.Metrics.Observe(ctx, metrics.Observation{
+			FullMethod: "/example.IntPointTxn/Read",
+			Verb:       "Read",
+			Type:       "IntPoint",
+			Latency:    time.Since(start),
+			Rows:       rows,
+			ErrorClass: metrics.ErrorClass(resultErr),
+		})
+	}()
 	span, errSpanCreate := m.spanCreate(ctx, in, "Read")
 	if errSpanCreate != nil {
 		return nil, errSpanCreate
@@ -1168,23 +2528,23 @@ func (m *IntPointTxnDefaultServer) Read(ctx context.Context, in *ReadIntPointReq
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeRead); ok {
 		var err error
 		if db, err = custom.BeforeRead(ctx, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, errors.Translate(err))
 		}
 	}
-	res, err := DefaultReadIntPoint(ctx, &IntPoint{Id: in.GetId()}, db, in.Fields)
+	res, err := m.intPointRepository(db).Read(ctx, &IntPoint{Id: in.GetId()}, in.Fields)
 	if err != nil {
-		return nil, m.spanError(span, err)
+		return nil, m.spanError(span, errors.Translate(err))
 	}
 	out := &ReadIntPointResponse{Result: res}
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterRead); ok {
 		var err error
 		if err = custom.AfterRead(ctx, out, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, errors.Translate(err))
 		}
 	}
 	errSpanResult := m.spanResult(span, out)
 	if errSpanResult != nil {
-		return nil, m.spanError(span, errSpanResult)
+		return nil, m.spanError(span, errors.Translate(errSpanResult))
 	}
 	return out, nil
 }
@@ -1200,7 +2560,25 @@ type IntPointTxnIntPointWithAfterRead interface {
 }
 
 // Update ...
-func (m *IntPointTxnDefaultServer) Update(ctx context.Context, in *UpdateIntPointRequest) (*UpdateIntPointResponse, error) {
+func (m *IntPointTxnDefaultServer) Update(ctx context.Context, in *UpdateIntPointRequest) (result *UpdateIntPointResponse, resultErr error) {
+	start := time.Now()
+	defer func() {
+		if m.Metrics == nil {
+			return
+		}
+		rows := 0
+		if resultErr == nil {
+			rows = 1
+		}
+		m.Metrics.Observe(ctx, metrics.Observation{
+			FullMethod: "/example.IntPointTxn/Update",
+			Verb:       "Update",
+			Type:       "IntPoint",
+			Latency:    time.Since(start),
+			Rows:       rows,
+			ErrorClass: metrics.ErrorClass(resultErr),
+		})
+	}()
 	span, errSpanCreate := m.spanCreate(ctx, in, "Update")
 	if errSpanCreate != nil {
 		return nil, errSpanCreate
@@ -1219,27 +2597,27 @@ func (m *IntPointTxnDefaultServer) Update(ctx context.Context, in *UpdateIntPoin
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeUpdate); ok {
 		var err error
 		if db, err = custom.BeforeUpdate(ctx, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, errors.Translate(err))
 		}
 	}
 	if in.GetGerogeriGegege() == nil {
-		res, err = DefaultStrictUpdateIntPoint(ctx, in.GetPayload(), db)
+		res, err = m.intPointRepository(db).StrictUpdate(ctx, in.GetPayload())
 	} else {
-		res, err = DefaultPatchIntPoint(ctx, in.GetPayload(), in.GetGerogeriGegege(), db)
+		res, err = m.intPointRepository(db).Patch(ctx, in.GetPayload(), in.GetGerogeriGegege())
 	}
 	if err != nil {
-		return nil, m.spanError(span, err)
+		return nil, m.spanError(span, errors.Translate(err))
 	}
 	out := &UpdateIntPointResponse{Result: res}
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterUpdate); ok {
 		var err error
 		if err = custom.AfterUpdate(ctx, out, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, errors.Translate(err))
 		}
 	}
 	errSpanResult := m.spanResult(span, out)
 	if errSpanResult != nil {
-		return nil, m.spanError(span, errSpanResult)
+		return nil, m.spanError(span, errors.Translate(errSpanResult))
 	}
 	return out, nil
 }
@@ -1255,7 +2633,22 @@ type IntPointTxnIntPointWithAfterUpdate interface {
 }
 
 // List ...
-func (m *IntPointTxnDefaultServer) List(ctx context.Context, in *ListIntPointRequest) (*ListIntPointResponse, error) {
+func (m *IntPointTxnDefaultServer) List(ctx context.Context, in *ListIntPointRequest) (result *ListIntPointResponse, resultErr error) {
+	start := time.Now()
+	defer func() {
+		if m.Metrics == nil {
+			return
+		}
+		rows := len(result.GetResults())
+		m.Metrics.Observe(ctx, metrics.Observation{
+			FullMethod: "/example.IntPointTxn/List",
+			Verb:       "List",
+			Type:       "IntPoint",
+			Latency:    time.Since(start),
+			Rows:       rows,
+			ErrorClass: metrics.ErrorClass(resultErr),
+		})
+	}()
 	span, errSpanCreate := m.spanCreate(ctx, in, "List")
 	if errSpanCreate != nil {
 		return nil, errSpanCreate
@@ -1272,23 +2665,26 @@ func (m *IntPointTxnDefaultServer) List(ctx context.Context, in *ListIntPointReq
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeList); ok {
 		var err error
 		if db, err = custom.BeforeList(ctx, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, errors.Translate(err))
 		}
 	}
 	pagedRequest := false
-	if in.GetPaging().GetLimit() >= 1 {
-		in.Paging.Limit++
+	page := in.GetPaging()
+	if page.GetLimit() >= 1 {
+		// one more row tells whether there is a next page, the request is
+		// left unchanged for the retries of the transaction
+		page = &query.Pagination{PageToken: page.GetPageToken(), Offset: page.GetOffset(), Limit: page.GetLimit() + 1}
 		pagedRequest = true
 	}
-	res, err := DefaultListIntPoint(ctx, db, in.Filter, in.OrderBy, in.Paging, in.Fields)
+	res, err := m.intPointRepository(db).List(ctx, in.Filter, in.OrderBy, page, in.Fields)
 	if err != nil {
-		return nil, m.spanError(span, err)
+		return nil, m.spanError(span, errors.Translate(err))
 	}
 	var resPaging *query.PageInfo
 	if pagedRequest {
 		var offset int32
 		var size int32 = int32(len(res))
-		if size == in.GetPaging().GetLimit() {
+		if size == page.GetLimit() {
 			size--
 			res = res[:size]
 			offset = in.GetPaging().GetOffset() + size
@@ -1299,12 +2695,12 @@ func (m *IntPointTxnDefaultServer) List(ctx context.Context, in *ListIntPointReq
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterList); ok {
 		var err error
 		if err = custom.AfterList(ctx, out, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, errors.Translate(err))
 		}
 	}
 	errSpanResult := m.spanResult(span, out)
 	if errSpanResult != nil {
-		return nil, m.spanError(span, errSpanResult)
+		return nil, m.spanError(span, errors.Translate(errSpanResult))
 	}
 	return out, nil
 }
@@ -1320,7 +2716,25 @@ type IntPointTxnIntPointWithAfterList interface {
 }
 
 // Delete ...
-func (m *IntPointTxnDefaultServer) Delete(ctx context.Context, in *DeleteIntPointRequest) (*DeleteIntPointResponse, error) {
+func (m *IntPointTxnDefaultServer) Delete(ctx context.Context, in *DeleteIntPointRequest) (result *DeleteIntPointResponse, resultErr error) {
+	start := time.Now()
+	defer func() {
+		if m.Metrics == nil {
+			return
+		}
+		rows := 0
+		if resultErr == nil {
+			rows = 1
+		}
+		m.Metrics.Observe(ctx, metrics.Observation{
+			FullMethod: "/example.IntPointTxn/Delete",
+			Verb:       "Delete",
+			Type:       "IntPoint",
+			Latency:    time.Since(start),
+			Rows:       rows,
+			ErrorClass: metrics.ErrorClass(resultErr),
+		})
+	}()
 	span, errSpanCreate := m.spanCreate(ctx, in, "Delete")
 	if errSpanCreate != nil {
 		return nil, errSpanCreate
@@ -1337,23 +2751,23 @@ func (m *IntPointTxnDefaultServer) Delete(ctx context.Context, in *DeleteIntPoin
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeDelete); ok {
 		var err error
 		if db, err = custom.BeforeDelete(ctx, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, errors.Translate(err))
 		}
 	}
-	err := DefaultDeleteIntPoint(ctx, &IntPoint{Id: in.GetId()}, db)
+	err := m.intPointRepository(db).Delete(ctx, &IntPoint{Id: in.GetId()})
 	if err != nil {
-		return nil, m.spanError(span, err)
+		return nil, m.spanError(span, errors.Translate(err))
 	}
 	out := &DeleteIntPointResponse{}
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterDelete); ok {
 		var err error
 		if err = custom.AfterDelete(ctx, out, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, errors.Translate(err))
 		}
 	}
 	errSpanResult := m.spanResult(span, out)
 	if errSpanResult != nil {
-		return nil, m.spanError(span, errSpanResult)
+		return nil, m.spanError(span, errors.Translate(errSpanResult))
 	}
 	return out, nil
 }
@@ -1369,7 +2783,25 @@ type IntPointTxnIntPointWithAfterDelete interface {
 }
 
 // DeleteSet ...
-func (m *IntPointTxnDefaultServer) DeleteSet(ctx context.Context, in *DeleteIntPointsRequest) (*DeleteIntPointResponse, error) {
+func (m *IntPointTxnDefaultServer) DeleteSet(ctx context.Context, in *DeleteIntPointsRequest) (result *DeleteIntPointResponse, resultErr error) {
+	start := time.Now()
+	defer func() {
+		if m.Metrics == nil {
+			return
+		}
+		rows := 0
+		if resultErr == nil {
+			rows = len(in.GetIds())
+		}
+		m.Metrics.Observe(ctx, metrics.Observation{
+			FullMethod: "/example.IntPointTxn/DeleteSet",
+			Verb:       "DeleteSet",
+			Type:       "IntPoint",
+			Latency:    time.Since(start),
+			Rows:       rows,
+			ErrorClass: metrics.ErrorClass(resultErr),
+		})
+	}()
 	span, errSpanCreate := m.spanCreate(ctx, in, "DeleteSet")
 	if errSpanCreate != nil {
 		return nil, errSpanCreate
@@ -1390,23 +2822,23 @@ func (m *IntPointTxnDefaultServer) DeleteSet(ctx context.Context, in *DeleteIntP
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithBeforeDeleteSet); ok {
 		var err error
 		if db, err = custom.BeforeDeleteSet(ctx, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, errors.Translate(err))
 		}
 	}
-	err := DefaultDeleteIntPointSet(ctx, objs, db)
+	err := m.intPointRepository(db).DeleteSet(ctx, objs)
 	if err != nil {
-		return nil, m.spanError(span, err)
+		return nil, m.spanError(span, errors.Translate(err))
 	}
 	out := &DeleteIntPointResponse{}
 	if custom, ok := interface{}(in).(IntPointTxnIntPointWithAfterDeleteSet); ok {
 		var err error
 		if err = custom.AfterDeleteSet(ctx, out, db); err != nil {
-			return nil, m.spanError(span, err)
+			return nil, m.spanError(span, errors.Translate(err))
 		}
 	}
 	errSpanResult := m.spanResult(span, out)
 	if errSpanResult != nil {
-		return nil, m.spanError(span, errSpanResult)
+		return nil, m.spanError(span, errors.Translate(errSpanResult))
 	}
 	return out, nil
 }
@@ -1431,7 +2863,7 @@ func (m *IntPointTxnDefaultServer) CustomMethod(ctx context.Context, in *emptypb
 	out := &emptypb.Empty{}
 	errSpanResult := m.spanResult(span, out)
 	if errSpanResult != nil {
-		return nil, m.spanError(span, errSpanResult)
+		return nil, m.spanError(span, errors.Translate(errSpanResult))
 	}
 	return out, nil
 }
@@ -1446,33 +2878,59 @@ func (m *IntPointTxnDefaultServer) CreateSomething(ctx context.Context, in *Some
 	out := &Something{}
 	errSpanResult := m.spanResult(span, out)
 	if errSpanResult != nil {
-		return nil, m.spanError(span, errSpanResult)
+		return nil, m.spanError(span, errors.Translate(errSpanResult))
 	}
 	return out, nil
 }
 
 type CircleServiceDefaultServer struct {
 	DB *gorm.DB
+	// ReaderDB is used by the read methods when it is set, a replica of DB for instance
+	ReaderDB *gorm.DB
+	// DBResolver picks the database of each request, DB or ReaderDB are used when it is nil
+	DBResolver dbresolver.Resolver
+	// NewCircleRepository returns the repository the methods run the operations on Circle with,
+	// NewGormCircleRepository when it is nil
+	NewCircleRepository func(*gorm.DB) CircleRepository
+}
+
+func (m *CircleServiceDefaultServer) circleRepository(db *gorm.DB) CircleRepository {
+	if m.NewCircleRepository != nil {
+		return m.NewCircleRepository(db)
+	}
+	return NewGormCircleRepository(db)
 }
 
 // List ...
 func (m *CircleServiceDefaultServer) List(ctx context.Context, in *ListCircleRequest) (*ListCircleResponse, error) {
 	db := m.DB
+	if m.ReaderDB != nil && !dbresolver.UsePrimary(ctx) {
+		db = dbresolver.Replica(m.ReaderDB)
+	}
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.CircleService/List", ReadOnly: true, Primary: dbresolver.UsePrimary(ctx)}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewCircleRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
 	if custom, ok := interface{}(in).(CircleServiceCircleWithBeforeList); ok {
 		var err error
 		if db, err = custom.BeforeList(ctx, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
-	res, err := DefaultListCircle(ctx, db)
+	res, err := m.circleRepository(db).List(ctx, nil, nil, nil, nil)
 	if err != nil {
-		return nil, err
+		return nil, errors.Translate(err)
 	}
 	out := &ListCircleResponse{Results: res}
 	if custom, ok := interface{}(in).(CircleServiceCircleWithAfterList); ok {
 		var err error
 		if err = custom.AfterList(ctx, out, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
 	return out, nil
@@ -1489,32 +2947,87 @@ type CircleServiceCircleWithAfterList interface {
 }
 type MultipleMethodsAutoGenDefaultServer struct {
 	DB *gorm.DB
+	// ReaderDB is used by the read methods when it is set, a replica of DB for instance
+	ReaderDB *gorm.DB
+	// DBResolver picks the database of each request, DB or ReaderDB are used when it is nil
+	DBResolver dbresolver.Resolver
+	// Retry is the policy of the retries of the transactions aborted by a serialization failure or a deadlock, retry.DefaultPolicy when nil
+	Retry *retry.Policy
+	// NewIntPointRepository returns the repository the methods run the operations on IntPoint with,
+	// NewGormIntPointRepository when it is nil
+	NewIntPointRepository func(*gorm.DB) IntPointRepository
+}
+
+func (m *MultipleMethodsAutoGenDefaultServer) intPointRepository(db *gorm.DB) IntPointRepository {
+	if m.NewIntPointRepository != nil {
+		return m.NewIntPointRepository(db)
+	}
+	return NewGormIntPointRepository(db)
 }
 
 // CreateA ...
 func (m *MultipleMethodsAutoGenDefaultServer) CreateA(ctx context.Context, in *CreateIntPointRequest) (*CreateIntPointResponse, error) {
+	var out *CreateIntPointResponse
+	err := retry.Do(ctx, m.Retry, func(ctx context.Context) (err error) {
+		out, err = m.createAInTransaction(ctx, in)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// the status is set once, after the attempt committed
+	if err = gateway.SetCreated(ctx, ""); err != nil {
+		return nil, errors.Translate(err)
+	}
+	return out, err
+}
+
+// createAInTransaction runs CreateA once, in a transaction
+func (m *MultipleMethodsAutoGenDefaultServer) createAInTransaction(ctx context.Context, in *CreateIntPointRequest) (*CreateIntPointResponse, error) {
 	db := m.DB
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.MultipleMethodsAutoGen/CreateA", ReadOnly: false, Primary: true}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewIntPointRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
+	ctx = cache.Defer(ctx)
+	var tx *gorm.DB
+	// the repositories without database run without transaction
+	if db != nil {
+		tx = transaction.Begin(ctx, db, transaction.Options{})
+		if tx.Error != nil {
+			return nil, errors.Translate(tx.Error)
+		}
+		defer tx.Rollback()
+		db = tx
+	}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeCreateA); ok {
 		var err error
 		if db, err = custom.BeforeCreateA(ctx, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
-	res, err := DefaultCreateIntPoint(ctx, in.GetPayload(), db)
+	res, err := m.intPointRepository(db).Create(ctx, in.GetPayload())
 	if err != nil {
-		return nil, err
+		return nil, errors.Translate(err)
 	}
 	out := &CreateIntPointResponse{Result: res}
-	err = gateway.SetCreated(ctx, "")
-	if err != nil {
-		return nil, err
-	}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterCreateA); ok {
 		var err error
 		if err = custom.AfterCreateA(ctx, out, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
+		}
+	}
+	if tx != nil {
+		if err := tx.Commit().Error; err != nil {
+			return nil, errors.Translate(err)
 		}
 	}
+	cache.Flush(ctx)
 	return out, nil
 }
 
@@ -1530,28 +3043,67 @@ type MultipleMethodsAutoGenIntPointWithAfterCreateA interface {
 
 // CreateB ...
 func (m *MultipleMethodsAutoGenDefaultServer) CreateB(ctx context.Context, in *CreateIntPointRequest) (*CreateIntPointResponse, error) {
+	var out *CreateIntPointResponse
+	err := retry.Do(ctx, m.Retry, func(ctx context.Context) (err error) {
+		out, err = m.createBInTransaction(ctx, in)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// the status is set once, after the attempt committed
+	if err = gateway.SetCreated(ctx, ""); err != nil {
+		return nil, errors.Translate(err)
+	}
+	return out, err
+}
+
+// createBInTransaction runs CreateB once, in a transaction
+func (m *MultipleMethodsAutoGenDefaultServer) createBInTransaction(ctx context.Context, in *CreateIntPointRequest) (*CreateIntPointResponse, error) {
 	db := m.DB
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.MultipleMethodsAutoGen/CreateB", ReadOnly: false, Primary: true}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewIntPointRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
+	ctx = cache.Defer(ctx)
+	var tx *gorm.DB
+	// the repositories without database run without transaction
+	if db != nil {
+		tx = transaction.Begin(ctx, db, transaction.Options{})
+		if tx.Error != nil {
+			return nil, errors.Translate(tx.Error)
+		}
+		defer tx.Rollback()
+		db = tx
+	}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeCreateB); ok {
 		var err error
 		if db, err = custom.BeforeCreateB(ctx, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
-	res, err := DefaultCreateIntPoint(ctx, in.GetPayload(), db)
+	res, err := m.intPointRepository(db).Create(ctx, in.GetPayload())
 	if err != nil {
-		return nil, err
+		return nil, errors.Translate(err)
 	}
 	out := &CreateIntPointResponse{Result: res}
-	err = gateway.SetCreated(ctx, "")
-	if err != nil {
-		return nil, err
-	}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterCreateB); ok {
 		var err error
 		if err = custom.AfterCreateB(ctx, out, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
+		}
+	}
+	if tx != nil {
+		if err := tx.Commit().Error; err != nil {
+			return nil, errors.Translate(err)
 		}
 	}
+	cache.Flush(ctx)
 	return out, nil
 }
 
@@ -1568,21 +3120,30 @@ type MultipleMethodsAutoGenIntPointWithAfterCreateB interface {
 // ReadA ...
 func (m *MultipleMethodsAutoGenDefaultServer) ReadA(ctx context.Context, in *ReadIntPointRequest) (*ReadIntPointResponse, error) {
 	db := m.DB
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.MultipleMethodsAutoGen/ReadA", ReadOnly: true, Primary: true}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewIntPointRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeReadA); ok {
 		var err error
 		if db, err = custom.BeforeReadA(ctx, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
-	res, err := DefaultReadIntPoint(ctx, &IntPoint{Id: in.GetId()}, db, in.Fields)
+	res, err := m.intPointRepository(db).Read(ctx, &IntPoint{Id: in.GetId()}, in.Fields)
 	if err != nil {
-		return nil, err
+		return nil, errors.Translate(err)
 	}
 	out := &ReadIntPointResponse{Result: res}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterReadA); ok {
 		var err error
 		if err = custom.AfterReadA(ctx, out, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
 	return out, nil
@@ -1600,22 +3161,59 @@ type MultipleMethodsAutoGenIntPointWithAfterReadA interface {
 
 // ReadB ...
 func (m *MultipleMethodsAutoGenDefaultServer) ReadB(ctx context.Context, in *ReadIntPointRequest) (*ReadIntPointResponse, error) {
+	var out *ReadIntPointResponse
+	err := retry.Do(ctx, m.Retry, func(ctx context.Context) (err error) {
+		out, err = m.readBInTransaction(ctx, in)
+		return err
+	})
+	return out, err
+}
+
+// readBInTransaction runs ReadB once, in a transaction
+func (m *MultipleMethodsAutoGenDefaultServer) readBInTransaction(ctx context.Context, in *ReadIntPointRequest) (*ReadIntPointResponse, error) {
 	db := m.DB
+	if m.ReaderDB != nil && !dbresolver.UsePrimary(ctx) {
+		db = dbresolver.Replica(m.ReaderDB)
+	}
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.MultipleMethodsAutoGen/ReadB", ReadOnly: true, Primary: dbresolver.UsePrimary(ctx)}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewIntPointRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
+	var tx *gorm.DB
+	// the repositories without database run without transaction
+	if db != nil {
+		tx = transaction.Begin(ctx, db, transaction.Options{ReadOnly: true, StatementTimeout: 5000 * time.Millisecond})
+		if tx.Error != nil {
+			return nil, errors.Translate(tx.Error)
+		}
+		defer tx.Rollback()
+		db = tx
+	}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeReadB); ok {
 		var err error
 		if db, err = custom.BeforeReadB(ctx, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
-	res, err := DefaultReadIntPoint(ctx, &IntPoint{Id: in.GetId()}, db, in.Fields)
+	res, err := m.intPointRepository(db).Read(ctx, &IntPoint{Id: in.GetId()}, in.Fields)
 	if err != nil {
-		return nil, err
+		return nil, errors.Translate(err)
 	}
 	out := &ReadIntPointResponse{Result: res}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterReadB); ok {
 		var err error
 		if err = custom.AfterReadB(ctx, out, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
+		}
+	}
+	if tx != nil {
+		if err := tx.Commit().Error; err != nil {
+			return nil, errors.Translate(err)
 		}
 	}
 	return out, nil
@@ -1633,30 +3231,66 @@ type MultipleMethodsAutoGenIntPointWithAfterReadB interface {
 
 // UpdateA ...
 func (m *MultipleMethodsAutoGenDefaultServer) UpdateA(ctx context.Context, in *UpdateIntPointRequest) (*UpdateIntPointResponse, error) {
+	var out *UpdateIntPointResponse
+	err := retry.Do(ctx, m.Retry, func(ctx context.Context) (err error) {
+		out, err = m.updateAInTransaction(ctx, in)
+		return err
+	})
+	return out, err
+}
+
+// updateAInTransaction runs UpdateA once, in a transaction
+func (m *MultipleMethodsAutoGenDefaultServer) updateAInTransaction(ctx context.Context, in *UpdateIntPointRequest) (*UpdateIntPointResponse, error) {
 	var err error
 	var res *IntPoint
 	db := m.DB
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.MultipleMethodsAutoGen/UpdateA", ReadOnly: false, Primary: true}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewIntPointRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
+	ctx = cache.Defer(ctx)
+	var tx *gorm.DB
+	// the repositories without database run without transaction
+	if db != nil {
+		tx = transaction.Begin(ctx, db, transaction.Options{})
+		if tx.Error != nil {
+			return nil, errors.Translate(tx.Error)
+		}
+		defer tx.Rollback()
+		db = tx
+	}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeUpdateA); ok {
 		var err error
 		if db, err = custom.BeforeUpdateA(ctx, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
 	if in.GetGerogeriGegege() == nil {
-		res, err = DefaultStrictUpdateIntPoint(ctx, in.GetPayload(), db)
+		res, err = m.intPointRepository(db).StrictUpdate(ctx, in.GetPayload())
 	} else {
-		res, err = DefaultPatchIntPoint(ctx, in.GetPayload(), in.GetGerogeriGegege(), db)
+		res, err = m.intPointRepository(db).Patch(ctx, in.GetPayload(), in.GetGerogeriGegege())
 	}
 	if err != nil {
-		return nil, err
+		return nil, errors.Translate(err)
 	}
 	out := &UpdateIntPointResponse{Result: res}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterUpdateA); ok {
 		var err error
 		if err = custom.AfterUpdateA(ctx, out, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
+		}
+	}
+	if tx != nil {
+		if err := tx.Commit().Error; err != nil {
+			return nil, errors.Translate(err)
 		}
 	}
+	cache.Flush(ctx)
 	return out, nil
 }
 
@@ -1672,30 +3306,66 @@ type MultipleMethodsAutoGenIntPointWithAfterUpdateA interface {
 
 // UpdateB ...
 func (m *MultipleMethodsAutoGenDefaultServer) UpdateB(ctx context.Context, in *UpdateIntPointRequest) (*UpdateIntPointResponse, error) {
+	var out *UpdateIntPointResponse
+	err := retry.Do(ctx, m.Retry, func(ctx context.Context) (err error) {
+		out, err = m.updateBInTransaction(ctx, in)
+		return err
+	})
+	return out, err
+}
+
+// updateBInTransaction runs UpdateB once, in a transaction
+func (m *MultipleMethodsAutoGenDefaultServer) updateBInTransaction(ctx context.Context, in *UpdateIntPointRequest) (*UpdateIntPointResponse, error) {
 	var err error
 	var res *IntPoint
 	db := m.DB
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.MultipleMethodsAutoGen/UpdateB", ReadOnly: false, Primary: true}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewIntPointRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
+	ctx = cache.Defer(ctx)
+	var tx *gorm.DB
+	// the repositories without database run without transaction
+	if db != nil {
+		tx = transaction.Begin(ctx, db, transaction.Options{Isolation: sql.LevelSerializable, LockTimeout: 1000 * time.Millisecond})
+		if tx.Error != nil {
+			return nil, errors.Translate(tx.Error)
+		}
+		defer tx.Rollback()
+		db = tx
+	}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeUpdateB); ok {
 		var err error
 		if db, err = custom.BeforeUpdateB(ctx, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
 	if in.GetGerogeriGegege() == nil {
-		res, err = DefaultStrictUpdateIntPoint(ctx, in.GetPayload(), db)
+		res, err = m.intPointRepository(db).StrictUpdate(ctx, in.GetPayload())
 	} else {
-		res, err = DefaultPatchIntPoint(ctx, in.GetPayload(), in.GetGerogeriGegege(), db)
+		res, err = m.intPointRepository(db).Patch(ctx, in.GetPayload(), in.GetGerogeriGegege())
 	}
 	if err != nil {
-		return nil, err
+		return nil, errors.Translate(err)
 	}
 	out := &UpdateIntPointResponse{Result: res}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterUpdateB); ok {
 		var err error
 		if err = custom.AfterUpdateB(ctx, out, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
+		}
+	}
+	if tx != nil {
+		if err := tx.Commit().Error; err != nil {
+			return nil, errors.Translate(err)
 		}
 	}
+	cache.Flush(ctx)
 	return out, nil
 }
 
@@ -1712,26 +3382,41 @@ type MultipleMethodsAutoGenIntPointWithAfterUpdateB interface {
 // ListA ...
 func (m *MultipleMethodsAutoGenDefaultServer) ListA(ctx context.Context, in *ListIntPointRequest) (*ListIntPointResponse, error) {
 	db := m.DB
+	if m.ReaderDB != nil && !dbresolver.UsePrimary(ctx) {
+		db = dbresolver.Replica(m.ReaderDB)
+	}
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.MultipleMethodsAutoGen/ListA", ReadOnly: true, Primary: dbresolver.UsePrimary(ctx)}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewIntPointRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeListA); ok {
 		var err error
 		if db, err = custom.BeforeListA(ctx, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
 	pagedRequest := false
-	if in.GetPaging().GetLimit() >= 1 {
-		in.Paging.Limit++
+	page := in.GetPaging()
+	if page.GetLimit() >= 1 {
+		// one more row tells whether there is a next page, the request is
+		// left unchanged for the retries of the transaction
+		page = &query.Pagination{PageToken: page.GetPageToken(), Offset: page.GetOffset(), Limit: page.GetLimit() + 1}
 		pagedRequest = true
 	}
-	res, err := DefaultListIntPoint(ctx, db, in.Filter, in.OrderBy, in.Paging, in.Fields)
+	res, err := m.intPointRepository(db).List(ctx, in.Filter, in.OrderBy, page, in.Fields)
 	if err != nil {
-		return nil, err
+		return nil, errors.Translate(err)
 	}
 	var resPaging *query.PageInfo
 	if pagedRequest {
 		var offset int32
 		var size int32 = int32(len(res))
-		if size == in.GetPaging().GetLimit() {
+		if size == page.GetLimit() {
 			size--
 			res = res[:size]
 			offset = in.GetPaging().GetOffset() + size
@@ -1742,7 +3427,7 @@ func (m *MultipleMethodsAutoGenDefaultServer) ListA(ctx context.Context, in *Lis
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterListA); ok {
 		var err error
 		if err = custom.AfterListA(ctx, out, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
 	return out, nil
@@ -1761,26 +3446,41 @@ type MultipleMethodsAutoGenIntPointWithAfterListA interface {
 // ListB ...
 func (m *MultipleMethodsAutoGenDefaultServer) ListB(ctx context.Context, in *ListIntPointRequest) (*ListIntPointResponse, error) {
 	db := m.DB
+	if m.ReaderDB != nil && !dbresolver.UsePrimary(ctx) {
+		db = dbresolver.Replica(m.ReaderDB)
+	}
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.MultipleMethodsAutoGen/ListB", ReadOnly: true, Primary: dbresolver.UsePrimary(ctx)}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewIntPointRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeListB); ok {
 		var err error
 		if db, err = custom.BeforeListB(ctx, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
 	pagedRequest := false
-	if in.GetPaging().GetLimit() >= 1 {
-		in.Paging.Limit++
+	page := in.GetPaging()
+	if page.GetLimit() >= 1 {
+		// one more row tells whether there is a next page, the request is
+		// left unchanged for the retries of the transaction
+		page = &query.Pagination{PageToken: page.GetPageToken(), Offset: page.GetOffset(), Limit: page.GetLimit() + 1}
 		pagedRequest = true
 	}
-	res, err := DefaultListIntPoint(ctx, db, in.Filter, in.OrderBy, in.Paging, in.Fields)
+	res, err := m.intPointRepository(db).List(ctx, in.Filter, in.OrderBy, page, in.Fields)
 	if err != nil {
-		return nil, err
+		return nil, errors.Translate(err)
 	}
 	var resPaging *query.PageInfo
 	if pagedRequest {
 		var offset int32
 		var size int32 = int32(len(res))
-		if size == in.GetPaging().GetLimit() {
+		if size == page.GetLimit() {
 			size--
 			res = res[:size]
 			offset = in.GetPaging().GetOffset() + size
@@ -1791,7 +3491,7 @@ func (m *MultipleMethodsAutoGenDefaultServer) ListB(ctx context.Context, in *Lis
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterListB); ok {
 		var err error
 		if err = custom.AfterListB(ctx, out, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
 	return out, nil
@@ -1809,24 +3509,60 @@ type MultipleMethodsAutoGenIntPointWithAfterListB interface {
 
 // DeleteA ...
 func (m *MultipleMethodsAutoGenDefaultServer) DeleteA(ctx context.Context, in *DeleteIntPointRequest) (*DeleteIntPointResponse, error) {
+	var out *DeleteIntPointResponse
+	err := retry.Do(ctx, m.Retry, func(ctx context.Context) (err error) {
+		out, err = m.deleteAInTransaction(ctx, in)
+		return err
+	})
+	return out, err
+}
+
+// deleteAInTransaction runs DeleteA once, in a transaction
+func (m *MultipleMethodsAutoGenDefaultServer) deleteAInTransaction(ctx context.Context, in *DeleteIntPointRequest) (*DeleteIntPointResponse, error) {
 	db := m.DB
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.MultipleMethodsAutoGen/DeleteA", ReadOnly: false, Primary: true}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewIntPointRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
+	ctx = cache.Defer(ctx)
+	var tx *gorm.DB
+	// the repositories without database run without transaction
+	if db != nil {
+		tx = transaction.Begin(ctx, db, transaction.Options{})
+		if tx.Error != nil {
+			return nil, errors.Translate(tx.Error)
+		}
+		defer tx.Rollback()
+		db = tx
+	}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeDeleteA); ok {
 		var err error
 		if db, err = custom.BeforeDeleteA(ctx, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
-	err := DefaultDeleteIntPoint(ctx, &IntPoint{Id: in.GetId()}, db)
+	err := m.intPointRepository(db).Delete(ctx, &IntPoint{Id: in.GetId()})
 	if err != nil {
-		return nil, err
+		return nil, errors.Translate(err)
 	}
 	out := &DeleteIntPointResponse{}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterDeleteA); ok {
 		var err error
 		if err = custom.AfterDeleteA(ctx, out, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
+		}
+	}
+	if tx != nil {
+		if err := tx.Commit().Error; err != nil {
+			return nil, errors.Translate(err)
 		}
 	}
+	cache.Flush(ctx)
 	return out, nil
 }
 
@@ -1842,24 +3578,60 @@ type MultipleMethodsAutoGenIntPointWithAfterDeleteA interface {
 
 // DeleteB ...
 func (m *MultipleMethodsAutoGenDefaultServer) DeleteB(ctx context.Context, in *DeleteIntPointRequest) (*DeleteIntPointResponse, error) {
+	var out *DeleteIntPointResponse
+	err := retry.Do(ctx, m.Retry, func(ctx context.Context) (err error) {
+		out, err = m.deleteBInTransaction(ctx, in)
+		return err
+	})
+	return out, err
+}
+
+// deleteBInTransaction runs DeleteB once, in a transaction
+func (m *MultipleMethodsAutoGenDefaultServer) deleteBInTransaction(ctx context.Context, in *DeleteIntPointRequest) (*DeleteIntPointResponse, error) {
 	db := m.DB
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.MultipleMethodsAutoGen/DeleteB", ReadOnly: false, Primary: true}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewIntPointRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
+	ctx = cache.Defer(ctx)
+	var tx *gorm.DB
+	// the repositories without database run without transaction
+	if db != nil {
+		tx = transaction.Begin(ctx, db, transaction.Options{})
+		if tx.Error != nil {
+			return nil, errors.Translate(tx.Error)
+		}
+		defer tx.Rollback()
+		db = tx
+	}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeDeleteB); ok {
 		var err error
 		if db, err = custom.BeforeDeleteB(ctx, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
-	err := DefaultDeleteIntPoint(ctx, &IntPoint{Id: in.GetId()}, db)
+	err := m.intPointRepository(db).Delete(ctx, &IntPoint{Id: in.GetId()})
 	if err != nil {
-		return nil, err
+		return nil, errors.Translate(err)
 	}
 	out := &DeleteIntPointResponse{}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterDeleteB); ok {
 		var err error
 		if err = custom.AfterDeleteB(ctx, out, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
+	if tx != nil {
+		if err := tx.Commit().Error; err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	cache.Flush(ctx)
 	return out, nil
 }
 
@@ -1875,7 +3647,37 @@ type MultipleMethodsAutoGenIntPointWithAfterDeleteB interface {
 
 // DeleteSetA ...
 func (m *MultipleMethodsAutoGenDefaultServer) DeleteSetA(ctx context.Context, in *DeleteIntPointsRequest) (*DeleteIntPointResponse, error) {
+	var out *DeleteIntPointResponse
+	err := retry.Do(ctx, m.Retry, func(ctx context.Context) (err error) {
+		out, err = m.deleteSetAInTransaction(ctx, in)
+		return err
+	})
+	return out, err
+}
+
+// deleteSetAInTransaction runs DeleteSetA once, in a transaction
+func (m *MultipleMethodsAutoGenDefaultServer) deleteSetAInTransaction(ctx context.Context, in *DeleteIntPointsRequest) (*DeleteIntPointResponse, error) {
 	db := m.DB
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.MultipleMethodsAutoGen/DeleteSetA", ReadOnly: false, Primary: true}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewIntPointRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
+	ctx = cache.Defer(ctx)
+	var tx *gorm.DB
+	// the repositories without database run without transaction
+	if db != nil {
+		tx = transaction.Begin(ctx, db, transaction.Options{})
+		if tx.Error != nil {
+			return nil, errors.Translate(tx.Error)
+		}
+		defer tx.Rollback()
+		db = tx
+	}
 	objs := []*IntPoint{}
 	for _, id := range in.Ids {
 		objs = append(objs, &IntPoint{Id: id})
@@ -1883,20 +3685,26 @@ func (m *MultipleMethodsAutoGenDefaultServer) DeleteSetA(ctx context.Context, in
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeDeleteSetA); ok {
 		var err error
 		if db, err = custom.BeforeDeleteSetA(ctx, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
-	err := DefaultDeleteIntPointSet(ctx, objs, db)
+	err := m.intPointRepository(db).DeleteSet(ctx, objs)
 	if err != nil {
-		return nil, err
+		return nil, errors.Translate(err)
 	}
 	out := &DeleteIntPointResponse{}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterDeleteSetA); ok {
 		var err error
 		if err = custom.AfterDeleteSetA(ctx, out, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
+		}
+	}
+	if tx != nil {
+		if err := tx.Commit().Error; err != nil {
+			return nil, errors.Translate(err)
 		}
 	}
+	cache.Flush(ctx)
 	return out, nil
 }
 
@@ -1912,7 +3720,37 @@ type MultipleMethodsAutoGenIntPointWithAfterDeleteSetA interface {
 
 // DeleteSetB ...
 func (m *MultipleMethodsAutoGenDefaultServer) DeleteSetB(ctx context.Context, in *DeleteIntPointsRequest) (*DeleteIntPointResponse, error) {
+	var out *DeleteIntPointResponse
+	err := retry.Do(ctx, m.Retry, func(ctx context.Context) (err error) {
+		out, err = m.deleteSetBInTransaction(ctx, in)
+		return err
+	})
+	return out, err
+}
+
+// deleteSetBInTransaction runs DeleteSetB once, in a transaction
+func (m *MultipleMethodsAutoGenDefaultServer) deleteSetBInTransaction(ctx context.Context, in *DeleteIntPointsRequest) (*DeleteIntPointResponse, error) {
 	db := m.DB
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.MultipleMethodsAutoGen/DeleteSetB", ReadOnly: false, Primary: true}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewIntPointRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
+	ctx = cache.Defer(ctx)
+	var tx *gorm.DB
+	// the repositories without database run without transaction
+	if db != nil {
+		tx = transaction.Begin(ctx, db, transaction.Options{})
+		if tx.Error != nil {
+			return nil, errors.Translate(tx.Error)
+		}
+		defer tx.Rollback()
+		db = tx
+	}
 	objs := []*IntPoint{}
 	for _, id := range in.Ids {
 		objs = append(objs, &IntPoint{Id: id})
@@ -1920,20 +3758,26 @@ func (m *MultipleMethodsAutoGenDefaultServer) DeleteSetB(ctx context.Context, in
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithBeforeDeleteSetB); ok {
 		var err error
 		if db, err = custom.BeforeDeleteSetB(ctx, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
 		}
 	}
-	err := DefaultDeleteIntPointSet(ctx, objs, db)
+	err := m.intPointRepository(db).DeleteSet(ctx, objs)
 	if err != nil {
-		return nil, err
+		return nil, errors.Translate(err)
 	}
 	out := &DeleteIntPointResponse{}
 	if custom, ok := interface{}(in).(MultipleMethodsAutoGenIntPointWithAfterDeleteSetB); ok {
 		var err error
 		if err = custom.AfterDeleteSetB(ctx, out, db); err != nil {
-			return nil, err
+			return nil, errors.Translate(err)
+		}
+	}
+	if tx != nil {
+		if err := tx.Commit().Error; err != nil {
+			return nil, errors.Translate(err)
 		}
 	}
+	cache.Flush(ctx)
 	return out, nil
 }
 
@@ -24,6 +24,7 @@ type IntPointServiceClient interface {
 	// so multiple objects can have CURDL handlers in the same service, provided
 	// they are given unique suffixes
 	Create(ctx context.Context, in *CreateIntPointRequest, opts ...grpc.CallOption) (*CreateIntPointResponse, error)
+	CreateSet(ctx context.Context, in *CreateSetIntPointRequest, opts ...grpc.CallOption) (*CreateSetIntPointResponse, error)
 	Read(ctx context.Context, in *ReadIntPointRequest, opts ...grpc.CallOption) (*ReadIntPointResponse, error)
 	Update(ctx context.Context, in *UpdateIntPointRequest, opts ...grpc.CallOption) (*UpdateIntPointResponse, error)
 	UpdateSet(ctx context.Context, in *UpdateSetIntPointRequest, opts ...grpc.CallOption) (*UpdateSetIntPointResponse, error)
@@ -35,6 +36,8 @@ type IntPointServiceClient interface {
 	CustomMethod(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// CreateSomething also doesn't match conventions and will become a stub
 	CreateSomething(ctx context.Context, in *Something, opts ...grpc.CallOption) (*Something, error)
+	// Only server streaming List methods are generated, others become stubs
+	SyncSomething(ctx context.Context, opts ...grpc.CallOption) (IntPointService_SyncSomethingClient, error)
 }
 
 type intPointServiceClient struct {
@@ -54,6 +57,15 @@ func (c *intPointServiceClient) Create(ctx context.Context, in *CreateIntPointRe
 	return out, nil
 }
 
+func (c *intPointServiceClient) CreateSet(ctx context.Context, in *CreateSetIntPointRequest, opts ...grpc.CallOption) (*CreateSetIntPointResponse, error) {
+	out := new(CreateSetIntPointResponse)
+	err := c.cc.Invoke(ctx, "/example.IntPointService/CreateSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *intPointServiceClient) Read(ctx context.Context, in *ReadIntPointRequest, opts ...grpc.CallOption) (*ReadIntPointResponse, error) {
 	out := new(ReadIntPointResponse)
 	err := c.cc.Invoke(ctx, "/example.IntPointService/Read", in, out, opts...)
@@ -126,6 +138,37 @@ func (c *intPointServiceClient) CreateSomething(ctx context.Context, in *Somethi
 	return out, nil
 }
 
+func (c *intPointServiceClient) SyncSomething(ctx context.Context, opts ...grpc.CallOption) (IntPointService_SyncSomethingClient, error) {
+	stream, err := c.cc.NewStream(ctx, &IntPointService_ServiceDesc.Streams[0], "/example.IntPointService/SyncSomething", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &intPointServiceSyncSomethingClient{stream}
+	return x, nil
+}
+
+type IntPointService_SyncSomethingClient interface {
+	Send(*Something) error
+	Recv() (*Something, error)
+	grpc.ClientStream
+}
+
+type intPointServiceSyncSomethingClient struct {
+	grpc.ClientStream
+}
+
+func (x *intPointServiceSyncSomethingClient) Send(m *Something) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *intPointServiceSyncSomethingClient) Recv() (*Something, error) {
+	m := new(Something)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // IntPointServiceServer is the server API for IntPointService service.
 // All implementations must embed UnimplementedIntPointServiceServer
 // for forward compatibility
@@ -135,6 +178,7 @@ type IntPointServiceServer interface {
 	// so multiple objects can have CURDL handlers in the same service, provided
 	// they are given unique suffixes
 	Create(context.Context, *CreateIntPointRequest) (*CreateIntPointResponse, error)
+	CreateSet(context.Context, *CreateSetIntPointRequest) (*CreateSetIntPointResponse, error)
 	Read(context.Context, *ReadIntPointRequest) (*ReadIntPointResponse, error)
 	Update(context.Context, *UpdateIntPointRequest) (*UpdateIntPointResponse, error)
 	UpdateSet(context.Context, *UpdateSetIntPointRequest) (*UpdateSetIntPointResponse, error)
@@ -146,6 +190,8 @@ type IntPointServiceServer interface {
 	CustomMethod(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	// CreateSomething also doesn't match conventions and will become a stub
 	CreateSomething(context.Context, *Something) (*Something, error)
+	// Only server streaming List methods are generated, others become stubs
+	SyncSomething(IntPointService_SyncSomethingServer) error
 	mustEmbedUnimplementedIntPointServiceServer()
 }
 
@@ -156,6 +202,9 @@ type UnimplementedIntPointServiceServer struct {
 func (UnimplementedIntPointServiceServer) Create(context.Context, *CreateIntPointRequest) (*CreateIntPointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
+func (UnimplementedIntPointServiceServer) CreateSet(context.Context, *CreateSetIntPointRequest) (*CreateSetIntPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSet not implemented")
+}
 func (UnimplementedIntPointServiceServer) Read(context.Context, *ReadIntPointRequest) (*ReadIntPointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
 }
@@ -180,6 +229,9 @@ func (UnimplementedIntPointServiceServer) CustomMethod(context.Context, *emptypb
 func (UnimplementedIntPointServiceServer) CreateSomething(context.Context, *Something) (*Something, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSomething not implemented")
 }
+func (UnimplementedIntPointServiceServer) SyncSomething(IntPointService_SyncSomethingServer) error {
+	return status.Errorf(codes.Unimplemented, "method SyncSomething not implemented")
+}
 func (UnimplementedIntPointServiceServer) mustEmbedUnimplementedIntPointServiceServer() {}
 
 // UnsafeIntPointServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -211,6 +263,24 @@ func _IntPointService_Create_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IntPointService_CreateSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSetIntPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntPointServiceServer).CreateSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.IntPointService/CreateSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntPointServiceServer).CreateSet(ctx, req.(*CreateSetIntPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IntPointService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReadIntPointRequest)
 	if err := dec(in); err != nil {
@@ -355,6 +425,32 @@ func _IntPointService_CreateSomething_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IntPointService_SyncSomething_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IntPointServiceServer).SyncSomething(&intPointServiceSyncSomethingServer{stream})
+}
+
+type IntPointService_SyncSomethingServer interface {
+	Send(*Something) error
+	Recv() (*Something, error)
+	grpc.ServerStream
+}
+
+type intPointServiceSyncSomethingServer struct {
+	grpc.ServerStream
+}
+
+func (x *intPointServiceSyncSomethingServer) Send(m *Something) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *intPointServiceSyncSomethingServer) Recv() (*Something, error) {
+	m := new(Something)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // IntPointService_ServiceDesc is the grpc.ServiceDesc for IntPointService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -366,6 +462,10 @@ var IntPointService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Create",
 			Handler:    _IntPointService_Create_Handler,
 		},
+		{
+			MethodName: "CreateSet",
+			Handler:    _IntPointService_CreateSet_Handler,
+		},
 		{
 			MethodName: "Read",
 			Handler:    _IntPointService_Read_Handler,
@@ -399,7 +499,14 @@ var IntPointService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _IntPointService_CreateSomething_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SyncSomething",
+			Handler:       _IntPointService_SyncSomething_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "feature_demo/demo_service.proto",
 }
 
@@ -1,9 +1,26 @@
 package example
 
 import (
+	"context"
 	fmt "fmt"
 	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/acanseco/protoc-gen-gorm/errors"
+	"github.com/acanseco/protoc-gen-gorm/runtime/cache"
+	"github.com/acanseco/protoc-gen-gorm/runtime/dbresolver"
+	"github.com/acanseco/protoc-gen-gorm/runtime/metrics"
+	"github.com/acanseco/protoc-gen-gorm/runtime/paging"
+	"github.com/acanseco/protoc-gen-gorm/runtime/preload"
+	"github.com/acanseco/protoc-gen-gorm/runtime/retry"
+	"github.com/acanseco/protoc-gen-gorm/runtime/tenant"
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestMultipleCrud(t *testing.T) {
@@ -24,4 +41,537 @@ func TestMultipleCrud(t *testing.T) {
 			}
 		}
 	})
-}
\ No newline at end of file
+}
+
+type blogPostStream struct {
+	BlogPostService_StreamBlogPostsServer
+	sent []*BlogPost
+}
+
+func (s *blogPostStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *blogPostStream) Send(post *BlogPost) error {
+	s.sent = append(s.sent, post)
+	return nil
+}
+
+func TestMetrics(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+	var observations []metrics.Observation
+	server := &BlogPostServiceDefaultServer{DB: db, Metrics: metrics.MetricsFunc(func(ctx context.Context, o metrics.Observation) {
+		observations = append(observations, o)
+	})}
+
+	mock.ExpectQuery(`SELECT \* FROM "blog_posts"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+	if err := server.StreamBlogPosts(&StreamBlogPostsRequest{}, &blogPostStream{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	mock.ExpectQuery(`SELECT \* FROM "blog_posts"`).
+		WillReturnError(status.Error(codes.Unavailable, "connection refused"))
+	if err := server.StreamBlogPosts(&StreamBlogPostsRequest{}, &blogPostStream{}); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if len(observations) != 2 {
+		t.Fatalf("got %d observations; want 2", len(observations))
+	}
+	ok, failed := observations[0], observations[1]
+	if ok.FullMethod != "/example.BlogPostService/StreamBlogPosts" || ok.Verb != "Stream" || ok.Type != "BlogPost" ||
+		ok.Rows != 2 || ok.ErrorClass != "OK" {
+		t.Errorf("unexpected observation %+v", ok)
+	}
+	if failed.Rows != 0 || failed.ErrorClass != "Unavailable" {
+		t.Errorf("unexpected observation %+v", failed)
+	}
+}
+
+func TestStreamBatches(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+	defer func(size int) { preload.BatchSize = size }(preload.BatchSize)
+	preload.BatchSize = 2
+
+	// each batch is read by its own query, closed before the next one
+	mock.ExpectQuery(`SELECT \* FROM "blog_posts" .* LIMIT 2 OFFSET 0`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+	mock.ExpectQuery(`SELECT \* FROM "blog_posts" .* LIMIT 2 OFFSET 2`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	var ids []uint64
+	if err := DefaultStreamBlogPost(context.Background(), db, nil, nil, nil, func(post *BlogPost) error {
+		ids = append(ids, post.GetId())
+		return nil
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ids) != 3 || ids[2] != 3 {
+		t.Errorf("got %v; want the rows of both batches", ids)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestErrorTranslation(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+	server := &IntPointServiceDefaultServer{DB: db}
+	ctx := context.Background()
+
+	if _, err := server.Read(ctx, &ReadIntPointRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("got error %v; want InvalidArgument", err)
+	}
+
+	mock.ExpectQuery(`SELECT \* FROM "int_points"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	if _, err := server.Read(ctx, &ReadIntPointRequest{Id: 1}); status.Code(err) != codes.NotFound {
+		t.Errorf("got error %v; want NotFound", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "int_points"`).
+		WillReturnError(&pq.Error{Code: "23505", Constraint: "int_points_pkey"})
+	mock.ExpectRollback()
+	_, err = server.Create(ctx, &CreateIntPointRequest{Payload: &IntPoint{Id: 1}})
+	if s := status.Convert(err); s.Code() != codes.AlreadyExists || s.Message() != `unique constraint "int_points_pkey" violated` {
+		t.Errorf("got error %v; want AlreadyExists", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTransaction(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+	server := &MultipleMethodsAutoGenDefaultServer{DB: db, Retry: &retry.Policy{MaxAttempts: 2}}
+	ctx := context.Background()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "int_points" WHERE \(id in \(\$1,\$2\)\)`).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+	if _, err := server.DeleteSetA(ctx, &DeleteIntPointsRequest{Ids: []uint32{1, 2}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "int_points"`).
+		WillReturnError(&pq.Error{Code: "23503"})
+	mock.ExpectRollback()
+	if _, err := server.DeleteA(ctx, &DeleteIntPointRequest{Id: 1}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("got error %v; want FailedPrecondition", err)
+	}
+
+	// the transactions aborted by a serialization failure are retried
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "int_points"`).
+		WillReturnError(&pq.Error{Code: "40001"})
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "int_points"`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	if _, err := server.DeleteA(ctx, &DeleteIntPointRequest{Id: 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mock.ExpectQuery(`SELECT \* FROM "int_points"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	if _, err := server.ReadA(ctx, &ReadIntPointRequest{Id: 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SET LOCAL statement_timeout = 5000`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT \* FROM "int_points"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	if _, err := server.ReadB(ctx, &ReadIntPointRequest{Id: 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTenantTransaction(t *testing.T) {
+	tenant.SetResolver(tenant.ResolverFunc(func(ctx context.Context) (string, error) {
+		id, _ := ctx.Value(tenantKey{}).(string)
+		return id, nil
+	}))
+	defer tenant.SetResolver(nil)
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+	server := &TenantTypeServiceDefaultServer{DB: db}
+	acme := "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	ctx := context.WithValue(context.Background(), tenantKey{}, acme)
+
+	// the reads see the rows of the tenant through the row level security
+	// policies only in the transaction setting it
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config\(\$1, \$2, true\)`).
+		WithArgs(tenant.Setting, acme).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT \* FROM "tenant_type_with_ids"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "some_field"}).AddRow(1, "a"))
+	mock.ExpectCommit()
+	res, err := server.Read(ctx, &ReadTenantTypeWithIDRequest{Id: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.GetResult().GetSomeField() != "a" {
+		t.Errorf("got %v; want the row of the tenant", res.GetResult())
+	}
+
+	// so do the methods of the services without the transaction option
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config\(\$1, \$2, true\)`).
+		WithArgs(tenant.Setting, acme).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT \* FROM "tenant_type_with_ids"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "some_field"}).AddRow(1, "a"))
+	mock.ExpectCommit()
+	reader := &TenantTypeReaderDefaultServer{DB: db}
+	if _, err := reader.Read(ctx, &ReadTenantTypeWithIDRequest{Id: 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRetriedList(t *testing.T) {
+	tenant.SetResolver(tenant.ResolverFunc(func(ctx context.Context) (string, error) {
+		id, _ := ctx.Value(tenantKey{}).(string)
+		return id, nil
+	}))
+	defer tenant.SetResolver(nil)
+	paging.SetTokenKey([]byte("secret"))
+	defer paging.SetTokenKey(nil)
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+	server := &TenantTypeServiceDefaultServer{DB: db, Retry: &retry.Policy{MaxAttempts: 2}}
+	acme := "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	ctx := context.WithValue(context.Background(), tenantKey{}, acme)
+
+	// the first attempt is aborted by a serialization failure
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT \* FROM "tenant_type_with_ids"`).
+		WillReturnError(&pq.Error{Code: "40001"})
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT \* FROM "tenant_type_with_ids" .* LIMIT 3`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "some_field"}).AddRow(1, "a").AddRow(2, "b").AddRow(3, "c"))
+	mock.ExpectCommit()
+	in := &ListTenantTypeWithIDRequest{Paging: &query.Pagination{Limit: 2}}
+	res, err := server.List(ctx, in)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(res.GetResults()) != 2 || res.GetNextPageToken() == "" {
+		t.Errorf("got %d results and token %q; want a page of 2 and a token", len(res.GetResults()), res.GetNextPageToken())
+	}
+	if in.GetPaging().GetLimit() != 2 {
+		t.Errorf("got limit %d; want the request unchanged", in.GetPaging().GetLimit())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestReaderDB(t *testing.T) {
+	open := func() (*gorm.DB, sqlmock.Sqlmock) {
+		sqlDB, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("failed to create sqlmock: %v", err)
+		}
+		db, err := gorm.Open("postgres", sqlDB)
+		if err != nil {
+			t.Fatalf("failed to open gorm db: %v", err)
+		}
+		return db, mock
+	}
+	primary, primaryMock := open()
+	reader, readerMock := open()
+	ctx := context.Background()
+
+	readerMock.ExpectQuery(`SELECT \* FROM "int_points"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	primaryMock.ExpectQuery(`SELECT \* FROM "int_points"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	primaryMock.ExpectQuery(`SELECT \* FROM "int_points"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	server := &IntPointServiceDefaultServer{DB: primary, ReaderDB: reader}
+	if _, err := server.Read(ctx, &ReadIntPointRequest{Id: 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// read your writes
+	if _, err := server.Read(dbresolver.WithPrimary(ctx), &ReadIntPointRequest{Id: 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// ReadA is pinned to the primary
+	pinned := &MultipleMethodsAutoGenDefaultServer{DB: primary, ReaderDB: reader}
+	if _, err := pinned.ReadA(ctx, &ReadIntPointRequest{Id: 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := readerMock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+	if err := primaryMock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCache(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+	lru := cache.NewLRU(10)
+	cache.SetCache(lru)
+	defer cache.SetCache(nil)
+	server := &IntPointServiceDefaultServer{DB: db}
+	writer := &MultipleMethodsAutoGenDefaultServer{DB: db}
+	ctx := context.Background()
+
+	mock.ExpectQuery(`SELECT \* FROM "int_points"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "x"}).AddRow(1, 4))
+	for i := 0; i < 2; i++ {
+		res, err := server.Read(ctx, &ReadIntPointRequest{Id: 1})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.GetResult().GetX() != 4 {
+			t.Errorf("got %v; want the point read", res.GetResult())
+		}
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "int_points"`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	if _, err := writer.DeleteA(ctx, &DeleteIntPointRequest{Id: 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if lru.Len() != 0 {
+		t.Error("expected the deleted point to be invalidated")
+	}
+
+	// the rows of a replica may lag behind the delete
+	replica := &IntPointServiceDefaultServer{DB: db, ReaderDB: db}
+	mock.ExpectQuery(`SELECT \* FROM "int_points"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "x"}).AddRow(1, 4))
+	if _, err := replica.Read(ctx, &ReadIntPointRequest{Id: 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if lru.Len() != 0 {
+		t.Error("expected the point read from the replica not to be cached")
+	}
+
+	// the cached objects ignore the conditions of the scopes
+	mock.ExpectQuery(`SELECT \* FROM "int_points"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "x"}).AddRow(1, 4))
+	if _, err := server.Read(ctx, &ReadIntPointRequest{Id: 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	mock.ExpectQuery(`SELECT \* FROM "int_points" WHERE \(x > \$1\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "x"}))
+	if _, err := DefaultReadIntPoint(ctx, &IntPoint{Id: 1}, db.Where("x > ?", 4), nil); err != gorm.ErrRecordNotFound {
+		t.Errorf("got error %v; want the scope to be applied", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+// intPointRepository reads the objects it is given.
+type intPointRepository struct {
+	IntPointRepository
+	objects map[uint32]*IntPoint
+}
+
+func (r *intPointRepository) Read(ctx context.Context, in *IntPoint, fs *query.FieldSelection) (*IntPoint, error) {
+	if out, ok := r.objects[in.GetId()]; ok {
+		return out, nil
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func TestRepository(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+	ctx := context.Background()
+
+	repository := &intPointRepository{objects: map[uint32]*IntPoint{1: {Id: 1, X: 2}}}
+	server := &IntPointServiceDefaultServer{DB: db, NewIntPointRepository: func(*gorm.DB) IntPointRepository {
+		return repository
+	}}
+	res, err := server.Read(ctx, &ReadIntPointRequest{Id: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.GetResult().GetX() != 2 {
+		t.Errorf("got %v; want the object of the repository", res.GetResult())
+	}
+	if _, err := server.Read(ctx, &ReadIntPointRequest{Id: 2}); status.Code(err) != codes.NotFound {
+		t.Errorf("got error %v; want NotFound", err)
+	}
+
+	// the arguments the handlers don't take are rejected
+	_, err = NewGormSomethingRepository(db).Count(ctx, &query.Filtering{}, paging.ExactCount)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("got error %v; want InvalidArgument", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMemoryServer(t *testing.T) {
+	ctx := context.Background()
+	repository := NewMemoryIntPointRepository()
+	if _, err := repository.CreateSet(ctx, []*IntPoint{{X: 3}, {X: 1}, {X: 2}}, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	server := &IntPointServiceDefaultServer{NewIntPointRepository: func(*gorm.DB) IntPointRepository {
+		return repository
+	}}
+
+	s, err := query.ParseSorting("x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := server.List(ctx, &ListIntPointRequest{OrderBy: s, Paging: &query.Pagination{Limit: 2}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(res.GetResults()) != 2 || res.GetResults()[0].GetX() != 1 || res.GetPageInfo().GetOffset() != 2 {
+		t.Errorf("got %v; want the first page of the sorted objects", res)
+	}
+
+	if _, err := server.Delete(ctx, &DeleteIntPointRequest{Id: 2}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := server.Read(ctx, &ReadIntPointRequest{Id: 2}); status.Code(err) != codes.NotFound {
+		t.Errorf("got error %v; want NotFound", err)
+	}
+}
+
+func TestNoDB(t *testing.T) {
+	server := &IntPointServiceDefaultServer{}
+	if _, err := server.Read(context.Background(), &ReadIntPointRequest{Id: 1}); err != errors.NoDBError {
+		t.Errorf("got error %v; want NoDBError", err)
+	}
+}
+
+func TestMemoryTransactionServer(t *testing.T) {
+	tenant.SetResolver(tenant.ResolverFunc(func(ctx context.Context) (string, error) {
+		id, _ := ctx.Value(tenantKey{}).(string)
+		return id, nil
+	}))
+	defer tenant.SetResolver(nil)
+	ctx := context.WithValue(context.Background(), tenantKey{}, "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	repository := NewMemoryTenantTypeWithIDRepository()
+	// the transactions and the keyset pages run without database
+	server := &TenantTypeServiceDefaultServer{NewTenantTypeWithIDRepository: func(*gorm.DB) TenantTypeWithIDRepository {
+		return repository
+	}}
+
+	if _, err := repository.CreateSet(ctx, []*TenantTypeWithID{{SomeField: "c"}, {SomeField: "a"}, {SomeField: "b"}}, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	res, err := server.Read(ctx, &ReadTenantTypeWithIDRequest{Id: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.GetResult().GetSomeField() != "c" {
+		t.Errorf("got %v; want the first object", res.GetResult())
+	}
+
+	s, err := query.ParseSorting("some_field")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var values []string
+	token := ""
+	for page := 0; page < 3; page++ {
+		res, err := server.List(ctx, &ListTenantTypeWithIDRequest{OrderBy: s, Paging: &query.Pagination{Limit: 2, PageToken: token}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, result := range res.GetResults() {
+			values = append(values, result.GetSomeField())
+		}
+		if token = res.GetNextPageToken(); token == "" {
+			break
+		}
+	}
+	if strings.Join(values, ",") != "a,b,c" {
+		t.Errorf("got %v; want the pages of the sorted objects", values)
+	}
+}
@@ -0,0 +1,575 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.1
+// source: feature_demo/demo_tenant_service.proto
+
+package example
+
+import (
+	_ "github.com/acanseco/protoc-gen-gorm/options"
+	query "github.com/infobloxopen/atlas-app-toolkit/query"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateTenantTypeWithIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload *TenantTypeWithID `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *CreateTenantTypeWithIDRequest) Reset() {
+	*x = CreateTenantTypeWithIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feature_demo_demo_tenant_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTenantTypeWithIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantTypeWithIDRequest) ProtoMessage() {}
+
+func (x *CreateTenantTypeWithIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feature_demo_demo_tenant_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantTypeWithIDRequest.ProtoReflect.Descriptor instead.
+func (*CreateTenantTypeWithIDRequest) Descriptor() ([]byte, []int) {
+	return file_feature_demo_demo_tenant_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateTenantTypeWithIDRequest) GetPayload() *TenantTypeWithID {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type CreateTenantTypeWithIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *TenantTypeWithID `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *CreateTenantTypeWithIDResponse) Reset() {
+	*x = CreateTenantTypeWithIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feature_demo_demo_tenant_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTenantTypeWithIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantTypeWithIDResponse) ProtoMessage() {}
+
+func (x *CreateTenantTypeWithIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feature_demo_demo_tenant_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantTypeWithIDResponse.ProtoReflect.Descriptor instead.
+func (*CreateTenantTypeWithIDResponse) Descriptor() ([]byte, []int) {
+	return file_feature_demo_demo_tenant_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateTenantTypeWithIDResponse) GetResult() *TenantTypeWithID {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ReadTenantTypeWithIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ReadTenantTypeWithIDRequest) Reset() {
+	*x = ReadTenantTypeWithIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feature_demo_demo_tenant_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadTenantTypeWithIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadTenantTypeWithIDRequest) ProtoMessage() {}
+
+func (x *ReadTenantTypeWithIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feature_demo_demo_tenant_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadTenantTypeWithIDRequest.ProtoReflect.Descriptor instead.
+func (*ReadTenantTypeWithIDRequest) Descriptor() ([]byte, []int) {
+	return file_feature_demo_demo_tenant_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReadTenantTypeWithIDRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ReadTenantTypeWithIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *TenantTypeWithID `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ReadTenantTypeWithIDResponse) Reset() {
+	*x = ReadTenantTypeWithIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feature_demo_demo_tenant_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadTenantTypeWithIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadTenantTypeWithIDResponse) ProtoMessage() {}
+
+func (x *ReadTenantTypeWithIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feature_demo_demo_tenant_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadTenantTypeWithIDResponse.ProtoReflect.Descriptor instead.
+func (*ReadTenantTypeWithIDResponse) Descriptor() ([]byte, []int) {
+	return file_feature_demo_demo_tenant_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReadTenantTypeWithIDResponse) GetResult() *TenantTypeWithID {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ListTenantTypeWithIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter  *query.Filtering `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy *query.Sorting   `protobuf:"bytes,2,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Pagination.page_token carries the next_page_token of the previous page
+	Paging *query.Pagination `protobuf:"bytes,3,opt,name=paging,proto3" json:"paging,omitempty"`
+}
+
+func (x *ListTenantTypeWithIDRequest) Reset() {
+	*x = ListTenantTypeWithIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feature_demo_demo_tenant_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTenantTypeWithIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantTypeWithIDRequest) ProtoMessage() {}
+
+func (x *ListTenantTypeWithIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feature_demo_demo_tenant_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantTypeWithIDRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantTypeWithIDRequest) Descriptor() ([]byte, []int) {
+	return file_feature_demo_demo_tenant_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListTenantTypeWithIDRequest) GetFilter() *query.Filtering {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListTenantTypeWithIDRequest) GetOrderBy() *query.Sorting {
+	if x != nil {
+		return x.OrderBy
+	}
+	return nil
+}
+
+func (x *ListTenantTypeWithIDRequest) GetPaging() *query.Pagination {
+	if x != nil {
+		return x.Paging
+	}
+	return nil
+}
+
+type ListTenantTypeWithIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results       []*TenantTypeWithID `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	PageInfo      *query.PageInfo     `protobuf:"bytes,2,opt,name=page_info,json=pageInfo,proto3" json:"page_info,omitempty"`
+	NextPageToken string              `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListTenantTypeWithIDResponse) Reset() {
+	*x = ListTenantTypeWithIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feature_demo_demo_tenant_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTenantTypeWithIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantTypeWithIDResponse) ProtoMessage() {}
+
+func (x *ListTenantTypeWithIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_feature_demo_demo_tenant_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantTypeWithIDResponse.ProtoReflect.Descriptor instead.
+func (*ListTenantTypeWithIDResponse) Descriptor() ([]byte, []int) {
+	return file_feature_demo_demo_tenant_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListTenantTypeWithIDResponse) GetResults() []*TenantTypeWithID {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ListTenantTypeWithIDResponse) GetPageInfo() *query.PageInfo {
+	if x != nil {
+		return x.PageInfo
+	}
+	return nil
+}
+
+func (x *ListTenantTypeWithIDResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_feature_demo_demo_tenant_service_proto protoreflect.FileDescriptor
+
+var file_feature_demo_demo_tenant_service_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x64,
+	0x65, 0x6d, 0x6f, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x1a, 0x12, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x6f, 0x72, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x29, 0x61, 0x74, 0x6c, 0x61, 0x73, 0x2f, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1d, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x64,
+	0x65, 0x6d, 0x6f, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x54, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x33, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x52, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x53, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x57, 0x69, 0x74, 0x68,
+	0x49, 0x44, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2d, 0x0a, 0x1b, 0x52, 0x65,
+	0x61, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x57, 0x69, 0x74, 0x68,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x1c, 0x52, 0x65, 0x61,
+	0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49,
+	0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x57, 0x69,
+	0x74, 0x68, 0x49, 0x44, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xb8, 0x01, 0x0a,
+	0x1b, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x57,
+	0x69, 0x74, 0x68, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61,
+	0x74, 0x6c, 0x61, 0x73, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x32, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x61, 0x74, 0x6c, 0x61, 0x73, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x79, 0x12, 0x32, 0x0a, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x74, 0x6c, 0x61, 0x73, 0x2e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x22, 0xb2, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x57, 0x69,
+	0x74, 0x68, 0x49, 0x44, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x35, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x61, 0x74, 0x6c, 0x61, 0x73, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0xae, 0x02, 0x0a,
+	0x11, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x5b, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x57,
+	0x69, 0x74, 0x68, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x55, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x24, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x57, 0x69, 0x74,
+	0x68, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xba, 0xb9, 0x19,
+	0x02, 0x20, 0x01, 0x1a, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x08, 0x01, 0x28, 0x01, 0x32, 0x71, 0x0a,
+	0x10, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x55, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x24, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x1a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01,
+	0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
+	0x63, 0x61, 0x6e, 0x73, 0x65, 0x63, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67,
+	0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x72, 0x6d, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x64, 0x65, 0x6d, 0x6f, 0x3b, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_feature_demo_demo_tenant_service_proto_rawDescOnce sync.Once
+	file_feature_demo_demo_tenant_service_proto_rawDescData = file_feature_demo_demo_tenant_service_proto_rawDesc
+)
+
+func file_feature_demo_demo_tenant_service_proto_rawDescGZIP() []byte {
+	file_feature_demo_demo_tenant_service_proto_rawDescOnce.Do(func() {
+		file_feature_demo_demo_tenant_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_feature_demo_demo_tenant_service_proto_rawDescData)
+	})
+	return file_feature_demo_demo_tenant_service_proto_rawDescData
+}
+
+var file_feature_demo_demo_tenant_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_feature_demo_demo_tenant_service_proto_goTypes = []interface{}{
+	(*CreateTenantTypeWithIDRequest)(nil),  // 0: example.CreateTenantTypeWithIDRequest
+	(*CreateTenantTypeWithIDResponse)(nil), // 1: example.CreateTenantTypeWithIDResponse
+	(*ReadTenantTypeWithIDRequest)(nil),    // 2: example.ReadTenantTypeWithIDRequest
+	(*ReadTenantTypeWithIDResponse)(nil),   // 3: example.ReadTenantTypeWithIDResponse
+	(*ListTenantTypeWithIDRequest)(nil),    // 4: example.ListTenantTypeWithIDRequest
+	(*ListTenantTypeWithIDResponse)(nil),   // 5: example.ListTenantTypeWithIDResponse
+	(*TenantTypeWithID)(nil),               // 6: example.TenantTypeWithID
+	(*query.Filtering)(nil),                // 7: atlas.query.v1.Filtering
+	(*query.Sorting)(nil),                  // 8: atlas.query.v1.Sorting
+	(*query.Pagination)(nil),               // 9: atlas.query.v1.Pagination
+	(*query.PageInfo)(nil),                 // 10: atlas.query.v1.PageInfo
+}
+var file_feature_demo_demo_tenant_service_proto_depIdxs = []int32{
+	6,  // 0: example.CreateTenantTypeWithIDRequest.payload:type_name -> example.TenantTypeWithID
+	6,  // 1: example.CreateTenantTypeWithIDResponse.result:type_name -> example.TenantTypeWithID
+	6,  // 2: example.ReadTenantTypeWithIDResponse.result:type_name -> example.TenantTypeWithID
+	7,  // 3: example.ListTenantTypeWithIDRequest.filter:type_name -> atlas.query.v1.Filtering
+	8,  // 4: example.ListTenantTypeWithIDRequest.order_by:type_name -> atlas.query.v1.Sorting
+	9,  // 5: example.ListTenantTypeWithIDRequest.paging:type_name -> atlas.query.v1.Pagination
+	6,  // 6: example.ListTenantTypeWithIDResponse.results:type_name -> example.TenantTypeWithID
+	10, // 7: example.ListTenantTypeWithIDResponse.page_info:type_name -> atlas.query.v1.PageInfo
+	0,  // 8: example.TenantTypeService.Create:input_type -> example.CreateTenantTypeWithIDRequest
+	2,  // 9: example.TenantTypeService.Read:input_type -> example.ReadTenantTypeWithIDRequest
+	4,  // 10: example.TenantTypeService.List:input_type -> example.ListTenantTypeWithIDRequest
+	2,  // 11: example.TenantTypeReader.Read:input_type -> example.ReadTenantTypeWithIDRequest
+	1,  // 12: example.TenantTypeService.Create:output_type -> example.CreateTenantTypeWithIDResponse
+	3,  // 13: example.TenantTypeService.Read:output_type -> example.ReadTenantTypeWithIDResponse
+	5,  // 14: example.TenantTypeService.List:output_type -> example.ListTenantTypeWithIDResponse
+	3,  // 15: example.TenantTypeReader.Read:output_type -> example.ReadTenantTypeWithIDResponse
+	12, // [12:16] is the sub-list for method output_type
+	8,  // [8:12] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_feature_demo_demo_tenant_service_proto_init() }
+func file_feature_demo_demo_tenant_service_proto_init() {
+	if File_feature_demo_demo_tenant_service_proto != nil {
+		return
+	}
+	file_feature_demo_demo_types_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_feature_demo_demo_tenant_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTenantTypeWithIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feature_demo_demo_tenant_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTenantTypeWithIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feature_demo_demo_tenant_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadTenantTypeWithIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feature_demo_demo_tenant_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadTenantTypeWithIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feature_demo_demo_tenant_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTenantTypeWithIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feature_demo_demo_tenant_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTenantTypeWithIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_feature_demo_demo_tenant_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_feature_demo_demo_tenant_service_proto_goTypes,
+		DependencyIndexes: file_feature_demo_demo_tenant_service_proto_depIdxs,
+		MessageInfos:      file_feature_demo_demo_tenant_service_proto_msgTypes,
+	}.Build()
+	File_feature_demo_demo_tenant_service_proto = out.File
+	file_feature_demo_demo_tenant_service_proto_rawDesc = nil
+	file_feature_demo_demo_tenant_service_proto_goTypes = nil
+	file_feature_demo_demo_tenant_service_proto_depIdxs = nil
+}
@@ -0,0 +1,367 @@
+package example
+
+import (
+	context "context"
+	errors "github.com/acanseco/protoc-gen-gorm/errors"
+	dbresolver "github.com/acanseco/protoc-gen-gorm/runtime/dbresolver"
+	retry "github.com/acanseco/protoc-gen-gorm/runtime/retry"
+	tenant "github.com/acanseco/protoc-gen-gorm/runtime/tenant"
+	_ "github.com/acanseco/protoc-gen-gorm/runtime/tenant/atlas"
+	transaction "github.com/acanseco/protoc-gen-gorm/runtime/transaction"
+	gateway "github.com/infobloxopen/atlas-app-toolkit/gateway"
+	query "github.com/infobloxopen/atlas-app-toolkit/query"
+	gorm "github.com/jinzhu/gorm"
+)
+
+type TenantTypeServiceDefaultServer struct {
+	DB *gorm.DB
+	// ReaderDB is used by the read methods when it is set, a replica of DB for instance
+	ReaderDB *gorm.DB
+	// DBResolver picks the database of each request, DB or ReaderDB are used when it is nil
+	DBResolver dbresolver.Resolver
+	// Retry is the policy of the retries of the transactions aborted by a serialization failure or a deadlock, retry.DefaultPolicy when nil
+	Retry *retry.Policy
+	// NewTenantTypeWithIDRepository returns the repository the methods run the operations on TenantTypeWithID with,
+	// NewGormTenantTypeWithIDRepository when it is nil
+	NewTenantTypeWithIDRepository func(*gorm.DB) TenantTypeWithIDRepository
+}
+
+func (m *TenantTypeServiceDefaultServer) tenantTypeWithIDRepository(db *gorm.DB) TenantTypeWithIDRepository {
+	if m.NewTenantTypeWithIDRepository != nil {
+		return m.NewTenantTypeWithIDRepository(db)
+	}
+	return NewGormTenantTypeWithIDRepository(db)
+}
+
+// Create ...
+func (m *TenantTypeServiceDefaultServer) Create(ctx context.Context, in *CreateTenantTypeWithIDRequest) (*CreateTenantTypeWithIDResponse, error) {
+	var out *CreateTenantTypeWithIDResponse
+	err := retry.Do(ctx, m.Retry, func(ctx context.Context) (err error) {
+		out, err = m.createInTransaction(ctx, in)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// the status is set once, after the attempt committed
+	if err = gateway.SetCreated(ctx, ""); err != nil {
+		return nil, errors.Translate(err)
+	}
+	return out, err
+}
+
+// createInTransaction runs Create once, in a transaction
+func (m *TenantTypeServiceDefaultServer) createInTransaction(ctx context.Context, in *CreateTenantTypeWithIDRequest) (*CreateTenantTypeWithIDResponse, error) {
+	db := m.DB
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.TenantTypeService/Create", ReadOnly: false, Primary: true}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewTenantTypeWithIDRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
+	var tx *gorm.DB
+	// the repositories without database run without transaction
+	if db != nil {
+		tx = transaction.Begin(ctx, db, transaction.Options{})
+		if tx.Error != nil {
+			return nil, errors.Translate(tx.Error)
+		}
+		defer tx.Rollback()
+		db = tx
+		if err := tenant.SetLocal(ctx, db, "/example.TenantTypeService/Create"); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if custom, ok := interface{}(in).(TenantTypeServiceTenantTypeWithIDWithBeforeCreate); ok {
+		var err error
+		if db, err = custom.BeforeCreate(ctx, db); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	res, err := m.tenantTypeWithIDRepository(db).Create(ctx, in.GetPayload())
+	if err != nil {
+		return nil, errors.Translate(err)
+	}
+	out := &CreateTenantTypeWithIDResponse{Result: res}
+	if custom, ok := interface{}(in).(TenantTypeServiceTenantTypeWithIDWithAfterCreate); ok {
+		var err error
+		if err = custom.AfterCreate(ctx, out, db); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if tx != nil {
+		if err := tx.Commit().Error; err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	return out, nil
+}
+
+// TenantTypeServiceTenantTypeWithIDWithBeforeCreate called before DefaultCreateTenantTypeWithID in the default Create handler
+type TenantTypeServiceTenantTypeWithIDWithBeforeCreate interface {
+	BeforeCreate(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// TenantTypeServiceTenantTypeWithIDWithAfterCreate called before DefaultCreateTenantTypeWithID in the default Create handler
+type TenantTypeServiceTenantTypeWithIDWithAfterCreate interface {
+	AfterCreate(context.Context, *CreateTenantTypeWithIDResponse, *gorm.DB) error
+}
+
+// Read ...
+func (m *TenantTypeServiceDefaultServer) Read(ctx context.Context, in *ReadTenantTypeWithIDRequest) (*ReadTenantTypeWithIDResponse, error) {
+	var out *ReadTenantTypeWithIDResponse
+	err := retry.Do(ctx, m.Retry, func(ctx context.Context) (err error) {
+		out, err = m.readInTransaction(ctx, in)
+		return err
+	})
+	return out, err
+}
+
+// readInTransaction runs Read once, in a transaction
+func (m *TenantTypeServiceDefaultServer) readInTransaction(ctx context.Context, in *ReadTenantTypeWithIDRequest) (*ReadTenantTypeWithIDResponse, error) {
+	db := m.DB
+	if m.ReaderDB != nil && !dbresolver.UsePrimary(ctx) {
+		db = dbresolver.Replica(m.ReaderDB)
+	}
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.TenantTypeService/Read", ReadOnly: true, Primary: dbresolver.UsePrimary(ctx)}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewTenantTypeWithIDRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
+	var tx *gorm.DB
+	// the repositories without database run without transaction
+	if db != nil {
+		tx = transaction.Begin(ctx, db, transaction.Options{ReadOnly: true})
+		if tx.Error != nil {
+			return nil, errors.Translate(tx.Error)
+		}
+		defer tx.Rollback()
+		db = tx
+		if err := tenant.SetLocal(ctx, db, "/example.TenantTypeService/Read"); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if custom, ok := interface{}(in).(TenantTypeServiceTenantTypeWithIDWithBeforeRead); ok {
+		var err error
+		if db, err = custom.BeforeRead(ctx, db); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	res, err := m.tenantTypeWithIDRepository(db).Read(ctx, &TenantTypeWithID{Id: in.GetId()}, nil)
+	if err != nil {
+		return nil, errors.Translate(err)
+	}
+	out := &ReadTenantTypeWithIDResponse{Result: res}
+	if custom, ok := interface{}(in).(TenantTypeServiceTenantTypeWithIDWithAfterRead); ok {
+		var err error
+		if err = custom.AfterRead(ctx, out, db); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if tx != nil {
+		if err := tx.Commit().Error; err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	return out, nil
+}
+
+// TenantTypeServiceTenantTypeWithIDWithBeforeRead called before DefaultReadTenantTypeWithID in the default Read handler
+type TenantTypeServiceTenantTypeWithIDWithBeforeRead interface {
+	BeforeRead(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// TenantTypeServiceTenantTypeWithIDWithAfterRead called before DefaultReadTenantTypeWithID in the default Read handler
+type TenantTypeServiceTenantTypeWithIDWithAfterRead interface {
+	AfterRead(context.Context, *ReadTenantTypeWithIDResponse, *gorm.DB) error
+}
+
+// List ...
+func (m *TenantTypeServiceDefaultServer) List(ctx context.Context, in *ListTenantTypeWithIDRequest) (*ListTenantTypeWithIDResponse, error) {
+	var out *ListTenantTypeWithIDResponse
+	err := retry.Do(ctx, m.Retry, func(ctx context.Context) (err error) {
+		out, err = m.listInTransaction(ctx, in)
+		return err
+	})
+	return out, err
+}
+
+// listInTransaction runs List once, in a transaction
+func (m *TenantTypeServiceDefaultServer) listInTransaction(ctx context.Context, in *ListTenantTypeWithIDRequest) (*ListTenantTypeWithIDResponse, error) {
+	db := m.DB
+	if m.ReaderDB != nil && !dbresolver.UsePrimary(ctx) {
+		db = dbresolver.Replica(m.ReaderDB)
+	}
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.TenantTypeService/List", ReadOnly: true, Primary: dbresolver.UsePrimary(ctx)}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewTenantTypeWithIDRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
+	var tx *gorm.DB
+	// the repositories without database run without transaction
+	if db != nil {
+		tx = transaction.Begin(ctx, db, transaction.Options{ReadOnly: true})
+		if tx.Error != nil {
+			return nil, errors.Translate(tx.Error)
+		}
+		defer tx.Rollback()
+		db = tx
+		if err := tenant.SetLocal(ctx, db, "/example.TenantTypeService/List"); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if custom, ok := interface{}(in).(TenantTypeServiceTenantTypeWithIDWithBeforeList); ok {
+		var err error
+		if db, err = custom.BeforeList(ctx, db); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	pagedRequest := false
+	page := in.GetPaging()
+	if page.GetLimit() >= 1 {
+		// one more row tells whether there is a next page, the request is
+		// left unchanged for the retries of the transaction
+		page = &query.Pagination{PageToken: page.GetPageToken(), Offset: page.GetOffset(), Limit: page.GetLimit() + 1}
+		pagedRequest = true
+	}
+	res, err := m.tenantTypeWithIDRepository(db).List(ctx, in.Filter, in.OrderBy, page, nil)
+	if err != nil {
+		return nil, errors.Translate(err)
+	}
+	var resPaging *query.PageInfo
+	if pagedRequest {
+		resPaging = &query.PageInfo{}
+		if size := int32(len(res)); size == page.GetLimit() {
+			res = res[:size-1]
+			if resPaging.PageToken, err = m.tenantTypeWithIDRepository(db).PageToken(ctx, res[size-2], in.GetOrderBy(), in.GetFilter()); err != nil {
+				return nil, errors.Translate(err)
+			}
+		}
+	}
+	out := &ListTenantTypeWithIDResponse{Results: res, PageInfo: resPaging, NextPageToken: resPaging.GetPageToken()}
+	if custom, ok := interface{}(in).(TenantTypeServiceTenantTypeWithIDWithAfterList); ok {
+		var err error
+		if err = custom.AfterList(ctx, out, db); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if tx != nil {
+		if err := tx.Commit().Error; err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	return out, nil
+}
+
+// TenantTypeServiceTenantTypeWithIDWithBeforeList called before DefaultListTenantTypeWithID in the default List handler
+type TenantTypeServiceTenantTypeWithIDWithBeforeList interface {
+	BeforeList(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// TenantTypeServiceTenantTypeWithIDWithAfterList called before DefaultListTenantTypeWithID in the default List handler
+type TenantTypeServiceTenantTypeWithIDWithAfterList interface {
+	AfterList(context.Context, *ListTenantTypeWithIDResponse, *gorm.DB) error
+}
+type TenantTypeReaderDefaultServer struct {
+	DB *gorm.DB
+	// ReaderDB is used by the read methods when it is set, a replica of DB for instance
+	ReaderDB *gorm.DB
+	// DBResolver picks the database of each request, DB or ReaderDB are used when it is nil
+	DBResolver dbresolver.Resolver
+	// Retry is the policy of the retries of the transactions aborted by a serialization failure or a deadlock, retry.DefaultPolicy when nil
+	Retry *retry.Policy
+	// NewTenantTypeWithIDRepository returns the repository the methods run the operations on TenantTypeWithID with,
+	// NewGormTenantTypeWithIDRepository when it is nil
+	NewTenantTypeWithIDRepository func(*gorm.DB) TenantTypeWithIDRepository
+}
+
+func (m *TenantTypeReaderDefaultServer) tenantTypeWithIDRepository(db *gorm.DB) TenantTypeWithIDRepository {
+	if m.NewTenantTypeWithIDRepository != nil {
+		return m.NewTenantTypeWithIDRepository(db)
+	}
+	return NewGormTenantTypeWithIDRepository(db)
+}
+
+// Read ...
+func (m *TenantTypeReaderDefaultServer) Read(ctx context.Context, in *ReadTenantTypeWithIDRequest) (*ReadTenantTypeWithIDResponse, error) {
+	var out *ReadTenantTypeWithIDResponse
+	err := retry.Do(ctx, m.Retry, func(ctx context.Context) (err error) {
+		out, err = m.readInTransaction(ctx, in)
+		return err
+	})
+	return out, err
+}
+
+// readInTransaction runs Read once, in a transaction
+func (m *TenantTypeReaderDefaultServer) readInTransaction(ctx context.Context, in *ReadTenantTypeWithIDRequest) (*ReadTenantTypeWithIDResponse, error) {
+	db := m.DB
+	if m.ReaderDB != nil && !dbresolver.UsePrimary(ctx) {
+		db = dbresolver.Replica(m.ReaderDB)
+	}
+	if m.DBResolver != nil {
+		var err error
+		if db, err = dbresolver.Resolve(ctx, m.DBResolver, dbresolver.Info{FullMethod: "/example.TenantTypeReader/Read", ReadOnly: true, Primary: dbresolver.UsePrimary(ctx)}); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if db == nil && m.NewTenantTypeWithIDRepository == nil {
+		return nil, errors.Translate(errors.NoDBError)
+	}
+	var tx *gorm.DB
+	// the repositories without database run without transaction
+	if db != nil {
+		tx = transaction.Begin(ctx, db, transaction.Options{ReadOnly: true})
+		if tx.Error != nil {
+			return nil, errors.Translate(tx.Error)
+		}
+		defer tx.Rollback()
+		db = tx
+		if err := tenant.SetLocal(ctx, db, "/example.TenantTypeReader/Read"); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if custom, ok := interface{}(in).(TenantTypeReaderTenantTypeWithIDWithBeforeRead); ok {
+		var err error
+		if db, err = custom.BeforeRead(ctx, db); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	res, err := m.tenantTypeWithIDRepository(db).Read(ctx, &TenantTypeWithID{Id: in.GetId()}, nil)
+	if err != nil {
+		return nil, errors.Translate(err)
+	}
+	out := &ReadTenantTypeWithIDResponse{Result: res}
+	if custom, ok := interface{}(in).(TenantTypeReaderTenantTypeWithIDWithAfterRead); ok {
+		var err error
+		if err = custom.AfterRead(ctx, out, db); err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	if tx != nil {
+		if err := tx.Commit().Error; err != nil {
+			return nil, errors.Translate(err)
+		}
+	}
+	return out, nil
+}
+
+// TenantTypeReaderTenantTypeWithIDWithBeforeRead called before DefaultReadTenantTypeWithID in the default Read handler
+type TenantTypeReaderTenantTypeWithIDWithBeforeRead interface {
+	BeforeRead(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// TenantTypeReaderTenantTypeWithIDWithAfterRead called before DefaultReadTenantTypeWithID in the default Read handler
+type TenantTypeReaderTenantTypeWithIDWithAfterRead interface {
+	AfterRead(context.Context, *ReadTenantTypeWithIDResponse, *gorm.DB) error
+}
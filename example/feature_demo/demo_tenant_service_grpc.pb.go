@@ -0,0 +1,259 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package example
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TenantTypeServiceClient is the client API for TenantTypeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TenantTypeServiceClient interface {
+	Create(ctx context.Context, in *CreateTenantTypeWithIDRequest, opts ...grpc.CallOption) (*CreateTenantTypeWithIDResponse, error)
+	Read(ctx context.Context, in *ReadTenantTypeWithIDRequest, opts ...grpc.CallOption) (*ReadTenantTypeWithIDResponse, error)
+	List(ctx context.Context, in *ListTenantTypeWithIDRequest, opts ...grpc.CallOption) (*ListTenantTypeWithIDResponse, error)
+}
+
+type tenantTypeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTenantTypeServiceClient(cc grpc.ClientConnInterface) TenantTypeServiceClient {
+	return &tenantTypeServiceClient{cc}
+}
+
+func (c *tenantTypeServiceClient) Create(ctx context.Context, in *CreateTenantTypeWithIDRequest, opts ...grpc.CallOption) (*CreateTenantTypeWithIDResponse, error) {
+	out := new(CreateTenantTypeWithIDResponse)
+	err := c.cc.Invoke(ctx, "/example.TenantTypeService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantTypeServiceClient) Read(ctx context.Context, in *ReadTenantTypeWithIDRequest, opts ...grpc.CallOption) (*ReadTenantTypeWithIDResponse, error) {
+	out := new(ReadTenantTypeWithIDResponse)
+	err := c.cc.Invoke(ctx, "/example.TenantTypeService/Read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantTypeServiceClient) List(ctx context.Context, in *ListTenantTypeWithIDRequest, opts ...grpc.CallOption) (*ListTenantTypeWithIDResponse, error) {
+	out := new(ListTenantTypeWithIDResponse)
+	err := c.cc.Invoke(ctx, "/example.TenantTypeService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TenantTypeServiceServer is the server API for TenantTypeService service.
+// All implementations must embed UnimplementedTenantTypeServiceServer
+// for forward compatibility
+type TenantTypeServiceServer interface {
+	Create(context.Context, *CreateTenantTypeWithIDRequest) (*CreateTenantTypeWithIDResponse, error)
+	Read(context.Context, *ReadTenantTypeWithIDRequest) (*ReadTenantTypeWithIDResponse, error)
+	List(context.Context, *ListTenantTypeWithIDRequest) (*ListTenantTypeWithIDResponse, error)
+	mustEmbedUnimplementedTenantTypeServiceServer()
+}
+
+// UnimplementedTenantTypeServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTenantTypeServiceServer struct {
+}
+
+func (UnimplementedTenantTypeServiceServer) Create(context.Context, *CreateTenantTypeWithIDRequest) (*CreateTenantTypeWithIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedTenantTypeServiceServer) Read(context.Context, *ReadTenantTypeWithIDRequest) (*ReadTenantTypeWithIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedTenantTypeServiceServer) List(context.Context, *ListTenantTypeWithIDRequest) (*ListTenantTypeWithIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedTenantTypeServiceServer) mustEmbedUnimplementedTenantTypeServiceServer() {}
+
+// UnsafeTenantTypeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TenantTypeServiceServer will
+// result in compilation errors.
+type UnsafeTenantTypeServiceServer interface {
+	mustEmbedUnimplementedTenantTypeServiceServer()
+}
+
+func RegisterTenantTypeServiceServer(s grpc.ServiceRegistrar, srv TenantTypeServiceServer) {
+	s.RegisterService(&TenantTypeService_ServiceDesc, srv)
+}
+
+func _TenantTypeService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenantTypeWithIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantTypeServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.TenantTypeService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantTypeServiceServer).Create(ctx, req.(*CreateTenantTypeWithIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantTypeService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadTenantTypeWithIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantTypeServiceServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.TenantTypeService/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantTypeServiceServer).Read(ctx, req.(*ReadTenantTypeWithIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantTypeService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenantTypeWithIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantTypeServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.TenantTypeService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantTypeServiceServer).List(ctx, req.(*ListTenantTypeWithIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TenantTypeService_ServiceDesc is the grpc.ServiceDesc for TenantTypeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TenantTypeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "example.TenantTypeService",
+	HandlerType: (*TenantTypeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _TenantTypeService_Create_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _TenantTypeService_Read_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _TenantTypeService_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "feature_demo/demo_tenant_service.proto",
+}
+
+// TenantTypeReaderClient is the client API for TenantTypeReader service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TenantTypeReaderClient interface {
+	Read(ctx context.Context, in *ReadTenantTypeWithIDRequest, opts ...grpc.CallOption) (*ReadTenantTypeWithIDResponse, error)
+}
+
+type tenantTypeReaderClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTenantTypeReaderClient(cc grpc.ClientConnInterface) TenantTypeReaderClient {
+	return &tenantTypeReaderClient{cc}
+}
+
+func (c *tenantTypeReaderClient) Read(ctx context.Context, in *ReadTenantTypeWithIDRequest, opts ...grpc.CallOption) (*ReadTenantTypeWithIDResponse, error) {
+	out := new(ReadTenantTypeWithIDResponse)
+	err := c.cc.Invoke(ctx, "/example.TenantTypeReader/Read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TenantTypeReaderServer is the server API for TenantTypeReader service.
+// All implementations must embed UnimplementedTenantTypeReaderServer
+// for forward compatibility
+type TenantTypeReaderServer interface {
+	Read(context.Context, *ReadTenantTypeWithIDRequest) (*ReadTenantTypeWithIDResponse, error)
+	mustEmbedUnimplementedTenantTypeReaderServer()
+}
+
+// UnimplementedTenantTypeReaderServer must be embedded to have forward compatible implementations.
+type UnimplementedTenantTypeReaderServer struct {
+}
+
+func (UnimplementedTenantTypeReaderServer) Read(context.Context, *ReadTenantTypeWithIDRequest) (*ReadTenantTypeWithIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedTenantTypeReaderServer) mustEmbedUnimplementedTenantTypeReaderServer() {}
+
+// UnsafeTenantTypeReaderServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TenantTypeReaderServer will
+// result in compilation errors.
+type UnsafeTenantTypeReaderServer interface {
+	mustEmbedUnimplementedTenantTypeReaderServer()
+}
+
+func RegisterTenantTypeReaderServer(s grpc.ServiceRegistrar, srv TenantTypeReaderServer) {
+	s.RegisterService(&TenantTypeReader_ServiceDesc, srv)
+}
+
+func _TenantTypeReader_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadTenantTypeWithIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantTypeReaderServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.TenantTypeReader/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantTypeReaderServer).Read(ctx, req.(*ReadTenantTypeWithIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TenantTypeReader_ServiceDesc is the grpc.ServiceDesc for TenantTypeReader service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TenantTypeReader_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "example.TenantTypeReader",
+	HandlerType: (*TenantTypeReaderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Read",
+			Handler:    _TenantTypeReader_Read_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "feature_demo/demo_tenant_service.proto",
+}
@@ -499,6 +499,64 @@ func (x *MultiaccountTypeWithoutID) GetSomeField() string {
 	return ""
 }
 
+// TenantTypeWithID demonstrates tenant isolation on a custom field, its rows
+// are isolated by the tenant.Resolver registered at runtime. The outbox
+// option writes its change events to the outbox table
+type TenantTypeWithID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SomeField string `protobuf:"bytes,2,opt,name=some_field,json=someField,proto3" json:"some_field,omitempty"`
+}
+
+func (x *TenantTypeWithID) Reset() {
+	*x = TenantTypeWithID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feature_demo_demo_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantTypeWithID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantTypeWithID) ProtoMessage() {}
+
+func (x *TenantTypeWithID) ProtoReflect() protoreflect.Message {
+	mi := &file_feature_demo_demo_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantTypeWithID.ProtoReflect.Descriptor instead.
+func (*TenantTypeWithID) Descriptor() ([]byte, []int) {
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TenantTypeWithID) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TenantTypeWithID) GetSomeField() string {
+	if x != nil {
+		return x.SomeField
+	}
+	return ""
+}
+
 type APIOnlyType struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -514,7 +572,7 @@ type APIOnlyType struct {
 func (x *APIOnlyType) Reset() {
 	*x = APIOnlyType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_types_proto_msgTypes[4]
+		mi := &file_feature_demo_demo_types_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -527,7 +585,7 @@ func (x *APIOnlyType) String() string {
 func (*APIOnlyType) ProtoMessage() {}
 
 func (x *APIOnlyType) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_types_proto_msgTypes[4]
+	mi := &file_feature_demo_demo_types_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -540,7 +598,7 @@ func (x *APIOnlyType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use APIOnlyType.ProtoReflect.Descriptor instead.
 func (*APIOnlyType) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{4}
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *APIOnlyType) GetContents() string {
@@ -562,7 +620,7 @@ type PrimaryUUIDType struct {
 func (x *PrimaryUUIDType) Reset() {
 	*x = PrimaryUUIDType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_types_proto_msgTypes[5]
+		mi := &file_feature_demo_demo_types_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -575,7 +633,7 @@ func (x *PrimaryUUIDType) String() string {
 func (*PrimaryUUIDType) ProtoMessage() {}
 
 func (x *PrimaryUUIDType) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_types_proto_msgTypes[5]
+	mi := &file_feature_demo_demo_types_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -588,7 +646,7 @@ func (x *PrimaryUUIDType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrimaryUUIDType.ProtoReflect.Descriptor instead.
 func (*PrimaryUUIDType) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{5}
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PrimaryUUIDType) GetId() *types.UUIDValue {
@@ -617,7 +675,7 @@ type PrimaryStringType struct {
 func (x *PrimaryStringType) Reset() {
 	*x = PrimaryStringType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_types_proto_msgTypes[6]
+		mi := &file_feature_demo_demo_types_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -630,7 +688,7 @@ func (x *PrimaryStringType) String() string {
 func (*PrimaryStringType) ProtoMessage() {}
 
 func (x *PrimaryStringType) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_types_proto_msgTypes[6]
+	mi := &file_feature_demo_demo_types_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -643,7 +701,7 @@ func (x *PrimaryStringType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrimaryStringType.ProtoReflect.Descriptor instead.
 func (*PrimaryStringType) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{6}
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PrimaryStringType) GetId() string {
@@ -672,7 +730,7 @@ type TestTag struct {
 func (x *TestTag) Reset() {
 	*x = TestTag{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_types_proto_msgTypes[7]
+		mi := &file_feature_demo_demo_types_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -685,7 +743,7 @@ func (x *TestTag) String() string {
 func (*TestTag) ProtoMessage() {}
 
 func (x *TestTag) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_types_proto_msgTypes[7]
+	mi := &file_feature_demo_demo_types_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,7 +756,7 @@ func (x *TestTag) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestTag.ProtoReflect.Descriptor instead.
 func (*TestTag) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{7}
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TestTag) GetId() string {
@@ -728,7 +786,7 @@ type TestAssocHandlerDefault struct {
 func (x *TestAssocHandlerDefault) Reset() {
 	*x = TestAssocHandlerDefault{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_types_proto_msgTypes[8]
+		mi := &file_feature_demo_demo_types_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -741,7 +799,7 @@ func (x *TestAssocHandlerDefault) String() string {
 func (*TestAssocHandlerDefault) ProtoMessage() {}
 
 func (x *TestAssocHandlerDefault) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_types_proto_msgTypes[8]
+	mi := &file_feature_demo_demo_types_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,7 +812,7 @@ func (x *TestAssocHandlerDefault) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestAssocHandlerDefault.ProtoReflect.Descriptor instead.
 func (*TestAssocHandlerDefault) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{8}
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TestAssocHandlerDefault) GetId() string {
@@ -783,7 +841,7 @@ type TestAssocHandlerReplace struct {
 func (x *TestAssocHandlerReplace) Reset() {
 	*x = TestAssocHandlerReplace{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_types_proto_msgTypes[9]
+		mi := &file_feature_demo_demo_types_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -796,7 +854,7 @@ func (x *TestAssocHandlerReplace) String() string {
 func (*TestAssocHandlerReplace) ProtoMessage() {}
 
 func (x *TestAssocHandlerReplace) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_types_proto_msgTypes[9]
+	mi := &file_feature_demo_demo_types_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -809,7 +867,7 @@ func (x *TestAssocHandlerReplace) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestAssocHandlerReplace.ProtoReflect.Descriptor instead.
 func (*TestAssocHandlerReplace) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{9}
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TestAssocHandlerReplace) GetId() string {
@@ -838,7 +896,7 @@ type TestAssocHandlerClear struct {
 func (x *TestAssocHandlerClear) Reset() {
 	*x = TestAssocHandlerClear{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_types_proto_msgTypes[10]
+		mi := &file_feature_demo_demo_types_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -851,7 +909,7 @@ func (x *TestAssocHandlerClear) String() string {
 func (*TestAssocHandlerClear) ProtoMessage() {}
 
 func (x *TestAssocHandlerClear) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_types_proto_msgTypes[10]
+	mi := &file_feature_demo_demo_types_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -864,7 +922,7 @@ func (x *TestAssocHandlerClear) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestAssocHandlerClear.ProtoReflect.Descriptor instead.
 func (*TestAssocHandlerClear) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{10}
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TestAssocHandlerClear) GetId() string {
@@ -893,7 +951,7 @@ type TestAssocHandlerAppend struct {
 func (x *TestAssocHandlerAppend) Reset() {
 	*x = TestAssocHandlerAppend{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_types_proto_msgTypes[11]
+		mi := &file_feature_demo_demo_types_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -906,7 +964,7 @@ func (x *TestAssocHandlerAppend) String() string {
 func (*TestAssocHandlerAppend) ProtoMessage() {}
 
 func (x *TestAssocHandlerAppend) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_types_proto_msgTypes[11]
+	mi := &file_feature_demo_demo_types_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -919,7 +977,7 @@ func (x *TestAssocHandlerAppend) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestAssocHandlerAppend.ProtoReflect.Descriptor instead.
 func (*TestAssocHandlerAppend) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{11}
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *TestAssocHandlerAppend) GetId() string {
@@ -947,7 +1005,7 @@ type TestTagAssociation struct {
 func (x *TestTagAssociation) Reset() {
 	*x = TestTagAssociation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_types_proto_msgTypes[12]
+		mi := &file_feature_demo_demo_types_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -960,7 +1018,7 @@ func (x *TestTagAssociation) String() string {
 func (*TestTagAssociation) ProtoMessage() {}
 
 func (x *TestTagAssociation) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_types_proto_msgTypes[12]
+	mi := &file_feature_demo_demo_types_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -973,7 +1031,7 @@ func (x *TestTagAssociation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestTagAssociation.ProtoReflect.Descriptor instead.
 func (*TestTagAssociation) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{12}
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *TestTagAssociation) GetSomeField() string {
@@ -994,7 +1052,7 @@ type PrimaryIncluded struct {
 func (x *PrimaryIncluded) Reset() {
 	*x = PrimaryIncluded{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feature_demo_demo_types_proto_msgTypes[13]
+		mi := &file_feature_demo_demo_types_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1007,7 +1065,7 @@ func (x *PrimaryIncluded) String() string {
 func (*PrimaryIncluded) ProtoMessage() {}
 
 func (x *PrimaryIncluded) ProtoReflect() protoreflect.Message {
-	mi := &file_feature_demo_demo_types_proto_msgTypes[13]
+	mi := &file_feature_demo_demo_types_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1020,7 +1078,7 @@ func (x *PrimaryIncluded) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrimaryIncluded.ProtoReflect.Descriptor instead.
 func (*PrimaryIncluded) Descriptor() ([]byte, []int) {
-	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{13}
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *PrimaryIncluded) GetChild() *ExternalChild {
@@ -1030,6 +1088,79 @@ func (x *PrimaryIncluded) GetChild() *ExternalChild {
 	return nil
 }
 
+// TagConstraints demonstrates the Validate method generated from the size,
+// not_null and precision of the tags and from the enums
+type TagConstraints struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nickname *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Price    float64                 `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Status   TestTypesStatus         `protobuf:"varint,4,opt,name=status,proto3,enum=example.TestTypesStatus" json:"status,omitempty"`
+}
+
+func (x *TagConstraints) Reset() {
+	*x = TagConstraints{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feature_demo_demo_types_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagConstraints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagConstraints) ProtoMessage() {}
+
+func (x *TagConstraints) ProtoReflect() protoreflect.Message {
+	mi := &file_feature_demo_demo_types_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagConstraints.ProtoReflect.Descriptor instead.
+func (*TagConstraints) Descriptor() ([]byte, []int) {
+	return file_feature_demo_demo_types_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TagConstraints) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TagConstraints) GetNickname() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Nickname
+	}
+	return nil
+}
+
+func (x *TagConstraints) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *TagConstraints) GetStatus() TestTypesStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TestTypes_UNKNOWN
+}
+
 var File_feature_demo_demo_types_proto protoreflect.FileDescriptor
 
 var file_feature_demo_demo_types_proto_rawDesc = []byte{
@@ -1147,84 +1278,103 @@ var file_feature_demo_demo_types_proto_rawDesc = []byte{
 	0x0c, 0x5b, 0x5d, 0x2a, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x13, 0x6d,
 	0x75, 0x6c, 0x74, 0x69, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
 	0x65, 0x73, 0x1a, 0x0e, 0x7a, 0x0c, 0x54, 0x79, 0x70, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44,
-	0x49, 0x44, 0x22, 0x51, 0x0a, 0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x49, 0x44, 0x22, 0x53, 0x0a, 0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x61, 0x63, 0x63, 0x6f, 0x75,
 	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
 	0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x6f, 0x6d, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x08, 0xba, 0xb9, 0x19,
-	0x04, 0x08, 0x01, 0x20, 0x01, 0x22, 0x44, 0x0a, 0x19, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74,
-	0x49, 0x44, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x6d, 0x65, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x3a, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x08, 0x01, 0x20, 0x01, 0x22, 0x29, 0x0a, 0x0b, 0x41,
-	0x50, 0x49, 0x4f, 0x6e, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x6e, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72,
-	0x79, 0x55, 0x55, 0x49, 0x44, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x2c, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x3a, 0x06,
-	0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x59, 0x0a, 0x11, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72,
-	0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x63,
-	0x68, 0x69, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x69,
-	0x6c, 0x64, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08,
-	0x01, 0x22, 0x6a, 0x0a, 0x07, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x0c,
-	0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x73,
-	0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x06, 0xba, 0xb9, 0x19, 0x02, 0x1a, 0x00, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67,
-	0x41, 0x73, 0x73, 0x6f, 0x63, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x7a, 0x0a,
-	0x17, 0x54, 0x65, 0x73, 0x74, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65,
-	0x72, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x47, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74,
-	0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67,
-	0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0xba, 0xb9, 0x19,
-	0x02, 0x2a, 0x00, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f,
-	0x63, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x7c, 0x0a, 0x17, 0x54, 0x65, 0x73,
-	0x74, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x70,
-	0x6c, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x49, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41,
-	0x73, 0x73, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f,
-	0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x2a, 0x02, 0x50,
-	0x01, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x3a,
-	0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x7a, 0x0a, 0x15, 0x54, 0x65, 0x73, 0x74, 0x41,
-	0x73, 0x73, 0x6f, 0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x43, 0x6c, 0x65, 0x61, 0x72,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x49, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x2a, 0x02, 0x60, 0x01, 0x52, 0x0c, 0x74,
+	0x52, 0x09, 0x73, 0x6f, 0x6d, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x0a, 0xba, 0xb9, 0x19,
+	0x06, 0x08, 0x01, 0x20, 0x01, 0x30, 0x01, 0x22, 0x44, 0x0a, 0x19, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x57, 0x69, 0x74, 0x68, 0x6f,
+	0x75, 0x74, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x6d, 0x65, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x3a, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x08, 0x01, 0x20, 0x01, 0x22, 0x62, 0x0a,
+	0x10, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49,
+	0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x6d, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x3a, 0x1f, 0xba, 0xb9, 0x19, 0x1b, 0x08, 0x01, 0x2a, 0x15, 0x0a, 0x05, 0x4f, 0x72, 0x67, 0x49,
+	0x44, 0x12, 0x04, 0x75, 0x75, 0x69, 0x64, 0x1a, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x38,
+	0x01, 0x22, 0x29, 0x0a, 0x0b, 0x41, 0x50, 0x49, 0x4f, 0x6e, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x6e, 0x0a, 0x0f,
+	0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x55, 0x55, 0x49, 0x44, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x25, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f,
+	0x72, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x59, 0x0a, 0x11,
+	0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x2c, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x3a,
+	0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x6a, 0x0a, 0x07, 0x54, 0x65, 0x73, 0x74, 0x54,
+	0x61, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x47, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73,
+	0x6f, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x1a, 0x00, 0x52, 0x0c, 0x74,
 	0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x3a, 0x06, 0xba, 0xb9, 0x19,
-	0x02, 0x08, 0x01, 0x22, 0x7b, 0x0a, 0x16, 0x54, 0x65, 0x73, 0x74, 0x41, 0x73, 0x73, 0x6f, 0x63,
-	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x49, 0x0a,
-	0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x54, 0x65,
-	0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x2a, 0x02, 0x58, 0x01, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74,
-	0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01,
-	0x22, 0x3b, 0x0a, 0x12, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63,
-	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x6d, 0x65,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x53, 0x0a,
-	0x0f, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64,
-	0x12, 0x2c, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x3a, 0x12,
-	0xba, 0xb9, 0x19, 0x0e, 0x08, 0x01, 0x12, 0x0a, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x02,
-	0x69, 0x64, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x62, 0x6c, 0x6f, 0x78, 0x6f, 0x70, 0x65, 0x6e, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x72, 0x6d, 0x2f, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x64, 0x65,
-	0x6d, 0x6f, 0x3b, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x02, 0x08, 0x01, 0x22, 0x7a, 0x0a, 0x17, 0x54, 0x65, 0x73, 0x74, 0x41, 0x73, 0x73, 0x6f, 0x63,
+	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x47,
+	0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x54,
+	0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x2a, 0x00, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54,
+	0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22,
+	0x7c, 0x0a, 0x17, 0x54, 0x65, 0x73, 0x74, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x48, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x49, 0x0a, 0x0c, 0x74, 0x65,
+	0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54,
+	0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba,
+	0xb9, 0x19, 0x04, 0x2a, 0x02, 0x50, 0x01, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67,
+	0x41, 0x73, 0x73, 0x6f, 0x63, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x7a, 0x0a,
+	0x15, 0x54, 0x65, 0x73, 0x74, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x72, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x49, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61,
+	0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73,
+	0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x2a,
+	0x02, 0x60, 0x01, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f,
+	0x63, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x7b, 0x0a, 0x16, 0x54, 0x65, 0x73,
+	0x74, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x41, 0x70, 0x70,
+	0x65, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x49, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73,
+	0x73, 0x6f, 0x63, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x2a, 0x02, 0x58, 0x01,
+	0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x3a, 0x06,
+	0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x22, 0x3b, 0x0a, 0x12, 0x54, 0x65, 0x73, 0x74, 0x54, 0x61,
+	0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x6f, 0x6d, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x06, 0xba, 0xb9, 0x19,
+	0x02, 0x08, 0x01, 0x22, 0x53, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x49, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x3a, 0x12, 0xba, 0xb9, 0x19, 0x0e, 0x08, 0x01, 0x12, 0x0a, 0x0a, 0x04,
+	0x55, 0x55, 0x49, 0x44, 0x12, 0x02, 0x69, 0x64, 0x22, 0xcd, 0x01, 0x0a, 0x0e, 0x54, 0x61, 0x67,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x44, 0x0a, 0x08, 0x6e,
+	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0a, 0xba, 0xb9, 0x19,
+	0x06, 0x0a, 0x04, 0x18, 0x08, 0x40, 0x01, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x2a, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x42, 0x14, 0xba, 0xb9, 0x19, 0x10, 0x0a, 0x0e, 0x12, 0x0c, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69,
+	0x63, 0x28, 0x38, 0x2c, 0x32, 0x29, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x31, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x63, 0x61, 0x6e, 0x73, 0x65, 0x63, 0x6f, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x72, 0x6d, 0x2f,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f,
+	0x64, 0x65, 0x6d, 0x6f, 0x3b, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1240,70 +1390,74 @@ func file_feature_demo_demo_types_proto_rawDescGZIP() []byte {
 }
 
 var file_feature_demo_demo_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_feature_demo_demo_types_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_feature_demo_demo_types_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_feature_demo_demo_types_proto_goTypes = []interface{}{
 	(TestTypesStatus)(0),              // 0: example.TestTypes.status
 	(*TestTypes)(nil),                 // 1: example.TestTypes
 	(*TypeWithID)(nil),                // 2: example.TypeWithID
 	(*MultiaccountTypeWithID)(nil),    // 3: example.MultiaccountTypeWithID
 	(*MultiaccountTypeWithoutID)(nil), // 4: example.MultiaccountTypeWithoutID
-	(*APIOnlyType)(nil),               // 5: example.APIOnlyType
-	(*PrimaryUUIDType)(nil),           // 6: example.PrimaryUUIDType
-	(*PrimaryStringType)(nil),         // 7: example.PrimaryStringType
-	(*TestTag)(nil),                   // 8: example.TestTag
-	(*TestAssocHandlerDefault)(nil),   // 9: example.TestAssocHandlerDefault
-	(*TestAssocHandlerReplace)(nil),   // 10: example.TestAssocHandlerReplace
-	(*TestAssocHandlerClear)(nil),     // 11: example.TestAssocHandlerClear
-	(*TestAssocHandlerAppend)(nil),    // 12: example.TestAssocHandlerAppend
-	(*TestTagAssociation)(nil),        // 13: example.TestTagAssociation
-	(*PrimaryIncluded)(nil),           // 14: example.PrimaryIncluded
-	(*wrapperspb.StringValue)(nil),    // 15: google.protobuf.StringValue
-	(*emptypb.Empty)(nil),             // 16: google.protobuf.Empty
-	(*types.UUID)(nil),                // 17: gorm.types.UUID
-	(*timestamppb.Timestamp)(nil),     // 18: google.protobuf.Timestamp
-	(*types.JSONValue)(nil),           // 19: gorm.types.JSONValue
-	(*types.UUIDValue)(nil),           // 20: gorm.types.UUIDValue
-	(*types.TimeOnly)(nil),            // 21: gorm.types.TimeOnly
-	(*IntPoint)(nil),                  // 22: example.IntPoint
-	(*user.User)(nil),                 // 23: user.User
-	(*types.InetValue)(nil),           // 24: gorm.types.InetValue
-	(*wrapperspb.FloatValue)(nil),     // 25: google.protobuf.FloatValue
-	(*wrapperspb.DoubleValue)(nil),    // 26: google.protobuf.DoubleValue
-	(*ExternalChild)(nil),             // 27: example.ExternalChild
+	(*TenantTypeWithID)(nil),          // 5: example.TenantTypeWithID
+	(*APIOnlyType)(nil),               // 6: example.APIOnlyType
+	(*PrimaryUUIDType)(nil),           // 7: example.PrimaryUUIDType
+	(*PrimaryStringType)(nil),         // 8: example.PrimaryStringType
+	(*TestTag)(nil),                   // 9: example.TestTag
+	(*TestAssocHandlerDefault)(nil),   // 10: example.TestAssocHandlerDefault
+	(*TestAssocHandlerReplace)(nil),   // 11: example.TestAssocHandlerReplace
+	(*TestAssocHandlerClear)(nil),     // 12: example.TestAssocHandlerClear
+	(*TestAssocHandlerAppend)(nil),    // 13: example.TestAssocHandlerAppend
+	(*TestTagAssociation)(nil),        // 14: example.TestTagAssociation
+	(*PrimaryIncluded)(nil),           // 15: example.PrimaryIncluded
+	(*TagConstraints)(nil),            // 16: example.TagConstraints
+	(*wrapperspb.StringValue)(nil),    // 17: google.protobuf.StringValue
+	(*emptypb.Empty)(nil),             // 18: google.protobuf.Empty
+	(*types.UUID)(nil),                // 19: gorm.types.UUID
+	(*timestamppb.Timestamp)(nil),     // 20: google.protobuf.Timestamp
+	(*types.JSONValue)(nil),           // 21: gorm.types.JSONValue
+	(*types.UUIDValue)(nil),           // 22: gorm.types.UUIDValue
+	(*types.TimeOnly)(nil),            // 23: gorm.types.TimeOnly
+	(*IntPoint)(nil),                  // 24: example.IntPoint
+	(*user.User)(nil),                 // 25: user.User
+	(*types.InetValue)(nil),           // 26: gorm.types.InetValue
+	(*wrapperspb.FloatValue)(nil),     // 27: google.protobuf.FloatValue
+	(*wrapperspb.DoubleValue)(nil),    // 28: google.protobuf.DoubleValue
+	(*ExternalChild)(nil),             // 29: example.ExternalChild
 }
 var file_feature_demo_demo_types_proto_depIdxs = []int32{
-	15, // 0: example.TestTypes.optional_string:type_name -> google.protobuf.StringValue
+	17, // 0: example.TestTypes.optional_string:type_name -> google.protobuf.StringValue
 	0,  // 1: example.TestTypes.becomes_int:type_name -> example.TestTypes.status
-	16, // 2: example.TestTypes.nothingness:type_name -> google.protobuf.Empty
-	17, // 3: example.TestTypes.uuid:type_name -> gorm.types.UUID
-	18, // 4: example.TestTypes.created_at:type_name -> google.protobuf.Timestamp
-	19, // 5: example.TestTypes.json_field:type_name -> gorm.types.JSONValue
-	20, // 6: example.TestTypes.nullable_uuid:type_name -> gorm.types.UUIDValue
-	21, // 7: example.TestTypes.time_only:type_name -> gorm.types.TimeOnly
+	18, // 2: example.TestTypes.nothingness:type_name -> google.protobuf.Empty
+	19, // 3: example.TestTypes.uuid:type_name -> gorm.types.UUID
+	20, // 4: example.TestTypes.created_at:type_name -> google.protobuf.Timestamp
+	21, // 5: example.TestTypes.json_field:type_name -> gorm.types.JSONValue
+	22, // 6: example.TestTypes.nullable_uuid:type_name -> gorm.types.UUIDValue
+	23, // 7: example.TestTypes.time_only:type_name -> gorm.types.TimeOnly
 	1,  // 8: example.TypeWithID.things:type_name -> example.TestTypes
 	1,  // 9: example.TypeWithID.a_nested_object:type_name -> example.TestTypes
-	22, // 10: example.TypeWithID.point:type_name -> example.IntPoint
-	23, // 11: example.TypeWithID.user:type_name -> user.User
-	24, // 12: example.TypeWithID.address:type_name -> gorm.types.InetValue
-	5,  // 13: example.TypeWithID.synthetic_field:type_name -> example.APIOnlyType
-	25, // 14: example.TypeWithID.float_field:type_name -> google.protobuf.FloatValue
-	26, // 15: example.TypeWithID.double_field:type_name -> google.protobuf.DoubleValue
-	21, // 16: example.TypeWithID.time_only:type_name -> gorm.types.TimeOnly
-	18, // 17: example.TypeWithID.deleted_at:type_name -> google.protobuf.Timestamp
-	20, // 18: example.PrimaryUUIDType.id:type_name -> gorm.types.UUIDValue
-	27, // 19: example.PrimaryUUIDType.child:type_name -> example.ExternalChild
-	27, // 20: example.PrimaryStringType.child:type_name -> example.ExternalChild
-	13, // 21: example.TestTag.testTagAssoc:type_name -> example.TestTagAssociation
-	13, // 22: example.TestAssocHandlerDefault.testTagAssoc:type_name -> example.TestTagAssociation
-	13, // 23: example.TestAssocHandlerReplace.testTagAssoc:type_name -> example.TestTagAssociation
-	13, // 24: example.TestAssocHandlerClear.testTagAssoc:type_name -> example.TestTagAssociation
-	13, // 25: example.TestAssocHandlerAppend.testTagAssoc:type_name -> example.TestTagAssociation
-	27, // 26: example.PrimaryIncluded.child:type_name -> example.ExternalChild
-	27, // [27:27] is the sub-list for method output_type
-	27, // [27:27] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	24, // 10: example.TypeWithID.point:type_name -> example.IntPoint
+	25, // 11: example.TypeWithID.user:type_name -> user.User
+	26, // 12: example.TypeWithID.address:type_name -> gorm.types.InetValue
+	6,  // 13: example.TypeWithID.synthetic_field:type_name -> example.APIOnlyType
+	27, // 14: example.TypeWithID.float_field:type_name -> google.protobuf.FloatValue
+	28, // 15: example.TypeWithID.double_field:type_name -> google.protobuf.DoubleValue
+	23, // 16: example.TypeWithID.time_only:type_name -> gorm.types.TimeOnly
+	20, // 17: example.TypeWithID.deleted_at:type_name -> google.protobuf.Timestamp
+	22, // 18: example.PrimaryUUIDType.id:type_name -> gorm.types.UUIDValue
+	29, // 19: example.PrimaryUUIDType.child:type_name -> example.ExternalChild
+	29, // 20: example.PrimaryStringType.child:type_name -> example.ExternalChild
+	14, // 21: example.TestTag.testTagAssoc:type_name -> example.TestTagAssociation
+	14, // 22: example.TestAssocHandlerDefault.testTagAssoc:type_name -> example.TestTagAssociation
+	14, // 23: example.TestAssocHandlerReplace.testTagAssoc:type_name -> example.TestTagAssociation
+	14, // 24: example.TestAssocHandlerClear.testTagAssoc:type_name -> example.TestTagAssociation
+	14, // 25: example.TestAssocHandlerAppend.testTagAssoc:type_name -> example.TestTagAssociation
+	29, // 26: example.PrimaryIncluded.child:type_name -> example.ExternalChild
+	17, // 27: example.TagConstraints.nickname:type_name -> google.protobuf.StringValue
+	0,  // 28: example.TagConstraints.status:type_name -> example.TestTypes.status
+	29, // [29:29] is the sub-list for method output_type
+	29, // [29:29] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_feature_demo_demo_types_proto_init() }
@@ -1363,7 +1517,7 @@ func file_feature_demo_demo_types_proto_init() {
 			}
 		}
 		file_feature_demo_demo_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*APIOnlyType); i {
+			switch v := v.(*TenantTypeWithID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1375,7 +1529,7 @@ func file_feature_demo_demo_types_proto_init() {
 			}
 		}
 		file_feature_demo_demo_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrimaryUUIDType); i {
+			switch v := v.(*APIOnlyType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1387,7 +1541,7 @@ func file_feature_demo_demo_types_proto_init() {
 			}
 		}
 		file_feature_demo_demo_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrimaryStringType); i {
+			switch v := v.(*PrimaryUUIDType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1399,7 +1553,7 @@ func file_feature_demo_demo_types_proto_init() {
 			}
 		}
 		file_feature_demo_demo_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestTag); i {
+			switch v := v.(*PrimaryStringType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1411,7 +1565,7 @@ func file_feature_demo_demo_types_proto_init() {
 			}
 		}
 		file_feature_demo_demo_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestAssocHandlerDefault); i {
+			switch v := v.(*TestTag); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1423,7 +1577,7 @@ func file_feature_demo_demo_types_proto_init() {
 			}
 		}
 		file_feature_demo_demo_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestAssocHandlerReplace); i {
+			switch v := v.(*TestAssocHandlerDefault); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1435,7 +1589,7 @@ func file_feature_demo_demo_types_proto_init() {
 			}
 		}
 		file_feature_demo_demo_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestAssocHandlerClear); i {
+			switch v := v.(*TestAssocHandlerReplace); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1447,7 +1601,7 @@ func file_feature_demo_demo_types_proto_init() {
 			}
 		}
 		file_feature_demo_demo_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestAssocHandlerAppend); i {
+			switch v := v.(*TestAssocHandlerClear); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1459,7 +1613,7 @@ func file_feature_demo_demo_types_proto_init() {
 			}
 		}
 		file_feature_demo_demo_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestTagAssociation); i {
+			switch v := v.(*TestAssocHandlerAppend); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1471,6 +1625,18 @@ func file_feature_demo_demo_types_proto_init() {
 			}
 		}
 		file_feature_demo_demo_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestTagAssociation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feature_demo_demo_types_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PrimaryIncluded); i {
 			case 0:
 				return &v.state
@@ -1482,6 +1648,18 @@ func file_feature_demo_demo_types_proto_init() {
 				return nil
 			}
 		}
+		file_feature_demo_demo_types_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagConstraints); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1489,7 +1667,7 @@ func file_feature_demo_demo_types_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_feature_demo_demo_types_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   14,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -3,22 +3,37 @@ package example
 import (
 	context "context"
 	fmt "fmt"
-	auth "github.com/infobloxopen/atlas-app-toolkit/auth"
-	gateway "github.com/infobloxopen/atlas-app-toolkit/gateway"
-	gorm1 "github.com/infobloxopen/atlas-app-toolkit/gorm"
 	errors "github.com/acanseco/protoc-gen-gorm/errors"
 	user "github.com/acanseco/protoc-gen-gorm/example/user"
+	audit "github.com/acanseco/protoc-gen-gorm/runtime/audit"
+	fixture "github.com/acanseco/protoc-gen-gorm/runtime/fixture"
+	insert "github.com/acanseco/protoc-gen-gorm/runtime/insert"
+	memory "github.com/acanseco/protoc-gen-gorm/runtime/memory"
+	outbox "github.com/acanseco/protoc-gen-gorm/runtime/outbox"
+	paging "github.com/acanseco/protoc-gen-gorm/runtime/paging"
+	preload "github.com/acanseco/protoc-gen-gorm/runtime/preload"
+	tenant "github.com/acanseco/protoc-gen-gorm/runtime/tenant"
+	_ "github.com/acanseco/protoc-gen-gorm/runtime/tenant/atlas"
+	transaction "github.com/acanseco/protoc-gen-gorm/runtime/transaction"
 	types "github.com/acanseco/protoc-gen-gorm/types"
+	gateway "github.com/infobloxopen/atlas-app-toolkit/gateway"
+	gorm1 "github.com/infobloxopen/atlas-app-toolkit/gorm"
+	query "github.com/infobloxopen/atlas-app-toolkit/query"
 	gorm "github.com/jinzhu/gorm"
 	postgres "github.com/jinzhu/gorm/dialects/postgres"
 	pq "github.com/lib/pq"
 	go_uuid "github.com/satori/go.uuid"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	proto "google.golang.org/protobuf/proto"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	math "math"
 	strings "strings"
 	time "time"
+	utf8 "unicode/utf8"
 )
 
 type TestTypesORM struct {
@@ -128,6 +143,19 @@ func (m *TestTypesORM) ToPB(ctx context.Context) (TestTypes, error) {
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *TestTypes) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	if m.BecomesInt.Descriptor().Values().ByNumber(m.BecomesInt.Number()) == nil {
+		v.Add("becomes_int", "is not a valid status")
+	}
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type TestTypes the arg will be the target, the caller the one being converted from
 
@@ -319,6 +347,25 @@ func (m *TypeWithIDORM) ToPB(ctx context.Context) (TypeWithID, error) {
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *TypeWithID) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	for i, e := range m.Things {
+		v.Nest(fmt.Sprintf("things[%d]", i), e.Validate())
+	}
+	v.Nest("a_nested_object", m.ANestedObject.Validate())
+	v.Nest("point", m.Point.Validate())
+	v.Nest("user", m.User.Validate())
+	if utf8.RuneCountInString(m.TagSizeTest) > 512 {
+		v.Add("tag_size_test", "must be at most 512 characters")
+	}
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type TypeWithID the arg will be the target, the caller the one being converted from
 
@@ -353,6 +400,53 @@ func (MultiaccountTypeWithIDORM) TableName() string {
 	return "multiaccount_type_with_ids"
 }
 
+// RowLevelSecurity returns the statements enabling row level security on the table, its rows
+// are restricted to the tenant set by tenant.SetLocal in the transaction, even for the table owner
+func (MultiaccountTypeWithIDORM) RowLevelSecurity() []string {
+	return []string{
+		`ALTER TABLE "multiaccount_type_with_ids" ENABLE ROW LEVEL SECURITY`,
+		`ALTER TABLE "multiaccount_type_with_ids" FORCE ROW LEVEL SECURITY`,
+		`DROP POLICY IF EXISTS "multiaccount_type_with_ids_tenant_isolation" ON "multiaccount_type_with_ids"`,
+		`CREATE POLICY "multiaccount_type_with_ids_tenant_isolation" ON "multiaccount_type_with_ids" USING ("account_id" = NULLIF(current_setting('app.account_id', true), '')) WITH CHECK ("account_id" = NULLIF(current_setting('app.account_id', true), ''))`,
+	}
+}
+
+// MultiaccountTypeWithIDHistoryORM is a row of the history of MultiaccountTypeWithID, written by its write handlers
+type MultiaccountTypeWithIDHistoryORM struct {
+	Id        uint64 `gorm:"primary_key"`
+	ObjectId  uint64 `gorm:"index"`
+	AccountID string
+	audit.Record
+}
+
+// TableName overrides the default tablename generated by GORM
+func (MultiaccountTypeWithIDHistoryORM) TableName() string {
+	return "multiaccount_type_with_ids_history"
+}
+
+// recordMultiaccountTypeWithIDHistory writes the history row of the operation changing before into after, either may be nil
+func recordMultiaccountTypeWithIDHistory(ctx context.Context, db *gorm.DB, operation string, before, after *MultiaccountTypeWithIDORM) error {
+	row := &MultiaccountTypeWithIDHistoryORM{}
+	var snapshots [2]proto.Message
+	for i, ormObj := range []*MultiaccountTypeWithIDORM{before, after} {
+		if ormObj == nil {
+			continue
+		}
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return err
+		}
+		snapshots[i] = &pbObj
+		row.ObjectId = ormObj.Id
+		row.AccountID = ormObj.AccountID
+	}
+	var err error
+	if row.Record, err = audit.NewRecord(ctx, operation, snapshots[0], snapshots[1]); err != nil {
+		return err
+	}
+	return db.Create(row).Error
+}
+
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
 func (m *MultiaccountTypeWithID) ToORM(ctx context.Context) (MultiaccountTypeWithIDORM, error) {
@@ -365,11 +459,13 @@ func (m *MultiaccountTypeWithID) ToORM(ctx context.Context) (MultiaccountTypeWit
 	}
 	to.Id = m.Id
 	to.SomeField = m.SomeField
-	accountID, err := auth.GetAccountID(ctx, nil)
+	tenantID, isolated, err := tenant.ID(ctx, "MultiaccountTypeWithID")
 	if err != nil {
 		return to, err
 	}
-	to.AccountID = accountID
+	if isolated {
+		to.AccountID = tenantID
+	}
 	if posthook, ok := interface{}(m).(MultiaccountTypeWithIDWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -394,6 +490,16 @@ func (m *MultiaccountTypeWithIDORM) ToPB(ctx context.Context) (MultiaccountTypeW
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *MultiaccountTypeWithID) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type MultiaccountTypeWithID the arg will be the target, the caller the one being converted from
 
@@ -427,6 +533,17 @@ func (MultiaccountTypeWithoutIDORM) TableName() string {
 	return "multiaccount_type_without_ids"
 }
 
+// RowLevelSecurity returns the statements enabling row level security on the table, its rows
+// are restricted to the tenant set by tenant.SetLocal in the transaction, even for the table owner
+func (MultiaccountTypeWithoutIDORM) RowLevelSecurity() []string {
+	return []string{
+		`ALTER TABLE "multiaccount_type_without_ids" ENABLE ROW LEVEL SECURITY`,
+		`ALTER TABLE "multiaccount_type_without_ids" FORCE ROW LEVEL SECURITY`,
+		`DROP POLICY IF EXISTS "multiaccount_type_without_ids_tenant_isolation" ON "multiaccount_type_without_ids"`,
+		`CREATE POLICY "multiaccount_type_without_ids_tenant_isolation" ON "multiaccount_type_without_ids" USING ("account_id" = NULLIF(current_setting('app.account_id', true), '')) WITH CHECK ("account_id" = NULLIF(current_setting('app.account_id', true), ''))`,
+	}
+}
+
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
 func (m *MultiaccountTypeWithoutID) ToORM(ctx context.Context) (MultiaccountTypeWithoutIDORM, error) {
@@ -438,11 +555,13 @@ func (m *MultiaccountTypeWithoutID) ToORM(ctx context.Context) (MultiaccountType
 		}
 	}
 	to.SomeField = m.SomeField
-	accountID, err := auth.GetAccountID(ctx, nil)
+	tenantID, isolated, err := tenant.ID(ctx, "MultiaccountTypeWithoutID")
 	if err != nil {
 		return to, err
 	}
-	to.AccountID = accountID
+	if isolated {
+		to.AccountID = tenantID
+	}
 	if posthook, ok := interface{}(m).(MultiaccountTypeWithoutIDWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -466,6 +585,16 @@ func (m *MultiaccountTypeWithoutIDORM) ToPB(ctx context.Context) (MultiaccountTy
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *MultiaccountTypeWithoutID) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type MultiaccountTypeWithoutID the arg will be the target, the caller the one being converted from
 
@@ -489,6 +618,128 @@ type MultiaccountTypeWithoutIDWithAfterToPB interface {
 	AfterToPB(context.Context, *MultiaccountTypeWithoutID) error
 }
 
+type TenantTypeWithIDORM struct {
+	Id        uint64
+	OrgID     go_uuid.UUID `gorm:"type:uuid"`
+	SomeField string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (TenantTypeWithIDORM) TableName() string {
+	return "tenant_type_with_ids"
+}
+
+// RowLevelSecurity returns the statements enabling row level security on the table, its rows
+// are restricted to the tenant set by tenant.SetLocal in the transaction, even for the table owner
+func (TenantTypeWithIDORM) RowLevelSecurity() []string {
+	return []string{
+		`ALTER TABLE "tenant_type_with_ids" ENABLE ROW LEVEL SECURITY`,
+		`ALTER TABLE "tenant_type_with_ids" FORCE ROW LEVEL SECURITY`,
+		`DROP POLICY IF EXISTS "tenant_type_with_ids_tenant_isolation" ON "tenant_type_with_ids"`,
+		`CREATE POLICY "tenant_type_with_ids_tenant_isolation" ON "tenant_type_with_ids" USING ("org_id" = NULLIF(current_setting('app.account_id', true), '')::uuid) WITH CHECK ("org_id" = NULLIF(current_setting('app.account_id', true), '')::uuid)`,
+	}
+}
+
+// recordTenantTypeWithIDEvent inserts the event of the change of before into after in the outbox, either may be nil
+func recordTenantTypeWithIDEvent(ctx context.Context, db *gorm.DB, eventType string, before, after *TenantTypeWithIDORM) error {
+	var states [2]proto.Message
+	var id interface{}
+	for i, ormObj := range []*TenantTypeWithIDORM{before, after} {
+		if ormObj == nil {
+			continue
+		}
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return err
+		}
+		states[i] = &pbObj
+		id = ormObj.Id
+	}
+	event, err := outbox.NewEvent(eventType, "TenantTypeWithID", id, states[0], states[1])
+	if err != nil {
+		return err
+	}
+	return db.Create(event).Error
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *TenantTypeWithID) ToORM(ctx context.Context) (TenantTypeWithIDORM, error) {
+	to := TenantTypeWithIDORM{}
+	var err error
+	if prehook, ok := interface{}(m).(TenantTypeWithIDWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.SomeField = m.SomeField
+	tenantID, isolated, err := tenant.ID(ctx, "TenantTypeWithID")
+	if err != nil {
+		return to, err
+	}
+	if isolated {
+		if to.OrgID, err = go_uuid.FromString(tenantID); err != nil {
+			return to, err
+		}
+	}
+	if posthook, ok := interface{}(m).(TenantTypeWithIDWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *TenantTypeWithIDORM) ToPB(ctx context.Context) (TenantTypeWithID, error) {
+	to := TenantTypeWithID{}
+	var err error
+	if prehook, ok := interface{}(m).(TenantTypeWithIDWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.SomeField = m.SomeField
+	if posthook, ok := interface{}(m).(TenantTypeWithIDWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *TenantTypeWithID) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	return v.Err()
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type TenantTypeWithID the arg will be the target, the caller the one being converted from
+
+// TenantTypeWithIDBeforeToORM called before default ToORM code
+type TenantTypeWithIDWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TenantTypeWithIDORM) error
+}
+
+// TenantTypeWithIDAfterToORM called after default ToORM code
+type TenantTypeWithIDWithAfterToORM interface {
+	AfterToORM(context.Context, *TenantTypeWithIDORM) error
+}
+
+// TenantTypeWithIDBeforeToPB called before default ToPB code
+type TenantTypeWithIDWithBeforeToPB interface {
+	BeforeToPB(context.Context, *TenantTypeWithID) error
+}
+
+// TenantTypeWithIDAfterToPB called after default ToPB code
+type TenantTypeWithIDWithAfterToPB interface {
+	AfterToPB(context.Context, *TenantTypeWithID) error
+}
+
 type PrimaryUUIDTypeORM struct {
 	Child *ExternalChildORM `gorm:"foreignkey:PrimaryUUIDTypeId;association_foreignkey:Id"`
 	Id    *go_uuid.UUID     `gorm:"type:uuid"`
@@ -555,6 +806,17 @@ func (m *PrimaryUUIDTypeORM) ToPB(ctx context.Context) (PrimaryUUIDType, error)
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *PrimaryUUIDType) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	v.Nest("child", m.Child.Validate())
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type PrimaryUUIDType the arg will be the target, the caller the one being converted from
 
@@ -636,6 +898,17 @@ func (m *PrimaryStringTypeORM) ToPB(ctx context.Context) (PrimaryStringType, err
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *PrimaryStringType) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	v.Nest("child", m.Child.Validate())
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type PrimaryStringType the arg will be the target, the caller the one being converted from
 
@@ -717,6 +990,17 @@ func (m *TestTagORM) ToPB(ctx context.Context) (TestTag, error) {
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *TestTag) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	v.Nest("testTagAssoc", m.TestTagAssoc.Validate())
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type TestTag the arg will be the target, the caller the one being converted from
 
@@ -806,6 +1090,19 @@ func (m *TestAssocHandlerDefaultORM) ToPB(ctx context.Context) (TestAssocHandler
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *TestAssocHandlerDefault) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	for i, e := range m.TestTagAssoc {
+		v.Nest(fmt.Sprintf("testTagAssoc[%d]", i), e.Validate())
+	}
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type TestAssocHandlerDefault the arg will be the target, the caller the one being converted from
 
@@ -895,6 +1192,19 @@ func (m *TestAssocHandlerReplaceORM) ToPB(ctx context.Context) (TestAssocHandler
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *TestAssocHandlerReplace) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	for i, e := range m.TestTagAssoc {
+		v.Nest(fmt.Sprintf("testTagAssoc[%d]", i), e.Validate())
+	}
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type TestAssocHandlerReplace the arg will be the target, the caller the one being converted from
 
@@ -984,6 +1294,19 @@ func (m *TestAssocHandlerClearORM) ToPB(ctx context.Context) (TestAssocHandlerCl
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *TestAssocHandlerClear) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	for i, e := range m.TestTagAssoc {
+		v.Nest(fmt.Sprintf("testTagAssoc[%d]", i), e.Validate())
+	}
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type TestAssocHandlerClear the arg will be the target, the caller the one being converted from
 
@@ -1073,6 +1396,19 @@ func (m *TestAssocHandlerAppendORM) ToPB(ctx context.Context) (TestAssocHandlerA
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *TestAssocHandlerAppend) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	for i, e := range m.TestTagAssoc {
+		v.Nest(fmt.Sprintf("testTagAssoc[%d]", i), e.Validate())
+	}
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type TestAssocHandlerAppend the arg will be the target, the caller the one being converted from
 
@@ -1144,6 +1480,16 @@ func (m *TestTagAssociationORM) ToPB(ctx context.Context) (TestTagAssociation, e
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *TestTagAssociation) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type TestTagAssociation the arg will be the target, the caller the one being converted from
 
@@ -1223,6 +1569,17 @@ func (m *PrimaryIncludedORM) ToPB(ctx context.Context) (PrimaryIncluded, error)
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *PrimaryIncluded) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	v.Nest("child", m.Child.Validate())
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type PrimaryIncluded the arg will be the target, the caller the one being converted from
 
@@ -1246,11 +1603,116 @@ type PrimaryIncludedWithAfterToPB interface {
 	AfterToPB(context.Context, *PrimaryIncluded) error
 }
 
+type TagConstraintsORM struct {
+	Id       uint64
+	Nickname *string `gorm:"size:8;not null"`
+	Price    float64 `gorm:"type:numeric(8,2)"`
+	Status   string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (TagConstraintsORM) TableName() string {
+	return "tag_constraints"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *TagConstraints) ToORM(ctx context.Context) (TagConstraintsORM, error) {
+	to := TagConstraintsORM{}
+	var err error
+	if prehook, ok := interface{}(m).(TagConstraintsWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.Nickname != nil {
+		v := m.Nickname.Value
+		to.Nickname = &v
+	}
+	to.Price = m.Price
+	to.Status = TestTypesStatus_name[int32(m.Status)]
+	if posthook, ok := interface{}(m).(TagConstraintsWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *TagConstraintsORM) ToPB(ctx context.Context) (TagConstraints, error) {
+	to := TagConstraints{}
+	var err error
+	if prehook, ok := interface{}(m).(TagConstraintsWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.Nickname != nil {
+		to.Nickname = &wrapperspb.StringValue{Value: *m.Nickname}
+	}
+	to.Price = m.Price
+	to.Status = TestTypesStatus(TestTypesStatus_value[m.Status])
+	if posthook, ok := interface{}(m).(TagConstraintsWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *TagConstraints) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	if m.Nickname == nil {
+		v.Add("nickname", "is required")
+	}
+	if utf8.RuneCountInString(m.Nickname.GetValue()) > 8 {
+		v.Add("nickname", "must be at most 8 characters")
+	}
+	if math.Abs(float64(m.Price)) >= 1e6 {
+		v.Add("price", "must have at most 6 digits before the decimal point")
+	}
+	if m.Status.Descriptor().Values().ByNumber(m.Status.Number()) == nil {
+		v.Add("status", "is not a valid status")
+	}
+	return v.Err()
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type TagConstraints the arg will be the target, the caller the one being converted from
+
+// TagConstraintsBeforeToORM called before default ToORM code
+type TagConstraintsWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TagConstraintsORM) error
+}
+
+// TagConstraintsAfterToORM called after default ToORM code
+type TagConstraintsWithAfterToORM interface {
+	AfterToORM(context.Context, *TagConstraintsORM) error
+}
+
+// TagConstraintsBeforeToPB called before default ToPB code
+type TagConstraintsWithBeforeToPB interface {
+	BeforeToPB(context.Context, *TagConstraints) error
+}
+
+// TagConstraintsAfterToPB called after default ToPB code
+type TagConstraintsWithAfterToPB interface {
+	AfterToPB(context.Context, *TagConstraints) error
+}
+
 // DefaultCreateTestTypes executes a basic gorm create call
 func DefaultCreateTestTypes(ctx context.Context, in *TestTypes, db *gorm.DB) (*TestTypes, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
@@ -1279,6 +1741,75 @@ type TestTypesORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateTestTypesSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateTestTypesSet(ctx context.Context, in []*TestTypes, db *gorm.DB, batchSize int) ([]*TestTypes, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*TestTypesORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&TestTypesORM{})).(TestTypesORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(TestTypesORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(TestTypesORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&TestTypesORM{})).(TestTypesORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*TestTypes, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type TestTypesORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*TestTypesORM, *gorm.DB) (*gorm.DB, error)
+}
+type TestTypesORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*TestTypesORM, *gorm.DB) error
+}
+
 // DefaultApplyFieldMaskTestTypes patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskTestTypes(ctx context.Context, patchee *TestTypes, patcher *TestTypes, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestTypes, error) {
 	if patcher == nil {
@@ -1413,7 +1944,7 @@ func DefaultListTestTypes(ctx context.Context, db *gorm.DB) ([]*TestTypes, error
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestTypesORM{}, &TestTypes{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &TestTypesORM{}, preload.NewConverter(&TestTypes{}), nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1453,39 +1984,313 @@ type TestTypesORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]TestTypesORM) error
 }
 
-// DefaultCreateTypeWithID executes a basic gorm create call
-func DefaultCreateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
+// DefaultCountTestTypes returns the number of rows DefaultListTestTypes pages through
+func DefaultCountTestTypes(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := TestTypes{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(TestTypesORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+	if hook, ok := interface{}(&ormObj).(TestTypesORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(TestTypesORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
 		}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	return paging.Count(db.Where(&ormObj), &TestTypesORM{}, strategy)
 }
 
-type TypeWithIDORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+type TestTypesORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// TestTypesRepository runs the Default operations of TestTypes, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type TestTypesRepository interface {
+	Create(ctx context.Context, in *TestTypes) (*TestTypes, error)
+	CreateSet(ctx context.Context, in []*TestTypes, batchSize int) ([]*TestTypes, error)
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TestTypes, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+}
+
+// GormTestTypesRepository is the TestTypesRepository calling the Default handlers with DB
+type GormTestTypesRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormTestTypesRepository returns the TestTypesRepository running the operations with db
+func NewGormTestTypesRepository(db *gorm.DB) *GormTestTypesRepository {
+	return &GormTestTypesRepository{DB: db}
+}
+
+func (r *GormTestTypesRepository) Create(ctx context.Context, in *TestTypes) (*TestTypes, error) {
+	return DefaultCreateTestTypes(ctx, in, r.DB)
+}
+
+func (r *GormTestTypesRepository) CreateSet(ctx context.Context, in []*TestTypes, batchSize int) ([]*TestTypes, error) {
+	return DefaultCreateTestTypesSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormTestTypesRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TestTypes, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestTypes doesn't support filtering")
+	}
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestTypes doesn't support sorting")
+	}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestTypes doesn't support pagination")
+	}
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestTypes doesn't support field selection")
+	}
+	return DefaultListTestTypes(ctx, r.DB)
+}
+
+func (r *GormTestTypesRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of TestTypes doesn't support filtering")
+	}
+	return DefaultCountTestTypes(ctx, r.DB, strategy)
+}
+
+// MemoryTestTypesRepository is the TestTypesRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryTestTypesRepository struct {
+	table memory.Table
+}
+
+// NewMemoryTestTypesRepository returns an empty MemoryTestTypesRepository
+func NewMemoryTestTypesRepository() *MemoryTestTypesRepository {
+	return &MemoryTestTypesRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryTestTypesRepository) row(ctx context.Context, in, stored *TestTypes) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: nil, Key: nil, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryTestTypesRepository) tenant(ctx context.Context) (interface{}, error) {
+	_, err := (&TestTypes{}).ToORM(ctx)
+	return nil, err
+}
+
+func (r *MemoryTestTypesRepository) Create(ctx context.Context, in *TestTypes) (*TestTypes, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*TestTypes), nil
+}
+
+func (r *MemoryTestTypesRepository) CreateSet(ctx context.Context, in []*TestTypes, batchSize int) ([]*TestTypes, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*TestTypes, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*TestTypes))
+	}
+	if err := r.table.Insert(rows...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *MemoryTestTypesRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TestTypes, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*TestTypes, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*TestTypes))
+	}
+	return out, nil
+}
+
+func (r *MemoryTestTypesRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+// TestTypesFixtureOption customizes the object returned by NewTestTypesFixture
+type TestTypesFixtureOption func(*TestTypes)
+
+// NewTestTypesFixture returns a TestTypes with random valid values in its fields and
+// its required associations, customized by opts
+func NewTestTypesFixture(opts ...TestTypesFixtureOption) *TestTypes {
+	m := &TestTypes{}
+	m.OptionalString = &wrapperspb.StringValue{Value: fixture.String(0)}
+	m.BecomesInt = TestTypesStatus(fixture.Enum(TestTypesStatus(0).Descriptor()))
+	m.Uuid = &types.UUID{Value: fixture.UUID()}
+	m.CreatedAt = timestamppb.New(fixture.Time())
+	m.TypeWithIdId = uint32(fixture.Uint(1000000))
+	m.JsonField = &types.JSONValue{Value: fixture.JSON()}
+	m.NullableUuid = &types.UUIDValue{Value: fixture.UUID()}
+	m.TimeOnly = &types.TimeOnly{Value: fixture.TimeOnly()}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CreateTestTypesFixture creates the object returned by NewTestTypesFixture and its
+// associations with DefaultCreateTestTypes
+func CreateTestTypesFixture(ctx context.Context, db *gorm.DB, opts ...TestTypesFixtureOption) (*TestTypes, error) {
+	return DefaultCreateTestTypes(ctx, NewTestTypesFixture(opts...), db)
+}
+
+// DefaultCreateTypeWithID executes a basic gorm create call
+func DefaultCreateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TypeWithIDORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 type TypeWithIDORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateTypeWithIDSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateTypeWithIDSet(ctx context.Context, in []*TypeWithID, db *gorm.DB, batchSize int) ([]*TypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*TypeWithIDORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&TypeWithIDORM{})).(TypeWithIDORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(TypeWithIDORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(TypeWithIDORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&TypeWithIDORM{})).(TypeWithIDORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*TypeWithID, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type TypeWithIDORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*TypeWithIDORM, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*TypeWithIDORM, *gorm.DB) error
+}
+
 func DefaultReadTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
@@ -1502,7 +2307,7 @@ func DefaultReadTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*T
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TypeWithIDORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelectionEx(ctx, db, nil, &TypeWithIDORM{}, preload.NewConverter(&TypeWithID{})); err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeReadFind); ok {
@@ -1609,6 +2414,9 @@ func DefaultStrictUpdateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm
 	if in == nil {
 		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTypeWithID")
 	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
@@ -1968,7 +2776,7 @@ func DefaultListTypeWithID(ctx context.Context, db *gorm.DB) ([]*TypeWithID, err
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TypeWithIDORM{}, &TypeWithID{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &TypeWithIDORM{}, preload.NewConverter(&TypeWithID{}), nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2009,377 +2817,520 @@ type TypeWithIDORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]TypeWithIDORM) error
 }
 
-// DefaultCreateMultiaccountTypeWithID executes a basic gorm create call
-func DefaultCreateMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
+// DefaultCountTypeWithID returns the number of rows DefaultListTypeWithID pages through
+func DefaultCountTypeWithID(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := TypeWithID{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
 		}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	return paging.Count(db.Where(&ormObj), &TypeWithIDORM{}, strategy)
 }
 
-type MultiaccountTypeWithIDORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+type TypeWithIDORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+
+// TypeWithIDConflictTargets maps the conflict targets accepted by DefaultUpsertTypeWithID to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var TypeWithIDConflictTargets = map[string][]string{
+	"id": {"id"},
 }
 
-func DefaultReadMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+// DefaultUpsertTypeWithID inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertTypeWithID(ctx context.Context, in *TypeWithID, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*TypeWithID, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
+	if err := in.Validate(); err != nil {
 		return nil, err
 	}
-	if ormObj.Id == 0 {
-		return nil, errors.EmptyIdError
+	conflict := insert.OnConflict{
+		Columns:   TypeWithIDConflictTargets[target],
+		UpdateAll: updateMask == nil,
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeReadApplyQuery); ok {
-		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for TypeWithID", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertTypeWithIDColumns(updateMask)
+		if err != nil {
 			return nil, err
 		}
+		conflict.Update = columns
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &MultiaccountTypeWithIDORM{}); err != nil {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeReadFind); ok {
-		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := MultiaccountTypeWithIDORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(MultiaccountTypeWithIDORMWithAfterReadFind); ok {
-		if err = hook.AfterReadFind(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := ormResponse.ToPB(ctx)
+	pbResponse, err := ormObj.ToPB(ctx)
 	return &pbResponse, err
 }
 
-type MultiaccountTypeWithIDORMWithBeforeReadApplyQuery interface {
-	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+type TypeWithIDORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDORMWithBeforeReadFind interface {
-	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+type TypeWithIDORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
 }
-type MultiaccountTypeWithIDORMWithAfterReadFind interface {
-	AfterReadFind(context.Context, *gorm.DB) error
+
+// upsertTypeWithIDColumns returns the columns of the fields of updateMask the upserts of
+// TypeWithID overwrite, the immutable fields and the paths of no column being rejected
+func upsertTypeWithIDColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		case "Ip":
+			columns = append(columns, "ip_addr")
+		case "Address":
+			columns = append(columns, "address")
+		case "TagTest":
+			columns = append(columns, "tag_test")
+		case "TagSizeTest":
+			columns = append(columns, "tag_size_test")
+		case "FloatField":
+			columns = append(columns, "float_field")
+		case "DoubleField":
+			columns = append(columns, "double_field")
+		case "TimeOnly":
+			columns = append(columns, "time_only")
+		case "DeletedAt":
+			columns = append(columns, "deleted_at")
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
+		}
+	}
+	return columns, nil
 }
 
-func DefaultDeleteMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
+// TypeWithIDRepository runs the Default operations of TypeWithID, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type TypeWithIDRepository interface {
+	Create(ctx context.Context, in *TypeWithID) (*TypeWithID, error)
+	CreateSet(ctx context.Context, in []*TypeWithID, batchSize int) ([]*TypeWithID, error)
+	Read(ctx context.Context, in *TypeWithID, fs *query.FieldSelection) (*TypeWithID, error)
+	StrictUpdate(ctx context.Context, in *TypeWithID) (*TypeWithID, error)
+	Patch(ctx context.Context, in *TypeWithID, updateMask *field_mask.FieldMask) (*TypeWithID, error)
+	PatchSet(ctx context.Context, objects []*TypeWithID, updateMasks []*field_mask.FieldMask) ([]*TypeWithID, error)
+	Delete(ctx context.Context, in *TypeWithID) error
+	DeleteSet(ctx context.Context, in []*TypeWithID) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TypeWithID, error)
+	PageToken(ctx context.Context, last *TypeWithID, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *TypeWithID, target string, updateMask *field_mask.FieldMask) (*TypeWithID, error)
+}
+
+// GormTypeWithIDRepository is the TypeWithIDRepository calling the Default handlers with DB
+type GormTypeWithIDRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormTypeWithIDRepository returns the TypeWithIDRepository running the operations with db
+func NewGormTypeWithIDRepository(db *gorm.DB) *GormTypeWithIDRepository {
+	return &GormTypeWithIDRepository{DB: db}
+}
+
+func (r *GormTypeWithIDRepository) Create(ctx context.Context, in *TypeWithID) (*TypeWithID, error) {
+	return DefaultCreateTypeWithID(ctx, in, r.DB)
+}
+
+func (r *GormTypeWithIDRepository) CreateSet(ctx context.Context, in []*TypeWithID, batchSize int) ([]*TypeWithID, error) {
+	return DefaultCreateTypeWithIDSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormTypeWithIDRepository) Read(ctx context.Context, in *TypeWithID, fs *query.FieldSelection) (*TypeWithID, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of TypeWithID doesn't support field selection")
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return err
+	return DefaultReadTypeWithID(ctx, in, r.DB)
+}
+
+func (r *GormTypeWithIDRepository) StrictUpdate(ctx context.Context, in *TypeWithID) (*TypeWithID, error) {
+	return DefaultStrictUpdateTypeWithID(ctx, in, r.DB)
+}
+
+func (r *GormTypeWithIDRepository) Patch(ctx context.Context, in *TypeWithID, updateMask *field_mask.FieldMask) (*TypeWithID, error) {
+	return DefaultPatchTypeWithID(ctx, in, updateMask, r.DB)
+}
+
+func (r *GormTypeWithIDRepository) PatchSet(ctx context.Context, objects []*TypeWithID, updateMasks []*field_mask.FieldMask) ([]*TypeWithID, error) {
+	return DefaultPatchSetTypeWithID(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormTypeWithIDRepository) Delete(ctx context.Context, in *TypeWithID) error {
+	return DefaultDeleteTypeWithID(ctx, in, r.DB)
+}
+
+func (r *GormTypeWithIDRepository) DeleteSet(ctx context.Context, in []*TypeWithID) error {
+	return DefaultDeleteTypeWithIDSet(ctx, in, r.DB)
+}
+
+func (r *GormTypeWithIDRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TypeWithID, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TypeWithID doesn't support filtering")
 	}
-	if ormObj.Id == 0 {
-		return errors.EmptyIdError
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TypeWithID doesn't support sorting")
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeDelete_); ok {
-		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
-			return err
-		}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TypeWithID doesn't support pagination")
 	}
-	err = db.Where(&ormObj).Delete(&MultiaccountTypeWithIDORM{}).Error
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TypeWithID doesn't support field selection")
+	}
+	return DefaultListTypeWithID(ctx, r.DB)
+}
+
+func (r *GormTypeWithIDRepository) PageToken(ctx context.Context, last *TypeWithID, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
 	if err != nil {
-		return err
+		return "", err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterDelete_); ok {
-		err = hook.AfterDelete_(ctx, db)
+	keyset, err := paging.NewKeyset(r.DB, &TypeWithIDORM{}, s, f)
+	if err != nil {
+		return "", err
 	}
-	return err
+	return keyset.Token(&ormObj)
 }
 
-type MultiaccountTypeWithIDORMWithBeforeDelete_ interface {
-	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+func (r *GormTypeWithIDRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of TypeWithID doesn't support filtering")
+	}
+	return DefaultCountTypeWithID(ctx, r.DB, strategy)
 }
-type MultiaccountTypeWithIDORMWithAfterDelete_ interface {
-	AfterDelete_(context.Context, *gorm.DB) error
+
+func (r *GormTypeWithIDRepository) Upsert(ctx context.Context, in *TypeWithID, target string, updateMask *field_mask.FieldMask) (*TypeWithID, error) {
+	return DefaultUpsertTypeWithID(ctx, in, target, updateMask, r.DB)
 }
 
-func DefaultDeleteMultiaccountTypeWithIDSet(ctx context.Context, in []*MultiaccountTypeWithID, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
-	var err error
-	keys := []uint64{}
-	for _, obj := range in {
-		ormObj, err := obj.ToORM(ctx)
-		if err != nil {
-			return err
-		}
-		if ormObj.Id == 0 {
-			return errors.EmptyIdError
-		}
-		keys = append(keys, ormObj.Id)
-	}
-	if hook, ok := (interface{}(&MultiaccountTypeWithIDORM{})).(MultiaccountTypeWithIDORMWithBeforeDeleteSet); ok {
-		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
-			return err
-		}
-	}
-	acctId, err := auth.GetAccountID(ctx, nil)
+// MemoryTypeWithIDRepository is the TypeWithIDRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryTypeWithIDRepository struct {
+	table memory.Table
+}
+
+// NewMemoryTypeWithIDRepository returns an empty MemoryTypeWithIDRepository
+func NewMemoryTypeWithIDRepository() *MemoryTypeWithIDRepository {
+	return &MemoryTypeWithIDRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryTypeWithIDRepository) row(ctx context.Context, in, stored *TypeWithID) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return err
+		return memory.Row{}, err
 	}
-	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&MultiaccountTypeWithIDORM{}).Error
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
 	if err != nil {
-		return err
-	}
-	if hook, ok := (interface{}(&MultiaccountTypeWithIDORM{})).(MultiaccountTypeWithIDORMWithAfterDeleteSet); ok {
-		err = hook.AfterDeleteSet(ctx, in, db)
+		return memory.Row{}, err
 	}
-	return err
+	return memory.Row{Tenant: nil, Key: ormObj.Id, Object: &out}, nil
 }
 
-type MultiaccountTypeWithIDORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*MultiaccountTypeWithID, *gorm.DB) (*gorm.DB, error)
-}
-type MultiaccountTypeWithIDORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*MultiaccountTypeWithID, *gorm.DB) error
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryTypeWithIDRepository) tenant(ctx context.Context) (interface{}, error) {
+	_, err := (&TypeWithID{}).ToORM(ctx)
+	return nil, err
 }
 
-// DefaultStrictUpdateMultiaccountTypeWithID clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+func (r *MemoryTypeWithIDRepository) Create(ctx context.Context, in *TypeWithID) (*TypeWithID, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateMultiaccountTypeWithID")
+		return nil, errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
+	if err := in.Validate(); err != nil {
 		return nil, err
 	}
-	accountID, err := auth.GetAccountID(ctx, nil)
+	row, err := r.row(ctx, in, nil)
 	if err != nil {
 		return nil, err
 	}
-	db = db.Where(map[string]interface{}{"account_id": accountID})
-	var count int64
-	lockedRow := &MultiaccountTypeWithIDORM{}
-	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeStrictUpdateCleanup); ok {
-		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*TypeWithID), nil
+}
+
+func (r *MemoryTypeWithIDRepository) CreateSet(ctx context.Context, in []*TypeWithID, batchSize int) ([]*TypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*TypeWithID, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
 			return nil, err
 		}
-	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeStrictUpdateSave); ok {
-		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
 			return nil, err
 		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*TypeWithID))
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	if err := r.table.Insert(rows...); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterStrictUpdateSave); ok {
-		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
-			return nil, err
-		}
+	return out, nil
+}
+
+func (r *MemoryTypeWithIDRepository) Read(ctx context.Context, in *TypeWithID, fs *query.FieldSelection) (*TypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
+	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if count == 0 {
-		err = gateway.SetCreated(ctx, "")
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
 	}
-	return &pbResponse, err
-}
-
-type MultiaccountTypeWithIDORMWithBeforeStrictUpdateCleanup interface {
-	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type MultiaccountTypeWithIDORMWithBeforeStrictUpdateSave interface {
-	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type MultiaccountTypeWithIDORMWithAfterStrictUpdateSave interface {
-	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+	out, ok := r.table.Get(nil, ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*TypeWithID), nil
 }
 
-// DefaultPatchMultiaccountTypeWithID executes a basic gorm update call with patch behavior
-func DefaultPatchMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, updateMask *field_mask.FieldMask, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+func (r *MemoryTypeWithIDRepository) StrictUpdate(ctx context.Context, in *TypeWithID) (*TypeWithID, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj MultiaccountTypeWithID
-	var err error
-	if hook, ok := interface{}(&pbObj).(MultiaccountTypeWithIDWithBeforePatchRead); ok {
-		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	if err := in.Validate(); err != nil {
+		return nil, err
 	}
-	pbReadRes, err := DefaultReadMultiaccountTypeWithID(ctx, &MultiaccountTypeWithID{Id: in.GetId()}, db)
+	row, err := r.row(ctx, in, nil)
 	if err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(MultiaccountTypeWithIDWithBeforePatchApplyFieldMask); ok {
-		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	if _, err := DefaultApplyFieldMaskMultiaccountTypeWithID(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err = r.table.Save(row); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(MultiaccountTypeWithIDWithBeforePatchSave); ok {
-		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	return row.Object.(*TypeWithID), nil
+}
+
+func (r *MemoryTypeWithIDRepository) Patch(ctx context.Context, in *TypeWithID, updateMask *field_mask.FieldMask) (*TypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
 	}
-	pbResponse, err := DefaultStrictUpdateMultiaccountTypeWithID(ctx, &pbObj, db)
+	patchee, err := r.Read(ctx, &TypeWithID{Id: in.GetId()}, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(MultiaccountTypeWithIDWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	if patchee, err = DefaultApplyFieldMaskTypeWithID(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
 	}
-	return pbResponse, nil
-}
-
-type MultiaccountTypeWithIDWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type MultiaccountTypeWithIDWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type MultiaccountTypeWithIDWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type MultiaccountTypeWithIDWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) error
+	return r.StrictUpdate(ctx, patchee)
 }
 
-// DefaultPatchSetMultiaccountTypeWithID executes a bulk gorm update call with patch behavior
-func DefaultPatchSetMultiaccountTypeWithID(ctx context.Context, objects []*MultiaccountTypeWithID, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*MultiaccountTypeWithID, error) {
+func (r *MemoryTypeWithIDRepository) PatchSet(ctx context.Context, objects []*TypeWithID, updateMasks []*field_mask.FieldMask) ([]*TypeWithID, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*MultiaccountTypeWithID, 0, len(objects))
+	out := make([]*TypeWithID, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchMultiaccountTypeWithID(ctx, patcher, updateMasks[i], db)
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
+		out = append(out, patched)
 	}
+	return out, nil
+}
 
-	return results, nil
+func (r *MemoryTypeWithIDRepository) Delete(ctx context.Context, in *TypeWithID) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(nil, ormObj.Id)
+	return nil
 }
 
-// DefaultApplyFieldMaskMultiaccountTypeWithID patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskMultiaccountTypeWithID(ctx context.Context, patchee *MultiaccountTypeWithID, patcher *MultiaccountTypeWithID, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*MultiaccountTypeWithID, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
+func (r *MemoryTypeWithIDRepository) DeleteSet(ctx context.Context, in []*TypeWithID) error {
+	if in == nil {
+		return errors.NilArgumentError
 	}
-	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
 		}
-		if f == prefix+"SomeField" {
-			patchee.SomeField = patcher.SomeField
-			continue
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
 		}
+		keys = append(keys, ormObj.Id)
 	}
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryTypeWithIDRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TypeWithID, error) {
+	tenant, err := r.tenant(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return patchee, nil
-}
-
-// DefaultListMultiaccountTypeWithID executes a gorm list call
-func DefaultListMultiaccountTypeWithID(ctx context.Context, db *gorm.DB) ([]*MultiaccountTypeWithID, error) {
-	in := MultiaccountTypeWithID{}
-	ormObj, err := in.ToORM(ctx)
+	objects, err := r.table.List(tenant, f, s, p)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
+	out := make([]*TypeWithID, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*TypeWithID))
+	}
+	return out, nil
+}
+
+func (r *MemoryTypeWithIDRepository) PageToken(ctx context.Context, last *TypeWithID, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &MultiaccountTypeWithIDORM{}, &MultiaccountTypeWithID{}, nil, nil, nil, nil)
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryTypeWithIDRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
 	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryTypeWithIDRepository) Upsert(ctx context.Context, in *TypeWithID, target string, updateMask *field_mask.FieldMask) (*TypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := TypeWithIDConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for TypeWithID", target)
+	}
+	if _, err := upsertTypeWithIDColumns(updateMask); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
-			return nil, err
-		}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []MultiaccountTypeWithIDORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
+	found, ok, err := r.table.Conflicting(nil, func(m proto.Message) bool {
+		other, err := m.(*TypeWithID).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
-			return nil, err
-		}
+	if !ok {
+		return r.Create(ctx, in)
 	}
-	pbResponse := []*MultiaccountTypeWithID{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
+	existing := found.(*TypeWithID)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskTypeWithID(ctx, existing, in, updateMask, "", nil)
 		if err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
+		return r.StrictUpdate(ctx, patched)
 	}
-	return pbResponse, nil
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*TypeWithID)
+	updated.Id = existing.Id
+	return r.StrictUpdate(ctx, updated)
 }
 
-type MultiaccountTypeWithIDORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+// TypeWithIDFixtureOption customizes the object returned by NewTypeWithIDFixture
+type TypeWithIDFixtureOption func(*TypeWithID)
+
+// NewTypeWithIDFixture returns a TypeWithID with random valid values in its fields and
+// its required associations, customized by opts
+func NewTypeWithIDFixture(opts ...TypeWithIDFixtureOption) *TypeWithID {
+	m := &TypeWithID{}
+	m.Ip = fixture.String(0)
+	m.Point = NewIntPointFixture()
+	m.User = user.NewUserFixture()
+	m.Address = &types.InetValue{Value: fixture.CIDR()}
+	m.TagTest = float32(fixture.Float(1000000))
+	m.TagSizeTest = fixture.String(512)
+	m.FloatField = &wrapperspb.FloatValue{Value: float32(fixture.Float(1000000))}
+	m.DoubleField = &wrapperspb.DoubleValue{Value: fixture.Float(1000000)}
+	m.TimeOnly = &types.TimeOnly{Value: fixture.TimeOnly()}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
-type MultiaccountTypeWithIDORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+
+// CreateTypeWithIDFixture creates the object returned by NewTypeWithIDFixture and its
+// associations with DefaultCreateTypeWithID
+func CreateTypeWithIDFixture(ctx context.Context, db *gorm.DB, opts ...TypeWithIDFixtureOption) (*TypeWithID, error) {
+	return DefaultCreateTypeWithID(ctx, NewTypeWithIDFixture(opts...), db)
 }
-type MultiaccountTypeWithIDORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]MultiaccountTypeWithIDORM) error
+
+// DefaultCreateMultiaccountTypeWithID executes a basic gorm create call
+func DefaultCreateMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+	var r0 *MultiaccountTypeWithID
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		r0, err = defaultCreateMultiaccountTypeWithIDInTransaction(ctx, in, tx)
+		return err
+	})
+	return r0, err
 }
 
-// DefaultCreateMultiaccountTypeWithoutID executes a basic gorm create call
-func DefaultCreateMultiaccountTypeWithoutID(ctx context.Context, in *MultiaccountTypeWithoutID, db *gorm.DB) (*MultiaccountTypeWithoutID, error) {
+func defaultCreateMultiaccountTypeWithIDInTransaction(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2387,7 +3338,10 @@ func DefaultCreateMultiaccountTypeWithoutID(ctx context.Context, in *Multiaccoun
 	if err = db.Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithAfterCreate_); ok {
+	if err = recordMultiaccountTypeWithIDHistory(ctx, db, audit.Create, nil, &ormObj); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2396,119 +3350,86 @@ func DefaultCreateMultiaccountTypeWithoutID(ctx context.Context, in *Multiaccoun
 	return &pbResponse, err
 }
 
-type MultiaccountTypeWithoutIDORMWithBeforeCreate_ interface {
+type MultiaccountTypeWithIDORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithoutIDORMWithAfterCreate_ interface {
+type MultiaccountTypeWithIDORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-// DefaultApplyFieldMaskMultiaccountTypeWithoutID patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskMultiaccountTypeWithoutID(ctx context.Context, patchee *MultiaccountTypeWithoutID, patcher *MultiaccountTypeWithoutID, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*MultiaccountTypeWithoutID, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
+// DefaultCreateMultiaccountTypeWithIDSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateMultiaccountTypeWithIDSet(ctx context.Context, in []*MultiaccountTypeWithID, db *gorm.DB, batchSize int) ([]*MultiaccountTypeWithID, error) {
+	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"SomeField" {
-			patchee.SomeField = patcher.SomeField
-			continue
+	ormObjs := make([]*MultiaccountTypeWithIDORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
 		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return patchee, nil
-}
-
-// DefaultListMultiaccountTypeWithoutID executes a gorm list call
-func DefaultListMultiaccountTypeWithoutID(ctx context.Context, db *gorm.DB) ([]*MultiaccountTypeWithoutID, error) {
-	in := MultiaccountTypeWithoutID{}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+		if err := obj.Validate(); err != nil {
 			return nil, err
 		}
-	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &MultiaccountTypeWithoutIDORM{}, &MultiaccountTypeWithoutID{}, nil, nil, nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
 			return nil, err
 		}
+		ormObjs = append(ormObjs, &ormObj)
 	}
-	db = db.Where(&ormObj)
-	ormResponse := []MultiaccountTypeWithoutIDORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
-			return nil, err
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&MultiaccountTypeWithIDORM{})).(MultiaccountTypeWithIDORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
 		}
-	}
-	pbResponse := []*MultiaccountTypeWithoutID{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
-		if err != nil {
-			return nil, err
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(MultiaccountTypeWithIDORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
 		}
-		pbResponse = append(pbResponse, &temp)
-	}
-	return pbResponse, nil
-}
-
-type MultiaccountTypeWithoutIDORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type MultiaccountTypeWithoutIDORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type MultiaccountTypeWithoutIDORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]MultiaccountTypeWithoutIDORM) error
-}
-
-// DefaultCreatePrimaryUUIDType executes a basic gorm create call
-func DefaultCreatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
 		}
-	}
-	if err = db.Create(&ormObj).Error; err != nil {
+		for _, ormObj := range ormObjs {
+			if err = recordMultiaccountTypeWithIDHistory(ctx, tx, audit.Create, nil, ormObj); err != nil {
+				return err
+			}
+			if hook, ok := interface{}(ormObj).(MultiaccountTypeWithIDORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&MultiaccountTypeWithIDORM{})).(MultiaccountTypeWithIDORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
+	pbResponse := make([]*MultiaccountTypeWithID, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
 			return nil, err
 		}
+		pbResponse = append(pbResponse, &pbObj)
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	return pbResponse, nil
 }
 
-type PrimaryUUIDTypeORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+type MultiaccountTypeWithIDORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*MultiaccountTypeWithIDORM, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+type MultiaccountTypeWithIDORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*MultiaccountTypeWithIDORM, *gorm.DB) error
 }
 
-func DefaultReadPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
+func DefaultReadMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2516,27 +3437,27 @@ func DefaultReadPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *go
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &PrimaryUUIDTypeORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelectionEx(ctx, db, nil, &MultiaccountTypeWithIDORM{}, preload.NewConverter(&MultiaccountTypeWithID{})); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := PrimaryUUIDTypeORM{}
+	ormResponse := MultiaccountTypeWithIDORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(PrimaryUUIDTypeORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(MultiaccountTypeWithIDORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2545,17 +3466,23 @@ func DefaultReadPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *go
 	return &pbResponse, err
 }
 
-type PrimaryUUIDTypeORMWithBeforeReadApplyQuery interface {
+type MultiaccountTypeWithIDORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithBeforeReadFind interface {
+type MultiaccountTypeWithIDORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithAfterReadFind interface {
+type MultiaccountTypeWithIDORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeletePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) error {
+func DefaultDeleteMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) error {
+	return transaction.Run(db, func(tx *gorm.DB) error {
+		return defaultDeleteMultiaccountTypeWithIDInTransaction(ctx, in, tx)
+	})
+}
+
+func defaultDeleteMultiaccountTypeWithIDInTransaction(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -2563,96 +3490,137 @@ func DefaultDeletePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&PrimaryUUIDTypeORM{}).Error
+	deleted := []*MultiaccountTypeWithIDORM{}
+	if err = db.Set("gorm:query_option", "FOR UPDATE").Where(&ormObj).Find(&deleted).Error; err != nil {
+		return err
+	}
+	err = db.Where(&ormObj).Delete(&MultiaccountTypeWithIDORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterDelete_); ok {
+	for _, deletedObj := range deleted {
+		if err = recordMultiaccountTypeWithIDHistory(ctx, db, audit.Delete, deletedObj, nil); err != nil {
+			return err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type PrimaryUUIDTypeORMWithBeforeDelete_ interface {
+type MultiaccountTypeWithIDORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithAfterDelete_ interface {
+type MultiaccountTypeWithIDORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeletePrimaryUUIDTypeSet(ctx context.Context, in []*PrimaryUUIDType, db *gorm.DB) error {
+func DefaultDeleteMultiaccountTypeWithIDSet(ctx context.Context, in []*MultiaccountTypeWithID, db *gorm.DB) error {
+	return transaction.Run(db, func(tx *gorm.DB) error {
+		return defaultDeleteMultiaccountTypeWithIDSetInTransaction(ctx, in, tx)
+	})
+}
+
+func defaultDeleteMultiaccountTypeWithIDSetInTransaction(ctx context.Context, in []*MultiaccountTypeWithID, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []*go_uuid.UUID{}
+	keys := []uint64{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+		if ormObj.Id == 0 {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&PrimaryUUIDTypeORM{})).(PrimaryUUIDTypeORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&MultiaccountTypeWithIDORM{})).(MultiaccountTypeWithIDORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&PrimaryUUIDTypeORM{}).Error
+	tenantID, isolated, err := tenant.ID(ctx, "MultiaccountTypeWithID")
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&PrimaryUUIDTypeORM{})).(PrimaryUUIDTypeORMWithAfterDeleteSet); ok {
+	if isolated {
+		db = db.Where("account_id = ?", tenantID)
+	}
+	deleted := []*MultiaccountTypeWithIDORM{}
+	if err = db.Set("gorm:query_option", "FOR UPDATE").Where("id in (?)", keys).Find(&deleted).Error; err != nil {
+		return err
+	}
+	err = db.Where("id in (?)", keys).Delete(&MultiaccountTypeWithIDORM{}).Error
+	if err != nil {
+		return err
+	}
+	for _, deletedObj := range deleted {
+		if err = recordMultiaccountTypeWithIDHistory(ctx, db, audit.Delete, deletedObj, nil); err != nil {
+			return err
+		}
+	}
+	if hook, ok := (interface{}(&MultiaccountTypeWithIDORM{})).(MultiaccountTypeWithIDORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type PrimaryUUIDTypeORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*PrimaryUUIDType, *gorm.DB) (*gorm.DB, error)
+type MultiaccountTypeWithIDORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*MultiaccountTypeWithID, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*PrimaryUUIDType, *gorm.DB) error
+type MultiaccountTypeWithIDORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*MultiaccountTypeWithID, *gorm.DB) error
 }
 
-// DefaultStrictUpdatePrimaryUUIDType clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
+// DefaultStrictUpdateMultiaccountTypeWithID clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+	var r0 *MultiaccountTypeWithID
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		r0, err = defaultStrictUpdateMultiaccountTypeWithIDInTransaction(ctx, in, tx)
+		return err
+	})
+	return r0, err
+}
+
+func defaultStrictUpdateMultiaccountTypeWithIDInTransaction(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryUUIDType")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateMultiaccountTypeWithID")
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
+	tenantID, isolated, err := tenant.ID(ctx, "MultiaccountTypeWithID")
+	if err != nil {
+		return nil, err
+	}
+	if isolated {
+		db = db.Where(map[string]interface{}{"account_id": tenantID})
+	}
 	var count int64
-	lockedRow := &PrimaryUUIDTypeORM{}
+	lockedRow := &MultiaccountTypeWithIDORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	filterChild := ExternalChildORM{}
-	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
-		return nil, errors.EmptyIdError
-	}
-	filterChild.PrimaryUUIDTypeId = new(go_uuid.UUID)
-	*filterChild.PrimaryUUIDTypeId = *ormObj.Id
-	if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2660,7 +3628,16 @@ func DefaultStrictUpdatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType
 	if err = db.Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterStrictUpdateSave); ok {
+	if count > 0 {
+		if err = recordMultiaccountTypeWithIDHistory(ctx, db, audit.Update, lockedRow, &ormObj); err != nil {
+			return nil, err
+		}
+	} else {
+		if err = recordMultiaccountTypeWithIDHistory(ctx, db, audit.Create, nil, &ormObj); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2675,51 +3652,60 @@ func DefaultStrictUpdatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType
 	return &pbResponse, err
 }
 
-type PrimaryUUIDTypeORMWithBeforeStrictUpdateCleanup interface {
+type MultiaccountTypeWithIDORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithBeforeStrictUpdateSave interface {
+type MultiaccountTypeWithIDORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithAfterStrictUpdateSave interface {
+type MultiaccountTypeWithIDORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchPrimaryUUIDType executes a basic gorm update call with patch behavior
-func DefaultPatchPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryUUIDType, error) {
+// DefaultPatchMultiaccountTypeWithID executes a basic gorm update call with patch behavior
+func DefaultPatchMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, updateMask *field_mask.FieldMask, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+	var r0 *MultiaccountTypeWithID
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		r0, err = defaultPatchMultiaccountTypeWithIDInTransaction(ctx, in, updateMask, tx)
+		return err
+	})
+	return r0, err
+}
+
+func defaultPatchMultiaccountTypeWithIDInTransaction(ctx context.Context, in *MultiaccountTypeWithID, updateMask *field_mask.FieldMask, db *gorm.DB) (*MultiaccountTypeWithID, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj PrimaryUUIDType
+	var pbObj MultiaccountTypeWithID
 	var err error
-	if hook, ok := interface{}(&pbObj).(PrimaryUUIDTypeWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(MultiaccountTypeWithIDWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadPrimaryUUIDType(ctx, &PrimaryUUIDType{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadMultiaccountTypeWithID(ctx, &MultiaccountTypeWithID{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(PrimaryUUIDTypeWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(MultiaccountTypeWithIDWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskPrimaryUUIDType(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskMultiaccountTypeWithID(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(PrimaryUUIDTypeWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(MultiaccountTypeWithIDWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdatePrimaryUUIDType(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateMultiaccountTypeWithID(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(PrimaryUUIDTypeWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(MultiaccountTypeWithIDWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -2727,28 +3713,28 @@ func DefaultPatchPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, updat
 	return pbResponse, nil
 }
 
-type PrimaryUUIDTypeWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type MultiaccountTypeWithIDWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type MultiaccountTypeWithIDWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type MultiaccountTypeWithIDWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) error
+type MultiaccountTypeWithIDWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetPrimaryUUIDType executes a bulk gorm update call with patch behavior
-func DefaultPatchSetPrimaryUUIDType(ctx context.Context, objects []*PrimaryUUIDType, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PrimaryUUIDType, error) {
+// DefaultPatchSetMultiaccountTypeWithID executes a bulk gorm update call with patch behavior
+func DefaultPatchSetMultiaccountTypeWithID(ctx context.Context, objects []*MultiaccountTypeWithID, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*MultiaccountTypeWithID, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*PrimaryUUIDType, 0, len(objects))
+	results := make([]*MultiaccountTypeWithID, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchPrimaryUUIDType(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchMultiaccountTypeWithID(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -2759,39 +3745,47 @@ func DefaultPatchSetPrimaryUUIDType(ctx context.Context, objects []*PrimaryUUIDT
 	return results, nil
 }
 
-// DefaultApplyFieldMaskPrimaryUUIDType patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskPrimaryUUIDType(ctx context.Context, patchee *PrimaryUUIDType, patcher *PrimaryUUIDType, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PrimaryUUIDType, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
+// DefaultListMultiaccountTypeWithIDHistory returns the history of the object, oldest change first
+func DefaultListMultiaccountTypeWithIDHistory(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) ([]*MultiaccountTypeWithIDHistoryORM, error) {
+	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var err error
-	var updatedChild bool
-	for i, f := range updateMask.Paths {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	tenantID, isolated, err := tenant.ID(ctx, "MultiaccountTypeWithID")
+	if err != nil {
+		return nil, err
+	}
+	if isolated {
+		db = db.Where(map[string]interface{}{"account_id": tenantID})
+	}
+	history := []*MultiaccountTypeWithIDHistoryORM{}
+	if err := db.Where("object_id = ?", ormObj.Id).Order("changed_at, id").Find(&history).Error; err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// DefaultApplyFieldMaskMultiaccountTypeWithID patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskMultiaccountTypeWithID(ctx context.Context, patchee *MultiaccountTypeWithID, patcher *MultiaccountTypeWithID, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
 		if f == prefix+"Id" {
 			patchee.Id = patcher.Id
 			continue
 		}
-		if !updatedChild && strings.HasPrefix(f, prefix+"Child.") {
-			updatedChild = true
-			if patcher.Child == nil {
-				patchee.Child = nil
-				continue
-			}
-			if patchee.Child == nil {
-				patchee.Child = &ExternalChild{}
-			}
-			if o, err := DefaultApplyFieldMaskExternalChild(ctx, patchee.Child, patcher.Child, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Child.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.Child = o
-			}
-			continue
-		}
-		if f == prefix+"Child" {
-			updatedChild = true
-			patchee.Child = patcher.Child
+		if f == prefix+"SomeField" {
+			patchee.SomeField = patcher.SomeField
 			continue
 		}
 	}
@@ -2801,39 +3795,39 @@ func DefaultApplyFieldMaskPrimaryUUIDType(ctx context.Context, patchee *PrimaryU
 	return patchee, nil
 }
 
-// DefaultListPrimaryUUIDType executes a gorm list call
-func DefaultListPrimaryUUIDType(ctx context.Context, db *gorm.DB) ([]*PrimaryUUIDType, error) {
-	in := PrimaryUUIDType{}
+// DefaultListMultiaccountTypeWithID executes a gorm list call
+func DefaultListMultiaccountTypeWithID(ctx context.Context, db *gorm.DB) ([]*MultiaccountTypeWithID, error) {
+	in := MultiaccountTypeWithID{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PrimaryUUIDTypeORM{}, &PrimaryUUIDType{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &MultiaccountTypeWithIDORM{}, preload.NewConverter(&MultiaccountTypeWithID{}), nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []PrimaryUUIDTypeORM{}
+	ormResponse := []MultiaccountTypeWithIDORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*PrimaryUUIDType{}
+	pbResponse := []*MultiaccountTypeWithID{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -2844,405 +3838,540 @@ func DefaultListPrimaryUUIDType(ctx context.Context, db *gorm.DB) ([]*PrimaryUUI
 	return pbResponse, nil
 }
 
-type PrimaryUUIDTypeORMWithBeforeListApplyQuery interface {
+type MultiaccountTypeWithIDORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithBeforeListFind interface {
+type MultiaccountTypeWithIDORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]PrimaryUUIDTypeORM) error
+type MultiaccountTypeWithIDORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]MultiaccountTypeWithIDORM) error
 }
 
-// DefaultCreatePrimaryStringType executes a basic gorm create call
-func DefaultCreatePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
+// DefaultCountMultiaccountTypeWithID returns the number of rows DefaultListMultiaccountTypeWithID pages through
+func DefaultCountMultiaccountTypeWithID(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := MultiaccountTypeWithID{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
 		}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	return paging.Count(db.Where(&ormObj), &MultiaccountTypeWithIDORM{}, strategy)
 }
 
-type PrimaryStringTypeORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+type MultiaccountTypeWithIDORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+
+// MultiaccountTypeWithIDConflictTargets maps the conflict targets accepted by DefaultUpsertMultiaccountTypeWithID to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var MultiaccountTypeWithIDConflictTargets = map[string][]string{
+	"id": {"id"},
 }
 
-func DefaultReadPrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
+// DefaultUpsertMultiaccountTypeWithID inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+	var r0 *MultiaccountTypeWithID
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		r0, err = defaultUpsertMultiaccountTypeWithIDInTransaction(ctx, in, target, updateMask, tx)
+		return err
+	})
+	return r0, err
+}
+
+func defaultUpsertMultiaccountTypeWithIDInTransaction(ctx context.Context, in *MultiaccountTypeWithID, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*MultiaccountTypeWithID, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
+	if err := in.Validate(); err != nil {
 		return nil, err
 	}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
+	conflict := insert.OnConflict{
+		Columns:   MultiaccountTypeWithIDConflictTargets[target],
+		UpdateAll: updateMask == nil,
+		Scope:     "account_id",
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeReadApplyQuery); ok {
-		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for MultiaccountTypeWithID", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertMultiaccountTypeWithIDColumns(updateMask)
+		if err != nil {
 			return nil, err
 		}
+		conflict.Update = columns
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &PrimaryStringTypeORM{}); err != nil {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeReadFind); ok {
-		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := PrimaryStringTypeORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	lockedRow := &MultiaccountTypeWithIDORM{}
+	locked, err := insert.LockConflicting(db, &ormObj, conflict.Columns, lockedRow)
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(PrimaryStringTypeORMWithAfterReadFind); ok {
-		if err = hook.AfterReadFind(ctx, db); err != nil {
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
+		return nil, err
+	}
+	if locked {
+		// the update mask and the kept columns leave stored values in the row
+		storedRow := &MultiaccountTypeWithIDORM{}
+		if err = db.Where("id = ?", ormObj.Id).First(storedRow).Error; err != nil {
+			return nil, err
+		}
+		if err = recordMultiaccountTypeWithIDHistory(ctx, db, audit.Update, lockedRow, storedRow); err != nil {
+			return nil, err
+		}
+	} else {
+		if err = recordMultiaccountTypeWithIDHistory(ctx, db, audit.Create, nil, &ormObj); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := ormResponse.ToPB(ctx)
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
 	return &pbResponse, err
 }
 
-type PrimaryStringTypeORMWithBeforeReadApplyQuery interface {
-	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+type MultiaccountTypeWithIDORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeORMWithBeforeReadFind interface {
-	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryStringTypeORMWithAfterReadFind interface {
-	AfterReadFind(context.Context, *gorm.DB) error
+type MultiaccountTypeWithIDORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
 }
 
-func DefaultDeletePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return err
-	}
-	if ormObj.Id == "" {
-		return errors.EmptyIdError
-	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeDelete_); ok {
-		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
-			return err
+// upsertMultiaccountTypeWithIDColumns returns the columns of the fields of updateMask the upserts of
+// MultiaccountTypeWithID overwrite, the immutable fields and the paths of no column being rejected
+func upsertMultiaccountTypeWithIDColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		case "SomeField":
+			columns = append(columns, "some_field")
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
 		}
 	}
-	err = db.Where(&ormObj).Delete(&PrimaryStringTypeORM{}).Error
-	if err != nil {
-		return err
-	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterDelete_); ok {
-		err = hook.AfterDelete_(ctx, db)
+	return columns, nil
+}
+
+// MultiaccountTypeWithIDRepository runs the Default operations of MultiaccountTypeWithID, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type MultiaccountTypeWithIDRepository interface {
+	Create(ctx context.Context, in *MultiaccountTypeWithID) (*MultiaccountTypeWithID, error)
+	CreateSet(ctx context.Context, in []*MultiaccountTypeWithID, batchSize int) ([]*MultiaccountTypeWithID, error)
+	Read(ctx context.Context, in *MultiaccountTypeWithID, fs *query.FieldSelection) (*MultiaccountTypeWithID, error)
+	StrictUpdate(ctx context.Context, in *MultiaccountTypeWithID) (*MultiaccountTypeWithID, error)
+	Patch(ctx context.Context, in *MultiaccountTypeWithID, updateMask *field_mask.FieldMask) (*MultiaccountTypeWithID, error)
+	PatchSet(ctx context.Context, objects []*MultiaccountTypeWithID, updateMasks []*field_mask.FieldMask) ([]*MultiaccountTypeWithID, error)
+	Delete(ctx context.Context, in *MultiaccountTypeWithID) error
+	DeleteSet(ctx context.Context, in []*MultiaccountTypeWithID) error
+	ListHistory(ctx context.Context, in *MultiaccountTypeWithID) ([]*MultiaccountTypeWithIDHistoryORM, error)
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*MultiaccountTypeWithID, error)
+	PageToken(ctx context.Context, last *MultiaccountTypeWithID, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *MultiaccountTypeWithID, target string, updateMask *field_mask.FieldMask) (*MultiaccountTypeWithID, error)
+}
+
+// GormMultiaccountTypeWithIDRepository is the MultiaccountTypeWithIDRepository calling the Default handlers with DB
+type GormMultiaccountTypeWithIDRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormMultiaccountTypeWithIDRepository returns the MultiaccountTypeWithIDRepository running the operations with db
+func NewGormMultiaccountTypeWithIDRepository(db *gorm.DB) *GormMultiaccountTypeWithIDRepository {
+	return &GormMultiaccountTypeWithIDRepository{DB: db}
+}
+
+func (r *GormMultiaccountTypeWithIDRepository) Create(ctx context.Context, in *MultiaccountTypeWithID) (*MultiaccountTypeWithID, error) {
+	return DefaultCreateMultiaccountTypeWithID(ctx, in, r.DB)
+}
+
+func (r *GormMultiaccountTypeWithIDRepository) CreateSet(ctx context.Context, in []*MultiaccountTypeWithID, batchSize int) ([]*MultiaccountTypeWithID, error) {
+	return DefaultCreateMultiaccountTypeWithIDSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormMultiaccountTypeWithIDRepository) Read(ctx context.Context, in *MultiaccountTypeWithID, fs *query.FieldSelection) (*MultiaccountTypeWithID, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of MultiaccountTypeWithID doesn't support field selection")
 	}
-	return err
+	return DefaultReadMultiaccountTypeWithID(ctx, in, r.DB)
 }
 
-type PrimaryStringTypeORMWithBeforeDelete_ interface {
-	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+func (r *GormMultiaccountTypeWithIDRepository) StrictUpdate(ctx context.Context, in *MultiaccountTypeWithID) (*MultiaccountTypeWithID, error) {
+	return DefaultStrictUpdateMultiaccountTypeWithID(ctx, in, r.DB)
 }
-type PrimaryStringTypeORMWithAfterDelete_ interface {
-	AfterDelete_(context.Context, *gorm.DB) error
+
+func (r *GormMultiaccountTypeWithIDRepository) Patch(ctx context.Context, in *MultiaccountTypeWithID, updateMask *field_mask.FieldMask) (*MultiaccountTypeWithID, error) {
+	return DefaultPatchMultiaccountTypeWithID(ctx, in, updateMask, r.DB)
 }
 
-func DefaultDeletePrimaryStringTypeSet(ctx context.Context, in []*PrimaryStringType, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
+func (r *GormMultiaccountTypeWithIDRepository) PatchSet(ctx context.Context, objects []*MultiaccountTypeWithID, updateMasks []*field_mask.FieldMask) ([]*MultiaccountTypeWithID, error) {
+	return DefaultPatchSetMultiaccountTypeWithID(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormMultiaccountTypeWithIDRepository) Delete(ctx context.Context, in *MultiaccountTypeWithID) error {
+	return DefaultDeleteMultiaccountTypeWithID(ctx, in, r.DB)
+}
+
+func (r *GormMultiaccountTypeWithIDRepository) DeleteSet(ctx context.Context, in []*MultiaccountTypeWithID) error {
+	return DefaultDeleteMultiaccountTypeWithIDSet(ctx, in, r.DB)
+}
+
+func (r *GormMultiaccountTypeWithIDRepository) ListHistory(ctx context.Context, in *MultiaccountTypeWithID) ([]*MultiaccountTypeWithIDHistoryORM, error) {
+	return DefaultListMultiaccountTypeWithIDHistory(ctx, in, r.DB)
+}
+
+func (r *GormMultiaccountTypeWithIDRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*MultiaccountTypeWithID, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of MultiaccountTypeWithID doesn't support filtering")
 	}
-	var err error
-	keys := []string{}
-	for _, obj := range in {
-		ormObj, err := obj.ToORM(ctx)
-		if err != nil {
-			return err
-		}
-		if ormObj.Id == "" {
-			return errors.EmptyIdError
-		}
-		keys = append(keys, ormObj.Id)
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of MultiaccountTypeWithID doesn't support sorting")
 	}
-	if hook, ok := (interface{}(&PrimaryStringTypeORM{})).(PrimaryStringTypeORMWithBeforeDeleteSet); ok {
-		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
-			return err
-		}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of MultiaccountTypeWithID doesn't support pagination")
 	}
-	err = db.Where("id in (?)", keys).Delete(&PrimaryStringTypeORM{}).Error
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of MultiaccountTypeWithID doesn't support field selection")
+	}
+	return DefaultListMultiaccountTypeWithID(ctx, r.DB)
+}
+
+func (r *GormMultiaccountTypeWithIDRepository) PageToken(ctx context.Context, last *MultiaccountTypeWithID, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
 	if err != nil {
-		return err
+		return "", err
 	}
-	if hook, ok := (interface{}(&PrimaryStringTypeORM{})).(PrimaryStringTypeORMWithAfterDeleteSet); ok {
-		err = hook.AfterDeleteSet(ctx, in, db)
+	keyset, err := paging.NewKeyset(r.DB, &MultiaccountTypeWithIDORM{}, s, f)
+	if err != nil {
+		return "", err
 	}
-	return err
+	return keyset.Token(&ormObj)
 }
 
-type PrimaryStringTypeORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*PrimaryStringType, *gorm.DB) (*gorm.DB, error)
+func (r *GormMultiaccountTypeWithIDRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of MultiaccountTypeWithID doesn't support filtering")
+	}
+	return DefaultCountMultiaccountTypeWithID(ctx, r.DB, strategy)
 }
-type PrimaryStringTypeORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*PrimaryStringType, *gorm.DB) error
+
+func (r *GormMultiaccountTypeWithIDRepository) Upsert(ctx context.Context, in *MultiaccountTypeWithID, target string, updateMask *field_mask.FieldMask) (*MultiaccountTypeWithID, error) {
+	return DefaultUpsertMultiaccountTypeWithID(ctx, in, target, updateMask, r.DB)
 }
 
-// DefaultStrictUpdatePrimaryStringType clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdatePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
-	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryStringType")
-	}
+// MemoryMultiaccountTypeWithIDRepository is the MultiaccountTypeWithIDRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryMultiaccountTypeWithIDRepository struct {
+	table memory.Table
+}
+
+// NewMemoryMultiaccountTypeWithIDRepository returns an empty MemoryMultiaccountTypeWithIDRepository
+func NewMemoryMultiaccountTypeWithIDRepository() *MemoryMultiaccountTypeWithIDRepository {
+	return &MemoryMultiaccountTypeWithIDRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryMultiaccountTypeWithIDRepository) row(ctx context.Context, in, stored *MultiaccountTypeWithID) (memory.Row, error) {
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return nil, err
+		return memory.Row{}, err
 	}
-	var count int64
-	lockedRow := &PrimaryStringTypeORM{}
-	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeStrictUpdateCleanup); ok {
-		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
-			return nil, err
-		}
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
 	}
-	filterChild := ExternalChildORM{}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
+	return memory.Row{Tenant: memory.Tenant(ormObj.AccountID), Key: ormObj.Id, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryMultiaccountTypeWithIDRepository) tenant(ctx context.Context) (interface{}, error) {
+	ormObj, err := (&MultiaccountTypeWithID{}).ToORM(ctx)
+	if err != nil {
+		return nil, err
 	}
-	filterChild.PrimaryStringTypeId = new(string)
-	*filterChild.PrimaryStringTypeId = ormObj.Id
-	if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
+	return memory.Tenant(ormObj.AccountID), nil
+}
+
+func (r *MemoryMultiaccountTypeWithIDRepository) Create(ctx context.Context, in *MultiaccountTypeWithID) (*MultiaccountTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeStrictUpdateSave); ok {
-		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
-			return nil, err
-		}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	if err = r.table.Insert(row); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterStrictUpdateSave); ok {
-		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+	return row.Object.(*MultiaccountTypeWithID), nil
+}
+
+func (r *MemoryMultiaccountTypeWithIDRepository) CreateSet(ctx context.Context, in []*MultiaccountTypeWithID, batchSize int) ([]*MultiaccountTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*MultiaccountTypeWithID, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
 			return nil, err
 		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*MultiaccountTypeWithID))
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	if err != nil {
+	if err := r.table.Insert(rows...); err != nil {
 		return nil, err
 	}
-	if count == 0 {
-		err = gateway.SetCreated(ctx, "")
-	}
-	return &pbResponse, err
+	return out, nil
 }
 
-type PrimaryStringTypeORMWithBeforeStrictUpdateCleanup interface {
-	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryStringTypeORMWithBeforeStrictUpdateSave interface {
-	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryStringTypeORMWithAfterStrictUpdateSave interface {
-	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+func (r *MemoryMultiaccountTypeWithIDRepository) Read(ctx context.Context, in *MultiaccountTypeWithID, fs *query.FieldSelection) (*MultiaccountTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	out, ok := r.table.Get(memory.Tenant(ormObj.AccountID), ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*MultiaccountTypeWithID), nil
 }
 
-// DefaultPatchPrimaryStringType executes a basic gorm update call with patch behavior
-func DefaultPatchPrimaryStringType(ctx context.Context, in *PrimaryStringType, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryStringType, error) {
+func (r *MemoryMultiaccountTypeWithIDRepository) StrictUpdate(ctx context.Context, in *MultiaccountTypeWithID) (*MultiaccountTypeWithID, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj PrimaryStringType
-	var err error
-	if hook, ok := interface{}(&pbObj).(PrimaryStringTypeWithBeforePatchRead); ok {
-		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	if err := in.Validate(); err != nil {
+		return nil, err
 	}
-	pbReadRes, err := DefaultReadPrimaryStringType(ctx, &PrimaryStringType{Id: in.GetId()}, db)
+	row, err := r.row(ctx, in, nil)
 	if err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(PrimaryStringTypeWithBeforePatchApplyFieldMask); ok {
-		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	if _, err := DefaultApplyFieldMaskPrimaryStringType(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err = r.table.Save(row); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(PrimaryStringTypeWithBeforePatchSave); ok {
-		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	return row.Object.(*MultiaccountTypeWithID), nil
+}
+
+func (r *MemoryMultiaccountTypeWithIDRepository) Patch(ctx context.Context, in *MultiaccountTypeWithID, updateMask *field_mask.FieldMask) (*MultiaccountTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
 	}
-	pbResponse, err := DefaultStrictUpdatePrimaryStringType(ctx, &pbObj, db)
+	patchee, err := r.Read(ctx, &MultiaccountTypeWithID{Id: in.GetId()}, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(PrimaryStringTypeWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	if patchee, err = DefaultApplyFieldMaskMultiaccountTypeWithID(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
 	}
-	return pbResponse, nil
-}
-
-type PrimaryStringTypeWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryStringTypeWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryStringTypeWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryStringTypeWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) error
+	return r.StrictUpdate(ctx, patchee)
 }
 
-// DefaultPatchSetPrimaryStringType executes a bulk gorm update call with patch behavior
-func DefaultPatchSetPrimaryStringType(ctx context.Context, objects []*PrimaryStringType, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PrimaryStringType, error) {
+func (r *MemoryMultiaccountTypeWithIDRepository) PatchSet(ctx context.Context, objects []*MultiaccountTypeWithID, updateMasks []*field_mask.FieldMask) ([]*MultiaccountTypeWithID, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*PrimaryStringType, 0, len(objects))
+	out := make([]*MultiaccountTypeWithID, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchPrimaryStringType(ctx, patcher, updateMasks[i], db)
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
+		out = append(out, patched)
 	}
+	return out, nil
+}
 
-	return results, nil
+func (r *MemoryMultiaccountTypeWithIDRepository) Delete(ctx context.Context, in *MultiaccountTypeWithID) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(memory.Tenant(ormObj.AccountID), ormObj.Id)
+	return nil
 }
 
-// DefaultApplyFieldMaskPrimaryStringType patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskPrimaryStringType(ctx context.Context, patchee *PrimaryStringType, patcher *PrimaryStringType, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PrimaryStringType, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
+func (r *MemoryMultiaccountTypeWithIDRepository) DeleteSet(ctx context.Context, in []*MultiaccountTypeWithID) error {
+	if in == nil {
+		return errors.NilArgumentError
 	}
-	var err error
-	var updatedChild bool
-	for i, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
-		}
-		if !updatedChild && strings.HasPrefix(f, prefix+"Child.") {
-			updatedChild = true
-			if patcher.Child == nil {
-				patchee.Child = nil
-				continue
-			}
-			if patchee.Child == nil {
-				patchee.Child = &ExternalChild{}
-			}
-			if o, err := DefaultApplyFieldMaskExternalChild(ctx, patchee.Child, patcher.Child, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Child.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.Child = o
-			}
-			continue
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
 		}
-		if f == prefix+"Child" {
-			updatedChild = true
-			patchee.Child = patcher.Child
-			continue
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
 		}
+		keys = append(keys, ormObj.Id)
+	}
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryMultiaccountTypeWithIDRepository) ListHistory(ctx context.Context, in *MultiaccountTypeWithID) ([]*MultiaccountTypeWithIDHistoryORM, error) {
+	return nil, status.Error(codes.Unimplemented, "the history of MultiaccountTypeWithID isn't kept in memory")
+}
+
+func (r *MemoryMultiaccountTypeWithIDRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*MultiaccountTypeWithID, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
 	}
+	objects, err := r.table.List(tenant, f, s, p)
 	if err != nil {
 		return nil, err
 	}
-	return patchee, nil
+	out := make([]*MultiaccountTypeWithID, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*MultiaccountTypeWithID))
+	}
+	return out, nil
 }
 
-// DefaultListPrimaryStringType executes a gorm list call
-func DefaultListPrimaryStringType(ctx context.Context, db *gorm.DB) ([]*PrimaryStringType, error) {
-	in := PrimaryStringType{}
-	ormObj, err := in.ToORM(ctx)
+func (r *MemoryMultiaccountTypeWithIDRepository) PageToken(ctx context.Context, last *MultiaccountTypeWithID, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
 	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
+		return "", err
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PrimaryStringTypeORM{}, &PrimaryStringType{}, nil, nil, nil, nil)
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryMultiaccountTypeWithIDRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
 	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryMultiaccountTypeWithIDRepository) Upsert(ctx context.Context, in *MultiaccountTypeWithID, target string, updateMask *field_mask.FieldMask) (*MultiaccountTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := MultiaccountTypeWithIDConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for MultiaccountTypeWithID", target)
+	}
+	if _, err := upsertMultiaccountTypeWithIDColumns(updateMask); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
-			return nil, err
-		}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []PrimaryStringTypeORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
+	found, ok, err := r.table.Conflicting(memory.Tenant(ormObj.AccountID), func(m proto.Message) bool {
+		other, err := m.(*MultiaccountTypeWithID).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
-			return nil, err
-		}
+	if !ok {
+		return r.Create(ctx, in)
 	}
-	pbResponse := []*PrimaryStringType{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
+	existing := found.(*MultiaccountTypeWithID)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskMultiaccountTypeWithID(ctx, existing, in, updateMask, "", nil)
 		if err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
+		return r.StrictUpdate(ctx, patched)
 	}
-	return pbResponse, nil
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*MultiaccountTypeWithID)
+	updated.Id = existing.Id
+	return r.StrictUpdate(ctx, updated)
 }
 
-type PrimaryStringTypeORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryStringTypeORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+// MultiaccountTypeWithIDFixtureOption customizes the object returned by NewMultiaccountTypeWithIDFixture
+type MultiaccountTypeWithIDFixtureOption func(*MultiaccountTypeWithID)
+
+// NewMultiaccountTypeWithIDFixture returns a MultiaccountTypeWithID with random valid values in its fields and
+// its required associations, customized by opts
+func NewMultiaccountTypeWithIDFixture(opts ...MultiaccountTypeWithIDFixtureOption) *MultiaccountTypeWithID {
+	m := &MultiaccountTypeWithID{}
+	m.SomeField = fixture.String(0)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
-type PrimaryStringTypeORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]PrimaryStringTypeORM) error
+
+// CreateMultiaccountTypeWithIDFixture creates the object returned by NewMultiaccountTypeWithIDFixture and its
+// associations with DefaultCreateMultiaccountTypeWithID
+func CreateMultiaccountTypeWithIDFixture(ctx context.Context, db *gorm.DB, opts ...MultiaccountTypeWithIDFixtureOption) (*MultiaccountTypeWithID, error) {
+	return DefaultCreateMultiaccountTypeWithID(ctx, NewMultiaccountTypeWithIDFixture(opts...), db)
 }
 
-// DefaultCreateTestTag executes a basic gorm create call
-func DefaultCreateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
+// DefaultCreateMultiaccountTypeWithoutID executes a basic gorm create call
+func DefaultCreateMultiaccountTypeWithoutID(ctx context.Context, in *MultiaccountTypeWithoutID, db *gorm.DB) (*MultiaccountTypeWithoutID, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3250,7 +4379,7 @@ func DefaultCreateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestT
 	if err = db.Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3259,369 +4388,7741 @@ func DefaultCreateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestT
 	return &pbResponse, err
 }
 
-type TestTagORMWithBeforeCreate_ interface {
+type MultiaccountTypeWithoutIDORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagORMWithAfterCreate_ interface {
+type MultiaccountTypeWithoutIDORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
+// DefaultCreateMultiaccountTypeWithoutIDSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateMultiaccountTypeWithoutIDSet(ctx context.Context, in []*MultiaccountTypeWithoutID, db *gorm.DB, batchSize int) ([]*MultiaccountTypeWithoutID, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
-	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeReadApplyQuery); ok {
-		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+	ormObjs := make([]*MultiaccountTypeWithoutIDORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
 			return nil, err
 		}
-	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TestTagORM{}); err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeReadFind); ok {
-		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
 			return nil, err
 		}
+		ormObjs = append(ormObjs, &ormObj)
 	}
-	ormResponse := TestTagORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&MultiaccountTypeWithoutIDORM{})).(MultiaccountTypeWithoutIDORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(MultiaccountTypeWithoutIDORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(MultiaccountTypeWithoutIDORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&MultiaccountTypeWithoutIDORM{})).(MultiaccountTypeWithoutIDORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(TestTagORMWithAfterReadFind); ok {
-		if err = hook.AfterReadFind(ctx, db); err != nil {
+	pbResponse := make([]*MultiaccountTypeWithoutID, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
 			return nil, err
 		}
+		pbResponse = append(pbResponse, &pbObj)
 	}
-	pbResponse, err := ormResponse.ToPB(ctx)
-	return &pbResponse, err
+	return pbResponse, nil
 }
 
-type TestTagORMWithBeforeReadApplyQuery interface {
-	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+type MultiaccountTypeWithoutIDORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*MultiaccountTypeWithoutIDORM, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagORMWithBeforeReadFind interface {
-	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+type MultiaccountTypeWithoutIDORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*MultiaccountTypeWithoutIDORM, *gorm.DB) error
 }
-type TestTagORMWithAfterReadFind interface {
-	AfterReadFind(context.Context, *gorm.DB) error
+
+// DefaultApplyFieldMaskMultiaccountTypeWithoutID patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskMultiaccountTypeWithoutID(ctx context.Context, patchee *MultiaccountTypeWithoutID, patcher *MultiaccountTypeWithoutID, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*MultiaccountTypeWithoutID, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"SomeField" {
+			patchee.SomeField = patcher.SomeField
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
 }
 
-func DefaultDeleteTestTag(ctx context.Context, in *TestTag, db *gorm.DB) error {
+// DefaultListMultiaccountTypeWithoutID executes a gorm list call
+func DefaultListMultiaccountTypeWithoutID(ctx context.Context, db *gorm.DB) ([]*MultiaccountTypeWithoutID, error) {
+	in := MultiaccountTypeWithoutID{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &MultiaccountTypeWithoutIDORM{}, preload.NewConverter(&MultiaccountTypeWithoutID{}), nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	ormResponse := []MultiaccountTypeWithoutIDORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*MultiaccountTypeWithoutID{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type MultiaccountTypeWithoutIDORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithoutIDORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithoutIDORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]MultiaccountTypeWithoutIDORM) error
+}
+
+// DefaultCountMultiaccountTypeWithoutID returns the number of rows DefaultListMultiaccountTypeWithoutID pages through
+func DefaultCountMultiaccountTypeWithoutID(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := MultiaccountTypeWithoutID{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	return paging.Count(db.Where(&ormObj), &MultiaccountTypeWithoutIDORM{}, strategy)
+}
+
+type MultiaccountTypeWithoutIDORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// MultiaccountTypeWithoutIDRepository runs the Default operations of MultiaccountTypeWithoutID, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type MultiaccountTypeWithoutIDRepository interface {
+	Create(ctx context.Context, in *MultiaccountTypeWithoutID) (*MultiaccountTypeWithoutID, error)
+	CreateSet(ctx context.Context, in []*MultiaccountTypeWithoutID, batchSize int) ([]*MultiaccountTypeWithoutID, error)
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*MultiaccountTypeWithoutID, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+}
+
+// GormMultiaccountTypeWithoutIDRepository is the MultiaccountTypeWithoutIDRepository calling the Default handlers with DB
+type GormMultiaccountTypeWithoutIDRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormMultiaccountTypeWithoutIDRepository returns the MultiaccountTypeWithoutIDRepository running the operations with db
+func NewGormMultiaccountTypeWithoutIDRepository(db *gorm.DB) *GormMultiaccountTypeWithoutIDRepository {
+	return &GormMultiaccountTypeWithoutIDRepository{DB: db}
+}
+
+func (r *GormMultiaccountTypeWithoutIDRepository) Create(ctx context.Context, in *MultiaccountTypeWithoutID) (*MultiaccountTypeWithoutID, error) {
+	return DefaultCreateMultiaccountTypeWithoutID(ctx, in, r.DB)
+}
+
+func (r *GormMultiaccountTypeWithoutIDRepository) CreateSet(ctx context.Context, in []*MultiaccountTypeWithoutID, batchSize int) ([]*MultiaccountTypeWithoutID, error) {
+	return DefaultCreateMultiaccountTypeWithoutIDSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormMultiaccountTypeWithoutIDRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*MultiaccountTypeWithoutID, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of MultiaccountTypeWithoutID doesn't support filtering")
+	}
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of MultiaccountTypeWithoutID doesn't support sorting")
+	}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of MultiaccountTypeWithoutID doesn't support pagination")
+	}
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of MultiaccountTypeWithoutID doesn't support field selection")
+	}
+	return DefaultListMultiaccountTypeWithoutID(ctx, r.DB)
+}
+
+func (r *GormMultiaccountTypeWithoutIDRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of MultiaccountTypeWithoutID doesn't support filtering")
+	}
+	return DefaultCountMultiaccountTypeWithoutID(ctx, r.DB, strategy)
+}
+
+// MemoryMultiaccountTypeWithoutIDRepository is the MultiaccountTypeWithoutIDRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryMultiaccountTypeWithoutIDRepository struct {
+	table memory.Table
+}
+
+// NewMemoryMultiaccountTypeWithoutIDRepository returns an empty MemoryMultiaccountTypeWithoutIDRepository
+func NewMemoryMultiaccountTypeWithoutIDRepository() *MemoryMultiaccountTypeWithoutIDRepository {
+	return &MemoryMultiaccountTypeWithoutIDRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryMultiaccountTypeWithoutIDRepository) row(ctx context.Context, in, stored *MultiaccountTypeWithoutID) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: memory.Tenant(ormObj.AccountID), Key: nil, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryMultiaccountTypeWithoutIDRepository) tenant(ctx context.Context) (interface{}, error) {
+	ormObj, err := (&MultiaccountTypeWithoutID{}).ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return memory.Tenant(ormObj.AccountID), nil
+}
+
+func (r *MemoryMultiaccountTypeWithoutIDRepository) Create(ctx context.Context, in *MultiaccountTypeWithoutID) (*MultiaccountTypeWithoutID, error) {
 	if in == nil {
-		return errors.NilArgumentError
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*MultiaccountTypeWithoutID), nil
+}
+
+func (r *MemoryMultiaccountTypeWithoutIDRepository) CreateSet(ctx context.Context, in []*MultiaccountTypeWithoutID, batchSize int) ([]*MultiaccountTypeWithoutID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*MultiaccountTypeWithoutID, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*MultiaccountTypeWithoutID))
+	}
+	if err := r.table.Insert(rows...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *MemoryMultiaccountTypeWithoutIDRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*MultiaccountTypeWithoutID, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*MultiaccountTypeWithoutID, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*MultiaccountTypeWithoutID))
+	}
+	return out, nil
+}
+
+func (r *MemoryMultiaccountTypeWithoutIDRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+// MultiaccountTypeWithoutIDFixtureOption customizes the object returned by NewMultiaccountTypeWithoutIDFixture
+type MultiaccountTypeWithoutIDFixtureOption func(*MultiaccountTypeWithoutID)
+
+// NewMultiaccountTypeWithoutIDFixture returns a MultiaccountTypeWithoutID with random valid values in its fields and
+// its required associations, customized by opts
+func NewMultiaccountTypeWithoutIDFixture(opts ...MultiaccountTypeWithoutIDFixtureOption) *MultiaccountTypeWithoutID {
+	m := &MultiaccountTypeWithoutID{}
+	m.SomeField = fixture.String(0)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CreateMultiaccountTypeWithoutIDFixture creates the object returned by NewMultiaccountTypeWithoutIDFixture and its
+// associations with DefaultCreateMultiaccountTypeWithoutID
+func CreateMultiaccountTypeWithoutIDFixture(ctx context.Context, db *gorm.DB, opts ...MultiaccountTypeWithoutIDFixtureOption) (*MultiaccountTypeWithoutID, error) {
+	return DefaultCreateMultiaccountTypeWithoutID(ctx, NewMultiaccountTypeWithoutIDFixture(opts...), db)
+}
+
+// DefaultCreateTenantTypeWithID executes a basic gorm create call
+func DefaultCreateTenantTypeWithID(ctx context.Context, in *TenantTypeWithID, db *gorm.DB) (*TenantTypeWithID, error) {
+	var r0 *TenantTypeWithID
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		r0, err = defaultCreateTenantTypeWithIDInTransaction(ctx, in, tx)
+		return err
+	})
+	return r0, err
+}
+
+func defaultCreateTenantTypeWithIDInTransaction(ctx context.Context, in *TenantTypeWithID, db *gorm.DB) (*TenantTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TenantTypeWithIDORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if err = recordTenantTypeWithIDEvent(ctx, db, "TenantTypeWithIDCreated", nil, &ormObj); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TenantTypeWithIDORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TenantTypeWithIDORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TenantTypeWithIDORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateTenantTypeWithIDSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateTenantTypeWithIDSet(ctx context.Context, in []*TenantTypeWithID, db *gorm.DB, batchSize int) ([]*TenantTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*TenantTypeWithIDORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&TenantTypeWithIDORM{})).(TenantTypeWithIDORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(TenantTypeWithIDORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if err = recordTenantTypeWithIDEvent(ctx, tx, "TenantTypeWithIDCreated", nil, ormObj); err != nil {
+				return err
+			}
+			if hook, ok := interface{}(ormObj).(TenantTypeWithIDORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&TenantTypeWithIDORM{})).(TenantTypeWithIDORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
 		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*TenantTypeWithID, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type TenantTypeWithIDORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*TenantTypeWithIDORM, *gorm.DB) (*gorm.DB, error)
+}
+type TenantTypeWithIDORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*TenantTypeWithIDORM, *gorm.DB) error
+}
+
+func DefaultReadTenantTypeWithID(ctx context.Context, in *TenantTypeWithID, db *gorm.DB) (*TenantTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TenantTypeWithIDORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelectionEx(ctx, db, nil, &TenantTypeWithIDORM{}, preload.NewConverter(&TenantTypeWithID{})); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TenantTypeWithIDORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TenantTypeWithIDORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(TenantTypeWithIDORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TenantTypeWithIDORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TenantTypeWithIDORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TenantTypeWithIDORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTenantTypeWithID(ctx context.Context, in *TenantTypeWithID, db *gorm.DB) error {
+	return transaction.Run(db, func(tx *gorm.DB) error {
+		return defaultDeleteTenantTypeWithIDInTransaction(ctx, in, tx)
+	})
+}
+
+func defaultDeleteTenantTypeWithIDInTransaction(ctx context.Context, in *TenantTypeWithID, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TenantTypeWithIDORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	deleted := []*TenantTypeWithIDORM{}
+	if err = db.Set("gorm:query_option", "FOR UPDATE").Where(&ormObj).Find(&deleted).Error; err != nil {
+		return err
+	}
+	err = db.Where(&ormObj).Delete(&TenantTypeWithIDORM{}).Error
+	if err != nil {
+		return err
+	}
+	for _, deletedObj := range deleted {
+		if err = recordTenantTypeWithIDEvent(ctx, db, "TenantTypeWithIDDeleted", deletedObj, nil); err != nil {
+			return err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TenantTypeWithIDORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type TenantTypeWithIDORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TenantTypeWithIDORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTenantTypeWithIDSet(ctx context.Context, in []*TenantTypeWithID, db *gorm.DB) error {
+	return transaction.Run(db, func(tx *gorm.DB) error {
+		return defaultDeleteTenantTypeWithIDSetInTransaction(ctx, in, tx)
+	})
+}
+
+func defaultDeleteTenantTypeWithIDSetInTransaction(ctx context.Context, in []*TenantTypeWithID, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&TenantTypeWithIDORM{})).(TenantTypeWithIDORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	tenantID, isolated, err := tenant.ID(ctx, "TenantTypeWithID")
+	if err != nil {
+		return err
+	}
+	if isolated {
+		db = db.Where("org_id = ?", tenantID)
+	}
+	deleted := []*TenantTypeWithIDORM{}
+	if err = db.Set("gorm:query_option", "FOR UPDATE").Where("id in (?)", keys).Find(&deleted).Error; err != nil {
+		return err
+	}
+	err = db.Where("id in (?)", keys).Delete(&TenantTypeWithIDORM{}).Error
+	if err != nil {
+		return err
+	}
+	for _, deletedObj := range deleted {
+		if err = recordTenantTypeWithIDEvent(ctx, db, "TenantTypeWithIDDeleted", deletedObj, nil); err != nil {
+			return err
+		}
+	}
+	if hook, ok := (interface{}(&TenantTypeWithIDORM{})).(TenantTypeWithIDORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type TenantTypeWithIDORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TenantTypeWithID, *gorm.DB) (*gorm.DB, error)
+}
+type TenantTypeWithIDORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TenantTypeWithID, *gorm.DB) error
+}
+
+// DefaultStrictUpdateTenantTypeWithID clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTenantTypeWithID(ctx context.Context, in *TenantTypeWithID, db *gorm.DB) (*TenantTypeWithID, error) {
+	var r0 *TenantTypeWithID
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		r0, err = defaultStrictUpdateTenantTypeWithIDInTransaction(ctx, in, tx)
+		return err
+	})
+	return r0, err
+}
+
+func defaultStrictUpdateTenantTypeWithIDInTransaction(ctx context.Context, in *TenantTypeWithID, db *gorm.DB) (*TenantTypeWithID, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTenantTypeWithID")
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, isolated, err := tenant.ID(ctx, "TenantTypeWithID")
+	if err != nil {
+		return nil, err
+	}
+	if isolated {
+		db = db.Where(map[string]interface{}{"org_id": tenantID})
+	}
+	var count int64
+	lockedRow := &TenantTypeWithIDORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(TenantTypeWithIDORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TenantTypeWithIDORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		if err = recordTenantTypeWithIDEvent(ctx, db, "TenantTypeWithIDUpdated", lockedRow, &ormObj); err != nil {
+			return nil, err
+		}
+	} else {
+		if err = recordTenantTypeWithIDEvent(ctx, db, "TenantTypeWithIDCreated", nil, &ormObj); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TenantTypeWithIDORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type TenantTypeWithIDORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TenantTypeWithIDORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TenantTypeWithIDORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTenantTypeWithID executes a basic gorm update call with patch behavior
+func DefaultPatchTenantTypeWithID(ctx context.Context, in *TenantTypeWithID, updateMask *field_mask.FieldMask, db *gorm.DB) (*TenantTypeWithID, error) {
+	var r0 *TenantTypeWithID
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		r0, err = defaultPatchTenantTypeWithIDInTransaction(ctx, in, updateMask, tx)
+		return err
+	})
+	return r0, err
+}
+
+func defaultPatchTenantTypeWithIDInTransaction(ctx context.Context, in *TenantTypeWithID, updateMask *field_mask.FieldMask, db *gorm.DB) (*TenantTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj TenantTypeWithID
+	var err error
+	if hook, ok := interface{}(&pbObj).(TenantTypeWithIDWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadTenantTypeWithID(ctx, &TenantTypeWithID{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(TenantTypeWithIDWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskTenantTypeWithID(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(TenantTypeWithIDWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateTenantTypeWithID(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TenantTypeWithIDWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TenantTypeWithIDWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TenantTypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TenantTypeWithIDWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TenantTypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TenantTypeWithIDWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TenantTypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TenantTypeWithIDWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TenantTypeWithID, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTenantTypeWithID executes a bulk gorm update call with patch behavior
+func DefaultPatchSetTenantTypeWithID(ctx context.Context, objects []*TenantTypeWithID, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TenantTypeWithID, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*TenantTypeWithID, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchTenantTypeWithID(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskTenantTypeWithID patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTenantTypeWithID(ctx context.Context, patchee *TenantTypeWithID, patcher *TenantTypeWithID, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TenantTypeWithID, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"SomeField" {
+			patchee.SomeField = patcher.SomeField
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListTenantTypeWithID executes a gorm list call
+func DefaultListTenantTypeWithID(ctx context.Context, db *gorm.DB, f *query.Filtering, s *query.Sorting, p *query.Pagination) ([]*TenantTypeWithID, error) {
+	in := TenantTypeWithID{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TenantTypeWithIDORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db, f, s, p); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &TenantTypeWithIDORM{}, preload.NewConverter(&TenantTypeWithID{}), f, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TenantTypeWithIDORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db, f, s, p); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	keyset, err := paging.NewKeyset(db, &TenantTypeWithIDORM{}, s, f)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = keyset.Apply(db, p.GetPageToken()); err != nil {
+		return nil, err
+	}
+	if p.GetLimit() > 0 {
+		db = db.Limit(p.GetLimit())
+	}
+	ormResponse := []TenantTypeWithIDORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TenantTypeWithIDORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse, f, s, p); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*TenantTypeWithID{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TenantTypeWithIDORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB, *query.Filtering, *query.Sorting, *query.Pagination) (*gorm.DB, error)
+}
+type TenantTypeWithIDORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB, *query.Filtering, *query.Sorting, *query.Pagination) (*gorm.DB, error)
+}
+type TenantTypeWithIDORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TenantTypeWithIDORM, *query.Filtering, *query.Sorting, *query.Pagination) error
+}
+
+// DefaultCountTenantTypeWithID returns the number of rows DefaultListTenantTypeWithID pages through
+func DefaultCountTenantTypeWithID(ctx context.Context, db *gorm.DB, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	in := TenantTypeWithID{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(TenantTypeWithIDORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db, f); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TenantTypeWithIDORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db, f, nil, nil); err != nil {
+			return 0, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TenantTypeWithIDORM{}, &TenantTypeWithID{}, f, nil, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(TenantTypeWithIDORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db, f, nil, nil); err != nil {
+			return 0, err
+		}
+	}
+	return paging.Count(db.Where(&ormObj), &TenantTypeWithIDORM{}, strategy)
+}
+
+type TenantTypeWithIDORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB, *query.Filtering) (*gorm.DB, error)
+}
+
+// TenantTypeWithIDConflictTargets maps the conflict targets accepted by DefaultUpsertTenantTypeWithID to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var TenantTypeWithIDConflictTargets = map[string][]string{
+	"id": {"id"},
+}
+
+// DefaultUpsertTenantTypeWithID inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertTenantTypeWithID(ctx context.Context, in *TenantTypeWithID, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*TenantTypeWithID, error) {
+	var r0 *TenantTypeWithID
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		r0, err = defaultUpsertTenantTypeWithIDInTransaction(ctx, in, target, updateMask, tx)
+		return err
+	})
+	return r0, err
+}
+
+func defaultUpsertTenantTypeWithIDInTransaction(ctx context.Context, in *TenantTypeWithID, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*TenantTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	conflict := insert.OnConflict{
+		Columns:   TenantTypeWithIDConflictTargets[target],
+		UpdateAll: updateMask == nil,
+		Scope:     "org_id",
+	}
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for TenantTypeWithID", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertTenantTypeWithIDColumns(updateMask)
+		if err != nil {
+			return nil, err
+		}
+		conflict.Update = columns
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TenantTypeWithIDORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	lockedRow := &TenantTypeWithIDORM{}
+	locked, err := insert.LockConflicting(db, &ormObj, conflict.Columns, lockedRow)
+	if err != nil {
+		return nil, err
+	}
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
+		return nil, err
+	}
+	if locked {
+		// the update mask and the kept columns leave stored values in the row
+		storedRow := &TenantTypeWithIDORM{}
+		if err = db.Where("id = ?", ormObj.Id).First(storedRow).Error; err != nil {
+			return nil, err
+		}
+		if err = recordTenantTypeWithIDEvent(ctx, db, "TenantTypeWithIDUpdated", lockedRow, storedRow); err != nil {
+			return nil, err
+		}
+	} else {
+		if err = recordTenantTypeWithIDEvent(ctx, db, "TenantTypeWithIDCreated", nil, &ormObj); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TenantTypeWithIDORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TenantTypeWithIDORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TenantTypeWithIDORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// upsertTenantTypeWithIDColumns returns the columns of the fields of updateMask the upserts of
+// TenantTypeWithID overwrite, the immutable fields and the paths of no column being rejected
+func upsertTenantTypeWithIDColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		case "SomeField":
+			columns = append(columns, "some_field")
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
+		}
+	}
+	return columns, nil
+}
+
+// TenantTypeWithIDRepository runs the Default operations of TenantTypeWithID, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type TenantTypeWithIDRepository interface {
+	Create(ctx context.Context, in *TenantTypeWithID) (*TenantTypeWithID, error)
+	CreateSet(ctx context.Context, in []*TenantTypeWithID, batchSize int) ([]*TenantTypeWithID, error)
+	Read(ctx context.Context, in *TenantTypeWithID, fs *query.FieldSelection) (*TenantTypeWithID, error)
+	StrictUpdate(ctx context.Context, in *TenantTypeWithID) (*TenantTypeWithID, error)
+	Patch(ctx context.Context, in *TenantTypeWithID, updateMask *field_mask.FieldMask) (*TenantTypeWithID, error)
+	PatchSet(ctx context.Context, objects []*TenantTypeWithID, updateMasks []*field_mask.FieldMask) ([]*TenantTypeWithID, error)
+	Delete(ctx context.Context, in *TenantTypeWithID) error
+	DeleteSet(ctx context.Context, in []*TenantTypeWithID) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TenantTypeWithID, error)
+	PageToken(ctx context.Context, last *TenantTypeWithID, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *TenantTypeWithID, target string, updateMask *field_mask.FieldMask) (*TenantTypeWithID, error)
+}
+
+// GormTenantTypeWithIDRepository is the TenantTypeWithIDRepository calling the Default handlers with DB
+type GormTenantTypeWithIDRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormTenantTypeWithIDRepository returns the TenantTypeWithIDRepository running the operations with db
+func NewGormTenantTypeWithIDRepository(db *gorm.DB) *GormTenantTypeWithIDRepository {
+	return &GormTenantTypeWithIDRepository{DB: db}
+}
+
+func (r *GormTenantTypeWithIDRepository) Create(ctx context.Context, in *TenantTypeWithID) (*TenantTypeWithID, error) {
+	return DefaultCreateTenantTypeWithID(ctx, in, r.DB)
+}
+
+func (r *GormTenantTypeWithIDRepository) CreateSet(ctx context.Context, in []*TenantTypeWithID, batchSize int) ([]*TenantTypeWithID, error) {
+	return DefaultCreateTenantTypeWithIDSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormTenantTypeWithIDRepository) Read(ctx context.Context, in *TenantTypeWithID, fs *query.FieldSelection) (*TenantTypeWithID, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of TenantTypeWithID doesn't support field selection")
+	}
+	return DefaultReadTenantTypeWithID(ctx, in, r.DB)
+}
+
+func (r *GormTenantTypeWithIDRepository) StrictUpdate(ctx context.Context, in *TenantTypeWithID) (*TenantTypeWithID, error) {
+	return DefaultStrictUpdateTenantTypeWithID(ctx, in, r.DB)
+}
+
+func (r *GormTenantTypeWithIDRepository) Patch(ctx context.Context, in *TenantTypeWithID, updateMask *field_mask.FieldMask) (*TenantTypeWithID, error) {
+	return DefaultPatchTenantTypeWithID(ctx, in, updateMask, r.DB)
+}
+
+func (r *GormTenantTypeWithIDRepository) PatchSet(ctx context.Context, objects []*TenantTypeWithID, updateMasks []*field_mask.FieldMask) ([]*TenantTypeWithID, error) {
+	return DefaultPatchSetTenantTypeWithID(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormTenantTypeWithIDRepository) Delete(ctx context.Context, in *TenantTypeWithID) error {
+	return DefaultDeleteTenantTypeWithID(ctx, in, r.DB)
+}
+
+func (r *GormTenantTypeWithIDRepository) DeleteSet(ctx context.Context, in []*TenantTypeWithID) error {
+	return DefaultDeleteTenantTypeWithIDSet(ctx, in, r.DB)
+}
+
+func (r *GormTenantTypeWithIDRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TenantTypeWithID, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TenantTypeWithID doesn't support field selection")
+	}
+	return DefaultListTenantTypeWithID(ctx, r.DB, f, s, p)
+}
+
+func (r *GormTenantTypeWithIDRepository) PageToken(ctx context.Context, last *TenantTypeWithID, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	keyset, err := paging.NewKeyset(r.DB, &TenantTypeWithIDORM{}, s, f)
+	if err != nil {
+		return "", err
+	}
+	return keyset.Token(&ormObj)
+}
+
+func (r *GormTenantTypeWithIDRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	return DefaultCountTenantTypeWithID(ctx, r.DB, f, strategy)
+}
+
+func (r *GormTenantTypeWithIDRepository) Upsert(ctx context.Context, in *TenantTypeWithID, target string, updateMask *field_mask.FieldMask) (*TenantTypeWithID, error) {
+	return DefaultUpsertTenantTypeWithID(ctx, in, target, updateMask, r.DB)
+}
+
+// MemoryTenantTypeWithIDRepository is the TenantTypeWithIDRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryTenantTypeWithIDRepository struct {
+	table memory.Table
+}
+
+// NewMemoryTenantTypeWithIDRepository returns an empty MemoryTenantTypeWithIDRepository
+func NewMemoryTenantTypeWithIDRepository() *MemoryTenantTypeWithIDRepository {
+	return &MemoryTenantTypeWithIDRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryTenantTypeWithIDRepository) row(ctx context.Context, in, stored *TenantTypeWithID) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: memory.Tenant(ormObj.OrgID), Key: ormObj.Id, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryTenantTypeWithIDRepository) tenant(ctx context.Context) (interface{}, error) {
+	ormObj, err := (&TenantTypeWithID{}).ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return memory.Tenant(ormObj.OrgID), nil
+}
+
+func (r *MemoryTenantTypeWithIDRepository) Create(ctx context.Context, in *TenantTypeWithID) (*TenantTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*TenantTypeWithID), nil
+}
+
+func (r *MemoryTenantTypeWithIDRepository) CreateSet(ctx context.Context, in []*TenantTypeWithID, batchSize int) ([]*TenantTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*TenantTypeWithID, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*TenantTypeWithID))
+	}
+	if err := r.table.Insert(rows...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *MemoryTenantTypeWithIDRepository) Read(ctx context.Context, in *TenantTypeWithID, fs *query.FieldSelection) (*TenantTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	out, ok := r.table.Get(memory.Tenant(ormObj.OrgID), ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*TenantTypeWithID), nil
+}
+
+func (r *MemoryTenantTypeWithIDRepository) StrictUpdate(ctx context.Context, in *TenantTypeWithID) (*TenantTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.table.Save(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*TenantTypeWithID), nil
+}
+
+func (r *MemoryTenantTypeWithIDRepository) Patch(ctx context.Context, in *TenantTypeWithID, updateMask *field_mask.FieldMask) (*TenantTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	patchee, err := r.Read(ctx, &TenantTypeWithID{Id: in.GetId()}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if patchee, err = DefaultApplyFieldMaskTenantTypeWithID(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
+	}
+	return r.StrictUpdate(ctx, patchee)
+}
+
+func (r *MemoryTenantTypeWithIDRepository) PatchSet(ctx context.Context, objects []*TenantTypeWithID, updateMasks []*field_mask.FieldMask) ([]*TenantTypeWithID, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	out := make([]*TenantTypeWithID, 0, len(objects))
+	for i, patcher := range objects {
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, patched)
+	}
+	return out, nil
+}
+
+func (r *MemoryTenantTypeWithIDRepository) Delete(ctx context.Context, in *TenantTypeWithID) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(memory.Tenant(ormObj.OrgID), ormObj.Id)
+	return nil
+}
+
+func (r *MemoryTenantTypeWithIDRepository) DeleteSet(ctx context.Context, in []*TenantTypeWithID) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryTenantTypeWithIDRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TenantTypeWithID, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*TenantTypeWithID, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*TenantTypeWithID))
+	}
+	return out, nil
+}
+
+func (r *MemoryTenantTypeWithIDRepository) PageToken(ctx context.Context, last *TenantTypeWithID, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryTenantTypeWithIDRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryTenantTypeWithIDRepository) Upsert(ctx context.Context, in *TenantTypeWithID, target string, updateMask *field_mask.FieldMask) (*TenantTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := TenantTypeWithIDConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for TenantTypeWithID", target)
+	}
+	if _, err := upsertTenantTypeWithIDColumns(updateMask); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found, ok, err := r.table.Conflicting(memory.Tenant(ormObj.OrgID), func(m proto.Message) bool {
+		other, err := m.(*TenantTypeWithID).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r.Create(ctx, in)
+	}
+	existing := found.(*TenantTypeWithID)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskTenantTypeWithID(ctx, existing, in, updateMask, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		return r.StrictUpdate(ctx, patched)
+	}
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*TenantTypeWithID)
+	updated.Id = existing.Id
+	return r.StrictUpdate(ctx, updated)
+}
+
+// TenantTypeWithIDFixtureOption customizes the object returned by NewTenantTypeWithIDFixture
+type TenantTypeWithIDFixtureOption func(*TenantTypeWithID)
+
+// NewTenantTypeWithIDFixture returns a TenantTypeWithID with random valid values in its fields and
+// its required associations, customized by opts
+func NewTenantTypeWithIDFixture(opts ...TenantTypeWithIDFixtureOption) *TenantTypeWithID {
+	m := &TenantTypeWithID{}
+	m.SomeField = fixture.String(0)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CreateTenantTypeWithIDFixture creates the object returned by NewTenantTypeWithIDFixture and its
+// associations with DefaultCreateTenantTypeWithID
+func CreateTenantTypeWithIDFixture(ctx context.Context, db *gorm.DB, opts ...TenantTypeWithIDFixtureOption) (*TenantTypeWithID, error) {
+	return DefaultCreateTenantTypeWithID(ctx, NewTenantTypeWithIDFixture(opts...), db)
+}
+
+// DefaultCreatePrimaryUUIDType executes a basic gorm create call
+func DefaultCreatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PrimaryUUIDTypeORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreatePrimaryUUIDTypeSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreatePrimaryUUIDTypeSet(ctx context.Context, in []*PrimaryUUIDType, db *gorm.DB, batchSize int) ([]*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*PrimaryUUIDTypeORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&PrimaryUUIDTypeORM{})).(PrimaryUUIDTypeORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(PrimaryUUIDTypeORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(PrimaryUUIDTypeORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&PrimaryUUIDTypeORM{})).(PrimaryUUIDTypeORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*PrimaryUUIDType, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type PrimaryUUIDTypeORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*PrimaryUUIDTypeORM, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*PrimaryUUIDTypeORM, *gorm.DB) error
+}
+
+func DefaultReadPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelectionEx(ctx, db, nil, &PrimaryUUIDTypeORM{}, preload.NewConverter(&PrimaryUUIDType{})); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := PrimaryUUIDTypeORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(PrimaryUUIDTypeORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PrimaryUUIDTypeORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&PrimaryUUIDTypeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type PrimaryUUIDTypeORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePrimaryUUIDTypeSet(ctx context.Context, in []*PrimaryUUIDType, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []*go_uuid.UUID{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&PrimaryUUIDTypeORM{})).(PrimaryUUIDTypeORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&PrimaryUUIDTypeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&PrimaryUUIDTypeORM{})).(PrimaryUUIDTypeORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type PrimaryUUIDTypeORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*PrimaryUUIDType, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*PrimaryUUIDType, *gorm.DB) error
+}
+
+// DefaultStrictUpdatePrimaryUUIDType clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryUUIDType")
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &PrimaryUUIDTypeORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterChild := ExternalChildORM{}
+	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+		return nil, errors.EmptyIdError
+	}
+	filterChild.PrimaryUUIDTypeId = new(go_uuid.UUID)
+	*filterChild.PrimaryUUIDTypeId = *ormObj.Id
+	if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type PrimaryUUIDTypeORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchPrimaryUUIDType executes a basic gorm update call with patch behavior
+func DefaultPatchPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj PrimaryUUIDType
+	var err error
+	if hook, ok := interface{}(&pbObj).(PrimaryUUIDTypeWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadPrimaryUUIDType(ctx, &PrimaryUUIDType{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(PrimaryUUIDTypeWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskPrimaryUUIDType(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(PrimaryUUIDTypeWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdatePrimaryUUIDType(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(PrimaryUUIDTypeWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type PrimaryUUIDTypeWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetPrimaryUUIDType executes a bulk gorm update call with patch behavior
+func DefaultPatchSetPrimaryUUIDType(ctx context.Context, objects []*PrimaryUUIDType, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PrimaryUUIDType, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*PrimaryUUIDType, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchPrimaryUUIDType(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskPrimaryUUIDType patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskPrimaryUUIDType(ctx context.Context, patchee *PrimaryUUIDType, patcher *PrimaryUUIDType, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PrimaryUUIDType, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedChild bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedChild && strings.HasPrefix(f, prefix+"Child.") {
+			updatedChild = true
+			if patcher.Child == nil {
+				patchee.Child = nil
+				continue
+			}
+			if patchee.Child == nil {
+				patchee.Child = &ExternalChild{}
+			}
+			if o, err := DefaultApplyFieldMaskExternalChild(ctx, patchee.Child, patcher.Child, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Child.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Child = o
+			}
+			continue
+		}
+		if f == prefix+"Child" {
+			updatedChild = true
+			patchee.Child = patcher.Child
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListPrimaryUUIDType executes a gorm list call
+func DefaultListPrimaryUUIDType(ctx context.Context, db *gorm.DB) ([]*PrimaryUUIDType, error) {
+	in := PrimaryUUIDType{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &PrimaryUUIDTypeORM{}, preload.NewConverter(&PrimaryUUIDType{}), nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []PrimaryUUIDTypeORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*PrimaryUUIDType{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type PrimaryUUIDTypeORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PrimaryUUIDTypeORM) error
+}
+
+// DefaultCountPrimaryUUIDType returns the number of rows DefaultListPrimaryUUIDType pages through
+func DefaultCountPrimaryUUIDType(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := PrimaryUUIDType{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	return paging.Count(db.Where(&ormObj), &PrimaryUUIDTypeORM{}, strategy)
+}
+
+type PrimaryUUIDTypeORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// PrimaryUUIDTypeConflictTargets maps the conflict targets accepted by DefaultUpsertPrimaryUUIDType to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var PrimaryUUIDTypeConflictTargets = map[string][]string{
+	"id": {"id"},
+}
+
+// DefaultUpsertPrimaryUUIDType inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	conflict := insert.OnConflict{
+		Columns:   PrimaryUUIDTypeConflictTargets[target],
+		UpdateAll: updateMask == nil,
+	}
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for PrimaryUUIDType", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertPrimaryUUIDTypeColumns(updateMask)
+		if err != nil {
+			return nil, err
+		}
+		conflict.Update = columns
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PrimaryUUIDTypeORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// upsertPrimaryUUIDTypeColumns returns the columns of the fields of updateMask the upserts of
+// PrimaryUUIDType overwrite, the immutable fields and the paths of no column being rejected
+func upsertPrimaryUUIDTypeColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
+		}
+	}
+	return columns, nil
+}
+
+// PrimaryUUIDTypeRepository runs the Default operations of PrimaryUUIDType, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type PrimaryUUIDTypeRepository interface {
+	Create(ctx context.Context, in *PrimaryUUIDType) (*PrimaryUUIDType, error)
+	CreateSet(ctx context.Context, in []*PrimaryUUIDType, batchSize int) ([]*PrimaryUUIDType, error)
+	Read(ctx context.Context, in *PrimaryUUIDType, fs *query.FieldSelection) (*PrimaryUUIDType, error)
+	StrictUpdate(ctx context.Context, in *PrimaryUUIDType) (*PrimaryUUIDType, error)
+	Patch(ctx context.Context, in *PrimaryUUIDType, updateMask *field_mask.FieldMask) (*PrimaryUUIDType, error)
+	PatchSet(ctx context.Context, objects []*PrimaryUUIDType, updateMasks []*field_mask.FieldMask) ([]*PrimaryUUIDType, error)
+	Delete(ctx context.Context, in *PrimaryUUIDType) error
+	DeleteSet(ctx context.Context, in []*PrimaryUUIDType) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*PrimaryUUIDType, error)
+	PageToken(ctx context.Context, last *PrimaryUUIDType, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *PrimaryUUIDType, target string, updateMask *field_mask.FieldMask) (*PrimaryUUIDType, error)
+}
+
+// GormPrimaryUUIDTypeRepository is the PrimaryUUIDTypeRepository calling the Default handlers with DB
+type GormPrimaryUUIDTypeRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormPrimaryUUIDTypeRepository returns the PrimaryUUIDTypeRepository running the operations with db
+func NewGormPrimaryUUIDTypeRepository(db *gorm.DB) *GormPrimaryUUIDTypeRepository {
+	return &GormPrimaryUUIDTypeRepository{DB: db}
+}
+
+func (r *GormPrimaryUUIDTypeRepository) Create(ctx context.Context, in *PrimaryUUIDType) (*PrimaryUUIDType, error) {
+	return DefaultCreatePrimaryUUIDType(ctx, in, r.DB)
+}
+
+func (r *GormPrimaryUUIDTypeRepository) CreateSet(ctx context.Context, in []*PrimaryUUIDType, batchSize int) ([]*PrimaryUUIDType, error) {
+	return DefaultCreatePrimaryUUIDTypeSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormPrimaryUUIDTypeRepository) Read(ctx context.Context, in *PrimaryUUIDType, fs *query.FieldSelection) (*PrimaryUUIDType, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of PrimaryUUIDType doesn't support field selection")
+	}
+	return DefaultReadPrimaryUUIDType(ctx, in, r.DB)
+}
+
+func (r *GormPrimaryUUIDTypeRepository) StrictUpdate(ctx context.Context, in *PrimaryUUIDType) (*PrimaryUUIDType, error) {
+	return DefaultStrictUpdatePrimaryUUIDType(ctx, in, r.DB)
+}
+
+func (r *GormPrimaryUUIDTypeRepository) Patch(ctx context.Context, in *PrimaryUUIDType, updateMask *field_mask.FieldMask) (*PrimaryUUIDType, error) {
+	return DefaultPatchPrimaryUUIDType(ctx, in, updateMask, r.DB)
+}
+
+func (r *GormPrimaryUUIDTypeRepository) PatchSet(ctx context.Context, objects []*PrimaryUUIDType, updateMasks []*field_mask.FieldMask) ([]*PrimaryUUIDType, error) {
+	return DefaultPatchSetPrimaryUUIDType(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormPrimaryUUIDTypeRepository) Delete(ctx context.Context, in *PrimaryUUIDType) error {
+	return DefaultDeletePrimaryUUIDType(ctx, in, r.DB)
+}
+
+func (r *GormPrimaryUUIDTypeRepository) DeleteSet(ctx context.Context, in []*PrimaryUUIDType) error {
+	return DefaultDeletePrimaryUUIDTypeSet(ctx, in, r.DB)
+}
+
+func (r *GormPrimaryUUIDTypeRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*PrimaryUUIDType, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of PrimaryUUIDType doesn't support filtering")
+	}
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of PrimaryUUIDType doesn't support sorting")
+	}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of PrimaryUUIDType doesn't support pagination")
+	}
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of PrimaryUUIDType doesn't support field selection")
+	}
+	return DefaultListPrimaryUUIDType(ctx, r.DB)
+}
+
+func (r *GormPrimaryUUIDTypeRepository) PageToken(ctx context.Context, last *PrimaryUUIDType, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	keyset, err := paging.NewKeyset(r.DB, &PrimaryUUIDTypeORM{}, s, f)
+	if err != nil {
+		return "", err
+	}
+	return keyset.Token(&ormObj)
+}
+
+func (r *GormPrimaryUUIDTypeRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of PrimaryUUIDType doesn't support filtering")
+	}
+	return DefaultCountPrimaryUUIDType(ctx, r.DB, strategy)
+}
+
+func (r *GormPrimaryUUIDTypeRepository) Upsert(ctx context.Context, in *PrimaryUUIDType, target string, updateMask *field_mask.FieldMask) (*PrimaryUUIDType, error) {
+	return DefaultUpsertPrimaryUUIDType(ctx, in, target, updateMask, r.DB)
+}
+
+// MemoryPrimaryUUIDTypeRepository is the PrimaryUUIDTypeRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryPrimaryUUIDTypeRepository struct {
+	table memory.Table
+}
+
+// NewMemoryPrimaryUUIDTypeRepository returns an empty MemoryPrimaryUUIDTypeRepository
+func NewMemoryPrimaryUUIDTypeRepository() *MemoryPrimaryUUIDTypeRepository {
+	return &MemoryPrimaryUUIDTypeRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryPrimaryUUIDTypeRepository) row(ctx context.Context, in, stored *PrimaryUUIDType) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: nil, Key: ormObj.Id, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryPrimaryUUIDTypeRepository) tenant(ctx context.Context) (interface{}, error) {
+	_, err := (&PrimaryUUIDType{}).ToORM(ctx)
+	return nil, err
+}
+
+func (r *MemoryPrimaryUUIDTypeRepository) Create(ctx context.Context, in *PrimaryUUIDType) (*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*PrimaryUUIDType), nil
+}
+
+func (r *MemoryPrimaryUUIDTypeRepository) CreateSet(ctx context.Context, in []*PrimaryUUIDType, batchSize int) ([]*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*PrimaryUUIDType, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*PrimaryUUIDType))
+	}
+	if err := r.table.Insert(rows...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *MemoryPrimaryUUIDTypeRepository) Read(ctx context.Context, in *PrimaryUUIDType, fs *query.FieldSelection) (*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+		return nil, errors.EmptyIdError
+	}
+	out, ok := r.table.Get(nil, ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*PrimaryUUIDType), nil
+}
+
+func (r *MemoryPrimaryUUIDTypeRepository) StrictUpdate(ctx context.Context, in *PrimaryUUIDType) (*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.table.Save(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*PrimaryUUIDType), nil
+}
+
+func (r *MemoryPrimaryUUIDTypeRepository) Patch(ctx context.Context, in *PrimaryUUIDType, updateMask *field_mask.FieldMask) (*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	patchee, err := r.Read(ctx, &PrimaryUUIDType{Id: in.GetId()}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if patchee, err = DefaultApplyFieldMaskPrimaryUUIDType(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
+	}
+	return r.StrictUpdate(ctx, patchee)
+}
+
+func (r *MemoryPrimaryUUIDTypeRepository) PatchSet(ctx context.Context, objects []*PrimaryUUIDType, updateMasks []*field_mask.FieldMask) ([]*PrimaryUUIDType, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	out := make([]*PrimaryUUIDType, 0, len(objects))
+	for i, patcher := range objects {
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, patched)
+	}
+	return out, nil
+}
+
+func (r *MemoryPrimaryUUIDTypeRepository) Delete(ctx context.Context, in *PrimaryUUIDType) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(nil, ormObj.Id)
+	return nil
+}
+
+func (r *MemoryPrimaryUUIDTypeRepository) DeleteSet(ctx context.Context, in []*PrimaryUUIDType) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryPrimaryUUIDTypeRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*PrimaryUUIDType, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*PrimaryUUIDType, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*PrimaryUUIDType))
+	}
+	return out, nil
+}
+
+func (r *MemoryPrimaryUUIDTypeRepository) PageToken(ctx context.Context, last *PrimaryUUIDType, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryPrimaryUUIDTypeRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryPrimaryUUIDTypeRepository) Upsert(ctx context.Context, in *PrimaryUUIDType, target string, updateMask *field_mask.FieldMask) (*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := PrimaryUUIDTypeConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for PrimaryUUIDType", target)
+	}
+	if _, err := upsertPrimaryUUIDTypeColumns(updateMask); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found, ok, err := r.table.Conflicting(nil, func(m proto.Message) bool {
+		other, err := m.(*PrimaryUUIDType).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r.Create(ctx, in)
+	}
+	existing := found.(*PrimaryUUIDType)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskPrimaryUUIDType(ctx, existing, in, updateMask, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		return r.StrictUpdate(ctx, patched)
+	}
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*PrimaryUUIDType)
+	updated.Id = existing.Id
+	return r.StrictUpdate(ctx, updated)
+}
+
+// PrimaryUUIDTypeFixtureOption customizes the object returned by NewPrimaryUUIDTypeFixture
+type PrimaryUUIDTypeFixtureOption func(*PrimaryUUIDType)
+
+// NewPrimaryUUIDTypeFixture returns a PrimaryUUIDType with random valid values in its fields and
+// its required associations, customized by opts
+func NewPrimaryUUIDTypeFixture(opts ...PrimaryUUIDTypeFixtureOption) *PrimaryUUIDType {
+	m := &PrimaryUUIDType{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CreatePrimaryUUIDTypeFixture creates the object returned by NewPrimaryUUIDTypeFixture and its
+// associations with DefaultCreatePrimaryUUIDType
+func CreatePrimaryUUIDTypeFixture(ctx context.Context, db *gorm.DB, opts ...PrimaryUUIDTypeFixtureOption) (*PrimaryUUIDType, error) {
+	return DefaultCreatePrimaryUUIDType(ctx, NewPrimaryUUIDTypeFixture(opts...), db)
+}
+
+// DefaultCreatePrimaryStringType executes a basic gorm create call
+func DefaultCreatePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PrimaryStringTypeORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreatePrimaryStringTypeSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreatePrimaryStringTypeSet(ctx context.Context, in []*PrimaryStringType, db *gorm.DB, batchSize int) ([]*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*PrimaryStringTypeORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&PrimaryStringTypeORM{})).(PrimaryStringTypeORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(PrimaryStringTypeORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(PrimaryStringTypeORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&PrimaryStringTypeORM{})).(PrimaryStringTypeORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*PrimaryStringType, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type PrimaryStringTypeORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*PrimaryStringTypeORM, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*PrimaryStringTypeORM, *gorm.DB) error
+}
+
+func DefaultReadPrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelectionEx(ctx, db, nil, &PrimaryStringTypeORM{}, preload.NewConverter(&PrimaryStringType{})); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := PrimaryStringTypeORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(PrimaryStringTypeORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PrimaryStringTypeORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&PrimaryStringTypeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type PrimaryStringTypeORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePrimaryStringTypeSet(ctx context.Context, in []*PrimaryStringType, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&PrimaryStringTypeORM{})).(PrimaryStringTypeORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&PrimaryStringTypeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&PrimaryStringTypeORM{})).(PrimaryStringTypeORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type PrimaryStringTypeORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*PrimaryStringType, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*PrimaryStringType, *gorm.DB) error
+}
+
+// DefaultStrictUpdatePrimaryStringType clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdatePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryStringType")
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &PrimaryStringTypeORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterChild := ExternalChildORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterChild.PrimaryStringTypeId = new(string)
+	*filterChild.PrimaryStringTypeId = ormObj.Id
+	if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type PrimaryStringTypeORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchPrimaryStringType executes a basic gorm update call with patch behavior
+func DefaultPatchPrimaryStringType(ctx context.Context, in *PrimaryStringType, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj PrimaryStringType
+	var err error
+	if hook, ok := interface{}(&pbObj).(PrimaryStringTypeWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadPrimaryStringType(ctx, &PrimaryStringType{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(PrimaryStringTypeWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskPrimaryStringType(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(PrimaryStringTypeWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdatePrimaryStringType(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(PrimaryStringTypeWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type PrimaryStringTypeWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetPrimaryStringType executes a bulk gorm update call with patch behavior
+func DefaultPatchSetPrimaryStringType(ctx context.Context, objects []*PrimaryStringType, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PrimaryStringType, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*PrimaryStringType, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchPrimaryStringType(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskPrimaryStringType patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskPrimaryStringType(ctx context.Context, patchee *PrimaryStringType, patcher *PrimaryStringType, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PrimaryStringType, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedChild bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedChild && strings.HasPrefix(f, prefix+"Child.") {
+			updatedChild = true
+			if patcher.Child == nil {
+				patchee.Child = nil
+				continue
+			}
+			if patchee.Child == nil {
+				patchee.Child = &ExternalChild{}
+			}
+			if o, err := DefaultApplyFieldMaskExternalChild(ctx, patchee.Child, patcher.Child, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Child.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Child = o
+			}
+			continue
+		}
+		if f == prefix+"Child" {
+			updatedChild = true
+			patchee.Child = patcher.Child
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListPrimaryStringType executes a gorm list call
+func DefaultListPrimaryStringType(ctx context.Context, db *gorm.DB) ([]*PrimaryStringType, error) {
+	in := PrimaryStringType{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &PrimaryStringTypeORM{}, preload.NewConverter(&PrimaryStringType{}), nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []PrimaryStringTypeORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*PrimaryStringType{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type PrimaryStringTypeORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PrimaryStringTypeORM) error
+}
+
+// DefaultCountPrimaryStringType returns the number of rows DefaultListPrimaryStringType pages through
+func DefaultCountPrimaryStringType(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := PrimaryStringType{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	return paging.Count(db.Where(&ormObj), &PrimaryStringTypeORM{}, strategy)
+}
+
+type PrimaryStringTypeORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// PrimaryStringTypeConflictTargets maps the conflict targets accepted by DefaultUpsertPrimaryStringType to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var PrimaryStringTypeConflictTargets = map[string][]string{
+	"id": {"id"},
+}
+
+// DefaultUpsertPrimaryStringType inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertPrimaryStringType(ctx context.Context, in *PrimaryStringType, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	conflict := insert.OnConflict{
+		Columns:   PrimaryStringTypeConflictTargets[target],
+		UpdateAll: updateMask == nil,
+	}
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for PrimaryStringType", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertPrimaryStringTypeColumns(updateMask)
+		if err != nil {
+			return nil, err
+		}
+		conflict.Update = columns
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PrimaryStringTypeORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// upsertPrimaryStringTypeColumns returns the columns of the fields of updateMask the upserts of
+// PrimaryStringType overwrite, the immutable fields and the paths of no column being rejected
+func upsertPrimaryStringTypeColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
+		}
+	}
+	return columns, nil
+}
+
+// PrimaryStringTypeRepository runs the Default operations of PrimaryStringType, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type PrimaryStringTypeRepository interface {
+	Create(ctx context.Context, in *PrimaryStringType) (*PrimaryStringType, error)
+	CreateSet(ctx context.Context, in []*PrimaryStringType, batchSize int) ([]*PrimaryStringType, error)
+	Read(ctx context.Context, in *PrimaryStringType, fs *query.FieldSelection) (*PrimaryStringType, error)
+	StrictUpdate(ctx context.Context, in *PrimaryStringType) (*PrimaryStringType, error)
+	Patch(ctx context.Context, in *PrimaryStringType, updateMask *field_mask.FieldMask) (*PrimaryStringType, error)
+	PatchSet(ctx context.Context, objects []*PrimaryStringType, updateMasks []*field_mask.FieldMask) ([]*PrimaryStringType, error)
+	Delete(ctx context.Context, in *PrimaryStringType) error
+	DeleteSet(ctx context.Context, in []*PrimaryStringType) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*PrimaryStringType, error)
+	PageToken(ctx context.Context, last *PrimaryStringType, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *PrimaryStringType, target string, updateMask *field_mask.FieldMask) (*PrimaryStringType, error)
+}
+
+// GormPrimaryStringTypeRepository is the PrimaryStringTypeRepository calling the Default handlers with DB
+type GormPrimaryStringTypeRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormPrimaryStringTypeRepository returns the PrimaryStringTypeRepository running the operations with db
+func NewGormPrimaryStringTypeRepository(db *gorm.DB) *GormPrimaryStringTypeRepository {
+	return &GormPrimaryStringTypeRepository{DB: db}
+}
+
+func (r *GormPrimaryStringTypeRepository) Create(ctx context.Context, in *PrimaryStringType) (*PrimaryStringType, error) {
+	return DefaultCreatePrimaryStringType(ctx, in, r.DB)
+}
+
+func (r *GormPrimaryStringTypeRepository) CreateSet(ctx context.Context, in []*PrimaryStringType, batchSize int) ([]*PrimaryStringType, error) {
+	return DefaultCreatePrimaryStringTypeSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormPrimaryStringTypeRepository) Read(ctx context.Context, in *PrimaryStringType, fs *query.FieldSelection) (*PrimaryStringType, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of PrimaryStringType doesn't support field selection")
+	}
+	return DefaultReadPrimaryStringType(ctx, in, r.DB)
+}
+
+func (r *GormPrimaryStringTypeRepository) StrictUpdate(ctx context.Context, in *PrimaryStringType) (*PrimaryStringType, error) {
+	return DefaultStrictUpdatePrimaryStringType(ctx, in, r.DB)
+}
+
+func (r *GormPrimaryStringTypeRepository) Patch(ctx context.Context, in *PrimaryStringType, updateMask *field_mask.FieldMask) (*PrimaryStringType, error) {
+	return DefaultPatchPrimaryStringType(ctx, in, updateMask, r.DB)
+}
+
+func (r *GormPrimaryStringTypeRepository) PatchSet(ctx context.Context, objects []*PrimaryStringType, updateMasks []*field_mask.FieldMask) ([]*PrimaryStringType, error) {
+	return DefaultPatchSetPrimaryStringType(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormPrimaryStringTypeRepository) Delete(ctx context.Context, in *PrimaryStringType) error {
+	return DefaultDeletePrimaryStringType(ctx, in, r.DB)
+}
+
+func (r *GormPrimaryStringTypeRepository) DeleteSet(ctx context.Context, in []*PrimaryStringType) error {
+	return DefaultDeletePrimaryStringTypeSet(ctx, in, r.DB)
+}
+
+func (r *GormPrimaryStringTypeRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*PrimaryStringType, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of PrimaryStringType doesn't support filtering")
+	}
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of PrimaryStringType doesn't support sorting")
+	}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of PrimaryStringType doesn't support pagination")
+	}
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of PrimaryStringType doesn't support field selection")
+	}
+	return DefaultListPrimaryStringType(ctx, r.DB)
+}
+
+func (r *GormPrimaryStringTypeRepository) PageToken(ctx context.Context, last *PrimaryStringType, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	keyset, err := paging.NewKeyset(r.DB, &PrimaryStringTypeORM{}, s, f)
+	if err != nil {
+		return "", err
+	}
+	return keyset.Token(&ormObj)
+}
+
+func (r *GormPrimaryStringTypeRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of PrimaryStringType doesn't support filtering")
+	}
+	return DefaultCountPrimaryStringType(ctx, r.DB, strategy)
+}
+
+func (r *GormPrimaryStringTypeRepository) Upsert(ctx context.Context, in *PrimaryStringType, target string, updateMask *field_mask.FieldMask) (*PrimaryStringType, error) {
+	return DefaultUpsertPrimaryStringType(ctx, in, target, updateMask, r.DB)
+}
+
+// MemoryPrimaryStringTypeRepository is the PrimaryStringTypeRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryPrimaryStringTypeRepository struct {
+	table memory.Table
+}
+
+// NewMemoryPrimaryStringTypeRepository returns an empty MemoryPrimaryStringTypeRepository
+func NewMemoryPrimaryStringTypeRepository() *MemoryPrimaryStringTypeRepository {
+	return &MemoryPrimaryStringTypeRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryPrimaryStringTypeRepository) row(ctx context.Context, in, stored *PrimaryStringType) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: nil, Key: ormObj.Id, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryPrimaryStringTypeRepository) tenant(ctx context.Context) (interface{}, error) {
+	_, err := (&PrimaryStringType{}).ToORM(ctx)
+	return nil, err
+}
+
+func (r *MemoryPrimaryStringTypeRepository) Create(ctx context.Context, in *PrimaryStringType) (*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*PrimaryStringType), nil
+}
+
+func (r *MemoryPrimaryStringTypeRepository) CreateSet(ctx context.Context, in []*PrimaryStringType, batchSize int) ([]*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*PrimaryStringType, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*PrimaryStringType))
+	}
+	if err := r.table.Insert(rows...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *MemoryPrimaryStringTypeRepository) Read(ctx context.Context, in *PrimaryStringType, fs *query.FieldSelection) (*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	out, ok := r.table.Get(nil, ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*PrimaryStringType), nil
+}
+
+func (r *MemoryPrimaryStringTypeRepository) StrictUpdate(ctx context.Context, in *PrimaryStringType) (*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.table.Save(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*PrimaryStringType), nil
+}
+
+func (r *MemoryPrimaryStringTypeRepository) Patch(ctx context.Context, in *PrimaryStringType, updateMask *field_mask.FieldMask) (*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	patchee, err := r.Read(ctx, &PrimaryStringType{Id: in.GetId()}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if patchee, err = DefaultApplyFieldMaskPrimaryStringType(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
+	}
+	return r.StrictUpdate(ctx, patchee)
+}
+
+func (r *MemoryPrimaryStringTypeRepository) PatchSet(ctx context.Context, objects []*PrimaryStringType, updateMasks []*field_mask.FieldMask) ([]*PrimaryStringType, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	out := make([]*PrimaryStringType, 0, len(objects))
+	for i, patcher := range objects {
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, patched)
+	}
+	return out, nil
+}
+
+func (r *MemoryPrimaryStringTypeRepository) Delete(ctx context.Context, in *PrimaryStringType) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(nil, ormObj.Id)
+	return nil
+}
+
+func (r *MemoryPrimaryStringTypeRepository) DeleteSet(ctx context.Context, in []*PrimaryStringType) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryPrimaryStringTypeRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*PrimaryStringType, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*PrimaryStringType, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*PrimaryStringType))
+	}
+	return out, nil
+}
+
+func (r *MemoryPrimaryStringTypeRepository) PageToken(ctx context.Context, last *PrimaryStringType, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryPrimaryStringTypeRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryPrimaryStringTypeRepository) Upsert(ctx context.Context, in *PrimaryStringType, target string, updateMask *field_mask.FieldMask) (*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := PrimaryStringTypeConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for PrimaryStringType", target)
+	}
+	if _, err := upsertPrimaryStringTypeColumns(updateMask); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found, ok, err := r.table.Conflicting(nil, func(m proto.Message) bool {
+		other, err := m.(*PrimaryStringType).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r.Create(ctx, in)
+	}
+	existing := found.(*PrimaryStringType)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskPrimaryStringType(ctx, existing, in, updateMask, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		return r.StrictUpdate(ctx, patched)
+	}
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*PrimaryStringType)
+	updated.Id = existing.Id
+	return r.StrictUpdate(ctx, updated)
+}
+
+// PrimaryStringTypeFixtureOption customizes the object returned by NewPrimaryStringTypeFixture
+type PrimaryStringTypeFixtureOption func(*PrimaryStringType)
+
+// NewPrimaryStringTypeFixture returns a PrimaryStringType with random valid values in its fields and
+// its required associations, customized by opts
+func NewPrimaryStringTypeFixture(opts ...PrimaryStringTypeFixtureOption) *PrimaryStringType {
+	m := &PrimaryStringType{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CreatePrimaryStringTypeFixture creates the object returned by NewPrimaryStringTypeFixture and its
+// associations with DefaultCreatePrimaryStringType
+func CreatePrimaryStringTypeFixture(ctx context.Context, db *gorm.DB, opts ...PrimaryStringTypeFixtureOption) (*PrimaryStringType, error) {
+	return DefaultCreatePrimaryStringType(ctx, NewPrimaryStringTypeFixture(opts...), db)
+}
+
+// DefaultCreateTestTag executes a basic gorm create call
+func DefaultCreateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestTagORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateTestTagSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateTestTagSet(ctx context.Context, in []*TestTag, db *gorm.DB, batchSize int) ([]*TestTag, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*TestTagORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&TestTagORM{})).(TestTagORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(TestTagORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(TestTagORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&TestTagORM{})).(TestTagORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*TestTag, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type TestTagORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*TestTagORM, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*TestTagORM, *gorm.DB) error
+}
+
+func DefaultReadTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelectionEx(ctx, db, nil, &TestTagORM{}, preload.NewConverter(&TestTag{})); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TestTagORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(TestTagORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestTagORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTestTag(ctx context.Context, in *TestTag, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&TestTagORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type TestTagORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTestTagSet(ctx context.Context, in []*TestTag, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&TestTagORM{})).(TestTagORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&TestTagORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&TestTagORM{})).(TestTagORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type TestTagORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TestTag, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TestTag, *gorm.DB) error
+}
+
+// DefaultStrictUpdateTestTag clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestTag")
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &TestTagORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterTestTagAssoc := TestTagAssociationORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterTestTagAssoc.TestTagId = new(string)
+	*filterTestTagAssoc.TestTagId = ormObj.Id
+	if err = db.Where(filterTestTagAssoc).Delete(TestTagAssociationORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type TestTagORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTestTag executes a basic gorm update call with patch behavior
+func DefaultPatchTestTag(ctx context.Context, in *TestTag, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestTag, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj TestTag
+	var err error
+	if hook, ok := interface{}(&pbObj).(TestTagWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadTestTag(ctx, &TestTag{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(TestTagWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskTestTag(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(TestTagWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateTestTag(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TestTagWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TestTagWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTestTag executes a bulk gorm update call with patch behavior
+func DefaultPatchSetTestTag(ctx context.Context, objects []*TestTag, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestTag, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*TestTag, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchTestTag(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskTestTag patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestTag(ctx context.Context, patchee *TestTag, patcher *TestTag, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestTag, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedTestTagAssoc bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedTestTagAssoc && strings.HasPrefix(f, prefix+"TestTagAssoc.") {
+			updatedTestTagAssoc = true
+			if patcher.TestTagAssoc == nil {
+				patchee.TestTagAssoc = nil
+				continue
+			}
+			if patchee.TestTagAssoc == nil {
+				patchee.TestTagAssoc = &TestTagAssociation{}
+			}
+			if o, err := DefaultApplyFieldMaskTestTagAssociation(ctx, patchee.TestTagAssoc, patcher.TestTagAssoc, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"TestTagAssoc.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.TestTagAssoc = o
+			}
+			continue
+		}
+		if f == prefix+"TestTagAssoc" {
+			updatedTestTagAssoc = true
+			patchee.TestTagAssoc = patcher.TestTagAssoc
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListTestTag executes a gorm list call
+func DefaultListTestTag(ctx context.Context, db *gorm.DB) ([]*TestTag, error) {
+	in := TestTag{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &TestTagORM{}, preload.NewConverter(&TestTag{}), nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []TestTagORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*TestTag{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TestTagORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestTagORM) error
+}
+
+// DefaultCountTestTag returns the number of rows DefaultListTestTag pages through
+func DefaultCountTestTag(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := TestTag{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	return paging.Count(db.Where(&ormObj), &TestTagORM{}, strategy)
+}
+
+type TestTagORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// TestTagConflictTargets maps the conflict targets accepted by DefaultUpsertTestTag to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var TestTagConflictTargets = map[string][]string{
+	"id": {"id"},
+}
+
+// DefaultUpsertTestTag inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertTestTag(ctx context.Context, in *TestTag, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestTag, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	conflict := insert.OnConflict{
+		Columns:   TestTagConflictTargets[target],
+		UpdateAll: updateMask == nil,
+	}
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for TestTag", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertTestTagColumns(updateMask)
+		if err != nil {
+			return nil, err
+		}
+		conflict.Update = columns
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestTagORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// upsertTestTagColumns returns the columns of the fields of updateMask the upserts of
+// TestTag overwrite, the immutable fields and the paths of no column being rejected
+func upsertTestTagColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
+		}
+	}
+	return columns, nil
+}
+
+// TestTagRepository runs the Default operations of TestTag, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type TestTagRepository interface {
+	Create(ctx context.Context, in *TestTag) (*TestTag, error)
+	CreateSet(ctx context.Context, in []*TestTag, batchSize int) ([]*TestTag, error)
+	Read(ctx context.Context, in *TestTag, fs *query.FieldSelection) (*TestTag, error)
+	StrictUpdate(ctx context.Context, in *TestTag) (*TestTag, error)
+	Patch(ctx context.Context, in *TestTag, updateMask *field_mask.FieldMask) (*TestTag, error)
+	PatchSet(ctx context.Context, objects []*TestTag, updateMasks []*field_mask.FieldMask) ([]*TestTag, error)
+	Delete(ctx context.Context, in *TestTag) error
+	DeleteSet(ctx context.Context, in []*TestTag) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TestTag, error)
+	PageToken(ctx context.Context, last *TestTag, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *TestTag, target string, updateMask *field_mask.FieldMask) (*TestTag, error)
+}
+
+// GormTestTagRepository is the TestTagRepository calling the Default handlers with DB
+type GormTestTagRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormTestTagRepository returns the TestTagRepository running the operations with db
+func NewGormTestTagRepository(db *gorm.DB) *GormTestTagRepository {
+	return &GormTestTagRepository{DB: db}
+}
+
+func (r *GormTestTagRepository) Create(ctx context.Context, in *TestTag) (*TestTag, error) {
+	return DefaultCreateTestTag(ctx, in, r.DB)
+}
+
+func (r *GormTestTagRepository) CreateSet(ctx context.Context, in []*TestTag, batchSize int) ([]*TestTag, error) {
+	return DefaultCreateTestTagSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormTestTagRepository) Read(ctx context.Context, in *TestTag, fs *query.FieldSelection) (*TestTag, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of TestTag doesn't support field selection")
+	}
+	return DefaultReadTestTag(ctx, in, r.DB)
+}
+
+func (r *GormTestTagRepository) StrictUpdate(ctx context.Context, in *TestTag) (*TestTag, error) {
+	return DefaultStrictUpdateTestTag(ctx, in, r.DB)
+}
+
+func (r *GormTestTagRepository) Patch(ctx context.Context, in *TestTag, updateMask *field_mask.FieldMask) (*TestTag, error) {
+	return DefaultPatchTestTag(ctx, in, updateMask, r.DB)
+}
+
+func (r *GormTestTagRepository) PatchSet(ctx context.Context, objects []*TestTag, updateMasks []*field_mask.FieldMask) ([]*TestTag, error) {
+	return DefaultPatchSetTestTag(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormTestTagRepository) Delete(ctx context.Context, in *TestTag) error {
+	return DefaultDeleteTestTag(ctx, in, r.DB)
+}
+
+func (r *GormTestTagRepository) DeleteSet(ctx context.Context, in []*TestTag) error {
+	return DefaultDeleteTestTagSet(ctx, in, r.DB)
+}
+
+func (r *GormTestTagRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TestTag, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestTag doesn't support filtering")
+	}
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestTag doesn't support sorting")
+	}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestTag doesn't support pagination")
+	}
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestTag doesn't support field selection")
+	}
+	return DefaultListTestTag(ctx, r.DB)
+}
+
+func (r *GormTestTagRepository) PageToken(ctx context.Context, last *TestTag, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	keyset, err := paging.NewKeyset(r.DB, &TestTagORM{}, s, f)
+	if err != nil {
+		return "", err
+	}
+	return keyset.Token(&ormObj)
+}
+
+func (r *GormTestTagRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of TestTag doesn't support filtering")
+	}
+	return DefaultCountTestTag(ctx, r.DB, strategy)
+}
+
+func (r *GormTestTagRepository) Upsert(ctx context.Context, in *TestTag, target string, updateMask *field_mask.FieldMask) (*TestTag, error) {
+	return DefaultUpsertTestTag(ctx, in, target, updateMask, r.DB)
+}
+
+// MemoryTestTagRepository is the TestTagRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryTestTagRepository struct {
+	table memory.Table
+}
+
+// NewMemoryTestTagRepository returns an empty MemoryTestTagRepository
+func NewMemoryTestTagRepository() *MemoryTestTagRepository {
+	return &MemoryTestTagRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryTestTagRepository) row(ctx context.Context, in, stored *TestTag) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: nil, Key: ormObj.Id, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryTestTagRepository) tenant(ctx context.Context) (interface{}, error) {
+	_, err := (&TestTag{}).ToORM(ctx)
+	return nil, err
+}
+
+func (r *MemoryTestTagRepository) Create(ctx context.Context, in *TestTag) (*TestTag, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*TestTag), nil
+}
+
+func (r *MemoryTestTagRepository) CreateSet(ctx context.Context, in []*TestTag, batchSize int) ([]*TestTag, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*TestTag, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*TestTag))
+	}
+	if err := r.table.Insert(rows...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *MemoryTestTagRepository) Read(ctx context.Context, in *TestTag, fs *query.FieldSelection) (*TestTag, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	out, ok := r.table.Get(nil, ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*TestTag), nil
+}
+
+func (r *MemoryTestTagRepository) StrictUpdate(ctx context.Context, in *TestTag) (*TestTag, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.table.Save(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*TestTag), nil
+}
+
+func (r *MemoryTestTagRepository) Patch(ctx context.Context, in *TestTag, updateMask *field_mask.FieldMask) (*TestTag, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	patchee, err := r.Read(ctx, &TestTag{Id: in.GetId()}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if patchee, err = DefaultApplyFieldMaskTestTag(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
+	}
+	return r.StrictUpdate(ctx, patchee)
+}
+
+func (r *MemoryTestTagRepository) PatchSet(ctx context.Context, objects []*TestTag, updateMasks []*field_mask.FieldMask) ([]*TestTag, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	out := make([]*TestTag, 0, len(objects))
+	for i, patcher := range objects {
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, patched)
+	}
+	return out, nil
+}
+
+func (r *MemoryTestTagRepository) Delete(ctx context.Context, in *TestTag) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(nil, ormObj.Id)
+	return nil
+}
+
+func (r *MemoryTestTagRepository) DeleteSet(ctx context.Context, in []*TestTag) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryTestTagRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TestTag, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*TestTag, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*TestTag))
+	}
+	return out, nil
+}
+
+func (r *MemoryTestTagRepository) PageToken(ctx context.Context, last *TestTag, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryTestTagRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryTestTagRepository) Upsert(ctx context.Context, in *TestTag, target string, updateMask *field_mask.FieldMask) (*TestTag, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := TestTagConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for TestTag", target)
+	}
+	if _, err := upsertTestTagColumns(updateMask); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found, ok, err := r.table.Conflicting(nil, func(m proto.Message) bool {
+		other, err := m.(*TestTag).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r.Create(ctx, in)
+	}
+	existing := found.(*TestTag)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskTestTag(ctx, existing, in, updateMask, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		return r.StrictUpdate(ctx, patched)
+	}
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*TestTag)
+	updated.Id = existing.Id
+	return r.StrictUpdate(ctx, updated)
+}
+
+// TestTagFixtureOption customizes the object returned by NewTestTagFixture
+type TestTagFixtureOption func(*TestTag)
+
+// NewTestTagFixture returns a TestTag with random valid values in its fields and
+// its required associations, customized by opts
+func NewTestTagFixture(opts ...TestTagFixtureOption) *TestTag {
+	m := &TestTag{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CreateTestTagFixture creates the object returned by NewTestTagFixture and its
+// associations with DefaultCreateTestTag
+func CreateTestTagFixture(ctx context.Context, db *gorm.DB, opts ...TestTagFixtureOption) (*TestTag, error) {
+	return DefaultCreateTestTag(ctx, NewTestTagFixture(opts...), db)
+}
+
+// DefaultCreateTestAssocHandlerDefault executes a basic gorm create call
+func DefaultCreateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerDefaultORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateTestAssocHandlerDefaultSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateTestAssocHandlerDefaultSet(ctx context.Context, in []*TestAssocHandlerDefault, db *gorm.DB, batchSize int) ([]*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*TestAssocHandlerDefaultORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&TestAssocHandlerDefaultORM{})).(TestAssocHandlerDefaultORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(TestAssocHandlerDefaultORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(TestAssocHandlerDefaultORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&TestAssocHandlerDefaultORM{})).(TestAssocHandlerDefaultORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*TestAssocHandlerDefault, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type TestAssocHandlerDefaultORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*TestAssocHandlerDefaultORM, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*TestAssocHandlerDefaultORM, *gorm.DB) error
+}
+
+func DefaultReadTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelectionEx(ctx, db, nil, &TestAssocHandlerDefaultORM{}, preload.NewConverter(&TestAssocHandlerDefault{})); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TestAssocHandlerDefaultORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerDefaultORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerDefaultORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&TestAssocHandlerDefaultORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type TestAssocHandlerDefaultORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTestAssocHandlerDefaultSet(ctx context.Context, in []*TestAssocHandlerDefault, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&TestAssocHandlerDefaultORM{})).(TestAssocHandlerDefaultORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerDefaultORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&TestAssocHandlerDefaultORM{})).(TestAssocHandlerDefaultORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type TestAssocHandlerDefaultORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TestAssocHandlerDefault, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TestAssocHandlerDefault, *gorm.DB) error
+}
+
+// DefaultStrictUpdateTestAssocHandlerDefault clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerDefault")
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &TestAssocHandlerDefaultORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterTestTagAssoc := TestTagAssociationORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterTestTagAssoc.TestAssocHandlerDefaultId = new(string)
+	*filterTestTagAssoc.TestAssocHandlerDefaultId = ormObj.Id
+	if err = db.Where(filterTestTagAssoc).Delete(TestTagAssociationORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type TestAssocHandlerDefaultORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTestAssocHandlerDefault executes a basic gorm update call with patch behavior
+func DefaultPatchTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj TestAssocHandlerDefault
+	var err error
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerDefaultWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadTestAssocHandlerDefault(ctx, &TestAssocHandlerDefault{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerDefaultWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskTestAssocHandlerDefault(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerDefaultWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateTestAssocHandlerDefault(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TestAssocHandlerDefaultWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TestAssocHandlerDefaultWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTestAssocHandlerDefault executes a bulk gorm update call with patch behavior
+func DefaultPatchSetTestAssocHandlerDefault(ctx context.Context, objects []*TestAssocHandlerDefault, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerDefault, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*TestAssocHandlerDefault, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchTestAssocHandlerDefault(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskTestAssocHandlerDefault patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestAssocHandlerDefault(ctx context.Context, patchee *TestAssocHandlerDefault, patcher *TestAssocHandlerDefault, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"TestTagAssoc" {
+			patchee.TestTagAssoc = patcher.TestTagAssoc
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListTestAssocHandlerDefault executes a gorm list call
+func DefaultListTestAssocHandlerDefault(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerDefault, error) {
+	in := TestAssocHandlerDefault{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &TestAssocHandlerDefaultORM{}, preload.NewConverter(&TestAssocHandlerDefault{}), nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []TestAssocHandlerDefaultORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*TestAssocHandlerDefault{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TestAssocHandlerDefaultORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerDefaultORM) error
+}
+
+// DefaultCountTestAssocHandlerDefault returns the number of rows DefaultListTestAssocHandlerDefault pages through
+func DefaultCountTestAssocHandlerDefault(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := TestAssocHandlerDefault{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	return paging.Count(db.Where(&ormObj), &TestAssocHandlerDefaultORM{}, strategy)
+}
+
+type TestAssocHandlerDefaultORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// TestAssocHandlerDefaultConflictTargets maps the conflict targets accepted by DefaultUpsertTestAssocHandlerDefault to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var TestAssocHandlerDefaultConflictTargets = map[string][]string{
+	"id": {"id"},
+}
+
+// DefaultUpsertTestAssocHandlerDefault inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	conflict := insert.OnConflict{
+		Columns:   TestAssocHandlerDefaultConflictTargets[target],
+		UpdateAll: updateMask == nil,
+	}
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for TestAssocHandlerDefault", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertTestAssocHandlerDefaultColumns(updateMask)
+		if err != nil {
+			return nil, err
+		}
+		conflict.Update = columns
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerDefaultORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// upsertTestAssocHandlerDefaultColumns returns the columns of the fields of updateMask the upserts of
+// TestAssocHandlerDefault overwrite, the immutable fields and the paths of no column being rejected
+func upsertTestAssocHandlerDefaultColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
+		}
+	}
+	return columns, nil
+}
+
+// TestAssocHandlerDefaultRepository runs the Default operations of TestAssocHandlerDefault, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type TestAssocHandlerDefaultRepository interface {
+	Create(ctx context.Context, in *TestAssocHandlerDefault) (*TestAssocHandlerDefault, error)
+	CreateSet(ctx context.Context, in []*TestAssocHandlerDefault, batchSize int) ([]*TestAssocHandlerDefault, error)
+	Read(ctx context.Context, in *TestAssocHandlerDefault, fs *query.FieldSelection) (*TestAssocHandlerDefault, error)
+	StrictUpdate(ctx context.Context, in *TestAssocHandlerDefault) (*TestAssocHandlerDefault, error)
+	Patch(ctx context.Context, in *TestAssocHandlerDefault, updateMask *field_mask.FieldMask) (*TestAssocHandlerDefault, error)
+	PatchSet(ctx context.Context, objects []*TestAssocHandlerDefault, updateMasks []*field_mask.FieldMask) ([]*TestAssocHandlerDefault, error)
+	Delete(ctx context.Context, in *TestAssocHandlerDefault) error
+	DeleteSet(ctx context.Context, in []*TestAssocHandlerDefault) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TestAssocHandlerDefault, error)
+	PageToken(ctx context.Context, last *TestAssocHandlerDefault, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *TestAssocHandlerDefault, target string, updateMask *field_mask.FieldMask) (*TestAssocHandlerDefault, error)
+}
+
+// GormTestAssocHandlerDefaultRepository is the TestAssocHandlerDefaultRepository calling the Default handlers with DB
+type GormTestAssocHandlerDefaultRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormTestAssocHandlerDefaultRepository returns the TestAssocHandlerDefaultRepository running the operations with db
+func NewGormTestAssocHandlerDefaultRepository(db *gorm.DB) *GormTestAssocHandlerDefaultRepository {
+	return &GormTestAssocHandlerDefaultRepository{DB: db}
+}
+
+func (r *GormTestAssocHandlerDefaultRepository) Create(ctx context.Context, in *TestAssocHandlerDefault) (*TestAssocHandlerDefault, error) {
+	return DefaultCreateTestAssocHandlerDefault(ctx, in, r.DB)
+}
+
+func (r *GormTestAssocHandlerDefaultRepository) CreateSet(ctx context.Context, in []*TestAssocHandlerDefault, batchSize int) ([]*TestAssocHandlerDefault, error) {
+	return DefaultCreateTestAssocHandlerDefaultSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormTestAssocHandlerDefaultRepository) Read(ctx context.Context, in *TestAssocHandlerDefault, fs *query.FieldSelection) (*TestAssocHandlerDefault, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of TestAssocHandlerDefault doesn't support field selection")
+	}
+	return DefaultReadTestAssocHandlerDefault(ctx, in, r.DB)
+}
+
+func (r *GormTestAssocHandlerDefaultRepository) StrictUpdate(ctx context.Context, in *TestAssocHandlerDefault) (*TestAssocHandlerDefault, error) {
+	return DefaultStrictUpdateTestAssocHandlerDefault(ctx, in, r.DB)
+}
+
+func (r *GormTestAssocHandlerDefaultRepository) Patch(ctx context.Context, in *TestAssocHandlerDefault, updateMask *field_mask.FieldMask) (*TestAssocHandlerDefault, error) {
+	return DefaultPatchTestAssocHandlerDefault(ctx, in, updateMask, r.DB)
+}
+
+func (r *GormTestAssocHandlerDefaultRepository) PatchSet(ctx context.Context, objects []*TestAssocHandlerDefault, updateMasks []*field_mask.FieldMask) ([]*TestAssocHandlerDefault, error) {
+	return DefaultPatchSetTestAssocHandlerDefault(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormTestAssocHandlerDefaultRepository) Delete(ctx context.Context, in *TestAssocHandlerDefault) error {
+	return DefaultDeleteTestAssocHandlerDefault(ctx, in, r.DB)
+}
+
+func (r *GormTestAssocHandlerDefaultRepository) DeleteSet(ctx context.Context, in []*TestAssocHandlerDefault) error {
+	return DefaultDeleteTestAssocHandlerDefaultSet(ctx, in, r.DB)
+}
+
+func (r *GormTestAssocHandlerDefaultRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TestAssocHandlerDefault, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestAssocHandlerDefault doesn't support filtering")
+	}
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestAssocHandlerDefault doesn't support sorting")
+	}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestAssocHandlerDefault doesn't support pagination")
+	}
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestAssocHandlerDefault doesn't support field selection")
+	}
+	return DefaultListTestAssocHandlerDefault(ctx, r.DB)
+}
+
+func (r *GormTestAssocHandlerDefaultRepository) PageToken(ctx context.Context, last *TestAssocHandlerDefault, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	keyset, err := paging.NewKeyset(r.DB, &TestAssocHandlerDefaultORM{}, s, f)
+	if err != nil {
+		return "", err
+	}
+	return keyset.Token(&ormObj)
+}
+
+func (r *GormTestAssocHandlerDefaultRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of TestAssocHandlerDefault doesn't support filtering")
+	}
+	return DefaultCountTestAssocHandlerDefault(ctx, r.DB, strategy)
+}
+
+func (r *GormTestAssocHandlerDefaultRepository) Upsert(ctx context.Context, in *TestAssocHandlerDefault, target string, updateMask *field_mask.FieldMask) (*TestAssocHandlerDefault, error) {
+	return DefaultUpsertTestAssocHandlerDefault(ctx, in, target, updateMask, r.DB)
+}
+
+// MemoryTestAssocHandlerDefaultRepository is the TestAssocHandlerDefaultRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryTestAssocHandlerDefaultRepository struct {
+	table memory.Table
+}
+
+// NewMemoryTestAssocHandlerDefaultRepository returns an empty MemoryTestAssocHandlerDefaultRepository
+func NewMemoryTestAssocHandlerDefaultRepository() *MemoryTestAssocHandlerDefaultRepository {
+	return &MemoryTestAssocHandlerDefaultRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryTestAssocHandlerDefaultRepository) row(ctx context.Context, in, stored *TestAssocHandlerDefault) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: nil, Key: ormObj.Id, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryTestAssocHandlerDefaultRepository) tenant(ctx context.Context) (interface{}, error) {
+	_, err := (&TestAssocHandlerDefault{}).ToORM(ctx)
+	return nil, err
+}
+
+func (r *MemoryTestAssocHandlerDefaultRepository) Create(ctx context.Context, in *TestAssocHandlerDefault) (*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*TestAssocHandlerDefault), nil
+}
+
+func (r *MemoryTestAssocHandlerDefaultRepository) CreateSet(ctx context.Context, in []*TestAssocHandlerDefault, batchSize int) ([]*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*TestAssocHandlerDefault, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*TestAssocHandlerDefault))
+	}
+	if err := r.table.Insert(rows...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *MemoryTestAssocHandlerDefaultRepository) Read(ctx context.Context, in *TestAssocHandlerDefault, fs *query.FieldSelection) (*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	out, ok := r.table.Get(nil, ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*TestAssocHandlerDefault), nil
+}
+
+func (r *MemoryTestAssocHandlerDefaultRepository) StrictUpdate(ctx context.Context, in *TestAssocHandlerDefault) (*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.table.Save(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*TestAssocHandlerDefault), nil
+}
+
+func (r *MemoryTestAssocHandlerDefaultRepository) Patch(ctx context.Context, in *TestAssocHandlerDefault, updateMask *field_mask.FieldMask) (*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	patchee, err := r.Read(ctx, &TestAssocHandlerDefault{Id: in.GetId()}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if patchee, err = DefaultApplyFieldMaskTestAssocHandlerDefault(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
+	}
+	return r.StrictUpdate(ctx, patchee)
+}
+
+func (r *MemoryTestAssocHandlerDefaultRepository) PatchSet(ctx context.Context, objects []*TestAssocHandlerDefault, updateMasks []*field_mask.FieldMask) ([]*TestAssocHandlerDefault, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	out := make([]*TestAssocHandlerDefault, 0, len(objects))
+	for i, patcher := range objects {
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, patched)
+	}
+	return out, nil
+}
+
+func (r *MemoryTestAssocHandlerDefaultRepository) Delete(ctx context.Context, in *TestAssocHandlerDefault) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(nil, ormObj.Id)
+	return nil
+}
+
+func (r *MemoryTestAssocHandlerDefaultRepository) DeleteSet(ctx context.Context, in []*TestAssocHandlerDefault) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryTestAssocHandlerDefaultRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TestAssocHandlerDefault, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*TestAssocHandlerDefault, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*TestAssocHandlerDefault))
+	}
+	return out, nil
+}
+
+func (r *MemoryTestAssocHandlerDefaultRepository) PageToken(ctx context.Context, last *TestAssocHandlerDefault, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryTestAssocHandlerDefaultRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryTestAssocHandlerDefaultRepository) Upsert(ctx context.Context, in *TestAssocHandlerDefault, target string, updateMask *field_mask.FieldMask) (*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := TestAssocHandlerDefaultConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for TestAssocHandlerDefault", target)
+	}
+	if _, err := upsertTestAssocHandlerDefaultColumns(updateMask); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found, ok, err := r.table.Conflicting(nil, func(m proto.Message) bool {
+		other, err := m.(*TestAssocHandlerDefault).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r.Create(ctx, in)
+	}
+	existing := found.(*TestAssocHandlerDefault)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskTestAssocHandlerDefault(ctx, existing, in, updateMask, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		return r.StrictUpdate(ctx, patched)
+	}
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*TestAssocHandlerDefault)
+	updated.Id = existing.Id
+	return r.StrictUpdate(ctx, updated)
+}
+
+// TestAssocHandlerDefaultFixtureOption customizes the object returned by NewTestAssocHandlerDefaultFixture
+type TestAssocHandlerDefaultFixtureOption func(*TestAssocHandlerDefault)
+
+// NewTestAssocHandlerDefaultFixture returns a TestAssocHandlerDefault with random valid values in its fields and
+// its required associations, customized by opts
+func NewTestAssocHandlerDefaultFixture(opts ...TestAssocHandlerDefaultFixtureOption) *TestAssocHandlerDefault {
+	m := &TestAssocHandlerDefault{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CreateTestAssocHandlerDefaultFixture creates the object returned by NewTestAssocHandlerDefaultFixture and its
+// associations with DefaultCreateTestAssocHandlerDefault
+func CreateTestAssocHandlerDefaultFixture(ctx context.Context, db *gorm.DB, opts ...TestAssocHandlerDefaultFixtureOption) (*TestAssocHandlerDefault, error) {
+	return DefaultCreateTestAssocHandlerDefault(ctx, NewTestAssocHandlerDefaultFixture(opts...), db)
+}
+
+// DefaultCreateTestAssocHandlerReplace executes a basic gorm create call
+func DefaultCreateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerReplaceORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateTestAssocHandlerReplaceSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateTestAssocHandlerReplaceSet(ctx context.Context, in []*TestAssocHandlerReplace, db *gorm.DB, batchSize int) ([]*TestAssocHandlerReplace, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*TestAssocHandlerReplaceORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&TestAssocHandlerReplaceORM{})).(TestAssocHandlerReplaceORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(TestAssocHandlerReplaceORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(TestAssocHandlerReplaceORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&TestAssocHandlerReplaceORM{})).(TestAssocHandlerReplaceORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*TestAssocHandlerReplace, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type TestAssocHandlerReplaceORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*TestAssocHandlerReplaceORM, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*TestAssocHandlerReplaceORM, *gorm.DB) error
+}
+
+func DefaultReadTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelectionEx(ctx, db, nil, &TestAssocHandlerReplaceORM{}, preload.NewConverter(&TestAssocHandlerReplace{})); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TestAssocHandlerReplaceORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerReplaceORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerReplaceORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&TestAssocHandlerReplaceORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type TestAssocHandlerReplaceORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTestAssocHandlerReplaceSet(ctx context.Context, in []*TestAssocHandlerReplace, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&TestAssocHandlerReplaceORM{})).(TestAssocHandlerReplaceORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerReplaceORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&TestAssocHandlerReplaceORM{})).(TestAssocHandlerReplaceORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type TestAssocHandlerReplaceORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TestAssocHandlerReplace, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TestAssocHandlerReplace, *gorm.DB) error
+}
+
+// DefaultStrictUpdateTestAssocHandlerReplace clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerReplace")
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &TestAssocHandlerReplaceORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Model(&ormObj).Association("TestTagAssoc").Replace(ormObj.TestTagAssoc).Error; err != nil {
+		return nil, err
+	}
+	ormObj.TestTagAssoc = nil
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type TestAssocHandlerReplaceORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTestAssocHandlerReplace executes a basic gorm update call with patch behavior
+func DefaultPatchTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj TestAssocHandlerReplace
+	var err error
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerReplaceWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadTestAssocHandlerReplace(ctx, &TestAssocHandlerReplace{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerReplaceWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskTestAssocHandlerReplace(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerReplaceWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateTestAssocHandlerReplace(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TestAssocHandlerReplaceWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TestAssocHandlerReplaceWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTestAssocHandlerReplace executes a bulk gorm update call with patch behavior
+func DefaultPatchSetTestAssocHandlerReplace(ctx context.Context, objects []*TestAssocHandlerReplace, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerReplace, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*TestAssocHandlerReplace, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchTestAssocHandlerReplace(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskTestAssocHandlerReplace patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestAssocHandlerReplace(ctx context.Context, patchee *TestAssocHandlerReplace, patcher *TestAssocHandlerReplace, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"TestTagAssoc" {
+			patchee.TestTagAssoc = patcher.TestTagAssoc
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListTestAssocHandlerReplace executes a gorm list call
+func DefaultListTestAssocHandlerReplace(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerReplace, error) {
+	in := TestAssocHandlerReplace{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &TestAssocHandlerReplaceORM{}, preload.NewConverter(&TestAssocHandlerReplace{}), nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []TestAssocHandlerReplaceORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*TestAssocHandlerReplace{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TestAssocHandlerReplaceORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerReplaceORM) error
+}
+
+// DefaultCountTestAssocHandlerReplace returns the number of rows DefaultListTestAssocHandlerReplace pages through
+func DefaultCountTestAssocHandlerReplace(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := TestAssocHandlerReplace{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	return paging.Count(db.Where(&ormObj), &TestAssocHandlerReplaceORM{}, strategy)
+}
+
+type TestAssocHandlerReplaceORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// TestAssocHandlerReplaceConflictTargets maps the conflict targets accepted by DefaultUpsertTestAssocHandlerReplace to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var TestAssocHandlerReplaceConflictTargets = map[string][]string{
+	"id": {"id"},
+}
+
+// DefaultUpsertTestAssocHandlerReplace inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	conflict := insert.OnConflict{
+		Columns:   TestAssocHandlerReplaceConflictTargets[target],
+		UpdateAll: updateMask == nil,
+	}
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for TestAssocHandlerReplace", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertTestAssocHandlerReplaceColumns(updateMask)
+		if err != nil {
+			return nil, err
+		}
+		conflict.Update = columns
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerReplaceORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// upsertTestAssocHandlerReplaceColumns returns the columns of the fields of updateMask the upserts of
+// TestAssocHandlerReplace overwrite, the immutable fields and the paths of no column being rejected
+func upsertTestAssocHandlerReplaceColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
+		}
+	}
+	return columns, nil
+}
+
+// TestAssocHandlerReplaceRepository runs the Default operations of TestAssocHandlerReplace, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type TestAssocHandlerReplaceRepository interface {
+	Create(ctx context.Context, in *TestAssocHandlerReplace) (*TestAssocHandlerReplace, error)
+	CreateSet(ctx context.Context, in []*TestAssocHandlerReplace, batchSize int) ([]*TestAssocHandlerReplace, error)
+	Read(ctx context.Context, in *TestAssocHandlerReplace, fs *query.FieldSelection) (*TestAssocHandlerReplace, error)
+	StrictUpdate(ctx context.Context, in *TestAssocHandlerReplace) (*TestAssocHandlerReplace, error)
+	Patch(ctx context.Context, in *TestAssocHandlerReplace, updateMask *field_mask.FieldMask) (*TestAssocHandlerReplace, error)
+	PatchSet(ctx context.Context, objects []*TestAssocHandlerReplace, updateMasks []*field_mask.FieldMask) ([]*TestAssocHandlerReplace, error)
+	Delete(ctx context.Context, in *TestAssocHandlerReplace) error
+	DeleteSet(ctx context.Context, in []*TestAssocHandlerReplace) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TestAssocHandlerReplace, error)
+	PageToken(ctx context.Context, last *TestAssocHandlerReplace, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *TestAssocHandlerReplace, target string, updateMask *field_mask.FieldMask) (*TestAssocHandlerReplace, error)
+}
+
+// GormTestAssocHandlerReplaceRepository is the TestAssocHandlerReplaceRepository calling the Default handlers with DB
+type GormTestAssocHandlerReplaceRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormTestAssocHandlerReplaceRepository returns the TestAssocHandlerReplaceRepository running the operations with db
+func NewGormTestAssocHandlerReplaceRepository(db *gorm.DB) *GormTestAssocHandlerReplaceRepository {
+	return &GormTestAssocHandlerReplaceRepository{DB: db}
+}
+
+func (r *GormTestAssocHandlerReplaceRepository) Create(ctx context.Context, in *TestAssocHandlerReplace) (*TestAssocHandlerReplace, error) {
+	return DefaultCreateTestAssocHandlerReplace(ctx, in, r.DB)
+}
+
+func (r *GormTestAssocHandlerReplaceRepository) CreateSet(ctx context.Context, in []*TestAssocHandlerReplace, batchSize int) ([]*TestAssocHandlerReplace, error) {
+	return DefaultCreateTestAssocHandlerReplaceSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormTestAssocHandlerReplaceRepository) Read(ctx context.Context, in *TestAssocHandlerReplace, fs *query.FieldSelection) (*TestAssocHandlerReplace, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of TestAssocHandlerReplace doesn't support field selection")
+	}
+	return DefaultReadTestAssocHandlerReplace(ctx, in, r.DB)
+}
+
+func (r *GormTestAssocHandlerReplaceRepository) StrictUpdate(ctx context.Context, in *TestAssocHandlerReplace) (*TestAssocHandlerReplace, error) {
+	return DefaultStrictUpdateTestAssocHandlerReplace(ctx, in, r.DB)
+}
+
+func (r *GormTestAssocHandlerReplaceRepository) Patch(ctx context.Context, in *TestAssocHandlerReplace, updateMask *field_mask.FieldMask) (*TestAssocHandlerReplace, error) {
+	return DefaultPatchTestAssocHandlerReplace(ctx, in, updateMask, r.DB)
+}
+
+func (r *GormTestAssocHandlerReplaceRepository) PatchSet(ctx context.Context, objects []*TestAssocHandlerReplace, updateMasks []*field_mask.FieldMask) ([]*TestAssocHandlerReplace, error) {
+	return DefaultPatchSetTestAssocHandlerReplace(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormTestAssocHandlerReplaceRepository) Delete(ctx context.Context, in *TestAssocHandlerReplace) error {
+	return DefaultDeleteTestAssocHandlerReplace(ctx, in, r.DB)
+}
+
+func (r *GormTestAssocHandlerReplaceRepository) DeleteSet(ctx context.Context, in []*TestAssocHandlerReplace) error {
+	return DefaultDeleteTestAssocHandlerReplaceSet(ctx, in, r.DB)
+}
+
+func (r *GormTestAssocHandlerReplaceRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TestAssocHandlerReplace, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestAssocHandlerReplace doesn't support filtering")
+	}
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestAssocHandlerReplace doesn't support sorting")
+	}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestAssocHandlerReplace doesn't support pagination")
+	}
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestAssocHandlerReplace doesn't support field selection")
+	}
+	return DefaultListTestAssocHandlerReplace(ctx, r.DB)
+}
+
+func (r *GormTestAssocHandlerReplaceRepository) PageToken(ctx context.Context, last *TestAssocHandlerReplace, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	keyset, err := paging.NewKeyset(r.DB, &TestAssocHandlerReplaceORM{}, s, f)
+	if err != nil {
+		return "", err
+	}
+	return keyset.Token(&ormObj)
+}
+
+func (r *GormTestAssocHandlerReplaceRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of TestAssocHandlerReplace doesn't support filtering")
+	}
+	return DefaultCountTestAssocHandlerReplace(ctx, r.DB, strategy)
+}
+
+func (r *GormTestAssocHandlerReplaceRepository) Upsert(ctx context.Context, in *TestAssocHandlerReplace, target string, updateMask *field_mask.FieldMask) (*TestAssocHandlerReplace, error) {
+	return DefaultUpsertTestAssocHandlerReplace(ctx, in, target, updateMask, r.DB)
+}
+
+// MemoryTestAssocHandlerReplaceRepository is the TestAssocHandlerReplaceRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryTestAssocHandlerReplaceRepository struct {
+	table memory.Table
+}
+
+// NewMemoryTestAssocHandlerReplaceRepository returns an empty MemoryTestAssocHandlerReplaceRepository
+func NewMemoryTestAssocHandlerReplaceRepository() *MemoryTestAssocHandlerReplaceRepository {
+	return &MemoryTestAssocHandlerReplaceRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryTestAssocHandlerReplaceRepository) row(ctx context.Context, in, stored *TestAssocHandlerReplace) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: nil, Key: ormObj.Id, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryTestAssocHandlerReplaceRepository) tenant(ctx context.Context) (interface{}, error) {
+	_, err := (&TestAssocHandlerReplace{}).ToORM(ctx)
+	return nil, err
+}
+
+func (r *MemoryTestAssocHandlerReplaceRepository) Create(ctx context.Context, in *TestAssocHandlerReplace) (*TestAssocHandlerReplace, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*TestAssocHandlerReplace), nil
+}
+
+func (r *MemoryTestAssocHandlerReplaceRepository) CreateSet(ctx context.Context, in []*TestAssocHandlerReplace, batchSize int) ([]*TestAssocHandlerReplace, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*TestAssocHandlerReplace, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*TestAssocHandlerReplace))
+	}
+	if err := r.table.Insert(rows...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *MemoryTestAssocHandlerReplaceRepository) Read(ctx context.Context, in *TestAssocHandlerReplace, fs *query.FieldSelection) (*TestAssocHandlerReplace, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	out, ok := r.table.Get(nil, ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*TestAssocHandlerReplace), nil
+}
+
+func (r *MemoryTestAssocHandlerReplaceRepository) StrictUpdate(ctx context.Context, in *TestAssocHandlerReplace) (*TestAssocHandlerReplace, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.table.Save(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*TestAssocHandlerReplace), nil
+}
+
+func (r *MemoryTestAssocHandlerReplaceRepository) Patch(ctx context.Context, in *TestAssocHandlerReplace, updateMask *field_mask.FieldMask) (*TestAssocHandlerReplace, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	patchee, err := r.Read(ctx, &TestAssocHandlerReplace{Id: in.GetId()}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if patchee, err = DefaultApplyFieldMaskTestAssocHandlerReplace(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
+	}
+	return r.StrictUpdate(ctx, patchee)
+}
+
+func (r *MemoryTestAssocHandlerReplaceRepository) PatchSet(ctx context.Context, objects []*TestAssocHandlerReplace, updateMasks []*field_mask.FieldMask) ([]*TestAssocHandlerReplace, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	out := make([]*TestAssocHandlerReplace, 0, len(objects))
+	for i, patcher := range objects {
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, patched)
+	}
+	return out, nil
+}
+
+func (r *MemoryTestAssocHandlerReplaceRepository) Delete(ctx context.Context, in *TestAssocHandlerReplace) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(nil, ormObj.Id)
+	return nil
+}
+
+func (r *MemoryTestAssocHandlerReplaceRepository) DeleteSet(ctx context.Context, in []*TestAssocHandlerReplace) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryTestAssocHandlerReplaceRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TestAssocHandlerReplace, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*TestAssocHandlerReplace, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*TestAssocHandlerReplace))
+	}
+	return out, nil
+}
+
+func (r *MemoryTestAssocHandlerReplaceRepository) PageToken(ctx context.Context, last *TestAssocHandlerReplace, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryTestAssocHandlerReplaceRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryTestAssocHandlerReplaceRepository) Upsert(ctx context.Context, in *TestAssocHandlerReplace, target string, updateMask *field_mask.FieldMask) (*TestAssocHandlerReplace, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := TestAssocHandlerReplaceConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for TestAssocHandlerReplace", target)
+	}
+	if _, err := upsertTestAssocHandlerReplaceColumns(updateMask); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found, ok, err := r.table.Conflicting(nil, func(m proto.Message) bool {
+		other, err := m.(*TestAssocHandlerReplace).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r.Create(ctx, in)
+	}
+	existing := found.(*TestAssocHandlerReplace)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskTestAssocHandlerReplace(ctx, existing, in, updateMask, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		return r.StrictUpdate(ctx, patched)
+	}
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*TestAssocHandlerReplace)
+	updated.Id = existing.Id
+	return r.StrictUpdate(ctx, updated)
+}
+
+// TestAssocHandlerReplaceFixtureOption customizes the object returned by NewTestAssocHandlerReplaceFixture
+type TestAssocHandlerReplaceFixtureOption func(*TestAssocHandlerReplace)
+
+// NewTestAssocHandlerReplaceFixture returns a TestAssocHandlerReplace with random valid values in its fields and
+// its required associations, customized by opts
+func NewTestAssocHandlerReplaceFixture(opts ...TestAssocHandlerReplaceFixtureOption) *TestAssocHandlerReplace {
+	m := &TestAssocHandlerReplace{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CreateTestAssocHandlerReplaceFixture creates the object returned by NewTestAssocHandlerReplaceFixture and its
+// associations with DefaultCreateTestAssocHandlerReplace
+func CreateTestAssocHandlerReplaceFixture(ctx context.Context, db *gorm.DB, opts ...TestAssocHandlerReplaceFixtureOption) (*TestAssocHandlerReplace, error) {
+	return DefaultCreateTestAssocHandlerReplace(ctx, NewTestAssocHandlerReplaceFixture(opts...), db)
+}
+
+// DefaultCreateTestAssocHandlerClear executes a basic gorm create call
+func DefaultCreateTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerClearORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateTestAssocHandlerClearSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateTestAssocHandlerClearSet(ctx context.Context, in []*TestAssocHandlerClear, db *gorm.DB, batchSize int) ([]*TestAssocHandlerClear, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*TestAssocHandlerClearORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&TestAssocHandlerClearORM{})).(TestAssocHandlerClearORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(TestAssocHandlerClearORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(TestAssocHandlerClearORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&TestAssocHandlerClearORM{})).(TestAssocHandlerClearORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*TestAssocHandlerClear, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type TestAssocHandlerClearORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*TestAssocHandlerClearORM, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*TestAssocHandlerClearORM, *gorm.DB) error
+}
+
+func DefaultReadTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelectionEx(ctx, db, nil, &TestAssocHandlerClearORM{}, preload.NewConverter(&TestAssocHandlerClear{})); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TestAssocHandlerClearORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerClearORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerClearORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&TestAssocHandlerClearORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type TestAssocHandlerClearORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTestAssocHandlerClearSet(ctx context.Context, in []*TestAssocHandlerClear, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&TestAssocHandlerClearORM{})).(TestAssocHandlerClearORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerClearORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&TestAssocHandlerClearORM{})).(TestAssocHandlerClearORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type TestAssocHandlerClearORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TestAssocHandlerClear, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TestAssocHandlerClear, *gorm.DB) error
+}
+
+// DefaultStrictUpdateTestAssocHandlerClear clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerClear")
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &TestAssocHandlerClearORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Model(&ormObj).Association("TestTagAssoc").Clear().Error; err != nil {
+		return nil, err
+	}
+	ormObj.TestTagAssoc = nil
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type TestAssocHandlerClearORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTestAssocHandlerClear executes a basic gorm update call with patch behavior
+func DefaultPatchTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerClear, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj TestAssocHandlerClear
+	var err error
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerClearWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadTestAssocHandlerClear(ctx, &TestAssocHandlerClear{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerClearWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskTestAssocHandlerClear(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerClearWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateTestAssocHandlerClear(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TestAssocHandlerClearWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TestAssocHandlerClearWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTestAssocHandlerClear executes a bulk gorm update call with patch behavior
+func DefaultPatchSetTestAssocHandlerClear(ctx context.Context, objects []*TestAssocHandlerClear, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerClear, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*TestAssocHandlerClear, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchTestAssocHandlerClear(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskTestAssocHandlerClear patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestAssocHandlerClear(ctx context.Context, patchee *TestAssocHandlerClear, patcher *TestAssocHandlerClear, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerClear, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"TestTagAssoc" {
+			patchee.TestTagAssoc = patcher.TestTagAssoc
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListTestAssocHandlerClear executes a gorm list call
+func DefaultListTestAssocHandlerClear(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerClear, error) {
+	in := TestAssocHandlerClear{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &TestAssocHandlerClearORM{}, preload.NewConverter(&TestAssocHandlerClear{}), nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []TestAssocHandlerClearORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*TestAssocHandlerClear{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TestAssocHandlerClearORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerClearORM) error
+}
+
+// DefaultCountTestAssocHandlerClear returns the number of rows DefaultListTestAssocHandlerClear pages through
+func DefaultCountTestAssocHandlerClear(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := TestAssocHandlerClear{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	return paging.Count(db.Where(&ormObj), &TestAssocHandlerClearORM{}, strategy)
+}
+
+type TestAssocHandlerClearORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// TestAssocHandlerClearConflictTargets maps the conflict targets accepted by DefaultUpsertTestAssocHandlerClear to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var TestAssocHandlerClearConflictTargets = map[string][]string{
+	"id": {"id"},
+}
+
+// DefaultUpsertTestAssocHandlerClear inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerClear, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	conflict := insert.OnConflict{
+		Columns:   TestAssocHandlerClearConflictTargets[target],
+		UpdateAll: updateMask == nil,
+	}
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for TestAssocHandlerClear", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertTestAssocHandlerClearColumns(updateMask)
+		if err != nil {
+			return nil, err
+		}
+		conflict.Update = columns
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerClearORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// upsertTestAssocHandlerClearColumns returns the columns of the fields of updateMask the upserts of
+// TestAssocHandlerClear overwrite, the immutable fields and the paths of no column being rejected
+func upsertTestAssocHandlerClearColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
+		}
+	}
+	return columns, nil
+}
+
+// TestAssocHandlerClearRepository runs the Default operations of TestAssocHandlerClear, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type TestAssocHandlerClearRepository interface {
+	Create(ctx context.Context, in *TestAssocHandlerClear) (*TestAssocHandlerClear, error)
+	CreateSet(ctx context.Context, in []*TestAssocHandlerClear, batchSize int) ([]*TestAssocHandlerClear, error)
+	Read(ctx context.Context, in *TestAssocHandlerClear, fs *query.FieldSelection) (*TestAssocHandlerClear, error)
+	StrictUpdate(ctx context.Context, in *TestAssocHandlerClear) (*TestAssocHandlerClear, error)
+	Patch(ctx context.Context, in *TestAssocHandlerClear, updateMask *field_mask.FieldMask) (*TestAssocHandlerClear, error)
+	PatchSet(ctx context.Context, objects []*TestAssocHandlerClear, updateMasks []*field_mask.FieldMask) ([]*TestAssocHandlerClear, error)
+	Delete(ctx context.Context, in *TestAssocHandlerClear) error
+	DeleteSet(ctx context.Context, in []*TestAssocHandlerClear) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TestAssocHandlerClear, error)
+	PageToken(ctx context.Context, last *TestAssocHandlerClear, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *TestAssocHandlerClear, target string, updateMask *field_mask.FieldMask) (*TestAssocHandlerClear, error)
+}
+
+// GormTestAssocHandlerClearRepository is the TestAssocHandlerClearRepository calling the Default handlers with DB
+type GormTestAssocHandlerClearRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormTestAssocHandlerClearRepository returns the TestAssocHandlerClearRepository running the operations with db
+func NewGormTestAssocHandlerClearRepository(db *gorm.DB) *GormTestAssocHandlerClearRepository {
+	return &GormTestAssocHandlerClearRepository{DB: db}
+}
+
+func (r *GormTestAssocHandlerClearRepository) Create(ctx context.Context, in *TestAssocHandlerClear) (*TestAssocHandlerClear, error) {
+	return DefaultCreateTestAssocHandlerClear(ctx, in, r.DB)
+}
+
+func (r *GormTestAssocHandlerClearRepository) CreateSet(ctx context.Context, in []*TestAssocHandlerClear, batchSize int) ([]*TestAssocHandlerClear, error) {
+	return DefaultCreateTestAssocHandlerClearSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormTestAssocHandlerClearRepository) Read(ctx context.Context, in *TestAssocHandlerClear, fs *query.FieldSelection) (*TestAssocHandlerClear, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of TestAssocHandlerClear doesn't support field selection")
+	}
+	return DefaultReadTestAssocHandlerClear(ctx, in, r.DB)
+}
+
+func (r *GormTestAssocHandlerClearRepository) StrictUpdate(ctx context.Context, in *TestAssocHandlerClear) (*TestAssocHandlerClear, error) {
+	return DefaultStrictUpdateTestAssocHandlerClear(ctx, in, r.DB)
+}
+
+func (r *GormTestAssocHandlerClearRepository) Patch(ctx context.Context, in *TestAssocHandlerClear, updateMask *field_mask.FieldMask) (*TestAssocHandlerClear, error) {
+	return DefaultPatchTestAssocHandlerClear(ctx, in, updateMask, r.DB)
+}
+
+func (r *GormTestAssocHandlerClearRepository) PatchSet(ctx context.Context, objects []*TestAssocHandlerClear, updateMasks []*field_mask.FieldMask) ([]*TestAssocHandlerClear, error) {
+	return DefaultPatchSetTestAssocHandlerClear(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormTestAssocHandlerClearRepository) Delete(ctx context.Context, in *TestAssocHandlerClear) error {
+	return DefaultDeleteTestAssocHandlerClear(ctx, in, r.DB)
+}
+
+func (r *GormTestAssocHandlerClearRepository) DeleteSet(ctx context.Context, in []*TestAssocHandlerClear) error {
+	return DefaultDeleteTestAssocHandlerClearSet(ctx, in, r.DB)
+}
+
+func (r *GormTestAssocHandlerClearRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TestAssocHandlerClear, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestAssocHandlerClear doesn't support filtering")
+	}
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestAssocHandlerClear doesn't support sorting")
+	}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestAssocHandlerClear doesn't support pagination")
+	}
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestAssocHandlerClear doesn't support field selection")
+	}
+	return DefaultListTestAssocHandlerClear(ctx, r.DB)
+}
+
+func (r *GormTestAssocHandlerClearRepository) PageToken(ctx context.Context, last *TestAssocHandlerClear, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	keyset, err := paging.NewKeyset(r.DB, &TestAssocHandlerClearORM{}, s, f)
+	if err != nil {
+		return "", err
+	}
+	return keyset.Token(&ormObj)
+}
+
+func (r *GormTestAssocHandlerClearRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of TestAssocHandlerClear doesn't support filtering")
+	}
+	return DefaultCountTestAssocHandlerClear(ctx, r.DB, strategy)
+}
+
+func (r *GormTestAssocHandlerClearRepository) Upsert(ctx context.Context, in *TestAssocHandlerClear, target string, updateMask *field_mask.FieldMask) (*TestAssocHandlerClear, error) {
+	return DefaultUpsertTestAssocHandlerClear(ctx, in, target, updateMask, r.DB)
+}
+
+// MemoryTestAssocHandlerClearRepository is the TestAssocHandlerClearRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryTestAssocHandlerClearRepository struct {
+	table memory.Table
+}
+
+// NewMemoryTestAssocHandlerClearRepository returns an empty MemoryTestAssocHandlerClearRepository
+func NewMemoryTestAssocHandlerClearRepository() *MemoryTestAssocHandlerClearRepository {
+	return &MemoryTestAssocHandlerClearRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryTestAssocHandlerClearRepository) row(ctx context.Context, in, stored *TestAssocHandlerClear) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: nil, Key: ormObj.Id, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryTestAssocHandlerClearRepository) tenant(ctx context.Context) (interface{}, error) {
+	_, err := (&TestAssocHandlerClear{}).ToORM(ctx)
+	return nil, err
+}
+
+func (r *MemoryTestAssocHandlerClearRepository) Create(ctx context.Context, in *TestAssocHandlerClear) (*TestAssocHandlerClear, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*TestAssocHandlerClear), nil
+}
+
+func (r *MemoryTestAssocHandlerClearRepository) CreateSet(ctx context.Context, in []*TestAssocHandlerClear, batchSize int) ([]*TestAssocHandlerClear, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*TestAssocHandlerClear, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*TestAssocHandlerClear))
+	}
+	if err := r.table.Insert(rows...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *MemoryTestAssocHandlerClearRepository) Read(ctx context.Context, in *TestAssocHandlerClear, fs *query.FieldSelection) (*TestAssocHandlerClear, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	out, ok := r.table.Get(nil, ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*TestAssocHandlerClear), nil
+}
+
+func (r *MemoryTestAssocHandlerClearRepository) StrictUpdate(ctx context.Context, in *TestAssocHandlerClear) (*TestAssocHandlerClear, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.table.Save(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*TestAssocHandlerClear), nil
+}
+
+func (r *MemoryTestAssocHandlerClearRepository) Patch(ctx context.Context, in *TestAssocHandlerClear, updateMask *field_mask.FieldMask) (*TestAssocHandlerClear, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	patchee, err := r.Read(ctx, &TestAssocHandlerClear{Id: in.GetId()}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if patchee, err = DefaultApplyFieldMaskTestAssocHandlerClear(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
+	}
+	return r.StrictUpdate(ctx, patchee)
+}
+
+func (r *MemoryTestAssocHandlerClearRepository) PatchSet(ctx context.Context, objects []*TestAssocHandlerClear, updateMasks []*field_mask.FieldMask) ([]*TestAssocHandlerClear, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	out := make([]*TestAssocHandlerClear, 0, len(objects))
+	for i, patcher := range objects {
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, patched)
+	}
+	return out, nil
+}
+
+func (r *MemoryTestAssocHandlerClearRepository) Delete(ctx context.Context, in *TestAssocHandlerClear) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(nil, ormObj.Id)
+	return nil
+}
+
+func (r *MemoryTestAssocHandlerClearRepository) DeleteSet(ctx context.Context, in []*TestAssocHandlerClear) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryTestAssocHandlerClearRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TestAssocHandlerClear, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*TestAssocHandlerClear, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*TestAssocHandlerClear))
+	}
+	return out, nil
+}
+
+func (r *MemoryTestAssocHandlerClearRepository) PageToken(ctx context.Context, last *TestAssocHandlerClear, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryTestAssocHandlerClearRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryTestAssocHandlerClearRepository) Upsert(ctx context.Context, in *TestAssocHandlerClear, target string, updateMask *field_mask.FieldMask) (*TestAssocHandlerClear, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := TestAssocHandlerClearConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for TestAssocHandlerClear", target)
+	}
+	if _, err := upsertTestAssocHandlerClearColumns(updateMask); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found, ok, err := r.table.Conflicting(nil, func(m proto.Message) bool {
+		other, err := m.(*TestAssocHandlerClear).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r.Create(ctx, in)
+	}
+	existing := found.(*TestAssocHandlerClear)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskTestAssocHandlerClear(ctx, existing, in, updateMask, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		return r.StrictUpdate(ctx, patched)
+	}
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*TestAssocHandlerClear)
+	updated.Id = existing.Id
+	return r.StrictUpdate(ctx, updated)
+}
+
+// TestAssocHandlerClearFixtureOption customizes the object returned by NewTestAssocHandlerClearFixture
+type TestAssocHandlerClearFixtureOption func(*TestAssocHandlerClear)
+
+// NewTestAssocHandlerClearFixture returns a TestAssocHandlerClear with random valid values in its fields and
+// its required associations, customized by opts
+func NewTestAssocHandlerClearFixture(opts ...TestAssocHandlerClearFixtureOption) *TestAssocHandlerClear {
+	m := &TestAssocHandlerClear{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CreateTestAssocHandlerClearFixture creates the object returned by NewTestAssocHandlerClearFixture and its
+// associations with DefaultCreateTestAssocHandlerClear
+func CreateTestAssocHandlerClearFixture(ctx context.Context, db *gorm.DB, opts ...TestAssocHandlerClearFixtureOption) (*TestAssocHandlerClear, error) {
+	return DefaultCreateTestAssocHandlerClear(ctx, NewTestAssocHandlerClearFixture(opts...), db)
+}
+
+// DefaultCreateTestAssocHandlerAppend executes a basic gorm create call
+func DefaultCreateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerAppendORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerAppendORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateTestAssocHandlerAppendSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateTestAssocHandlerAppendSet(ctx context.Context, in []*TestAssocHandlerAppend, db *gorm.DB, batchSize int) ([]*TestAssocHandlerAppend, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*TestAssocHandlerAppendORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&TestAssocHandlerAppendORM{})).(TestAssocHandlerAppendORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(TestAssocHandlerAppendORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(TestAssocHandlerAppendORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&TestAssocHandlerAppendORM{})).(TestAssocHandlerAppendORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*TestAssocHandlerAppend, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type TestAssocHandlerAppendORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*TestAssocHandlerAppendORM, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerAppendORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*TestAssocHandlerAppendORM, *gorm.DB) error
+}
+
+func DefaultReadTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelectionEx(ctx, db, nil, &TestAssocHandlerAppendORM{}, preload.NewConverter(&TestAssocHandlerAppend{})); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TestAssocHandlerAppendORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerAppendORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerAppendORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerAppendORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerAppendORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&TestAssocHandlerAppendORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type TestAssocHandlerAppendORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerAppendORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTestAssocHandlerAppendSet(ctx context.Context, in []*TestAssocHandlerAppend, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&TestAssocHandlerAppendORM{})).(TestAssocHandlerAppendORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerAppendORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&TestAssocHandlerAppendORM{})).(TestAssocHandlerAppendORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type TestAssocHandlerAppendORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TestAssocHandlerAppend, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerAppendORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TestAssocHandlerAppend, *gorm.DB) error
+}
+
+// DefaultStrictUpdateTestAssocHandlerAppend clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerAppend")
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &TestAssocHandlerAppendORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Model(&ormObj).Association("TestTagAssoc").Append(ormObj.TestTagAssoc).Error; err != nil {
+		return nil, err
+	}
+	ormObj.TestTagAssoc = nil
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type TestAssocHandlerAppendORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerAppendORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerAppendORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTestAssocHandlerAppend executes a basic gorm update call with patch behavior
+func DefaultPatchTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj TestAssocHandlerAppend
+	var err error
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerAppendWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadTestAssocHandlerAppend(ctx, &TestAssocHandlerAppend{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerAppendWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskTestAssocHandlerAppend(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerAppendWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateTestAssocHandlerAppend(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TestAssocHandlerAppendWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TestAssocHandlerAppendWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerAppendWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerAppendWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerAppendWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTestAssocHandlerAppend executes a bulk gorm update call with patch behavior
+func DefaultPatchSetTestAssocHandlerAppend(ctx context.Context, objects []*TestAssocHandlerAppend, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerAppend, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*TestAssocHandlerAppend, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchTestAssocHandlerAppend(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskTestAssocHandlerAppend patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestAssocHandlerAppend(ctx context.Context, patchee *TestAssocHandlerAppend, patcher *TestAssocHandlerAppend, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"TestTagAssoc" {
+			patchee.TestTagAssoc = patcher.TestTagAssoc
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListTestAssocHandlerAppend executes a gorm list call
+func DefaultListTestAssocHandlerAppend(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerAppend, error) {
+	in := TestAssocHandlerAppend{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &TestAssocHandlerAppendORM{}, preload.NewConverter(&TestAssocHandlerAppend{}), nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []TestAssocHandlerAppendORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*TestAssocHandlerAppend{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TestAssocHandlerAppendORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerAppendORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerAppendORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerAppendORM) error
+}
+
+// DefaultCountTestAssocHandlerAppend returns the number of rows DefaultListTestAssocHandlerAppend pages through
+func DefaultCountTestAssocHandlerAppend(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := TestAssocHandlerAppend{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	return paging.Count(db.Where(&ormObj), &TestAssocHandlerAppendORM{}, strategy)
+}
+
+type TestAssocHandlerAppendORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// TestAssocHandlerAppendConflictTargets maps the conflict targets accepted by DefaultUpsertTestAssocHandlerAppend to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var TestAssocHandlerAppendConflictTargets = map[string][]string{
+	"id": {"id"},
+}
+
+// DefaultUpsertTestAssocHandlerAppend inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	conflict := insert.OnConflict{
+		Columns:   TestAssocHandlerAppendConflictTargets[target],
+		UpdateAll: updateMask == nil,
+	}
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for TestAssocHandlerAppend", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertTestAssocHandlerAppendColumns(updateMask)
+		if err != nil {
+			return nil, err
+		}
+		conflict.Update = columns
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerAppendORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerAppendORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// upsertTestAssocHandlerAppendColumns returns the columns of the fields of updateMask the upserts of
+// TestAssocHandlerAppend overwrite, the immutable fields and the paths of no column being rejected
+func upsertTestAssocHandlerAppendColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
+		}
+	}
+	return columns, nil
+}
+
+// TestAssocHandlerAppendRepository runs the Default operations of TestAssocHandlerAppend, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type TestAssocHandlerAppendRepository interface {
+	Create(ctx context.Context, in *TestAssocHandlerAppend) (*TestAssocHandlerAppend, error)
+	CreateSet(ctx context.Context, in []*TestAssocHandlerAppend, batchSize int) ([]*TestAssocHandlerAppend, error)
+	Read(ctx context.Context, in *TestAssocHandlerAppend, fs *query.FieldSelection) (*TestAssocHandlerAppend, error)
+	StrictUpdate(ctx context.Context, in *TestAssocHandlerAppend) (*TestAssocHandlerAppend, error)
+	Patch(ctx context.Context, in *TestAssocHandlerAppend, updateMask *field_mask.FieldMask) (*TestAssocHandlerAppend, error)
+	PatchSet(ctx context.Context, objects []*TestAssocHandlerAppend, updateMasks []*field_mask.FieldMask) ([]*TestAssocHandlerAppend, error)
+	Delete(ctx context.Context, in *TestAssocHandlerAppend) error
+	DeleteSet(ctx context.Context, in []*TestAssocHandlerAppend) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TestAssocHandlerAppend, error)
+	PageToken(ctx context.Context, last *TestAssocHandlerAppend, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *TestAssocHandlerAppend, target string, updateMask *field_mask.FieldMask) (*TestAssocHandlerAppend, error)
+}
+
+// GormTestAssocHandlerAppendRepository is the TestAssocHandlerAppendRepository calling the Default handlers with DB
+type GormTestAssocHandlerAppendRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormTestAssocHandlerAppendRepository returns the TestAssocHandlerAppendRepository running the operations with db
+func NewGormTestAssocHandlerAppendRepository(db *gorm.DB) *GormTestAssocHandlerAppendRepository {
+	return &GormTestAssocHandlerAppendRepository{DB: db}
+}
+
+func (r *GormTestAssocHandlerAppendRepository) Create(ctx context.Context, in *TestAssocHandlerAppend) (*TestAssocHandlerAppend, error) {
+	return DefaultCreateTestAssocHandlerAppend(ctx, in, r.DB)
+}
+
+func (r *GormTestAssocHandlerAppendRepository) CreateSet(ctx context.Context, in []*TestAssocHandlerAppend, batchSize int) ([]*TestAssocHandlerAppend, error) {
+	return DefaultCreateTestAssocHandlerAppendSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormTestAssocHandlerAppendRepository) Read(ctx context.Context, in *TestAssocHandlerAppend, fs *query.FieldSelection) (*TestAssocHandlerAppend, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of TestAssocHandlerAppend doesn't support field selection")
+	}
+	return DefaultReadTestAssocHandlerAppend(ctx, in, r.DB)
+}
+
+func (r *GormTestAssocHandlerAppendRepository) StrictUpdate(ctx context.Context, in *TestAssocHandlerAppend) (*TestAssocHandlerAppend, error) {
+	return DefaultStrictUpdateTestAssocHandlerAppend(ctx, in, r.DB)
+}
+
+func (r *GormTestAssocHandlerAppendRepository) Patch(ctx context.Context, in *TestAssocHandlerAppend, updateMask *field_mask.FieldMask) (*TestAssocHandlerAppend, error) {
+	return DefaultPatchTestAssocHandlerAppend(ctx, in, updateMask, r.DB)
+}
+
+func (r *GormTestAssocHandlerAppendRepository) PatchSet(ctx context.Context, objects []*TestAssocHandlerAppend, updateMasks []*field_mask.FieldMask) ([]*TestAssocHandlerAppend, error) {
+	return DefaultPatchSetTestAssocHandlerAppend(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormTestAssocHandlerAppendRepository) Delete(ctx context.Context, in *TestAssocHandlerAppend) error {
+	return DefaultDeleteTestAssocHandlerAppend(ctx, in, r.DB)
+}
+
+func (r *GormTestAssocHandlerAppendRepository) DeleteSet(ctx context.Context, in []*TestAssocHandlerAppend) error {
+	return DefaultDeleteTestAssocHandlerAppendSet(ctx, in, r.DB)
+}
+
+func (r *GormTestAssocHandlerAppendRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TestAssocHandlerAppend, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestAssocHandlerAppend doesn't support filtering")
 	}
-	if ormObj.Id == "" {
-		return errors.EmptyIdError
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestAssocHandlerAppend doesn't support sorting")
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeDelete_); ok {
-		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
-			return err
-		}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestAssocHandlerAppend doesn't support pagination")
 	}
-	err = db.Where(&ormObj).Delete(&TestTagORM{}).Error
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestAssocHandlerAppend doesn't support field selection")
+	}
+	return DefaultListTestAssocHandlerAppend(ctx, r.DB)
+}
+
+func (r *GormTestAssocHandlerAppendRepository) PageToken(ctx context.Context, last *TestAssocHandlerAppend, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
 	if err != nil {
-		return err
+		return "", err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterDelete_); ok {
-		err = hook.AfterDelete_(ctx, db)
+	keyset, err := paging.NewKeyset(r.DB, &TestAssocHandlerAppendORM{}, s, f)
+	if err != nil {
+		return "", err
 	}
-	return err
+	return keyset.Token(&ormObj)
 }
 
-type TestTagORMWithBeforeDelete_ interface {
-	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+func (r *GormTestAssocHandlerAppendRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of TestAssocHandlerAppend doesn't support filtering")
+	}
+	return DefaultCountTestAssocHandlerAppend(ctx, r.DB, strategy)
 }
-type TestTagORMWithAfterDelete_ interface {
-	AfterDelete_(context.Context, *gorm.DB) error
+
+func (r *GormTestAssocHandlerAppendRepository) Upsert(ctx context.Context, in *TestAssocHandlerAppend, target string, updateMask *field_mask.FieldMask) (*TestAssocHandlerAppend, error) {
+	return DefaultUpsertTestAssocHandlerAppend(ctx, in, target, updateMask, r.DB)
 }
 
-func DefaultDeleteTestTagSet(ctx context.Context, in []*TestTag, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
-	var err error
-	keys := []string{}
-	for _, obj := range in {
-		ormObj, err := obj.ToORM(ctx)
-		if err != nil {
-			return err
-		}
-		if ormObj.Id == "" {
-			return errors.EmptyIdError
-		}
-		keys = append(keys, ormObj.Id)
-	}
-	if hook, ok := (interface{}(&TestTagORM{})).(TestTagORMWithBeforeDeleteSet); ok {
-		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
-			return err
-		}
-	}
-	err = db.Where("id in (?)", keys).Delete(&TestTagORM{}).Error
+// MemoryTestAssocHandlerAppendRepository is the TestAssocHandlerAppendRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryTestAssocHandlerAppendRepository struct {
+	table memory.Table
+}
+
+// NewMemoryTestAssocHandlerAppendRepository returns an empty MemoryTestAssocHandlerAppendRepository
+func NewMemoryTestAssocHandlerAppendRepository() *MemoryTestAssocHandlerAppendRepository {
+	return &MemoryTestAssocHandlerAppendRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryTestAssocHandlerAppendRepository) row(ctx context.Context, in, stored *TestAssocHandlerAppend) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return err
+		return memory.Row{}, err
 	}
-	if hook, ok := (interface{}(&TestTagORM{})).(TestTagORMWithAfterDeleteSet); ok {
-		err = hook.AfterDeleteSet(ctx, in, db)
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
 	}
-	return err
+	return memory.Row{Tenant: nil, Key: ormObj.Id, Object: &out}, nil
 }
 
-type TestTagORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*TestTag, *gorm.DB) (*gorm.DB, error)
-}
-type TestTagORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*TestTag, *gorm.DB) error
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryTestAssocHandlerAppendRepository) tenant(ctx context.Context) (interface{}, error) {
+	_, err := (&TestAssocHandlerAppend{}).ToORM(ctx)
+	return nil, err
 }
 
-// DefaultStrictUpdateTestTag clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
+func (r *MemoryTestAssocHandlerAppendRepository) Create(ctx context.Context, in *TestAssocHandlerAppend) (*TestAssocHandlerAppend, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestTag")
+		return nil, errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
+	if err := in.Validate(); err != nil {
 		return nil, err
 	}
-	var count int64
-	lockedRow := &TestTagORM{}
-	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeStrictUpdateCleanup); ok {
-		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	filterTestTagAssoc := TestTagAssociationORM{}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
 	}
-	filterTestTagAssoc.TestTagId = new(string)
-	*filterTestTagAssoc.TestTagId = ormObj.Id
-	if err = db.Where(filterTestTagAssoc).Delete(TestTagAssociationORM{}).Error; err != nil {
+	if err = r.table.Insert(row); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeStrictUpdateSave); ok {
-		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+	return row.Object.(*TestAssocHandlerAppend), nil
+}
+
+func (r *MemoryTestAssocHandlerAppendRepository) CreateSet(ctx context.Context, in []*TestAssocHandlerAppend, batchSize int) ([]*TestAssocHandlerAppend, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*TestAssocHandlerAppend, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
 			return nil, err
 		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*TestAssocHandlerAppend))
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	if err := r.table.Insert(rows...); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterStrictUpdateSave); ok {
-		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
-			return nil, err
-		}
+	return out, nil
+}
+
+func (r *MemoryTestAssocHandlerAppendRepository) Read(ctx context.Context, in *TestAssocHandlerAppend, fs *query.FieldSelection) (*TestAssocHandlerAppend, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
+	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if count == 0 {
-		err = gateway.SetCreated(ctx, "")
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
 	}
-	return &pbResponse, err
-}
-
-type TestTagORMWithBeforeStrictUpdateCleanup interface {
-	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestTagORMWithBeforeStrictUpdateSave interface {
-	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestTagORMWithAfterStrictUpdateSave interface {
-	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+	out, ok := r.table.Get(nil, ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*TestAssocHandlerAppend), nil
 }
 
-// DefaultPatchTestTag executes a basic gorm update call with patch behavior
-func DefaultPatchTestTag(ctx context.Context, in *TestTag, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestTag, error) {
+func (r *MemoryTestAssocHandlerAppendRepository) StrictUpdate(ctx context.Context, in *TestAssocHandlerAppend) (*TestAssocHandlerAppend, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj TestTag
-	var err error
-	if hook, ok := interface{}(&pbObj).(TestTagWithBeforePatchRead); ok {
-		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	if err := in.Validate(); err != nil {
+		return nil, err
 	}
-	pbReadRes, err := DefaultReadTestTag(ctx, &TestTag{Id: in.GetId()}, db)
+	row, err := r.row(ctx, in, nil)
 	if err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(TestTagWithBeforePatchApplyFieldMask); ok {
-		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	if _, err := DefaultApplyFieldMaskTestTag(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err = r.table.Save(row); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(TestTagWithBeforePatchSave); ok {
-		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	return row.Object.(*TestAssocHandlerAppend), nil
+}
+
+func (r *MemoryTestAssocHandlerAppendRepository) Patch(ctx context.Context, in *TestAssocHandlerAppend, updateMask *field_mask.FieldMask) (*TestAssocHandlerAppend, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
 	}
-	pbResponse, err := DefaultStrictUpdateTestTag(ctx, &pbObj, db)
+	patchee, err := r.Read(ctx, &TestAssocHandlerAppend{Id: in.GetId()}, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(TestTagWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	if patchee, err = DefaultApplyFieldMaskTestAssocHandlerAppend(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
 	}
-	return pbResponse, nil
-}
-
-type TestTagWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestTagWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestTagWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestTagWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) error
+	return r.StrictUpdate(ctx, patchee)
 }
 
-// DefaultPatchSetTestTag executes a bulk gorm update call with patch behavior
-func DefaultPatchSetTestTag(ctx context.Context, objects []*TestTag, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestTag, error) {
+func (r *MemoryTestAssocHandlerAppendRepository) PatchSet(ctx context.Context, objects []*TestAssocHandlerAppend, updateMasks []*field_mask.FieldMask) ([]*TestAssocHandlerAppend, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*TestTag, 0, len(objects))
+	out := make([]*TestAssocHandlerAppend, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTestTag(ctx, patcher, updateMasks[i], db)
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
+		out = append(out, patched)
 	}
+	return out, nil
+}
 
-	return results, nil
+func (r *MemoryTestAssocHandlerAppendRepository) Delete(ctx context.Context, in *TestAssocHandlerAppend) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(nil, ormObj.Id)
+	return nil
 }
 
-// DefaultApplyFieldMaskTestTag patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTestTag(ctx context.Context, patchee *TestTag, patcher *TestTag, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestTag, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
+func (r *MemoryTestAssocHandlerAppendRepository) DeleteSet(ctx context.Context, in []*TestAssocHandlerAppend) error {
+	if in == nil {
+		return errors.NilArgumentError
 	}
-	var err error
-	var updatedTestTagAssoc bool
-	for i, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
-		}
-		if !updatedTestTagAssoc && strings.HasPrefix(f, prefix+"TestTagAssoc.") {
-			updatedTestTagAssoc = true
-			if patcher.TestTagAssoc == nil {
-				patchee.TestTagAssoc = nil
-				continue
-			}
-			if patchee.TestTagAssoc == nil {
-				patchee.TestTagAssoc = &TestTagAssociation{}
-			}
-			if o, err := DefaultApplyFieldMaskTestTagAssociation(ctx, patchee.TestTagAssoc, patcher.TestTagAssoc, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"TestTagAssoc.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.TestTagAssoc = o
-			}
-			continue
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
 		}
-		if f == prefix+"TestTagAssoc" {
-			updatedTestTagAssoc = true
-			patchee.TestTagAssoc = patcher.TestTagAssoc
-			continue
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
 		}
+		keys = append(keys, ormObj.Id)
+	}
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryTestAssocHandlerAppendRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TestAssocHandlerAppend, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
 	}
+	objects, err := r.table.List(tenant, f, s, p)
 	if err != nil {
 		return nil, err
 	}
-	return patchee, nil
+	out := make([]*TestAssocHandlerAppend, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*TestAssocHandlerAppend))
+	}
+	return out, nil
 }
 
-// DefaultListTestTag executes a gorm list call
-func DefaultListTestTag(ctx context.Context, db *gorm.DB) ([]*TestTag, error) {
-	in := TestTag{}
-	ormObj, err := in.ToORM(ctx)
+func (r *MemoryTestAssocHandlerAppendRepository) PageToken(ctx context.Context, last *TestAssocHandlerAppend, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
 	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
+		return "", err
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestTagORM{}, &TestTag{}, nil, nil, nil, nil)
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryTestAssocHandlerAppendRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
 	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryTestAssocHandlerAppendRepository) Upsert(ctx context.Context, in *TestAssocHandlerAppend, target string, updateMask *field_mask.FieldMask) (*TestAssocHandlerAppend, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := TestAssocHandlerAppendConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for TestAssocHandlerAppend", target)
+	}
+	if _, err := upsertTestAssocHandlerAppendColumns(updateMask); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
-			return nil, err
-		}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []TestTagORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
+	found, ok, err := r.table.Conflicting(nil, func(m proto.Message) bool {
+		other, err := m.(*TestAssocHandlerAppend).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
-			return nil, err
-		}
+	if !ok {
+		return r.Create(ctx, in)
 	}
-	pbResponse := []*TestTag{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
+	existing := found.(*TestAssocHandlerAppend)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskTestAssocHandlerAppend(ctx, existing, in, updateMask, "", nil)
 		if err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
+		return r.StrictUpdate(ctx, patched)
 	}
-	return pbResponse, nil
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*TestAssocHandlerAppend)
+	updated.Id = existing.Id
+	return r.StrictUpdate(ctx, updated)
 }
 
-type TestTagORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestTagORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+// TestAssocHandlerAppendFixtureOption customizes the object returned by NewTestAssocHandlerAppendFixture
+type TestAssocHandlerAppendFixtureOption func(*TestAssocHandlerAppend)
+
+// NewTestAssocHandlerAppendFixture returns a TestAssocHandlerAppend with random valid values in its fields and
+// its required associations, customized by opts
+func NewTestAssocHandlerAppendFixture(opts ...TestAssocHandlerAppendFixtureOption) *TestAssocHandlerAppend {
+	m := &TestAssocHandlerAppend{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
-type TestTagORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TestTagORM) error
+
+// CreateTestAssocHandlerAppendFixture creates the object returned by NewTestAssocHandlerAppendFixture and its
+// associations with DefaultCreateTestAssocHandlerAppend
+func CreateTestAssocHandlerAppendFixture(ctx context.Context, db *gorm.DB, opts ...TestAssocHandlerAppendFixtureOption) (*TestAssocHandlerAppend, error) {
+	return DefaultCreateTestAssocHandlerAppend(ctx, NewTestAssocHandlerAppendFixture(opts...), db)
 }
 
-// DefaultCreateTestAssocHandlerDefault executes a basic gorm create call
-func DefaultCreateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+// DefaultCreateTestTagAssociation executes a basic gorm create call
+func DefaultCreateTestTagAssociation(ctx context.Context, in *TestTagAssociation, db *gorm.DB) (*TestTagAssociation, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3629,7 +12130,7 @@ func DefaultCreateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHand
 	if err = db.Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3638,351 +12139,362 @@ func DefaultCreateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHand
 	return &pbResponse, err
 }
 
-type TestAssocHandlerDefaultORMWithBeforeCreate_ interface {
+type TestTagAssociationORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultORMWithAfterCreate_ interface {
+type TestTagAssociationORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+// DefaultCreateTestTagAssociationSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateTestTagAssociationSet(ctx context.Context, in []*TestTagAssociation, db *gorm.DB, batchSize int) ([]*TestTagAssociation, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
-	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeReadApplyQuery); ok {
-		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
-			return nil, err
+	ormObjs := make([]*TestTagAssociationORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
 		}
-	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TestAssocHandlerDefaultORM{}); err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeReadFind); ok {
-		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+		if err := obj.Validate(); err != nil {
 			return nil, err
 		}
-	}
-	ormResponse := TestAssocHandlerDefaultORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerDefaultORMWithAfterReadFind); ok {
-		if err = hook.AfterReadFind(ctx, db); err != nil {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
 			return nil, err
 		}
+		ormObjs = append(ormObjs, &ormObj)
 	}
-	pbResponse, err := ormResponse.ToPB(ctx)
-	return &pbResponse, err
-}
-
-type TestAssocHandlerDefaultORMWithBeforeReadApplyQuery interface {
-	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerDefaultORMWithBeforeReadFind interface {
-	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerDefaultORMWithAfterReadFind interface {
-	AfterReadFind(context.Context, *gorm.DB) error
-}
-
-func DefaultDeleteTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return err
-	}
-	if ormObj.Id == "" {
-		return errors.EmptyIdError
-	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeDelete_); ok {
-		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&TestTagAssociationORM{})).(TestTagAssociationORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(TestTagAssociationORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
 			return err
 		}
-	}
-	err = db.Where(&ormObj).Delete(&TestAssocHandlerDefaultORM{}).Error
-	if err != nil {
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(TestTagAssociationORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&TestTagAssociationORM{})).(TestTagAssociationORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
 		return err
+	})
+	if err != nil {
+		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterDelete_); ok {
-		err = hook.AfterDelete_(ctx, db)
+	pbResponse := make([]*TestTagAssociation, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
 	}
-	return err
+	return pbResponse, nil
 }
 
-type TestAssocHandlerDefaultORMWithBeforeDelete_ interface {
-	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+type TestTagAssociationORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*TestTagAssociationORM, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultORMWithAfterDelete_ interface {
-	AfterDelete_(context.Context, *gorm.DB) error
+type TestTagAssociationORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*TestTagAssociationORM, *gorm.DB) error
 }
 
-func DefaultDeleteTestAssocHandlerDefaultSet(ctx context.Context, in []*TestAssocHandlerDefault, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
+// DefaultApplyFieldMaskTestTagAssociation patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestTagAssociation(ctx context.Context, patchee *TestTagAssociation, patcher *TestTagAssociation, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestTagAssociation, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
 	}
 	var err error
-	keys := []string{}
-	for _, obj := range in {
-		ormObj, err := obj.ToORM(ctx)
-		if err != nil {
-			return err
-		}
-		if ormObj.Id == "" {
-			return errors.EmptyIdError
-		}
-		keys = append(keys, ormObj.Id)
-	}
-	if hook, ok := (interface{}(&TestAssocHandlerDefaultORM{})).(TestAssocHandlerDefaultORMWithBeforeDeleteSet); ok {
-		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
-			return err
+	for _, f := range updateMask.Paths {
+		if f == prefix+"SomeField" {
+			patchee.SomeField = patcher.SomeField
+			continue
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerDefaultORM{}).Error
 	if err != nil {
-		return err
-	}
-	if hook, ok := (interface{}(&TestAssocHandlerDefaultORM{})).(TestAssocHandlerDefaultORMWithAfterDeleteSet); ok {
-		err = hook.AfterDeleteSet(ctx, in, db)
+		return nil, err
 	}
-	return err
-}
-
-type TestAssocHandlerDefaultORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*TestAssocHandlerDefault, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerDefaultORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*TestAssocHandlerDefault, *gorm.DB) error
+	return patchee, nil
 }
 
-// DefaultStrictUpdateTestAssocHandlerDefault clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
-	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerDefault")
-	}
+// DefaultListTestTagAssociation executes a gorm list call
+func DefaultListTestTagAssociation(ctx context.Context, db *gorm.DB) ([]*TestTagAssociation, error) {
+	in := TestTagAssociation{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	var count int64
-	lockedRow := &TestAssocHandlerDefaultORM{}
-	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeStrictUpdateCleanup); ok {
-		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	filterTestTagAssoc := TestTagAssociationORM{}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
-	}
-	filterTestTagAssoc.TestAssocHandlerDefaultId = new(string)
-	*filterTestTagAssoc.TestAssocHandlerDefaultId = ormObj.Id
-	if err = db.Where(filterTestTagAssoc).Delete(TestTagAssociationORM{}).Error; err != nil {
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &TestTagAssociationORM{}, preload.NewConverter(&TestTagAssociation{}), nil, nil, nil, nil)
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeStrictUpdateSave); ok {
-		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	db = db.Where(&ormObj)
+	ormResponse := []TestTagAssociationORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterStrictUpdateSave); ok {
-		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if count == 0 {
-		err = gateway.SetCreated(ctx, "")
+	pbResponse := []*TestTagAssociation{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
 	}
-	return &pbResponse, err
+	return pbResponse, nil
 }
 
-type TestAssocHandlerDefaultORMWithBeforeStrictUpdateCleanup interface {
-	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+type TestTagAssociationORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultORMWithBeforeStrictUpdateSave interface {
-	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+type TestTagAssociationORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultORMWithAfterStrictUpdateSave interface {
-	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+type TestTagAssociationORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestTagAssociationORM) error
 }
 
-// DefaultPatchTestAssocHandlerDefault executes a basic gorm update call with patch behavior
-func DefaultPatchTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerDefault, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	var pbObj TestAssocHandlerDefault
-	var err error
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerDefaultWithBeforePatchRead); ok {
-		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	pbReadRes, err := DefaultReadTestAssocHandlerDefault(ctx, &TestAssocHandlerDefault{Id: in.GetId()}, db)
+// DefaultCountTestTagAssociation returns the number of rows DefaultListTestTagAssociation pages through
+func DefaultCountTestTagAssociation(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := TestTagAssociation{}
+	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerDefaultWithBeforePatchApplyFieldMask); ok {
-		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskTestAssocHandlerDefault(ctx, &pbObj, in, updateMask, "", db); err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerDefaultWithBeforePatchSave); ok {
-		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateTestAssocHandlerDefault(ctx, &pbObj, db)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(pbResponse).(TestAssocHandlerDefaultWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
 		}
 	}
-	return pbResponse, nil
+	return paging.Count(db.Where(&ormObj), &TestTagAssociationORM{}, strategy)
 }
 
-type TestAssocHandlerDefaultWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestTagAssociationORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+
+// TestTagAssociationRepository runs the Default operations of TestTagAssociation, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type TestTagAssociationRepository interface {
+	Create(ctx context.Context, in *TestTagAssociation) (*TestTagAssociation, error)
+	CreateSet(ctx context.Context, in []*TestTagAssociation, batchSize int) ([]*TestTagAssociation, error)
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TestTagAssociation, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
 }
-type TestAssocHandlerDefaultWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+
+// GormTestTagAssociationRepository is the TestTagAssociationRepository calling the Default handlers with DB
+type GormTestTagAssociationRepository struct {
+	DB *gorm.DB
 }
-type TestAssocHandlerDefaultWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) error
+
+// NewGormTestTagAssociationRepository returns the TestTagAssociationRepository running the operations with db
+func NewGormTestTagAssociationRepository(db *gorm.DB) *GormTestTagAssociationRepository {
+	return &GormTestTagAssociationRepository{DB: db}
 }
 
-// DefaultPatchSetTestAssocHandlerDefault executes a bulk gorm update call with patch behavior
-func DefaultPatchSetTestAssocHandlerDefault(ctx context.Context, objects []*TestAssocHandlerDefault, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerDefault, error) {
-	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+func (r *GormTestTagAssociationRepository) Create(ctx context.Context, in *TestTagAssociation) (*TestTagAssociation, error) {
+	return DefaultCreateTestTagAssociation(ctx, in, r.DB)
+}
+
+func (r *GormTestTagAssociationRepository) CreateSet(ctx context.Context, in []*TestTagAssociation, batchSize int) ([]*TestTagAssociation, error) {
+	return DefaultCreateTestTagAssociationSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormTestTagAssociationRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TestTagAssociation, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestTagAssociation doesn't support filtering")
+	}
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestTagAssociation doesn't support sorting")
+	}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestTagAssociation doesn't support pagination")
+	}
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TestTagAssociation doesn't support field selection")
 	}
+	return DefaultListTestTagAssociation(ctx, r.DB)
+}
 
-	results := make([]*TestAssocHandlerDefault, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTestAssocHandlerDefault(ctx, patcher, updateMasks[i], db)
-		if err != nil {
-			return nil, err
-		}
+func (r *GormTestTagAssociationRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of TestTagAssociation doesn't support filtering")
+	}
+	return DefaultCountTestTagAssociation(ctx, r.DB, strategy)
+}
 
-		results = append(results, pbResponse)
+// MemoryTestTagAssociationRepository is the TestTagAssociationRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryTestTagAssociationRepository struct {
+	table memory.Table
+}
+
+// NewMemoryTestTagAssociationRepository returns an empty MemoryTestTagAssociationRepository
+func NewMemoryTestTagAssociationRepository() *MemoryTestTagAssociationRepository {
+	return &MemoryTestTagAssociationRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryTestTagAssociationRepository) row(ctx context.Context, in, stored *TestTagAssociation) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
 	}
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: nil, Key: nil, Object: &out}, nil
+}
 
-	return results, nil
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryTestTagAssociationRepository) tenant(ctx context.Context) (interface{}, error) {
+	_, err := (&TestTagAssociation{}).ToORM(ctx)
+	return nil, err
 }
 
-// DefaultApplyFieldMaskTestAssocHandlerDefault patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTestAssocHandlerDefault(ctx context.Context, patchee *TestAssocHandlerDefault, patcher *TestAssocHandlerDefault, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerDefault, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
+func (r *MemoryTestTagAssociationRepository) Create(ctx context.Context, in *TestTagAssociation) (*TestTagAssociation, error) {
+	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
-		}
-		if f == prefix+"TestTagAssoc" {
-			patchee.TestTagAssoc = patcher.TestTagAssoc
-			continue
-		}
+	if err := in.Validate(); err != nil {
+		return nil, err
 	}
+	row, err := r.row(ctx, in, nil)
 	if err != nil {
 		return nil, err
 	}
-	return patchee, nil
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*TestTagAssociation), nil
 }
 
-// DefaultListTestAssocHandlerDefault executes a gorm list call
-func DefaultListTestAssocHandlerDefault(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerDefault, error) {
-	in := TestAssocHandlerDefault{}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+func (r *MemoryTestTagAssociationRepository) CreateSet(ctx context.Context, in []*TestTagAssociation, batchSize int) ([]*TestTagAssociation, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*TestTagAssociation, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
 			return nil, err
 		}
-	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocHandlerDefaultORM{}, &TestAssocHandlerDefault{}, nil, nil, nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
 			return nil, err
 		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*TestTagAssociation))
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []TestAssocHandlerDefaultORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
+	if err := r.table.Insert(rows...); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
-			return nil, err
-		}
+	return out, nil
+}
+
+func (r *MemoryTestTagAssociationRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TestTagAssociation, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
 	}
-	pbResponse := []*TestAssocHandlerDefault{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
-		if err != nil {
-			return nil, err
-		}
-		pbResponse = append(pbResponse, &temp)
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
 	}
-	return pbResponse, nil
+	out := make([]*TestTagAssociation, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*TestTagAssociation))
+	}
+	return out, nil
 }
 
-type TestAssocHandlerDefaultORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+func (r *MemoryTestTagAssociationRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
 }
-type TestAssocHandlerDefaultORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+
+// TestTagAssociationFixtureOption customizes the object returned by NewTestTagAssociationFixture
+type TestTagAssociationFixtureOption func(*TestTagAssociation)
+
+// NewTestTagAssociationFixture returns a TestTagAssociation with random valid values in its fields and
+// its required associations, customized by opts
+func NewTestTagAssociationFixture(opts ...TestTagAssociationFixtureOption) *TestTagAssociation {
+	m := &TestTagAssociation{}
+	m.SomeField = fixture.String(0)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
-type TestAssocHandlerDefaultORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerDefaultORM) error
+
+// CreateTestTagAssociationFixture creates the object returned by NewTestTagAssociationFixture and its
+// associations with DefaultCreateTestTagAssociation
+func CreateTestTagAssociationFixture(ctx context.Context, db *gorm.DB, opts ...TestTagAssociationFixtureOption) (*TestTagAssociation, error) {
+	return DefaultCreateTestTagAssociation(ctx, NewTestTagAssociationFixture(opts...), db)
 }
 
-// DefaultCreateTestAssocHandlerReplace executes a basic gorm create call
-func DefaultCreateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+// DefaultCreatePrimaryIncluded executes a basic gorm create call
+func DefaultCreatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) (*PrimaryIncluded, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3990,7 +12502,7 @@ func DefaultCreateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHand
 	if err = db.Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3999,14 +12511,83 @@ func DefaultCreateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHand
 	return &pbResponse, err
 }
 
-type TestAssocHandlerReplaceORMWithBeforeCreate_ interface {
+type PrimaryIncludedORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithAfterCreate_ interface {
+type PrimaryIncludedORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+// DefaultCreatePrimaryIncludedSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreatePrimaryIncludedSet(ctx context.Context, in []*PrimaryIncluded, db *gorm.DB, batchSize int) ([]*PrimaryIncluded, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*PrimaryIncludedORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&PrimaryIncludedORM{})).(PrimaryIncludedORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(PrimaryIncludedORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(PrimaryIncludedORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&PrimaryIncludedORM{})).(PrimaryIncludedORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*PrimaryIncluded, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type PrimaryIncludedORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*PrimaryIncludedORM, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryIncludedORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*PrimaryIncludedORM, *gorm.DB) error
+}
+
+func DefaultReadPrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) (*PrimaryIncluded, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -4014,27 +12595,27 @@ func DefaultReadTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandle
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == go_uuid.Nil {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TestAssocHandlerReplaceORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelectionEx(ctx, db, nil, &PrimaryIncludedORM{}, preload.NewConverter(&PrimaryIncluded{})); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := TestAssocHandlerReplaceORM{}
+	ormResponse := PrimaryIncludedORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerReplaceORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(PrimaryIncludedORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4043,17 +12624,17 @@ func DefaultReadTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandle
 	return &pbResponse, err
 }
 
-type TestAssocHandlerReplaceORMWithBeforeReadApplyQuery interface {
+type PrimaryIncludedORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithBeforeReadFind interface {
+type PrimaryIncludedORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithAfterReadFind interface {
+type PrimaryIncludedORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) error {
+func DefaultDeletePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -4061,91 +12642,99 @@ func DefaultDeleteTestAssocHandlerReplace(ctx context.Context, in *TestAssocHand
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == go_uuid.Nil {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&TestAssocHandlerReplaceORM{}).Error
+	err = db.Where(&ormObj).Delete(&PrimaryIncludedORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type TestAssocHandlerReplaceORMWithBeforeDelete_ interface {
+type PrimaryIncludedORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithAfterDelete_ interface {
+type PrimaryIncludedORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTestAssocHandlerReplaceSet(ctx context.Context, in []*TestAssocHandlerReplace, db *gorm.DB) error {
+func DefaultDeletePrimaryIncludedSet(ctx context.Context, in []*PrimaryIncluded, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []string{}
+	keys := []go_uuid.UUID{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == "" {
+		if ormObj.Id == go_uuid.Nil {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&TestAssocHandlerReplaceORM{})).(TestAssocHandlerReplaceORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&PrimaryIncludedORM{})).(PrimaryIncludedORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerReplaceORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&PrimaryIncludedORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&TestAssocHandlerReplaceORM{})).(TestAssocHandlerReplaceORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&PrimaryIncludedORM{})).(PrimaryIncludedORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type TestAssocHandlerReplaceORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*TestAssocHandlerReplace, *gorm.DB) (*gorm.DB, error)
+type PrimaryIncludedORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*PrimaryIncluded, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*TestAssocHandlerReplace, *gorm.DB) error
+type PrimaryIncludedORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*PrimaryIncluded, *gorm.DB) error
 }
 
-// DefaultStrictUpdateTestAssocHandlerReplace clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+// DefaultStrictUpdatePrimaryIncluded clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) (*PrimaryIncluded, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerReplace")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryIncluded")
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	var count int64
-	lockedRow := &TestAssocHandlerReplaceORM{}
+	lockedRow := &PrimaryIncludedORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Model(&ormObj).Association("TestTagAssoc").Replace(ormObj.TestTagAssoc).Error; err != nil {
+	filterChild := ExternalChildORM{}
+	if ormObj.Id == go_uuid.Nil {
+		return nil, errors.EmptyIdError
+	}
+	filterChild.PrimaryIncludedId = new(go_uuid.UUID)
+	*filterChild.PrimaryIncludedId = ormObj.Id
+	if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
 		return nil, err
 	}
-	ormObj.TestTagAssoc = nil
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4153,7 +12742,7 @@ func DefaultStrictUpdateTestAssocHandlerReplace(ctx context.Context, in *TestAss
 	if err = db.Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4168,51 +12757,46 @@ func DefaultStrictUpdateTestAssocHandlerReplace(ctx context.Context, in *TestAss
 	return &pbResponse, err
 }
 
-type TestAssocHandlerReplaceORMWithBeforeStrictUpdateCleanup interface {
+type PrimaryIncludedORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithBeforeStrictUpdateSave interface {
+type PrimaryIncludedORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithAfterStrictUpdateSave interface {
+type PrimaryIncludedORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchTestAssocHandlerReplace executes a basic gorm update call with patch behavior
-func DefaultPatchTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+// DefaultPatchPrimaryIncluded executes a basic gorm update call with patch behavior
+func DefaultPatchPrimaryIncluded(ctx context.Context, in *PrimaryIncluded, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryIncluded, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj TestAssocHandlerReplace
+	var pbObj PrimaryIncluded
 	var err error
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerReplaceWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(PrimaryIncludedWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadTestAssocHandlerReplace(ctx, &TestAssocHandlerReplace{Id: in.GetId()}, db)
-	if err != nil {
-		return nil, err
-	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerReplaceWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(PrimaryIncludedWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskTestAssocHandlerReplace(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskPrimaryIncluded(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerReplaceWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(PrimaryIncludedWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateTestAssocHandlerReplace(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdatePrimaryIncluded(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(TestAssocHandlerReplaceWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(PrimaryIncludedWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -4220,28 +12804,28 @@ func DefaultPatchTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandl
 	return pbResponse, nil
 }
 
-type TestAssocHandlerReplaceWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type PrimaryIncludedWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type PrimaryIncludedWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type PrimaryIncludedWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) error
+type PrimaryIncludedWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetTestAssocHandlerReplace executes a bulk gorm update call with patch behavior
-func DefaultPatchSetTestAssocHandlerReplace(ctx context.Context, objects []*TestAssocHandlerReplace, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerReplace, error) {
+// DefaultPatchSetPrimaryIncluded executes a bulk gorm update call with patch behavior
+func DefaultPatchSetPrimaryIncluded(ctx context.Context, objects []*PrimaryIncluded, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PrimaryIncluded, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*TestAssocHandlerReplace, 0, len(objects))
+	results := make([]*PrimaryIncluded, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTestAssocHandlerReplace(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchPrimaryIncluded(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -4252,21 +12836,35 @@ func DefaultPatchSetTestAssocHandlerReplace(ctx context.Context, objects []*Test
 	return results, nil
 }
 
-// DefaultApplyFieldMaskTestAssocHandlerReplace patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTestAssocHandlerReplace(ctx context.Context, patchee *TestAssocHandlerReplace, patcher *TestAssocHandlerReplace, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+// DefaultApplyFieldMaskPrimaryIncluded patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskPrimaryIncluded(ctx context.Context, patchee *PrimaryIncluded, patcher *PrimaryIncluded, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PrimaryIncluded, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
 	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
+	var updatedChild bool
+	for i, f := range updateMask.Paths {
+		if !updatedChild && strings.HasPrefix(f, prefix+"Child.") {
+			updatedChild = true
+			if patcher.Child == nil {
+				patchee.Child = nil
+				continue
+			}
+			if patchee.Child == nil {
+				patchee.Child = &ExternalChild{}
+			}
+			if o, err := DefaultApplyFieldMaskExternalChild(ctx, patchee.Child, patcher.Child, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Child.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Child = o
+			}
 			continue
 		}
-		if f == prefix+"TestTagAssoc" {
-			patchee.TestTagAssoc = patcher.TestTagAssoc
+		if f == prefix+"Child" {
+			updatedChild = true
+			patchee.Child = patcher.Child
 			continue
 		}
 	}
@@ -4276,39 +12874,39 @@ func DefaultApplyFieldMaskTestAssocHandlerReplace(ctx context.Context, patchee *
 	return patchee, nil
 }
 
-// DefaultListTestAssocHandlerReplace executes a gorm list call
-func DefaultListTestAssocHandlerReplace(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerReplace, error) {
-	in := TestAssocHandlerReplace{}
+// DefaultListPrimaryIncluded executes a gorm list call
+func DefaultListPrimaryIncluded(ctx context.Context, db *gorm.DB) ([]*PrimaryIncluded, error) {
+	in := PrimaryIncluded{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocHandlerReplaceORM{}, &TestAssocHandlerReplace{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &PrimaryIncludedORM{}, preload.NewConverter(&PrimaryIncluded{}), nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []TestAssocHandlerReplaceORM{}
+	ormResponse := []PrimaryIncludedORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*TestAssocHandlerReplace{}
+	pbResponse := []*PrimaryIncluded{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -4319,382 +12917,491 @@ func DefaultListTestAssocHandlerReplace(ctx context.Context, db *gorm.DB) ([]*Te
 	return pbResponse, nil
 }
 
-type TestAssocHandlerReplaceORMWithBeforeListApplyQuery interface {
+type PrimaryIncludedORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithBeforeListFind interface {
+type PrimaryIncludedORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerReplaceORM) error
+type PrimaryIncludedORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PrimaryIncludedORM) error
 }
 
-// DefaultCreateTestAssocHandlerClear executes a basic gorm create call
-func DefaultCreateTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
+// DefaultCountPrimaryIncluded returns the number of rows DefaultListPrimaryIncluded pages through
+func DefaultCountPrimaryIncluded(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := PrimaryIncluded{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
 		}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	return paging.Count(db.Where(&ormObj), &PrimaryIncludedORM{}, strategy)
 }
 
-type TestAssocHandlerClearORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+type PrimaryIncludedORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerClearORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+
+// PrimaryIncludedConflictTargets maps the conflict targets accepted by DefaultUpsertPrimaryIncluded to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var PrimaryIncludedConflictTargets = map[string][]string{
+	"id": {"id"},
 }
 
-func DefaultReadTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
+// DefaultUpsertPrimaryIncluded inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertPrimaryIncluded(ctx context.Context, in *PrimaryIncluded, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryIncluded, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
+	if err := in.Validate(); err != nil {
 		return nil, err
 	}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
+	conflict := insert.OnConflict{
+		Columns:   PrimaryIncludedConflictTargets[target],
+		UpdateAll: updateMask == nil,
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeReadApplyQuery); ok {
-		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for PrimaryIncluded", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertPrimaryIncludedColumns(updateMask)
+		if err != nil {
 			return nil, err
 		}
+		conflict.Update = columns
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TestAssocHandlerClearORM{}); err != nil {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeReadFind); ok {
-		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := TestAssocHandlerClearORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerClearORMWithAfterReadFind); ok {
-		if err = hook.AfterReadFind(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := ormResponse.ToPB(ctx)
+	pbResponse, err := ormObj.ToPB(ctx)
 	return &pbResponse, err
 }
 
-type TestAssocHandlerClearORMWithBeforeReadApplyQuery interface {
-	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerClearORMWithBeforeReadFind interface {
-	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+type PrimaryIncludedORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerClearORMWithAfterReadFind interface {
-	AfterReadFind(context.Context, *gorm.DB) error
+type PrimaryIncludedORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return err
-	}
-	if ormObj.Id == "" {
-		return errors.EmptyIdError
-	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeDelete_); ok {
-		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
-			return err
+// upsertPrimaryIncludedColumns returns the columns of the fields of updateMask the upserts of
+// PrimaryIncluded overwrite, the immutable fields and the paths of no column being rejected
+func upsertPrimaryIncludedColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
 		}
 	}
-	err = db.Where(&ormObj).Delete(&TestAssocHandlerClearORM{}).Error
-	if err != nil {
-		return err
-	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterDelete_); ok {
-		err = hook.AfterDelete_(ctx, db)
-	}
-	return err
+	return columns, nil
 }
 
-type TestAssocHandlerClearORMWithBeforeDelete_ interface {
-	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+// PrimaryIncludedRepository runs the Default operations of PrimaryIncluded, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type PrimaryIncludedRepository interface {
+	Create(ctx context.Context, in *PrimaryIncluded) (*PrimaryIncluded, error)
+	CreateSet(ctx context.Context, in []*PrimaryIncluded, batchSize int) ([]*PrimaryIncluded, error)
+	Read(ctx context.Context, in *PrimaryIncluded, fs *query.FieldSelection) (*PrimaryIncluded, error)
+	StrictUpdate(ctx context.Context, in *PrimaryIncluded) (*PrimaryIncluded, error)
+	Patch(ctx context.Context, in *PrimaryIncluded, updateMask *field_mask.FieldMask) (*PrimaryIncluded, error)
+	PatchSet(ctx context.Context, objects []*PrimaryIncluded, updateMasks []*field_mask.FieldMask) ([]*PrimaryIncluded, error)
+	Delete(ctx context.Context, in *PrimaryIncluded) error
+	DeleteSet(ctx context.Context, in []*PrimaryIncluded) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*PrimaryIncluded, error)
+	PageToken(ctx context.Context, last *PrimaryIncluded, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *PrimaryIncluded, target string, updateMask *field_mask.FieldMask) (*PrimaryIncluded, error)
 }
-type TestAssocHandlerClearORMWithAfterDelete_ interface {
-	AfterDelete_(context.Context, *gorm.DB) error
+
+// GormPrimaryIncludedRepository is the PrimaryIncludedRepository calling the Default handlers with DB
+type GormPrimaryIncludedRepository struct {
+	DB *gorm.DB
 }
 
-func DefaultDeleteTestAssocHandlerClearSet(ctx context.Context, in []*TestAssocHandlerClear, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
-	var err error
-	keys := []string{}
-	for _, obj := range in {
-		ormObj, err := obj.ToORM(ctx)
-		if err != nil {
-			return err
-		}
-		if ormObj.Id == "" {
-			return errors.EmptyIdError
-		}
-		keys = append(keys, ormObj.Id)
-	}
-	if hook, ok := (interface{}(&TestAssocHandlerClearORM{})).(TestAssocHandlerClearORMWithBeforeDeleteSet); ok {
-		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
-			return err
-		}
-	}
-	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerClearORM{}).Error
-	if err != nil {
-		return err
-	}
-	if hook, ok := (interface{}(&TestAssocHandlerClearORM{})).(TestAssocHandlerClearORMWithAfterDeleteSet); ok {
-		err = hook.AfterDeleteSet(ctx, in, db)
-	}
-	return err
+// NewGormPrimaryIncludedRepository returns the PrimaryIncludedRepository running the operations with db
+func NewGormPrimaryIncludedRepository(db *gorm.DB) *GormPrimaryIncludedRepository {
+	return &GormPrimaryIncludedRepository{DB: db}
 }
 
-type TestAssocHandlerClearORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*TestAssocHandlerClear, *gorm.DB) (*gorm.DB, error)
+func (r *GormPrimaryIncludedRepository) Create(ctx context.Context, in *PrimaryIncluded) (*PrimaryIncluded, error) {
+	return DefaultCreatePrimaryIncluded(ctx, in, r.DB)
 }
-type TestAssocHandlerClearORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*TestAssocHandlerClear, *gorm.DB) error
+
+func (r *GormPrimaryIncludedRepository) CreateSet(ctx context.Context, in []*PrimaryIncluded, batchSize int) ([]*PrimaryIncluded, error) {
+	return DefaultCreatePrimaryIncludedSet(ctx, in, r.DB, batchSize)
 }
 
-// DefaultStrictUpdateTestAssocHandlerClear clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
-	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerClear")
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+func (r *GormPrimaryIncludedRepository) Read(ctx context.Context, in *PrimaryIncluded, fs *query.FieldSelection) (*PrimaryIncluded, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of PrimaryIncluded doesn't support field selection")
 	}
-	var count int64
-	lockedRow := &TestAssocHandlerClearORM{}
-	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeStrictUpdateCleanup); ok {
-		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
-			return nil, err
-		}
+	return DefaultReadPrimaryIncluded(ctx, in, r.DB)
+}
+
+func (r *GormPrimaryIncludedRepository) StrictUpdate(ctx context.Context, in *PrimaryIncluded) (*PrimaryIncluded, error) {
+	return DefaultStrictUpdatePrimaryIncluded(ctx, in, r.DB)
+}
+
+func (r *GormPrimaryIncludedRepository) Patch(ctx context.Context, in *PrimaryIncluded, updateMask *field_mask.FieldMask) (*PrimaryIncluded, error) {
+	return DefaultPatchPrimaryIncluded(ctx, in, updateMask, r.DB)
+}
+
+func (r *GormPrimaryIncludedRepository) PatchSet(ctx context.Context, objects []*PrimaryIncluded, updateMasks []*field_mask.FieldMask) ([]*PrimaryIncluded, error) {
+	return DefaultPatchSetPrimaryIncluded(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormPrimaryIncludedRepository) Delete(ctx context.Context, in *PrimaryIncluded) error {
+	return DefaultDeletePrimaryIncluded(ctx, in, r.DB)
+}
+
+func (r *GormPrimaryIncludedRepository) DeleteSet(ctx context.Context, in []*PrimaryIncluded) error {
+	return DefaultDeletePrimaryIncludedSet(ctx, in, r.DB)
+}
+
+func (r *GormPrimaryIncludedRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*PrimaryIncluded, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of PrimaryIncluded doesn't support filtering")
 	}
-	if err = db.Model(&ormObj).Association("TestTagAssoc").Clear().Error; err != nil {
-		return nil, err
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of PrimaryIncluded doesn't support sorting")
 	}
-	ormObj.TestTagAssoc = nil
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeStrictUpdateSave); ok {
-		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
-			return nil, err
-		}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of PrimaryIncluded doesn't support pagination")
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
-		return nil, err
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of PrimaryIncluded doesn't support field selection")
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterStrictUpdateSave); ok {
-		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
-			return nil, err
-		}
+	return DefaultListPrimaryIncluded(ctx, r.DB)
+}
+
+func (r *GormPrimaryIncludedRepository) PageToken(ctx context.Context, last *PrimaryIncluded, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
+	keyset, err := paging.NewKeyset(r.DB, &PrimaryIncludedORM{}, s, f)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	if count == 0 {
-		err = gateway.SetCreated(ctx, "")
+	return keyset.Token(&ormObj)
+}
+
+func (r *GormPrimaryIncludedRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of PrimaryIncluded doesn't support filtering")
 	}
-	return &pbResponse, err
+	return DefaultCountPrimaryIncluded(ctx, r.DB, strategy)
 }
 
-type TestAssocHandlerClearORMWithBeforeStrictUpdateCleanup interface {
-	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+func (r *GormPrimaryIncludedRepository) Upsert(ctx context.Context, in *PrimaryIncluded, target string, updateMask *field_mask.FieldMask) (*PrimaryIncluded, error) {
+	return DefaultUpsertPrimaryIncluded(ctx, in, target, updateMask, r.DB)
 }
-type TestAssocHandlerClearORMWithBeforeStrictUpdateSave interface {
-	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+
+// MemoryPrimaryIncludedRepository is the PrimaryIncludedRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryPrimaryIncludedRepository struct {
+	table memory.Table
 }
-type TestAssocHandlerClearORMWithAfterStrictUpdateSave interface {
-	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+
+// NewMemoryPrimaryIncludedRepository returns an empty MemoryPrimaryIncludedRepository
+func NewMemoryPrimaryIncludedRepository() *MemoryPrimaryIncludedRepository {
+	return &MemoryPrimaryIncludedRepository{}
 }
 
-// DefaultPatchTestAssocHandlerClear executes a basic gorm update call with patch behavior
-func DefaultPatchTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerClear, error) {
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryPrimaryIncludedRepository) row(ctx context.Context, in, stored *PrimaryIncluded) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: nil, Key: ormObj.Id, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryPrimaryIncludedRepository) tenant(ctx context.Context) (interface{}, error) {
+	_, err := (&PrimaryIncluded{}).ToORM(ctx)
+	return nil, err
+}
+
+func (r *MemoryPrimaryIncludedRepository) Create(ctx context.Context, in *PrimaryIncluded) (*PrimaryIncluded, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj TestAssocHandlerClear
-	var err error
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerClearWithBeforePatchRead); ok {
-		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	if err := in.Validate(); err != nil {
+		return nil, err
 	}
-	pbReadRes, err := DefaultReadTestAssocHandlerClear(ctx, &TestAssocHandlerClear{Id: in.GetId()}, db)
+	row, err := r.row(ctx, in, nil)
 	if err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerClearWithBeforePatchApplyFieldMask); ok {
-		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*PrimaryIncluded), nil
+}
+
+func (r *MemoryPrimaryIncludedRepository) CreateSet(ctx context.Context, in []*PrimaryIncluded, batchSize int) ([]*PrimaryIncluded, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*PrimaryIncluded, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
 			return nil, err
 		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*PrimaryIncluded))
 	}
-	if _, err := DefaultApplyFieldMaskTestAssocHandlerClear(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if err := r.table.Insert(rows...); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerClearWithBeforePatchSave); ok {
-		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	return out, nil
+}
+
+func (r *MemoryPrimaryIncludedRepository) Read(ctx context.Context, in *PrimaryIncluded, fs *query.FieldSelection) (*PrimaryIncluded, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
 	}
-	pbResponse, err := DefaultStrictUpdateTestAssocHandlerClear(ctx, &pbObj, db)
+	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(TestAssocHandlerClearWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	if ormObj.Id == go_uuid.Nil {
+		return nil, errors.EmptyIdError
 	}
-	return pbResponse, nil
+	out, ok := r.table.Get(nil, ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*PrimaryIncluded), nil
 }
 
-type TestAssocHandlerClearWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerClearWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerClearWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+func (r *MemoryPrimaryIncludedRepository) StrictUpdate(ctx context.Context, in *PrimaryIncluded) (*PrimaryIncluded, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.table.Save(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*PrimaryIncluded), nil
 }
-type TestAssocHandlerClearWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) error
+
+func (r *MemoryPrimaryIncludedRepository) Patch(ctx context.Context, in *PrimaryIncluded, updateMask *field_mask.FieldMask) (*PrimaryIncluded, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	patchee := &PrimaryIncluded{}
+	var err error
+	if patchee, err = DefaultApplyFieldMaskPrimaryIncluded(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
+	}
+	return r.StrictUpdate(ctx, patchee)
 }
 
-// DefaultPatchSetTestAssocHandlerClear executes a bulk gorm update call with patch behavior
-func DefaultPatchSetTestAssocHandlerClear(ctx context.Context, objects []*TestAssocHandlerClear, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerClear, error) {
+func (r *MemoryPrimaryIncludedRepository) PatchSet(ctx context.Context, objects []*PrimaryIncluded, updateMasks []*field_mask.FieldMask) ([]*PrimaryIncluded, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*TestAssocHandlerClear, 0, len(objects))
+	out := make([]*PrimaryIncluded, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTestAssocHandlerClear(ctx, patcher, updateMasks[i], db)
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
+		out = append(out, patched)
 	}
+	return out, nil
+}
 
-	return results, nil
+func (r *MemoryPrimaryIncludedRepository) Delete(ctx context.Context, in *PrimaryIncluded) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == go_uuid.Nil {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(nil, ormObj.Id)
+	return nil
 }
 
-// DefaultApplyFieldMaskTestAssocHandlerClear patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTestAssocHandlerClear(ctx context.Context, patchee *TestAssocHandlerClear, patcher *TestAssocHandlerClear, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerClear, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
+func (r *MemoryPrimaryIncludedRepository) DeleteSet(ctx context.Context, in []*PrimaryIncluded) error {
+	if in == nil {
+		return errors.NilArgumentError
 	}
-	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
 		}
-		if f == prefix+"TestTagAssoc" {
-			patchee.TestTagAssoc = patcher.TestTagAssoc
-			continue
+		if ormObj.Id == go_uuid.Nil {
+			return errors.EmptyIdError
 		}
+		keys = append(keys, ormObj.Id)
 	}
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryPrimaryIncludedRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*PrimaryIncluded, error) {
+	tenant, err := r.tenant(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return patchee, nil
-}
-
-// DefaultListTestAssocHandlerClear executes a gorm list call
-func DefaultListTestAssocHandlerClear(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerClear, error) {
-	in := TestAssocHandlerClear{}
-	ormObj, err := in.ToORM(ctx)
+	objects, err := r.table.List(tenant, f, s, p)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
+	out := make([]*PrimaryIncluded, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*PrimaryIncluded))
+	}
+	return out, nil
+}
+
+func (r *MemoryPrimaryIncludedRepository) PageToken(ctx context.Context, last *PrimaryIncluded, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocHandlerClearORM{}, &TestAssocHandlerClear{}, nil, nil, nil, nil)
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryPrimaryIncludedRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
 	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryPrimaryIncludedRepository) Upsert(ctx context.Context, in *PrimaryIncluded, target string, updateMask *field_mask.FieldMask) (*PrimaryIncluded, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := PrimaryIncludedConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for PrimaryIncluded", target)
+	}
+	if _, err := upsertPrimaryIncludedColumns(updateMask); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
-			return nil, err
-		}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []TestAssocHandlerClearORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
+	found, ok, err := r.table.Conflicting(nil, func(m proto.Message) bool {
+		other, err := m.(*PrimaryIncluded).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
-			return nil, err
-		}
+	if !ok {
+		return r.Create(ctx, in)
 	}
-	pbResponse := []*TestAssocHandlerClear{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
+	existing := found.(*PrimaryIncluded)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskPrimaryIncluded(ctx, existing, in, updateMask, "", nil)
 		if err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
+		return r.StrictUpdate(ctx, patched)
 	}
-	return pbResponse, nil
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*PrimaryIncluded)
+	return r.StrictUpdate(ctx, updated)
 }
 
-type TestAssocHandlerClearORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerClearORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+// PrimaryIncludedFixtureOption customizes the object returned by NewPrimaryIncludedFixture
+type PrimaryIncludedFixtureOption func(*PrimaryIncluded)
+
+// NewPrimaryIncludedFixture returns a PrimaryIncluded with random valid values in its fields and
+// its required associations, customized by opts
+func NewPrimaryIncludedFixture(opts ...PrimaryIncludedFixtureOption) *PrimaryIncluded {
+	m := &PrimaryIncluded{}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
-type TestAssocHandlerClearORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerClearORM) error
+
+// CreatePrimaryIncludedFixture creates the object returned by NewPrimaryIncludedFixture and its
+// associations with DefaultCreatePrimaryIncluded
+func CreatePrimaryIncludedFixture(ctx context.Context, db *gorm.DB, opts ...PrimaryIncludedFixtureOption) (*PrimaryIncluded, error) {
+	return DefaultCreatePrimaryIncluded(ctx, NewPrimaryIncludedFixture(opts...), db)
 }
 
-// DefaultCreateTestAssocHandlerAppend executes a basic gorm create call
-func DefaultCreateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+// DefaultCreateTagConstraints executes a basic gorm create call
+func DefaultCreateTagConstraints(ctx context.Context, in *TagConstraints, db *gorm.DB) (*TagConstraints, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(TagConstraintsORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4702,7 +13409,7 @@ func DefaultCreateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandl
 	if err = db.Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(TagConstraintsORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4711,14 +13418,83 @@ func DefaultCreateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandl
 	return &pbResponse, err
 }
 
-type TestAssocHandlerAppendORMWithBeforeCreate_ interface {
+type TagConstraintsORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithAfterCreate_ interface {
+type TagConstraintsORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+// DefaultCreateTagConstraintsSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateTagConstraintsSet(ctx context.Context, in []*TagConstraints, db *gorm.DB, batchSize int) ([]*TagConstraints, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*TagConstraintsORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&TagConstraintsORM{})).(TagConstraintsORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(TagConstraintsORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(TagConstraintsORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&TagConstraintsORM{})).(TagConstraintsORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*TagConstraints, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type TagConstraintsORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*TagConstraintsORM, *gorm.DB) (*gorm.DB, error)
+}
+type TagConstraintsORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*TagConstraintsORM, *gorm.DB) error
+}
+
+func DefaultReadTagConstraints(ctx context.Context, in *TagConstraints, db *gorm.DB) (*TagConstraints, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -4726,27 +13502,27 @@ func DefaultReadTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandler
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TagConstraintsORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TestAssocHandlerAppendORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelectionEx(ctx, db, nil, &TagConstraintsORM{}, preload.NewConverter(&TagConstraints{})); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(TagConstraintsORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := TestAssocHandlerAppendORM{}
+	ormResponse := TagConstraintsORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerAppendORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(TagConstraintsORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4755,17 +13531,17 @@ func DefaultReadTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandler
 	return &pbResponse, err
 }
 
-type TestAssocHandlerAppendORMWithBeforeReadApplyQuery interface {
+type TagConstraintsORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithBeforeReadFind interface {
+type TagConstraintsORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithAfterReadFind interface {
+type TagConstraintsORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) error {
+func DefaultDeleteTagConstraints(ctx context.Context, in *TagConstraints, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -4773,91 +13549,90 @@ func DefaultDeleteTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandl
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(TagConstraintsORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&TestAssocHandlerAppendORM{}).Error
+	err = db.Where(&ormObj).Delete(&TagConstraintsORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(TagConstraintsORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type TestAssocHandlerAppendORMWithBeforeDelete_ interface {
+type TagConstraintsORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithAfterDelete_ interface {
+type TagConstraintsORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTestAssocHandlerAppendSet(ctx context.Context, in []*TestAssocHandlerAppend, db *gorm.DB) error {
+func DefaultDeleteTagConstraintsSet(ctx context.Context, in []*TagConstraints, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []string{}
+	keys := []uint64{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == "" {
+		if ormObj.Id == 0 {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&TestAssocHandlerAppendORM{})).(TestAssocHandlerAppendORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&TagConstraintsORM{})).(TagConstraintsORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerAppendORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&TagConstraintsORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&TestAssocHandlerAppendORM{})).(TestAssocHandlerAppendORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&TagConstraintsORM{})).(TagConstraintsORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type TestAssocHandlerAppendORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*TestAssocHandlerAppend, *gorm.DB) (*gorm.DB, error)
+type TagConstraintsORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TagConstraints, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*TestAssocHandlerAppend, *gorm.DB) error
+type TagConstraintsORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TagConstraints, *gorm.DB) error
 }
 
-// DefaultStrictUpdateTestAssocHandlerAppend clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+// DefaultStrictUpdateTagConstraints clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTagConstraints(ctx context.Context, in *TagConstraints, db *gorm.DB) (*TagConstraints, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerAppend")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTagConstraints")
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	var count int64
-	lockedRow := &TestAssocHandlerAppendORM{}
+	lockedRow := &TagConstraintsORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(TagConstraintsORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Model(&ormObj).Association("TestTagAssoc").Append(ormObj.TestTagAssoc).Error; err != nil {
-		return nil, err
-	}
-	ormObj.TestTagAssoc = nil
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(TagConstraintsORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4865,7 +13640,7 @@ func DefaultStrictUpdateTestAssocHandlerAppend(ctx context.Context, in *TestAsso
 	if err = db.Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(TagConstraintsORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4880,51 +13655,51 @@ func DefaultStrictUpdateTestAssocHandlerAppend(ctx context.Context, in *TestAsso
 	return &pbResponse, err
 }
 
-type TestAssocHandlerAppendORMWithBeforeStrictUpdateCleanup interface {
+type TagConstraintsORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithBeforeStrictUpdateSave interface {
+type TagConstraintsORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithAfterStrictUpdateSave interface {
+type TagConstraintsORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchTestAssocHandlerAppend executes a basic gorm update call with patch behavior
-func DefaultPatchTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+// DefaultPatchTagConstraints executes a basic gorm update call with patch behavior
+func DefaultPatchTagConstraints(ctx context.Context, in *TagConstraints, updateMask *field_mask.FieldMask, db *gorm.DB) (*TagConstraints, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj TestAssocHandlerAppend
+	var pbObj TagConstraints
 	var err error
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerAppendWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(TagConstraintsWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadTestAssocHandlerAppend(ctx, &TestAssocHandlerAppend{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadTagConstraints(ctx, &TagConstraints{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerAppendWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(TagConstraintsWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskTestAssocHandlerAppend(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskTagConstraints(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerAppendWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(TagConstraintsWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateTestAssocHandlerAppend(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateTagConstraints(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(TestAssocHandlerAppendWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(TagConstraintsWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -4932,28 +13707,28 @@ func DefaultPatchTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandle
 	return pbResponse, nil
 }
 
-type TestAssocHandlerAppendWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TagConstraintsWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TagConstraints, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TagConstraintsWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TagConstraints, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TagConstraintsWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TagConstraints, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) error
+type TagConstraintsWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TagConstraints, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetTestAssocHandlerAppend executes a bulk gorm update call with patch behavior
-func DefaultPatchSetTestAssocHandlerAppend(ctx context.Context, objects []*TestAssocHandlerAppend, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerAppend, error) {
+// DefaultPatchSetTagConstraints executes a bulk gorm update call with patch behavior
+func DefaultPatchSetTagConstraints(ctx context.Context, objects []*TagConstraints, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TagConstraints, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*TestAssocHandlerAppend, 0, len(objects))
+	results := make([]*TagConstraints, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTestAssocHandlerAppend(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchTagConstraints(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -4964,21 +13739,49 @@ func DefaultPatchSetTestAssocHandlerAppend(ctx context.Context, objects []*TestA
 	return results, nil
 }
 
-// DefaultApplyFieldMaskTestAssocHandlerAppend patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTestAssocHandlerAppend(ctx context.Context, patchee *TestAssocHandlerAppend, patcher *TestAssocHandlerAppend, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+// DefaultApplyFieldMaskTagConstraints patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTagConstraints(ctx context.Context, patchee *TagConstraints, patcher *TagConstraints, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TagConstraints, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
 	var err error
-	for _, f := range updateMask.Paths {
+	var updatedNickname bool
+	for i, f := range updateMask.Paths {
 		if f == prefix+"Id" {
 			patchee.Id = patcher.Id
 			continue
 		}
-		if f == prefix+"TestTagAssoc" {
-			patchee.TestTagAssoc = patcher.TestTagAssoc
+		if !updatedNickname && strings.HasPrefix(f, prefix+"Nickname.") {
+			if patcher.Nickname == nil {
+				patchee.Nickname = nil
+				continue
+			}
+			if patchee.Nickname == nil {
+				patchee.Nickname = &wrapperspb.StringValue{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Nickname."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.Nickname, patchee.Nickname, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"Nickname" {
+			updatedNickname = true
+			patchee.Nickname = patcher.Nickname
+			continue
+		}
+		if f == prefix+"Price" {
+			patchee.Price = patcher.Price
+			continue
+		}
+		if f == prefix+"Status" {
+			patchee.Status = patcher.Status
 			continue
 		}
 	}
@@ -4988,39 +13791,39 @@ func DefaultApplyFieldMaskTestAssocHandlerAppend(ctx context.Context, patchee *T
 	return patchee, nil
 }
 
-// DefaultListTestAssocHandlerAppend executes a gorm list call
-func DefaultListTestAssocHandlerAppend(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerAppend, error) {
-	in := TestAssocHandlerAppend{}
+// DefaultListTagConstraints executes a gorm list call
+func DefaultListTagConstraints(ctx context.Context, db *gorm.DB) ([]*TagConstraints, error) {
+	in := TagConstraints{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TagConstraintsORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocHandlerAppendORM{}, &TestAssocHandlerAppend{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &TagConstraintsORM{}, preload.NewConverter(&TagConstraints{}), nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(TagConstraintsORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []TestAssocHandlerAppendORM{}
+	ormResponse := []TagConstraintsORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(TagConstraintsORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*TestAssocHandlerAppend{}
+	pbResponse := []*TagConstraints{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -5031,140 +13834,88 @@ func DefaultListTestAssocHandlerAppend(ctx context.Context, db *gorm.DB) ([]*Tes
 	return pbResponse, nil
 }
 
-type TestAssocHandlerAppendORMWithBeforeListApplyQuery interface {
+type TagConstraintsORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithBeforeListFind interface {
+type TagConstraintsORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerAppendORM) error
+type TagConstraintsORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TagConstraintsORM) error
 }
 
-// DefaultCreateTestTagAssociation executes a basic gorm create call
-func DefaultCreateTestTagAssociation(ctx context.Context, in *TestTagAssociation, db *gorm.DB) (*TestTagAssociation, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
+// DefaultCountTagConstraints returns the number of rows DefaultListTagConstraints pages through
+func DefaultCountTagConstraints(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := TagConstraints{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(TagConstraintsORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+	if hook, ok := interface{}(&ormObj).(TagConstraintsORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(TagConstraintsORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
 		}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	return paging.Count(db.Where(&ormObj), &TagConstraintsORM{}, strategy)
 }
 
-type TestTagAssociationORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestTagAssociationORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+type TagConstraintsORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
 }
 
-// DefaultApplyFieldMaskTestTagAssociation patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTestTagAssociation(ctx context.Context, patchee *TestTagAssociation, patcher *TestTagAssociation, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestTagAssociation, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
-	}
-	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"SomeField" {
-			patchee.SomeField = patcher.SomeField
-			continue
-		}
-	}
-	if err != nil {
-		return nil, err
-	}
-	return patchee, nil
+// TagConstraintsConflictTargets maps the conflict targets accepted by DefaultUpsertTagConstraints to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var TagConstraintsConflictTargets = map[string][]string{
+	"id": {"id"},
 }
 
-// DefaultListTestTagAssociation executes a gorm list call
-func DefaultListTestTagAssociation(ctx context.Context, db *gorm.DB) ([]*TestTagAssociation, error) {
-	in := TestTagAssociation{}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
+// DefaultUpsertTagConstraints inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertTagConstraints(ctx context.Context, in *TagConstraints, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*TagConstraints, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestTagAssociationORM{}, &TestTagAssociation{}, nil, nil, nil, nil)
-	if err != nil {
+	if err := in.Validate(); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	db = db.Where(&ormObj)
-	ormResponse := []TestTagAssociationORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
-		return nil, err
+	conflict := insert.OnConflict{
+		Columns:   TagConstraintsConflictTargets[target],
+		UpdateAll: updateMask == nil,
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
-			return nil, err
-		}
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for TagConstraints", target)
 	}
-	pbResponse := []*TestTagAssociation{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
+	if updateMask != nil {
+		columns, err := upsertTagConstraintsColumns(updateMask)
 		if err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
-	}
-	return pbResponse, nil
-}
-
-type TestTagAssociationORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestTagAssociationORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestTagAssociationORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TestTagAssociationORM) error
-}
-
-// DefaultCreatePrimaryIncluded executes a basic gorm create call
-func DefaultCreatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) (*PrimaryIncluded, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
+		conflict.Update = columns
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(TagConstraintsORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(TagConstraintsORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
@@ -5172,346 +13923,399 @@ func DefaultCreatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *
 	return &pbResponse, err
 }
 
-type PrimaryIncludedORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+type TagConstraintsORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+type TagConstraintsORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
 }
 
-func DefaultReadPrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) (*PrimaryIncluded, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if ormObj.Id == go_uuid.Nil {
-		return nil, errors.EmptyIdError
-	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeReadApplyQuery); ok {
-		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &PrimaryIncludedORM{}); err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeReadFind); ok {
-		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
-			return nil, err
+// upsertTagConstraintsColumns returns the columns of the fields of updateMask the upserts of
+// TagConstraints overwrite, the immutable fields and the paths of no column being rejected
+func upsertTagConstraintsColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		case "Nickname":
+			columns = append(columns, "nickname")
+		case "Price":
+			columns = append(columns, "price")
+		case "Status":
+			columns = append(columns, "status")
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
 		}
 	}
-	ormResponse := PrimaryIncludedORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormResponse).(PrimaryIncludedORMWithAfterReadFind); ok {
-		if err = hook.AfterReadFind(ctx, db); err != nil {
-			return nil, err
-		}
+	return columns, nil
+}
+
+// TagConstraintsRepository runs the Default operations of TagConstraints, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type TagConstraintsRepository interface {
+	Create(ctx context.Context, in *TagConstraints) (*TagConstraints, error)
+	CreateSet(ctx context.Context, in []*TagConstraints, batchSize int) ([]*TagConstraints, error)
+	Read(ctx context.Context, in *TagConstraints, fs *query.FieldSelection) (*TagConstraints, error)
+	StrictUpdate(ctx context.Context, in *TagConstraints) (*TagConstraints, error)
+	Patch(ctx context.Context, in *TagConstraints, updateMask *field_mask.FieldMask) (*TagConstraints, error)
+	PatchSet(ctx context.Context, objects []*TagConstraints, updateMasks []*field_mask.FieldMask) ([]*TagConstraints, error)
+	Delete(ctx context.Context, in *TagConstraints) error
+	DeleteSet(ctx context.Context, in []*TagConstraints) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TagConstraints, error)
+	PageToken(ctx context.Context, last *TagConstraints, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *TagConstraints, target string, updateMask *field_mask.FieldMask) (*TagConstraints, error)
+}
+
+// GormTagConstraintsRepository is the TagConstraintsRepository calling the Default handlers with DB
+type GormTagConstraintsRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormTagConstraintsRepository returns the TagConstraintsRepository running the operations with db
+func NewGormTagConstraintsRepository(db *gorm.DB) *GormTagConstraintsRepository {
+	return &GormTagConstraintsRepository{DB: db}
+}
+
+func (r *GormTagConstraintsRepository) Create(ctx context.Context, in *TagConstraints) (*TagConstraints, error) {
+	return DefaultCreateTagConstraints(ctx, in, r.DB)
+}
+
+func (r *GormTagConstraintsRepository) CreateSet(ctx context.Context, in []*TagConstraints, batchSize int) ([]*TagConstraints, error) {
+	return DefaultCreateTagConstraintsSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormTagConstraintsRepository) Read(ctx context.Context, in *TagConstraints, fs *query.FieldSelection) (*TagConstraints, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of TagConstraints doesn't support field selection")
 	}
-	pbResponse, err := ormResponse.ToPB(ctx)
-	return &pbResponse, err
+	return DefaultReadTagConstraints(ctx, in, r.DB)
 }
 
-type PrimaryIncludedORMWithBeforeReadApplyQuery interface {
-	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+func (r *GormTagConstraintsRepository) StrictUpdate(ctx context.Context, in *TagConstraints) (*TagConstraints, error) {
+	return DefaultStrictUpdateTagConstraints(ctx, in, r.DB)
 }
-type PrimaryIncludedORMWithBeforeReadFind interface {
-	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+
+func (r *GormTagConstraintsRepository) Patch(ctx context.Context, in *TagConstraints, updateMask *field_mask.FieldMask) (*TagConstraints, error) {
+	return DefaultPatchTagConstraints(ctx, in, updateMask, r.DB)
 }
-type PrimaryIncludedORMWithAfterReadFind interface {
-	AfterReadFind(context.Context, *gorm.DB) error
+
+func (r *GormTagConstraintsRepository) PatchSet(ctx context.Context, objects []*TagConstraints, updateMasks []*field_mask.FieldMask) ([]*TagConstraints, error) {
+	return DefaultPatchSetTagConstraints(ctx, objects, updateMasks, r.DB)
 }
 
-func DefaultDeletePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
+func (r *GormTagConstraintsRepository) Delete(ctx context.Context, in *TagConstraints) error {
+	return DefaultDeleteTagConstraints(ctx, in, r.DB)
+}
+
+func (r *GormTagConstraintsRepository) DeleteSet(ctx context.Context, in []*TagConstraints) error {
+	return DefaultDeleteTagConstraintsSet(ctx, in, r.DB)
+}
+
+func (r *GormTagConstraintsRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TagConstraints, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TagConstraints doesn't support filtering")
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return err
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TagConstraints doesn't support sorting")
 	}
-	if ormObj.Id == go_uuid.Nil {
-		return errors.EmptyIdError
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TagConstraints doesn't support pagination")
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeDelete_); ok {
-		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
-			return err
-		}
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of TagConstraints doesn't support field selection")
 	}
-	err = db.Where(&ormObj).Delete(&PrimaryIncludedORM{}).Error
+	return DefaultListTagConstraints(ctx, r.DB)
+}
+
+func (r *GormTagConstraintsRepository) PageToken(ctx context.Context, last *TagConstraints, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
 	if err != nil {
-		return err
+		return "", err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterDelete_); ok {
-		err = hook.AfterDelete_(ctx, db)
+	keyset, err := paging.NewKeyset(r.DB, &TagConstraintsORM{}, s, f)
+	if err != nil {
+		return "", err
 	}
-	return err
+	return keyset.Token(&ormObj)
+}
+
+func (r *GormTagConstraintsRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of TagConstraints doesn't support filtering")
+	}
+	return DefaultCountTagConstraints(ctx, r.DB, strategy)
+}
+
+func (r *GormTagConstraintsRepository) Upsert(ctx context.Context, in *TagConstraints, target string, updateMask *field_mask.FieldMask) (*TagConstraints, error) {
+	return DefaultUpsertTagConstraints(ctx, in, target, updateMask, r.DB)
 }
 
-type PrimaryIncludedORMWithBeforeDelete_ interface {
-	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+// MemoryTagConstraintsRepository is the TagConstraintsRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryTagConstraintsRepository struct {
+	table memory.Table
 }
-type PrimaryIncludedORMWithAfterDelete_ interface {
-	AfterDelete_(context.Context, *gorm.DB) error
+
+// NewMemoryTagConstraintsRepository returns an empty MemoryTagConstraintsRepository
+func NewMemoryTagConstraintsRepository() *MemoryTagConstraintsRepository {
+	return &MemoryTagConstraintsRepository{}
 }
 
-func DefaultDeletePrimaryIncludedSet(ctx context.Context, in []*PrimaryIncluded, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
-	var err error
-	keys := []go_uuid.UUID{}
-	for _, obj := range in {
-		ormObj, err := obj.ToORM(ctx)
-		if err != nil {
-			return err
-		}
-		if ormObj.Id == go_uuid.Nil {
-			return errors.EmptyIdError
-		}
-		keys = append(keys, ormObj.Id)
-	}
-	if hook, ok := (interface{}(&PrimaryIncludedORM{})).(PrimaryIncludedORMWithBeforeDeleteSet); ok {
-		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
-			return err
-		}
-	}
-	err = db.Where("id in (?)", keys).Delete(&PrimaryIncludedORM{}).Error
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryTagConstraintsRepository) row(ctx context.Context, in, stored *TagConstraints) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return err
+		return memory.Row{}, err
 	}
-	if hook, ok := (interface{}(&PrimaryIncludedORM{})).(PrimaryIncludedORMWithAfterDeleteSet); ok {
-		err = hook.AfterDeleteSet(ctx, in, db)
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
 	}
-	return err
+	return memory.Row{Tenant: nil, Key: ormObj.Id, Object: &out}, nil
 }
 
-type PrimaryIncludedORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*PrimaryIncluded, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryIncludedORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*PrimaryIncluded, *gorm.DB) error
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryTagConstraintsRepository) tenant(ctx context.Context) (interface{}, error) {
+	_, err := (&TagConstraints{}).ToORM(ctx)
+	return nil, err
 }
 
-// DefaultStrictUpdatePrimaryIncluded clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) (*PrimaryIncluded, error) {
+func (r *MemoryTagConstraintsRepository) Create(ctx context.Context, in *TagConstraints) (*TagConstraints, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryIncluded")
+		return nil, errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
+	if err := in.Validate(); err != nil {
 		return nil, err
 	}
-	var count int64
-	lockedRow := &PrimaryIncludedORM{}
-	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeStrictUpdateCleanup); ok {
-		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	filterChild := ExternalChildORM{}
-	if ormObj.Id == go_uuid.Nil {
-		return nil, errors.EmptyIdError
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
 	}
-	filterChild.PrimaryIncludedId = new(go_uuid.UUID)
-	*filterChild.PrimaryIncludedId = ormObj.Id
-	if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
+	if err = r.table.Insert(row); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeStrictUpdateSave); ok {
-		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+	return row.Object.(*TagConstraints), nil
+}
+
+func (r *MemoryTagConstraintsRepository) CreateSet(ctx context.Context, in []*TagConstraints, batchSize int) ([]*TagConstraints, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*TagConstraints, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
 			return nil, err
 		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*TagConstraints))
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	if err := r.table.Insert(rows...); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterStrictUpdateSave); ok {
-		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
-			return nil, err
-		}
+	return out, nil
+}
+
+func (r *MemoryTagConstraintsRepository) Read(ctx context.Context, in *TagConstraints, fs *query.FieldSelection) (*TagConstraints, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
+	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if count == 0 {
-		err = gateway.SetCreated(ctx, "")
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
 	}
-	return &pbResponse, err
-}
-
-type PrimaryIncludedORMWithBeforeStrictUpdateCleanup interface {
-	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryIncludedORMWithBeforeStrictUpdateSave interface {
-	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryIncludedORMWithAfterStrictUpdateSave interface {
-	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+	out, ok := r.table.Get(nil, ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*TagConstraints), nil
 }
 
-// DefaultPatchPrimaryIncluded executes a basic gorm update call with patch behavior
-func DefaultPatchPrimaryIncluded(ctx context.Context, in *PrimaryIncluded, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryIncluded, error) {
+func (r *MemoryTagConstraintsRepository) StrictUpdate(ctx context.Context, in *TagConstraints) (*TagConstraints, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj PrimaryIncluded
-	var err error
-	if hook, ok := interface{}(&pbObj).(PrimaryIncludedWithBeforePatchRead); ok {
-		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	if err := in.Validate(); err != nil {
+		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(PrimaryIncludedWithBeforePatchApplyFieldMask); ok {
-		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
 	}
-	if _, err := DefaultApplyFieldMaskPrimaryIncluded(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err = r.table.Save(row); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(PrimaryIncludedWithBeforePatchSave); ok {
-		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	return row.Object.(*TagConstraints), nil
+}
+
+func (r *MemoryTagConstraintsRepository) Patch(ctx context.Context, in *TagConstraints, updateMask *field_mask.FieldMask) (*TagConstraints, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
 	}
-	pbResponse, err := DefaultStrictUpdatePrimaryIncluded(ctx, &pbObj, db)
+	patchee, err := r.Read(ctx, &TagConstraints{Id: in.GetId()}, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(PrimaryIncludedWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	if patchee, err = DefaultApplyFieldMaskTagConstraints(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
 	}
-	return pbResponse, nil
-}
-
-type PrimaryIncludedWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryIncludedWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryIncludedWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryIncludedWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) error
+	return r.StrictUpdate(ctx, patchee)
 }
 
-// DefaultPatchSetPrimaryIncluded executes a bulk gorm update call with patch behavior
-func DefaultPatchSetPrimaryIncluded(ctx context.Context, objects []*PrimaryIncluded, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PrimaryIncluded, error) {
+func (r *MemoryTagConstraintsRepository) PatchSet(ctx context.Context, objects []*TagConstraints, updateMasks []*field_mask.FieldMask) ([]*TagConstraints, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*PrimaryIncluded, 0, len(objects))
+	out := make([]*TagConstraints, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchPrimaryIncluded(ctx, patcher, updateMasks[i], db)
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
+		out = append(out, patched)
 	}
+	return out, nil
+}
 
-	return results, nil
+func (r *MemoryTagConstraintsRepository) Delete(ctx context.Context, in *TagConstraints) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(nil, ormObj.Id)
+	return nil
 }
 
-// DefaultApplyFieldMaskPrimaryIncluded patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskPrimaryIncluded(ctx context.Context, patchee *PrimaryIncluded, patcher *PrimaryIncluded, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PrimaryIncluded, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
+func (r *MemoryTagConstraintsRepository) DeleteSet(ctx context.Context, in []*TagConstraints) error {
+	if in == nil {
+		return errors.NilArgumentError
 	}
-	var err error
-	var updatedChild bool
-	for i, f := range updateMask.Paths {
-		if !updatedChild && strings.HasPrefix(f, prefix+"Child.") {
-			updatedChild = true
-			if patcher.Child == nil {
-				patchee.Child = nil
-				continue
-			}
-			if patchee.Child == nil {
-				patchee.Child = &ExternalChild{}
-			}
-			if o, err := DefaultApplyFieldMaskExternalChild(ctx, patchee.Child, patcher.Child, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Child.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.Child = o
-			}
-			continue
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
 		}
-		if f == prefix+"Child" {
-			updatedChild = true
-			patchee.Child = patcher.Child
-			continue
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
 		}
+		keys = append(keys, ormObj.Id)
 	}
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryTagConstraintsRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*TagConstraints, error) {
+	tenant, err := r.tenant(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return patchee, nil
-}
-
-// DefaultListPrimaryIncluded executes a gorm list call
-func DefaultListPrimaryIncluded(ctx context.Context, db *gorm.DB) ([]*PrimaryIncluded, error) {
-	in := PrimaryIncluded{}
-	ormObj, err := in.ToORM(ctx)
+	objects, err := r.table.List(tenant, f, s, p)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
+	out := make([]*TagConstraints, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*TagConstraints))
+	}
+	return out, nil
+}
+
+func (r *MemoryTagConstraintsRepository) PageToken(ctx context.Context, last *TagConstraints, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PrimaryIncludedORM{}, &PrimaryIncluded{}, nil, nil, nil, nil)
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryTagConstraintsRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
 	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryTagConstraintsRepository) Upsert(ctx context.Context, in *TagConstraints, target string, updateMask *field_mask.FieldMask) (*TagConstraints, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := TagConstraintsConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for TagConstraints", target)
+	}
+	if _, err := upsertTagConstraintsColumns(updateMask); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
-			return nil, err
-		}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []PrimaryIncludedORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
+	found, ok, err := r.table.Conflicting(nil, func(m proto.Message) bool {
+		other, err := m.(*TagConstraints).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
-			return nil, err
-		}
+	if !ok {
+		return r.Create(ctx, in)
 	}
-	pbResponse := []*PrimaryIncluded{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
+	existing := found.(*TagConstraints)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskTagConstraints(ctx, existing, in, updateMask, "", nil)
 		if err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
+		return r.StrictUpdate(ctx, patched)
 	}
-	return pbResponse, nil
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*TagConstraints)
+	updated.Id = existing.Id
+	return r.StrictUpdate(ctx, updated)
 }
 
-type PrimaryIncludedORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryIncludedORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+// TagConstraintsFixtureOption customizes the object returned by NewTagConstraintsFixture
+type TagConstraintsFixtureOption func(*TagConstraints)
+
+// NewTagConstraintsFixture returns a TagConstraints with random valid values in its fields and
+// its required associations, customized by opts
+func NewTagConstraintsFixture(opts ...TagConstraintsFixtureOption) *TagConstraints {
+	m := &TagConstraints{}
+	m.Nickname = &wrapperspb.StringValue{Value: fixture.String(8)}
+	m.Price = fixture.Float(1000000)
+	m.Status = TestTypesStatus(fixture.Enum(TestTypesStatus(0).Descriptor()))
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
-type PrimaryIncludedORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]PrimaryIncludedORM) error
+
+// CreateTagConstraintsFixture creates the object returned by NewTagConstraintsFixture and its
+// associations with DefaultCreateTagConstraints
+func CreateTagConstraintsFixture(ctx context.Context, db *gorm.DB, opts ...TagConstraintsFixtureOption) (*TagConstraints, error) {
+	return DefaultCreateTagConstraints(ctx, NewTagConstraintsFixture(opts...), db)
 }
@@ -2,9 +2,28 @@ package example
 
 import (
 	"context"
+	"database/sql/driver"
+	stderrors "errors"
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/acanseco/protoc-gen-gorm/errors"
+	"github.com/acanseco/protoc-gen-gorm/runtime/audit"
+	"github.com/acanseco/protoc-gen-gorm/runtime/insert"
+	"github.com/acanseco/protoc-gen-gorm/runtime/paging"
+	"github.com/acanseco/protoc-gen-gorm/runtime/tenant"
 	"github.com/acanseco/protoc-gen-gorm/types"
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestInet(t *testing.T) {
@@ -108,4 +127,536 @@ func TestTypeWithIDORM_ToPB(t *testing.T) {
 			}
 		}
 	})
-}
\ No newline at end of file
+}
+
+func TestValidate(t *testing.T) {
+	valid := &TagConstraints{Nickname: &wrapperspb.StringValue{Value: "déjà vu"}, Price: 999999.99, Status: TestTypes_GOOD}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	invalid := &TagConstraints{Price: -1e6, Status: TestTypesStatus(7)}
+	_, err := DefaultCreateTagConstraints(context.Background(), invalid, nil)
+	var verr *errors.ValidationError
+	if !stderrors.As(err, &verr) || status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("got error %v; want a validation error", err)
+	}
+	want := []errors.FieldViolation{
+		{Field: "nickname", Description: "is required"},
+		{Field: "price", Description: "must have at most 6 digits before the decimal point"},
+		{Field: "status", Description: "is not a valid status"},
+	}
+	if !reflect.DeepEqual(verr.Violations, want) {
+		t.Errorf("got violations %v; want %v", verr.Violations, want)
+	}
+
+	// the set and upsert handlers validate their objects before any query,
+	// the database being nil
+	valid = &TagConstraints{Nickname: &wrapperspb.StringValue{Value: "ok"}}
+	if _, err := DefaultCreateTagConstraintsSet(context.Background(), []*TagConstraints{valid, invalid}, nil, 0); !stderrors.As(err, &verr) {
+		t.Errorf("got error %v; want a validation error for the set", err)
+	}
+	if _, err := DefaultUpsertTagConstraints(context.Background(), invalid, "id", nil, nil); !stderrors.As(err, &verr) {
+		t.Errorf("got error %v; want a validation error for the upsert", err)
+	}
+
+	invalid = &TagConstraints{Nickname: &wrapperspb.StringValue{Value: "too long name"}}
+	if err := invalid.Validate(); err == nil || err.Error() != "invalid argument: nickname must be at most 8 characters" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+// notTime matches the arguments other than t.
+type notTime struct {
+	t time.Time
+}
+
+func (m notTime) Match(v driver.Value) bool {
+	got, ok := v.(time.Time)
+	return !ok || !got.Equal(m.t)
+}
+
+func TestProtectedFields(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+	ctx := context.Background()
+	sent := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	stored := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "blog_posts" \("author","created_at","slug","title"\)`).
+		WithArgs("alice", notTime{sent}, "hello", "Hello").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	in := &BlogPost{Title: "Hello", Author: "alice", Slug: "hello", CreatedAt: timestamppb.New(sent)}
+	if _, err := DefaultCreateBlogPost(ctx, in, db); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mock.ExpectQuery(`SELECT \* FROM "blog_posts" WHERE \(id=\$1\)`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "author", "created_at", "slug"}).AddRow(1, "Hello", "alice", stored, "hello"))
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "blog_posts" SET "author" = \$1, "created_at" = \$2, "slug" = \$3, "title" = \$4 WHERE "blog_posts"."id" = \$5`).
+		WithArgs("bob", stored, "hello", "Bye", 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	in = &BlogPost{Id: 1, Title: "Bye", Author: "bob", Slug: "bye", CreatedAt: timestamppb.New(sent)}
+	updated, err := DefaultStrictUpdateBlogPost(ctx, in, db)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.GetSlug() != "hello" || !updated.GetCreatedAt().AsTime().Equal(stored) {
+		t.Errorf("got %v; want the stored slug and creation time", updated)
+	}
+
+	mask := &field_mask.FieldMask{Paths: []string{"Slug"}}
+	if _, err := DefaultApplyFieldMaskBlogPost(ctx, &BlogPost{}, in, mask, "", db); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("got error %v; want InvalidArgument for an immutable path", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+type hookCallsKey struct{}
+
+func (m *ExternalChildORM) BeforeCreate_(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	if calls, ok := ctx.Value(hookCallsKey{}).(*[]string); ok {
+		*calls = append(*calls, "before "+m.Id)
+	}
+	return db, nil
+}
+
+func (m *ExternalChildORM) AfterCreate_(ctx context.Context, db *gorm.DB) error {
+	if calls, ok := ctx.Value(hookCallsKey{}).(*[]string); ok {
+		*calls = append(*calls, "after "+m.Id)
+	}
+	return nil
+}
+
+func TestCreateSet(t *testing.T) {
+	var calls []string
+	ctx := context.WithValue(context.Background(), hookCallsKey{}, &calls)
+	if _, err := DefaultCreateExternalChildSet(ctx, []*ExternalChild{{Id: "a"}, nil}, nil, 0); err != errors.NilArgumentError {
+		t.Errorf("got error %v; want NilArgumentError", err)
+	}
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "external_children"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("a").AddRow("b"))
+	mock.ExpectCommit()
+	if _, err := DefaultCreateExternalChildSet(ctx, []*ExternalChild{{Id: "a"}, {Id: "b"}}, db, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"before a", "before b", "after a", "after b"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("got hook calls %v; want %v", calls, want)
+	}
+
+	// on the open transaction of the txn middleware the set is inserted
+	// without a nested one, and the transaction is left to its owner
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "external_children"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("c"))
+	mock.ExpectCommit()
+	tx := db.Begin()
+	if _, err := DefaultCreateExternalChildSet(ctx, []*ExternalChild{{Id: "c"}}, tx, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFixture(t *testing.T) {
+	ctx := tenant.Bypass(context.Background(), "test")
+	fixture := NewTypeWithIDFixture(func(m *TypeWithID) { m.Ip = "10.0.0.1" })
+	if err := fixture.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fixture.GetIp() != "10.0.0.1" {
+		t.Errorf("got ip %q; want the one of the option", fixture.GetIp())
+	}
+	if fixture.GetPoint() == nil || fixture.GetUser().GetBillingAddress() == nil {
+		t.Errorf("got %v; want the belongs_to associations to be built", fixture)
+	}
+	if _, err := fixture.ToORM(ctx); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := NewTestTypesFixture().ToORM(ctx); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "int_points"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`INSERT INTO "addresses"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`INSERT INTO "addresses"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	mock.ExpectQuery(`INSERT INTO "users"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("6ba7b810-9dad-11d1-80b4-00c04fd430c8"))
+	mock.ExpectQuery(`INSERT INTO "type_with_ids"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	created, err := CreateTypeWithIDFixture(ctx, db)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.GetPoint().GetId() != 1 || created.GetUser().GetShippingAddress().GetId().GetResourceId() != "2" {
+		t.Errorf("got %v; want the associations created with the object", created)
+	}
+
+	// the not null foreign key of a has_many child is set by its parent
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "threads"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectQuery(`INSERT INTO "replies" \("code","thread_id"\)`).
+		WithArgs(sqlmock.AnyArg(), 7).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	reply, err := CreateReplyFixture(ctx, db)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if reply.GetId() != 1 || len(reply.GetCode()) != 4 {
+		t.Errorf("got %v; want the reply created in its thread", reply)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAudit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+	audit.SetActor(audit.ActorFunc(func(ctx context.Context) string { return "alice" }))
+	defer audit.SetActor(audit.ActorFunc(func(ctx context.Context) string { return "" }))
+	ctx := tenant.Bypass(context.Background(), "test")
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "multiaccount_type_with_ids"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`INSERT INTO "multiaccount_type_with_ids_history" \("object_id","account_id","operation","actor","changed_at","changed_fields","before","after"\)`).
+		WithArgs(1, "", audit.Create, "alice", sqlmock.AnyArg(), "id,some_field", nil, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	if _, err := DefaultCreateMultiaccountTypeWithID(ctx, &MultiaccountTypeWithID{SomeField: "a"}, db); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "multiaccount_type_with_ids" WHERE \("multiaccount_type_with_ids"."id" = \$1\) FOR UPDATE`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "some_field"}).AddRow(1, "a"))
+	mock.ExpectExec(`DELETE FROM "multiaccount_type_with_ids"`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`INSERT INTO "multiaccount_type_with_ids_history"`).
+		WithArgs(1, "", audit.Delete, "alice", sqlmock.AnyArg(), "id,some_field", sqlmock.AnyArg(), nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	mock.ExpectCommit()
+	if err := DefaultDeleteMultiaccountTypeWithID(ctx, &MultiaccountTypeWithID{Id: 1}, db); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mock.ExpectQuery(`SELECT \* FROM "multiaccount_type_with_ids_history" WHERE \(object_id = \$1\) ORDER BY changed_at, id`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "object_id", "operation"}).AddRow(1, 1, audit.Create).AddRow(2, 1, audit.Delete))
+	history, err := DefaultListMultiaccountTypeWithIDHistory(ctx, &MultiaccountTypeWithID{Id: 1}, db)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(history) != 2 || history[1].Operation != audit.Delete {
+		t.Errorf("unexpected history %+v", history)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestOutbox(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+	ctx := tenant.Bypass(context.Background(), "test")
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "tenant_type_with_ids"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectQuery(`INSERT INTO "outbox" \("type","aggregate_type","aggregate_id","state","old_state","created_at"\)`).
+		WithArgs("TenantTypeWithIDCreated", "TenantTypeWithID", "7", sqlmock.AnyArg(), []byte(nil), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	if _, err := DefaultCreateTenantTypeWithID(ctx, &TenantTypeWithID{SomeField: "a"}, db); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "tenant_type_with_ids" WHERE \("tenant_type_with_ids"."id" = \$1\) .* FOR UPDATE`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "some_field"}).AddRow(7, "a"))
+	mock.ExpectQuery(`INSERT INTO "tenant_type_with_ids" .* ON CONFLICT \("id"\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectQuery(`SELECT \* FROM "tenant_type_with_ids" WHERE \(id = \$1\)`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "some_field"}).AddRow(7, "b"))
+	mock.ExpectQuery(`INSERT INTO "outbox"`).
+		WithArgs("TenantTypeWithIDUpdated", "TenantTypeWithID", "7", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	mock.ExpectCommit()
+	if _, err := DefaultUpsertTenantTypeWithID(ctx, &TenantTypeWithID{Id: 7, SomeField: "b"}, "id", nil, db); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "tenant_type_with_ids" WHERE \("tenant_type_with_ids"."id" = \$1\) FOR UPDATE`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "some_field"}).AddRow(7, "a"))
+	mock.ExpectExec(`DELETE FROM "tenant_type_with_ids"`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`INSERT INTO "outbox"`).
+		WithArgs("TenantTypeWithIDDeleted", "TenantTypeWithID", "7", []byte(nil), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	mock.ExpectCommit()
+	if err := DefaultDeleteTenantTypeWithID(ctx, &TenantTypeWithID{Id: 7}, db); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRowLevelSecurity(t *testing.T) {
+	// with an empty setting, as left by SET LOCAL on a pooled connection, the
+	// policies must match no row rather than fail to cast '' or match it
+	setting := fmt.Sprintf("NULLIF(current_setting('%s', true), '')", tenant.Setting)
+	for name, test := range map[string]struct {
+		statements []string
+		condition  string
+	}{
+		"string": {MultiaccountTypeWithIDORM{}.RowLevelSecurity(), `"account_id" = ` + setting},
+		"uuid":   {TenantTypeWithIDORM{}.RowLevelSecurity(), `"org_id" = ` + setting + `::uuid`},
+	} {
+		policy := test.statements[len(test.statements)-1]
+		if want := fmt.Sprintf("USING (%s) WITH CHECK (%s)", test.condition, test.condition); !strings.HasSuffix(policy, want) {
+			t.Errorf("%s: got policy %s; want it to end with %s", name, policy, want)
+		}
+	}
+}
+
+type tenantKey struct{}
+
+func TestMemoryRepository(t *testing.T) {
+	tenant.SetResolver(tenant.ResolverFunc(func(ctx context.Context) (string, error) {
+		id, _ := ctx.Value(tenantKey{}).(string)
+		return id, nil
+	}))
+	defer tenant.SetResolver(nil)
+	acme := context.WithValue(context.Background(), tenantKey{}, "acme")
+	initech := context.WithValue(context.Background(), tenantKey{}, "initech")
+	repository := NewMemoryMultiaccountTypeWithIDRepository()
+
+	created, err := repository.CreateSet(acme, []*MultiaccountTypeWithID{{SomeField: "b"}, {SomeField: "a"}}, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created[0].GetId() != 1 || created[1].GetId() != 2 {
+		t.Errorf("got ids %d and %d; want 1 and 2", created[0].GetId(), created[1].GetId())
+	}
+	if _, err := repository.Create(initech, &MultiaccountTypeWithID{SomeField: "c"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := repository.Read(initech, &MultiaccountTypeWithID{Id: 1}, nil); err != gorm.ErrRecordNotFound {
+		t.Errorf("got error %v; want the object of another tenant not to be found", err)
+	}
+	patched, err := repository.Patch(acme, &MultiaccountTypeWithID{Id: 1, SomeField: "z"}, &field_mask.FieldMask{Paths: []string{"SomeField"}})
+	if err != nil || patched.GetSomeField() != "z" {
+		t.Fatalf("got %v, %v; want the patched object", patched, err)
+	}
+
+	s, err := query.ParseSorting("some_field desc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	listed, err := repository.List(acme, nil, s, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var fields []string
+	for _, object := range listed {
+		fields = append(fields, object.GetSomeField())
+	}
+	if !reflect.DeepEqual(fields, []string{"z", "a"}) {
+		t.Errorf("got %v; want the objects of acme sorted", fields)
+	}
+	f, err := query.ParseFiltering(`some_field == "c"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count, err := repository.Count(tenant.Bypass(acme, "test"), f, paging.ExactCount); err != nil || count != 1 {
+		t.Errorf("got %d, %v; want the object of initech counted when bypassing tenant isolation", count, err)
+	}
+
+	if err := repository.DeleteSet(initech, []*MultiaccountTypeWithID{{Id: 1}, {Id: 3}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count, _ := repository.Count(acme, nil, paging.ExactCount); count != 2 {
+		t.Errorf("got %d objects of acme; want them not to be deleted by initech", count)
+	}
+}
+
+func TestRepositoriesProtectedFields(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+	ctx := context.Background()
+	sent := timestamppb.New(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "blog_posts"`).
+		WithArgs("", notTime{sent.AsTime()}, "hello", "Hello").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(`SELECT \* FROM "blog_posts" WHERE \(id=\$1\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "slug"}).AddRow(1, "Hello", "hello"))
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "blog_posts"`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`ON CONFLICT \("slug"\)`).
+		WithArgs("", notTime{sent.AsTime()}, "hello", "Again").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	for name, repository := range map[string]BlogPostRepository{
+		"gorm":   NewGormBlogPostRepository(db),
+		"memory": NewMemoryBlogPostRepository(),
+	} {
+		created, err := repository.Create(ctx, &BlogPost{Title: "Hello", Slug: "hello", CreatedAt: sent})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if created.GetId() != 1 || created.GetCreatedAt().AsTime().Equal(sent.AsTime()) {
+			t.Errorf("%s: got %v; want the output only field not stored", name, created)
+		}
+		updated, err := repository.StrictUpdate(ctx, &BlogPost{Id: 1, Title: "Bye", Slug: "bye", CreatedAt: sent})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if updated.GetSlug() != "hello" || updated.GetCreatedAt().AsTime().Equal(sent.AsTime()) {
+			t.Errorf("%s: got %v; want the immutable and output only fields kept", name, updated)
+		}
+		upserted, err := repository.Upsert(ctx, &BlogPost{Title: "Again", Slug: "hello", CreatedAt: sent}, "idx_blog_post_slug", nil)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if upserted.GetId() != 1 || upserted.GetCreatedAt().AsTime().Equal(sent.AsTime()) {
+			t.Errorf("%s: got %v; want the conflicting object updated without the output only field", name, upserted)
+		}
+		mask := &field_mask.FieldMask{Paths: []string{"Slug"}}
+		if _, err := repository.Upsert(ctx, &BlogPost{Slug: "hello"}, "idx_blog_post_slug", mask); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: got error %v; want InvalidArgument for an immutable path", name, err)
+		}
+		mask = &field_mask.FieldMask{Paths: []string{"Title", "Body"}}
+		if _, err := repository.Upsert(ctx, &BlogPost{Slug: "hello"}, "idx_blog_post_slug", mask); status.Code(err) != codes.InvalidArgument ||
+			!strings.Contains(err.Error(), `"Body"`) {
+			t.Errorf("%s: got error %v; want InvalidArgument naming the unknown path", name, err)
+		}
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRepositoriesConflictScope(t *testing.T) {
+	tenant.SetResolver(tenant.ResolverFunc(func(ctx context.Context) (string, error) {
+		id, _ := ctx.Value(tenantKey{}).(string)
+		return id, nil
+	}))
+	defer tenant.SetResolver(nil)
+	acme := context.WithValue(context.Background(), tenantKey{}, "acme")
+	initech := context.WithValue(context.Background(), tenantKey{}, "initech")
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "multiaccount_type_with_ids"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`INSERT INTO "multiaccount_type_with_ids_history"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	// the conflicting row of acme isn't updated and isn't returned
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "multiaccount_type_with_ids" .* FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "account_id"}).AddRow(1, "acme"))
+	mock.ExpectQuery(`ON CONFLICT \("id"\) .* WHERE "multiaccount_type_with_ids"."account_id" = EXCLUDED."account_id"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+
+	for name, repository := range map[string]MultiaccountTypeWithIDRepository{
+		"gorm":   NewGormMultiaccountTypeWithIDRepository(db),
+		"memory": NewMemoryMultiaccountTypeWithIDRepository(),
+	} {
+		if _, err := repository.Create(acme, &MultiaccountTypeWithID{SomeField: "a"}); err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		_, err := repository.Upsert(initech, &MultiaccountTypeWithID{Id: 1, SomeField: "b"}, "id", nil)
+		if !stderrors.Is(err, insert.ErrOtherScope) || status.Code(err) != codes.AlreadyExists {
+			t.Errorf("%s: got error %v; want AlreadyExists for the object of another tenant", name, err)
+		}
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
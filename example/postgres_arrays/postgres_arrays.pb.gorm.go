@@ -3,12 +3,22 @@ package postgres_arrays
 import (
 	context "context"
 	fmt "fmt"
+	errors "github.com/acanseco/protoc-gen-gorm/errors"
+	fixture "github.com/acanseco/protoc-gen-gorm/runtime/fixture"
+	insert "github.com/acanseco/protoc-gen-gorm/runtime/insert"
+	memory "github.com/acanseco/protoc-gen-gorm/runtime/memory"
+	paging "github.com/acanseco/protoc-gen-gorm/runtime/paging"
+	preload "github.com/acanseco/protoc-gen-gorm/runtime/preload"
+	transaction "github.com/acanseco/protoc-gen-gorm/runtime/transaction"
 	gateway "github.com/infobloxopen/atlas-app-toolkit/gateway"
 	gorm1 "github.com/infobloxopen/atlas-app-toolkit/gorm"
-	errors "github.com/acanseco/protoc-gen-gorm/errors"
+	query "github.com/infobloxopen/atlas-app-toolkit/query"
 	gorm "github.com/jinzhu/gorm"
 	pq "github.com/lib/pq"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	proto "google.golang.org/protobuf/proto"
 )
 
 type ExampleORM struct {
@@ -93,6 +103,16 @@ func (m *ExampleORM) ToPB(ctx context.Context) (Example, error) {
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *Example) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type Example the arg will be the target, the caller the one being converted from
 
@@ -121,6 +141,9 @@ func DefaultCreateExample(ctx context.Context, in *Example, db *gorm.DB) (*Examp
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
@@ -149,6 +172,75 @@ type ExampleORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateExampleSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateExampleSet(ctx context.Context, in []*Example, db *gorm.DB, batchSize int) ([]*Example, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*ExampleORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&ExampleORM{})).(ExampleORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(ExampleORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(ExampleORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&ExampleORM{})).(ExampleORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*Example, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type ExampleORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*ExampleORM, *gorm.DB) (*gorm.DB, error)
+}
+type ExampleORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*ExampleORM, *gorm.DB) error
+}
+
 func DefaultReadExample(ctx context.Context, in *Example, db *gorm.DB) (*Example, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
@@ -165,7 +257,7 @@ func DefaultReadExample(ctx context.Context, in *Example, db *gorm.DB) (*Example
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &ExampleORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelectionEx(ctx, db, nil, &ExampleORM{}, preload.NewConverter(&Example{})); err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormObj).(ExampleORMWithBeforeReadFind); ok {
@@ -272,6 +364,9 @@ func DefaultStrictUpdateExample(ctx context.Context, in *Example, db *gorm.DB) (
 	if in == nil {
 		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateExample")
 	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
@@ -443,7 +538,7 @@ func DefaultListExample(ctx context.Context, db *gorm.DB) ([]*Example, error) {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &ExampleORM{}, &Example{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperatorsEx(ctx, db, &ExampleORM{}, preload.NewConverter(&Example{}), nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -483,3 +578,481 @@ type ExampleORMWithBeforeListFind interface {
 type ExampleORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]ExampleORM) error
 }
+
+// DefaultCountExample returns the number of rows DefaultListExample pages through
+func DefaultCountExample(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := Example{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(ExampleORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ExampleORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ExampleORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	return paging.Count(db.Where(&ormObj), &ExampleORM{}, strategy)
+}
+
+type ExampleORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// ExampleConflictTargets maps the conflict targets accepted by DefaultUpsertExample to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var ExampleConflictTargets = map[string][]string{
+	"id": {"id"},
+}
+
+// DefaultUpsertExample inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertExample(ctx context.Context, in *Example, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*Example, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	conflict := insert.OnConflict{
+		Columns:   ExampleConflictTargets[target],
+		UpdateAll: updateMask == nil,
+	}
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for Example", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertExampleColumns(updateMask)
+		if err != nil {
+			return nil, err
+		}
+		conflict.Update = columns
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ExampleORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ExampleORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ExampleORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ExampleORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// upsertExampleColumns returns the columns of the fields of updateMask the upserts of
+// Example overwrite, the immutable fields and the paths of no column being rejected
+func upsertExampleColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		case "Description":
+			columns = append(columns, "description")
+		case "ArrayOfBools":
+			columns = append(columns, "array_of_bools")
+		case "ArrayOfFloat64":
+			columns = append(columns, "array_of_float64")
+		case "ArrayOfInt64":
+			columns = append(columns, "array_of_int64")
+		case "ArrayOfString":
+			columns = append(columns, "array_of_string")
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
+		}
+	}
+	return columns, nil
+}
+
+// ExampleRepository runs the Default operations of Example, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type ExampleRepository interface {
+	Create(ctx context.Context, in *Example) (*Example, error)
+	CreateSet(ctx context.Context, in []*Example, batchSize int) ([]*Example, error)
+	Read(ctx context.Context, in *Example, fs *query.FieldSelection) (*Example, error)
+	StrictUpdate(ctx context.Context, in *Example) (*Example, error)
+	Patch(ctx context.Context, in *Example, updateMask *field_mask.FieldMask) (*Example, error)
+	PatchSet(ctx context.Context, objects []*Example, updateMasks []*field_mask.FieldMask) ([]*Example, error)
+	Delete(ctx context.Context, in *Example) error
+	DeleteSet(ctx context.Context, in []*Example) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Example, error)
+	PageToken(ctx context.Context, last *Example, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *Example, target string, updateMask *field_mask.FieldMask) (*Example, error)
+}
+
+// GormExampleRepository is the ExampleRepository calling the Default handlers with DB
+type GormExampleRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormExampleRepository returns the ExampleRepository running the operations with db
+func NewGormExampleRepository(db *gorm.DB) *GormExampleRepository {
+	return &GormExampleRepository{DB: db}
+}
+
+func (r *GormExampleRepository) Create(ctx context.Context, in *Example) (*Example, error) {
+	return DefaultCreateExample(ctx, in, r.DB)
+}
+
+func (r *GormExampleRepository) CreateSet(ctx context.Context, in []*Example, batchSize int) ([]*Example, error) {
+	return DefaultCreateExampleSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormExampleRepository) Read(ctx context.Context, in *Example, fs *query.FieldSelection) (*Example, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of Example doesn't support field selection")
+	}
+	return DefaultReadExample(ctx, in, r.DB)
+}
+
+func (r *GormExampleRepository) StrictUpdate(ctx context.Context, in *Example) (*Example, error) {
+	return DefaultStrictUpdateExample(ctx, in, r.DB)
+}
+
+func (r *GormExampleRepository) Patch(ctx context.Context, in *Example, updateMask *field_mask.FieldMask) (*Example, error) {
+	return DefaultPatchExample(ctx, in, updateMask, r.DB)
+}
+
+func (r *GormExampleRepository) PatchSet(ctx context.Context, objects []*Example, updateMasks []*field_mask.FieldMask) ([]*Example, error) {
+	return DefaultPatchSetExample(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormExampleRepository) Delete(ctx context.Context, in *Example) error {
+	return DefaultDeleteExample(ctx, in, r.DB)
+}
+
+func (r *GormExampleRepository) DeleteSet(ctx context.Context, in []*Example) error {
+	return DefaultDeleteExampleSet(ctx, in, r.DB)
+}
+
+func (r *GormExampleRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Example, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Example doesn't support filtering")
+	}
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Example doesn't support sorting")
+	}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Example doesn't support pagination")
+	}
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Example doesn't support field selection")
+	}
+	return DefaultListExample(ctx, r.DB)
+}
+
+func (r *GormExampleRepository) PageToken(ctx context.Context, last *Example, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	keyset, err := paging.NewKeyset(r.DB, &ExampleORM{}, s, f)
+	if err != nil {
+		return "", err
+	}
+	return keyset.Token(&ormObj)
+}
+
+func (r *GormExampleRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of Example doesn't support filtering")
+	}
+	return DefaultCountExample(ctx, r.DB, strategy)
+}
+
+func (r *GormExampleRepository) Upsert(ctx context.Context, in *Example, target string, updateMask *field_mask.FieldMask) (*Example, error) {
+	return DefaultUpsertExample(ctx, in, target, updateMask, r.DB)
+}
+
+// MemoryExampleRepository is the ExampleRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryExampleRepository struct {
+	table memory.Table
+}
+
+// NewMemoryExampleRepository returns an empty MemoryExampleRepository
+func NewMemoryExampleRepository() *MemoryExampleRepository {
+	return &MemoryExampleRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryExampleRepository) row(ctx context.Context, in, stored *Example) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: nil, Key: ormObj.Id, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryExampleRepository) tenant(ctx context.Context) (interface{}, error) {
+	_, err := (&Example{}).ToORM(ctx)
+	return nil, err
+}
+
+func (r *MemoryExampleRepository) Create(ctx context.Context, in *Example) (*Example, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*Example), nil
+}
+
+func (r *MemoryExampleRepository) CreateSet(ctx context.Context, in []*Example, batchSize int) ([]*Example, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*Example, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*Example))
+	}
+	if err := r.table.Insert(rows...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *MemoryExampleRepository) Read(ctx context.Context, in *Example, fs *query.FieldSelection) (*Example, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	out, ok := r.table.Get(nil, ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*Example), nil
+}
+
+func (r *MemoryExampleRepository) StrictUpdate(ctx context.Context, in *Example) (*Example, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.table.Save(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*Example), nil
+}
+
+func (r *MemoryExampleRepository) Patch(ctx context.Context, in *Example, updateMask *field_mask.FieldMask) (*Example, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	patchee, err := r.Read(ctx, &Example{Id: in.GetId()}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if patchee, err = DefaultApplyFieldMaskExample(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
+	}
+	return r.StrictUpdate(ctx, patchee)
+}
+
+func (r *MemoryExampleRepository) PatchSet(ctx context.Context, objects []*Example, updateMasks []*field_mask.FieldMask) ([]*Example, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	out := make([]*Example, 0, len(objects))
+	for i, patcher := range objects {
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, patched)
+	}
+	return out, nil
+}
+
+func (r *MemoryExampleRepository) Delete(ctx context.Context, in *Example) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(nil, ormObj.Id)
+	return nil
+}
+
+func (r *MemoryExampleRepository) DeleteSet(ctx context.Context, in []*Example) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryExampleRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Example, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*Example, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*Example))
+	}
+	return out, nil
+}
+
+func (r *MemoryExampleRepository) PageToken(ctx context.Context, last *Example, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryExampleRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryExampleRepository) Upsert(ctx context.Context, in *Example, target string, updateMask *field_mask.FieldMask) (*Example, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := ExampleConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for Example", target)
+	}
+	if _, err := upsertExampleColumns(updateMask); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found, ok, err := r.table.Conflicting(nil, func(m proto.Message) bool {
+		other, err := m.(*Example).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r.Create(ctx, in)
+	}
+	existing := found.(*Example)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskExample(ctx, existing, in, updateMask, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		return r.StrictUpdate(ctx, patched)
+	}
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*Example)
+	updated.Id = existing.Id
+	return r.StrictUpdate(ctx, updated)
+}
+
+// ExampleFixtureOption customizes the object returned by NewExampleFixture
+type ExampleFixtureOption func(*Example)
+
+// NewExampleFixture returns a Example with random valid values in its fields and
+// its required associations, customized by opts
+func NewExampleFixture(opts ...ExampleFixtureOption) *Example {
+	m := &Example{}
+	m.Description = fixture.String(0)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CreateExampleFixture creates the object returned by NewExampleFixture and its
+// associations with DefaultCreateExample
+func CreateExampleFixture(ctx context.Context, db *gorm.DB, opts ...ExampleFixtureOption) (*Example, error) {
+	return DefaultCreateExample(ctx, NewExampleFixture(opts...), db)
+}
@@ -3,13 +3,23 @@ package user
 import (
 	context "context"
 	fmt "fmt"
-	auth "github.com/infobloxopen/atlas-app-toolkit/auth"
+	errors "github.com/acanseco/protoc-gen-gorm/errors"
+	collection "github.com/acanseco/protoc-gen-gorm/runtime/collection"
+	fixture "github.com/acanseco/protoc-gen-gorm/runtime/fixture"
+	insert "github.com/acanseco/protoc-gen-gorm/runtime/insert"
+	memory "github.com/acanseco/protoc-gen-gorm/runtime/memory"
+	paging "github.com/acanseco/protoc-gen-gorm/runtime/paging"
+	tenant "github.com/acanseco/protoc-gen-gorm/runtime/tenant"
+	tracing "github.com/acanseco/protoc-gen-gorm/runtime/tracing"
+	transaction "github.com/acanseco/protoc-gen-gorm/runtime/transaction"
 	gateway "github.com/infobloxopen/atlas-app-toolkit/gateway"
-	gorm1 "github.com/infobloxopen/atlas-app-toolkit/gorm"
 	resource "github.com/infobloxopen/atlas-app-toolkit/gorm/resource"
-	errors "github.com/acanseco/protoc-gen-gorm/errors"
+	query "github.com/infobloxopen/atlas-app-toolkit/query"
 	gorm "github.com/jinzhu/gorm"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	proto "google.golang.org/protobuf/proto"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	strings "strings"
 	time "time"
@@ -22,7 +32,7 @@ type UserORM struct {
 	Birthday          *time.Time
 	CreatedAt         *time.Time
 	CreditCard        *CreditCardORM `gorm:"foreignkey:UserId;association_foreignkey:Id"`
-	Emails            []*EmailORM    `gorm:"foreignkey:UserId;association_foreignkey:Id"`
+	Emails            []*EmailORM    `gorm:"foreignkey:UserId;association_foreignkey:Id;preload:true"`
 	ExternalUuid      *string        `gorm:"type:uuid"`
 	Friends           []*UserORM     `gorm:"foreignkey:Id;association_foreignkey:Id;many2many:user_friends;jointable_foreignkey:UserId;association_jointable_foreignkey:FriendId"`
 	Id                string         `gorm:"type:uuid;primary_key"`
@@ -30,7 +40,7 @@ type UserORM struct {
 	Num               uint32
 	ShippingAddress   *AddressORM `gorm:"foreignkey:ShippingAddressId;association_foreignkey:Id"`
 	ShippingAddressId *int64
-	Tasks             []*TaskORM `gorm:"foreignkey:UserId;association_foreignkey:Id" atlas:"position:Priority"`
+	Tasks             []*TaskORM `gorm:"foreignkey:UserId;association_foreignkey:Id;preload:true" atlas:"position:Priority"`
 	UpdatedAt         *time.Time
 }
 
@@ -147,11 +157,13 @@ func (m *User) ToORM(ctx context.Context) (UserORM, error) {
 			to.ExternalUuid = &vv
 		}
 	}
-	accountID, err := auth.GetAccountID(ctx, nil)
+	tenantID, isolated, err := tenant.ID(ctx, "User")
 	if err != nil {
 		return to, err
 	}
-	to.AccountID = accountID
+	if isolated {
+		to.AccountID = tenantID
+	}
 	for i, e := range to.Tasks {
 		e.Priority = int64(i)
 	}
@@ -271,6 +283,31 @@ func (m *UserORM) ToPB(ctx context.Context) (User, error) {
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *User) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	v.Nest("credit_card", m.CreditCard.Validate())
+	for i, e := range m.Emails {
+		v.Nest(fmt.Sprintf("emails[%d]", i), e.Validate())
+	}
+	for i, e := range m.Tasks {
+		v.Nest(fmt.Sprintf("tasks[%d]", i), e.Validate())
+	}
+	v.Nest("billing_address", m.BillingAddress.Validate())
+	v.Nest("shipping_address", m.ShippingAddress.Validate())
+	for i, e := range m.Languages {
+		v.Nest(fmt.Sprintf("languages[%d]", i), e.Validate())
+	}
+	for i, e := range m.Friends {
+		v.Nest(fmt.Sprintf("friends[%d]", i), e.Validate())
+	}
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type User the arg will be the target, the caller the one being converted from
 
@@ -338,11 +375,13 @@ func (m *Email) ToORM(ctx context.Context) (EmailORM, error) {
 	} else if v != nil {
 		to.ExternalNotNull = v.(string)
 	}
-	accountID, err := auth.GetAccountID(ctx, nil)
+	tenantID, isolated, err := tenant.ID(ctx, "Email")
 	if err != nil {
 		return to, err
 	}
-	to.AccountID = accountID
+	if isolated {
+		to.AccountID = tenantID
+	}
 	if posthook, ok := interface{}(m).(EmailWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -384,6 +423,19 @@ func (m *EmailORM) ToPB(ctx context.Context) (Email, error) {
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *Email) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	if m.ExternalNotNull == nil {
+		v.Add("external_not_null", "is required")
+	}
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type Email the arg will be the target, the caller the one being converted from
 
@@ -453,11 +505,13 @@ func (m *Address) ToORM(ctx context.Context) (AddressORM, error) {
 			to.ImplicitFk = &vv
 		}
 	}
-	accountID, err := auth.GetAccountID(ctx, nil)
+	tenantID, isolated, err := tenant.ID(ctx, "Address")
 	if err != nil {
 		return to, err
 	}
-	to.AccountID = accountID
+	if isolated {
+		to.AccountID = tenantID
+	}
 	if posthook, ok := interface{}(m).(AddressWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -500,6 +554,16 @@ func (m *AddressORM) ToPB(ctx context.Context) (Address, error) {
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *Address) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type Address the arg will be the target, the caller the one being converted from
 
@@ -560,11 +624,13 @@ func (m *Language) ToORM(ctx context.Context) (LanguageORM, error) {
 			to.ExternalInt = &v
 		}
 	}
-	accountID, err := auth.GetAccountID(ctx, nil)
+	tenantID, isolated, err := tenant.ID(ctx, "Language")
 	if err != nil {
 		return to, err
 	}
-	to.AccountID = accountID
+	if isolated {
+		to.AccountID = tenantID
+	}
 	if posthook, ok := interface{}(m).(LanguageWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -601,6 +667,16 @@ func (m *LanguageORM) ToPB(ctx context.Context) (Language, error) {
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *Language) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type Language the arg will be the target, the caller the one being converted from
 
@@ -670,11 +746,13 @@ func (m *CreditCard) ToORM(ctx context.Context) (CreditCardORM, error) {
 			to.UserId = &vv
 		}
 	}
-	accountID, err := auth.GetAccountID(ctx, nil)
+	tenantID, isolated, err := tenant.ID(ctx, "CreditCard")
 	if err != nil {
 		return to, err
 	}
-	to.AccountID = accountID
+	if isolated {
+		to.AccountID = tenantID
+	}
 	if posthook, ok := interface{}(m).(CreditCardWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -716,6 +794,16 @@ func (m *CreditCardORM) ToPB(ctx context.Context) (CreditCard, error) {
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *CreditCard) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type CreditCard the arg will be the target, the caller the one being converted from
 
@@ -765,11 +853,13 @@ func (m *Task) ToORM(ctx context.Context) (TaskORM, error) {
 	to.Name = m.Name
 	to.Description = m.Description
 	to.Priority = m.Priority
-	accountID, err := auth.GetAccountID(ctx, nil)
+	tenantID, isolated, err := tenant.ID(ctx, "Task")
 	if err != nil {
 		return to, err
 	}
-	to.AccountID = accountID
+	if isolated {
+		to.AccountID = tenantID
+	}
 	if posthook, ok := interface{}(m).(TaskWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -795,6 +885,16 @@ func (m *TaskORM) ToPB(ctx context.Context) (Task, error) {
 	return to, err
 }
 
+// Validate checks the fields of the object against the constraints of their gorm tags and
+// returns an *errors.ValidationError listing every violation
+func (m *Task) Validate() error {
+	if m == nil {
+		return nil
+	}
+	v := &errors.ValidationError{}
+	return v.Err()
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type Task the arg will be the target, the caller the one being converted from
 
@@ -820,9 +920,19 @@ type TaskWithAfterToPB interface {
 
 // DefaultCreateUser executes a basic gorm create call
 func DefaultCreateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultCreateUser", "User")
+	defer span.End()
+	r0, err := defaultCreateUser(ctx, in, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultCreateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
@@ -851,7 +961,90 @@ type UserORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateUserSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateUserSet(ctx context.Context, in []*User, db *gorm.DB, batchSize int) ([]*User, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultCreateUserSet", "User")
+	defer span.End()
+	r0, err := defaultCreateUserSet(ctx, in, tracing.WithContext(ctx, db), batchSize)
+	return r0, tracing.Error(span, err)
+}
+
+func defaultCreateUserSet(ctx context.Context, in []*User, db *gorm.DB, batchSize int) ([]*User, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*UserORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&UserORM{})).(UserORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(UserORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(UserORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&UserORM{})).(UserORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*User, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type UserORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*UserORM, *gorm.DB) (*gorm.DB, error)
+}
+type UserORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*UserORM, *gorm.DB) error
+}
+
 func DefaultReadUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultReadUser", "User")
+	defer span.End()
+	r0, err := defaultReadUser(ctx, in, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultReadUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -867,7 +1060,7 @@ func DefaultReadUser(ctx context.Context, in *User, db *gorm.DB) (*User, error)
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &UserORM{}); err != nil {
+	if db, err = collection.ApplyFieldSelection(db, &UserORM{}, nil, "Emails", "Tasks"); err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeReadFind); ok {
@@ -899,6 +1092,12 @@ type UserORMWithAfterReadFind interface {
 }
 
 func DefaultDeleteUser(ctx context.Context, in *User, db *gorm.DB) error {
+	ctx, span := tracing.StartHandler(ctx, "DefaultDeleteUser", "User")
+	defer span.End()
+	return tracing.Error(span, defaultDeleteUser(ctx, in, tracing.WithContext(ctx, db)))
+}
+
+func defaultDeleteUser(ctx context.Context, in *User, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -932,6 +1131,12 @@ type UserORMWithAfterDelete_ interface {
 }
 
 func DefaultDeleteUserSet(ctx context.Context, in []*User, db *gorm.DB) error {
+	ctx, span := tracing.StartHandler(ctx, "DefaultDeleteUserSet", "User")
+	defer span.End()
+	return tracing.Error(span, defaultDeleteUserSet(ctx, in, tracing.WithContext(ctx, db)))
+}
+
+func defaultDeleteUserSet(ctx context.Context, in []*User, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -952,11 +1157,14 @@ func DefaultDeleteUserSet(ctx context.Context, in []*User, db *gorm.DB) error {
 			return err
 		}
 	}
-	acctId, err := auth.GetAccountID(ctx, nil)
+	tenantID, isolated, err := tenant.ID(ctx, "User")
 	if err != nil {
 		return err
 	}
-	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&UserORM{}).Error
+	if isolated {
+		db = db.Where("account_id = ?", tenantID)
+	}
+	err = db.Where("id in (?)", keys).Delete(&UserORM{}).Error
 	if err != nil {
 		return err
 	}
@@ -975,18 +1183,30 @@ type UserORMWithAfterDeleteSet interface {
 
 // DefaultStrictUpdateUser clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultStrictUpdateUser", "User")
+	defer span.End()
+	r0, err := defaultStrictUpdateUser(ctx, in, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultStrictUpdateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
 	if in == nil {
 		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateUser")
 	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	accountID, err := auth.GetAccountID(ctx, nil)
+	tenantID, isolated, err := tenant.ID(ctx, "User")
 	if err != nil {
 		return nil, err
 	}
-	db = db.Where(map[string]interface{}{"account_id": accountID})
+	if isolated {
+		db = db.Where(map[string]interface{}{"account_id": tenantID})
+	}
 	var count int64
 	lockedRow := &UserORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
@@ -1064,6 +1284,13 @@ type UserORMWithAfterStrictUpdateSave interface {
 
 // DefaultPatchUser executes a basic gorm update call with patch behavior
 func DefaultPatchUser(ctx context.Context, in *User, updateMask *field_mask.FieldMask, db *gorm.DB) (*User, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultPatchUser", "User")
+	defer span.End()
+	r0, err := defaultPatchUser(ctx, in, updateMask, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultPatchUser(ctx context.Context, in *User, updateMask *field_mask.FieldMask, db *gorm.DB) (*User, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -1119,6 +1346,13 @@ type UserWithAfterPatchSave interface {
 
 // DefaultPatchSetUser executes a bulk gorm update call with patch behavior
 func DefaultPatchSetUser(ctx context.Context, objects []*User, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*User, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultPatchSetUser", "User")
+	defer span.End()
+	r0, err := defaultPatchSetUser(ctx, objects, updateMasks, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultPatchSetUser(ctx context.Context, objects []*User, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*User, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
@@ -1138,6 +1372,13 @@ func DefaultPatchSetUser(ctx context.Context, objects []*User, updateMasks []*fi
 
 // DefaultApplyFieldMaskUser patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*User, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultApplyFieldMaskUser", "User")
+	defer span.End()
+	r0, err := defaultApplyFieldMaskUser(ctx, patchee, patcher, updateMask, prefix, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*User, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -1169,7 +1410,7 @@ func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User
 					childMask.Paths = append(childMask.Paths, trimPath)
 				}
 			}
-			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+			if err := collection.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
 				return nil, nil
 			}
 		}
@@ -1192,7 +1433,7 @@ func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User
 					childMask.Paths = append(childMask.Paths, trimPath)
 				}
 			}
-			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+			if err := collection.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
 				return nil, nil
 			}
 		}
@@ -1215,7 +1456,7 @@ func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User
 					childMask.Paths = append(childMask.Paths, trimPath)
 				}
 			}
-			if err := gorm1.MergeWithMask(patcher.Birthday, patchee.Birthday, childMask); err != nil {
+			if err := collection.MergeWithMask(patcher.Birthday, patchee.Birthday, childMask); err != nil {
 				return nil, nil
 			}
 		}
@@ -1328,6 +1569,13 @@ func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User
 
 // DefaultListUser executes a gorm list call
 func DefaultListUser(ctx context.Context, db *gorm.DB) ([]*User, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultListUser", "User")
+	defer span.End()
+	r0, err := defaultListUser(ctx, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultListUser(ctx context.Context, db *gorm.DB) ([]*User, error) {
 	in := User{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
@@ -1338,7 +1586,7 @@ func DefaultListUser(ctx context.Context, db *gorm.DB) ([]*User, error) {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &UserORM{}, &User{}, nil, nil, nil, nil)
+	db, err = collection.Apply(db, &UserORM{}, nil, nil, nil, nil, "Emails", "Tasks")
 	if err != nil {
 		return nil, err
 	}
@@ -1379,389 +1627,530 @@ type UserORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]UserORM) error
 }
 
-// DefaultCreateEmail executes a basic gorm create call
-func DefaultCreateEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
+// DefaultCountUser returns the number of rows DefaultListUser pages through
+func DefaultCountUser(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultCountUser", "User")
+	defer span.End()
+	r0, err := defaultCountUser(ctx, tracing.WithContext(ctx, db), strategy)
+	return r0, tracing.Error(span, err)
+}
+
+func defaultCountUser(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := User{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
 		}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	return paging.Count(db.Where(&ormObj), &UserORM{}, strategy)
 }
 
-type EmailORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+type UserORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type EmailORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+
+// UserConflictTargets maps the conflict targets accepted by DefaultUpsertUser to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var UserConflictTargets = map[string][]string{
+	"id": {"id"},
 }
 
-func DefaultReadEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
+// DefaultUpsertUser inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertUser(ctx context.Context, in *User, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*User, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultUpsertUser", "User")
+	defer span.End()
+	r0, err := defaultUpsertUser(ctx, in, target, updateMask, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultUpsertUser(ctx context.Context, in *User, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*User, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
+	if err := in.Validate(); err != nil {
 		return nil, err
 	}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
+	conflict := insert.OnConflict{
+		Columns:   UserConflictTargets[target],
+		UpdateAll: updateMask == nil,
+		Scope:     "account_id",
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeReadApplyQuery); ok {
-		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for User", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertUserColumns(updateMask)
+		if err != nil {
 			return nil, err
 		}
+		conflict.Update = columns
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &EmailORM{}); err != nil {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeReadFind); ok {
-		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := EmailORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(EmailORMWithAfterReadFind); ok {
-		if err = hook.AfterReadFind(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(UserORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := ormResponse.ToPB(ctx)
+	pbResponse, err := ormObj.ToPB(ctx)
 	return &pbResponse, err
 }
 
-type EmailORMWithBeforeReadApplyQuery interface {
-	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type EmailORMWithBeforeReadFind interface {
-	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+type UserORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type EmailORMWithAfterReadFind interface {
-	AfterReadFind(context.Context, *gorm.DB) error
+type UserORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteEmail(ctx context.Context, in *Email, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return err
-	}
-	if ormObj.Id == "" {
-		return errors.EmptyIdError
-	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeDelete_); ok {
-		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
-			return err
+// upsertUserColumns returns the columns of the fields of updateMask the upserts of
+// User overwrite, the immutable fields and the paths of no column being rejected
+func upsertUserColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		case "CreatedAt":
+			columns = append(columns, "created_at")
+		case "UpdatedAt":
+			columns = append(columns, "updated_at")
+		case "Birthday":
+			columns = append(columns, "birthday")
+		case "Num":
+			columns = append(columns, "num")
+		case "ShippingAddressId":
+			columns = append(columns, "shipping_address_id")
+		case "ExternalUuid":
+			columns = append(columns, "external_uuid")
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
 		}
 	}
-	err = db.Where(&ormObj).Delete(&EmailORM{}).Error
-	if err != nil {
-		return err
-	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterDelete_); ok {
-		err = hook.AfterDelete_(ctx, db)
+	return columns, nil
+}
+
+// UserRepository runs the Default operations of User, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type UserRepository interface {
+	Create(ctx context.Context, in *User) (*User, error)
+	CreateSet(ctx context.Context, in []*User, batchSize int) ([]*User, error)
+	Read(ctx context.Context, in *User, fs *query.FieldSelection) (*User, error)
+	StrictUpdate(ctx context.Context, in *User) (*User, error)
+	Patch(ctx context.Context, in *User, updateMask *field_mask.FieldMask) (*User, error)
+	PatchSet(ctx context.Context, objects []*User, updateMasks []*field_mask.FieldMask) ([]*User, error)
+	Delete(ctx context.Context, in *User) error
+	DeleteSet(ctx context.Context, in []*User) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*User, error)
+	PageToken(ctx context.Context, last *User, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *User, target string, updateMask *field_mask.FieldMask) (*User, error)
+}
+
+// GormUserRepository is the UserRepository calling the Default handlers with DB
+type GormUserRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormUserRepository returns the UserRepository running the operations with db
+func NewGormUserRepository(db *gorm.DB) *GormUserRepository {
+	return &GormUserRepository{DB: db}
+}
+
+func (r *GormUserRepository) Create(ctx context.Context, in *User) (*User, error) {
+	return DefaultCreateUser(ctx, in, r.DB)
+}
+
+func (r *GormUserRepository) CreateSet(ctx context.Context, in []*User, batchSize int) ([]*User, error) {
+	return DefaultCreateUserSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormUserRepository) Read(ctx context.Context, in *User, fs *query.FieldSelection) (*User, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of User doesn't support field selection")
 	}
-	return err
+	return DefaultReadUser(ctx, in, r.DB)
 }
 
-type EmailORMWithBeforeDelete_ interface {
-	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+func (r *GormUserRepository) StrictUpdate(ctx context.Context, in *User) (*User, error) {
+	return DefaultStrictUpdateUser(ctx, in, r.DB)
 }
-type EmailORMWithAfterDelete_ interface {
-	AfterDelete_(context.Context, *gorm.DB) error
+
+func (r *GormUserRepository) Patch(ctx context.Context, in *User, updateMask *field_mask.FieldMask) (*User, error) {
+	return DefaultPatchUser(ctx, in, updateMask, r.DB)
 }
 
-func DefaultDeleteEmailSet(ctx context.Context, in []*Email, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
+func (r *GormUserRepository) PatchSet(ctx context.Context, objects []*User, updateMasks []*field_mask.FieldMask) ([]*User, error) {
+	return DefaultPatchSetUser(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormUserRepository) Delete(ctx context.Context, in *User) error {
+	return DefaultDeleteUser(ctx, in, r.DB)
+}
+
+func (r *GormUserRepository) DeleteSet(ctx context.Context, in []*User) error {
+	return DefaultDeleteUserSet(ctx, in, r.DB)
+}
+
+func (r *GormUserRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*User, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of User doesn't support filtering")
 	}
-	var err error
-	keys := []string{}
-	for _, obj := range in {
-		ormObj, err := obj.ToORM(ctx)
-		if err != nil {
-			return err
-		}
-		if ormObj.Id == "" {
-			return errors.EmptyIdError
-		}
-		keys = append(keys, ormObj.Id)
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of User doesn't support sorting")
 	}
-	if hook, ok := (interface{}(&EmailORM{})).(EmailORMWithBeforeDeleteSet); ok {
-		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
-			return err
-		}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of User doesn't support pagination")
+	}
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of User doesn't support field selection")
 	}
-	acctId, err := auth.GetAccountID(ctx, nil)
+	return DefaultListUser(ctx, r.DB)
+}
+
+func (r *GormUserRepository) PageToken(ctx context.Context, last *User, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
 	if err != nil {
-		return err
+		return "", err
 	}
-	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&EmailORM{}).Error
+	keyset, err := paging.NewKeyset(r.DB, &UserORM{}, s, f)
 	if err != nil {
-		return err
+		return "", err
 	}
-	if hook, ok := (interface{}(&EmailORM{})).(EmailORMWithAfterDeleteSet); ok {
-		err = hook.AfterDeleteSet(ctx, in, db)
+	return keyset.Token(&ormObj)
+}
+
+func (r *GormUserRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of User doesn't support filtering")
 	}
-	return err
+	return DefaultCountUser(ctx, r.DB, strategy)
 }
 
-type EmailORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*Email, *gorm.DB) (*gorm.DB, error)
+func (r *GormUserRepository) Upsert(ctx context.Context, in *User, target string, updateMask *field_mask.FieldMask) (*User, error) {
+	return DefaultUpsertUser(ctx, in, target, updateMask, r.DB)
 }
-type EmailORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*Email, *gorm.DB) error
+
+// MemoryUserRepository is the UserRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryUserRepository struct {
+	table memory.Table
 }
 
-// DefaultStrictUpdateEmail clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
-	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateEmail")
-	}
+// NewMemoryUserRepository returns an empty MemoryUserRepository
+func NewMemoryUserRepository() *MemoryUserRepository {
+	return &MemoryUserRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryUserRepository) row(ctx context.Context, in, stored *User) (memory.Row, error) {
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return nil, err
+		return memory.Row{}, err
 	}
-	accountID, err := auth.GetAccountID(ctx, nil)
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: memory.Tenant(ormObj.AccountID), Key: ormObj.Id, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryUserRepository) tenant(ctx context.Context) (interface{}, error) {
+	ormObj, err := (&User{}).ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	db = db.Where(map[string]interface{}{"account_id": accountID})
-	var count int64
-	lockedRow := &EmailORM{}
-	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeStrictUpdateCleanup); ok {
-		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
-			return nil, err
-		}
+	return memory.Tenant(ormObj.AccountID), nil
+}
+
+func (r *MemoryUserRepository) Create(ctx context.Context, in *User) (*User, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeStrictUpdateSave); ok {
-		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
-			return nil, err
-		}
+	if err := in.Validate(); err != nil {
+		return nil, err
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterStrictUpdateSave); ok {
-		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*User), nil
+}
+
+func (r *MemoryUserRepository) CreateSet(ctx context.Context, in []*User, batchSize int) ([]*User, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*User, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
 			return nil, err
 		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*User))
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	if err != nil {
+	if err := r.table.Insert(rows...); err != nil {
 		return nil, err
 	}
-	if count == 0 {
-		err = gateway.SetCreated(ctx, "")
-	}
-	return &pbResponse, err
+	return out, nil
 }
 
-type EmailORMWithBeforeStrictUpdateCleanup interface {
-	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type EmailORMWithBeforeStrictUpdateSave interface {
-	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type EmailORMWithAfterStrictUpdateSave interface {
-	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+func (r *MemoryUserRepository) Read(ctx context.Context, in *User, fs *query.FieldSelection) (*User, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	out, ok := r.table.Get(memory.Tenant(ormObj.AccountID), ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*User), nil
 }
 
-// DefaultPatchEmail executes a basic gorm update call with patch behavior
-func DefaultPatchEmail(ctx context.Context, in *Email, updateMask *field_mask.FieldMask, db *gorm.DB) (*Email, error) {
+func (r *MemoryUserRepository) StrictUpdate(ctx context.Context, in *User) (*User, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj Email
-	var err error
-	if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchRead); ok {
-		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	if err := in.Validate(); err != nil {
+		return nil, err
 	}
-	pbReadRes, err := DefaultReadEmail(ctx, &Email{Id: in.GetId()}, db)
+	row, err := r.row(ctx, in, nil)
 	if err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchApplyFieldMask); ok {
-		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	if _, err := DefaultApplyFieldMaskEmail(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err = r.table.Save(row); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchSave); ok {
-		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	return row.Object.(*User), nil
+}
+
+func (r *MemoryUserRepository) Patch(ctx context.Context, in *User, updateMask *field_mask.FieldMask) (*User, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
 	}
-	pbResponse, err := DefaultStrictUpdateEmail(ctx, &pbObj, db)
+	patchee, err := r.Read(ctx, &User{Id: in.GetId()}, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(EmailWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	if patchee, err = DefaultApplyFieldMaskUser(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
 	}
-	return pbResponse, nil
-}
-
-type EmailWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type EmailWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type EmailWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type EmailWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) error
+	return r.StrictUpdate(ctx, patchee)
 }
 
-// DefaultPatchSetEmail executes a bulk gorm update call with patch behavior
-func DefaultPatchSetEmail(ctx context.Context, objects []*Email, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Email, error) {
+func (r *MemoryUserRepository) PatchSet(ctx context.Context, objects []*User, updateMasks []*field_mask.FieldMask) ([]*User, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*Email, 0, len(objects))
+	out := make([]*User, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchEmail(ctx, patcher, updateMasks[i], db)
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
+		out = append(out, patched)
 	}
+	return out, nil
+}
 
-	return results, nil
+func (r *MemoryUserRepository) Delete(ctx context.Context, in *User) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(memory.Tenant(ormObj.AccountID), ormObj.Id)
+	return nil
 }
 
-// DefaultApplyFieldMaskEmail patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskEmail(ctx context.Context, patchee *Email, patcher *Email, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Email, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
+func (r *MemoryUserRepository) DeleteSet(ctx context.Context, in []*User) error {
+	if in == nil {
+		return errors.NilArgumentError
 	}
-	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
-		}
-		if f == prefix+"Email" {
-			patchee.Email = patcher.Email
-			continue
-		}
-		if f == prefix+"Subscribed" {
-			patchee.Subscribed = patcher.Subscribed
-			continue
-		}
-		if f == prefix+"UserId" {
-			patchee.UserId = patcher.UserId
-			continue
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
 		}
-		if f == prefix+"ExternalNotNull" {
-			patchee.ExternalNotNull = patcher.ExternalNotNull
-			continue
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
 		}
+		keys = append(keys, ormObj.Id)
 	}
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryUserRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*User, error) {
+	tenant, err := r.tenant(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return patchee, nil
-}
-
-// DefaultListEmail executes a gorm list call
-func DefaultListEmail(ctx context.Context, db *gorm.DB) ([]*Email, error) {
-	in := Email{}
-	ormObj, err := in.ToORM(ctx)
+	objects, err := r.table.List(tenant, f, s, p)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
+	out := make([]*User, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*User))
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &EmailORM{}, &Email{}, nil, nil, nil, nil)
+	return out, nil
+}
+
+func (r *MemoryUserRepository) PageToken(ctx context.Context, last *User, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryUserRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
 	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryUserRepository) Upsert(ctx context.Context, in *User, target string, updateMask *field_mask.FieldMask) (*User, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := UserConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for User", target)
+	}
+	if _, err := upsertUserColumns(updateMask); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
-			return nil, err
-		}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []EmailORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
+	found, ok, err := r.table.Conflicting(memory.Tenant(ormObj.AccountID), func(m proto.Message) bool {
+		other, err := m.(*User).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
-			return nil, err
-		}
+	if !ok {
+		return r.Create(ctx, in)
 	}
-	pbResponse := []*Email{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
+	existing := found.(*User)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskUser(ctx, existing, in, updateMask, "", nil)
 		if err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
+		return r.StrictUpdate(ctx, patched)
 	}
-	return pbResponse, nil
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*User)
+	updated.Id = existing.Id
+	return r.StrictUpdate(ctx, updated)
 }
 
-type EmailORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+// UserFixtureOption customizes the object returned by NewUserFixture
+type UserFixtureOption func(*User)
+
+// NewUserFixture returns a User with random valid values in its fields and
+// its required associations, customized by opts
+func NewUserFixture(opts ...UserFixtureOption) *User {
+	m := &User{}
+	m.CreatedAt = timestamppb.New(fixture.Time())
+	m.UpdatedAt = timestamppb.New(fixture.Time())
+	m.Birthday = timestamppb.New(fixture.Time())
+	m.Num = uint32(fixture.Uint(1000000))
+	m.BillingAddress = NewAddressFixture()
+	m.ShippingAddress = NewAddressFixture()
+	m.ExternalUuid = fixture.Identifier(nil, fixture.UUID())
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
-type EmailORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+
+// CreateUserFixture creates the object returned by NewUserFixture and its
+// associations with DefaultCreateUser
+func CreateUserFixture(ctx context.Context, db *gorm.DB, opts ...UserFixtureOption) (*User, error) {
+	return DefaultCreateUser(ctx, NewUserFixture(opts...), db)
 }
-type EmailORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]EmailORM) error
+
+// DefaultCreateEmail executes a basic gorm create call
+func DefaultCreateEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultCreateEmail", "Email")
+	defer span.End()
+	r0, err := defaultCreateEmail(ctx, in, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
 }
 
-// DefaultCreateAddress executes a basic gorm create call
-func DefaultCreateAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
+func defaultCreateEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1769,7 +2158,7 @@ func DefaultCreateAddress(ctx context.Context, in *Address, db *gorm.DB) (*Addre
 	if err = db.Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1778,61 +2167,150 @@ func DefaultCreateAddress(ctx context.Context, in *Address, db *gorm.DB) (*Addre
 	return &pbResponse, err
 }
 
-type AddressORMWithBeforeCreate_ interface {
+type EmailORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type AddressORMWithAfterCreate_ interface {
+type EmailORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
+// DefaultCreateEmailSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateEmailSet(ctx context.Context, in []*Email, db *gorm.DB, batchSize int) ([]*Email, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultCreateEmailSet", "Email")
+	defer span.End()
+	r0, err := defaultCreateEmailSet(ctx, in, tracing.WithContext(ctx, db), batchSize)
+	return r0, tracing.Error(span, err)
+}
+
+func defaultCreateEmailSet(ctx context.Context, in []*Email, db *gorm.DB, batchSize int) ([]*Email, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if ormObj.Id == 0 {
-		return nil, errors.EmptyIdError
-	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeReadApplyQuery); ok {
-		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+	ormObjs := make([]*EmailORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
 			return nil, err
 		}
-	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &AddressORM{}); err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeReadFind); ok {
-		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
 			return nil, err
 		}
+		ormObjs = append(ormObjs, &ormObj)
 	}
-	ormResponse := AddressORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&EmailORM{})).(EmailORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(EmailORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(EmailORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&EmailORM{})).(EmailORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(AddressORMWithAfterReadFind); ok {
-		if err = hook.AfterReadFind(ctx, db); err != nil {
+	pbResponse := make([]*Email, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
 			return nil, err
 		}
+		pbResponse = append(pbResponse, &pbObj)
 	}
-	pbResponse, err := ormResponse.ToPB(ctx)
-	return &pbResponse, err
+	return pbResponse, nil
 }
 
-type AddressORMWithBeforeReadApplyQuery interface {
-	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+type EmailORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*EmailORM, *gorm.DB) (*gorm.DB, error)
 }
-type AddressORMWithBeforeReadFind interface {
-	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+type EmailORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*EmailORM, *gorm.DB) error
 }
-type AddressORMWithAfterReadFind interface {
+
+func DefaultReadEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultReadEmail", "Email")
+	defer span.End()
+	r0, err := defaultReadEmail(ctx, in, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultReadEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = collection.ApplyFieldSelection(db, &EmailORM{}, nil); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := EmailORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(EmailORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type EmailORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EmailORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EmailORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteAddress(ctx context.Context, in *Address, db *gorm.DB) error {
+func DefaultDeleteEmail(ctx context.Context, in *Email, db *gorm.DB) error {
+	ctx, span := tracing.StartHandler(ctx, "DefaultDeleteEmail", "Email")
+	defer span.End()
+	return tracing.Error(span, defaultDeleteEmail(ctx, in, tracing.WithContext(ctx, db)))
+}
+
+func defaultDeleteEmail(ctx context.Context, in *Email, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -1840,96 +2318,117 @@ func DefaultDeleteAddress(ctx context.Context, in *Address, db *gorm.DB) error {
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == 0 {
+	if ormObj.Id == "" {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&AddressORM{}).Error
+	err = db.Where(&ormObj).Delete(&EmailORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type AddressORMWithBeforeDelete_ interface {
+type EmailORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type AddressORMWithAfterDelete_ interface {
+type EmailORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteAddressSet(ctx context.Context, in []*Address, db *gorm.DB) error {
+func DefaultDeleteEmailSet(ctx context.Context, in []*Email, db *gorm.DB) error {
+	ctx, span := tracing.StartHandler(ctx, "DefaultDeleteEmailSet", "Email")
+	defer span.End()
+	return tracing.Error(span, defaultDeleteEmailSet(ctx, in, tracing.WithContext(ctx, db)))
+}
+
+func defaultDeleteEmailSet(ctx context.Context, in []*Email, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []int64{}
+	keys := []string{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == 0 {
+		if ormObj.Id == "" {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&AddressORM{})).(AddressORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&EmailORM{})).(EmailORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	acctId, err := auth.GetAccountID(ctx, nil)
+	tenantID, isolated, err := tenant.ID(ctx, "Email")
 	if err != nil {
 		return err
 	}
-	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&AddressORM{}).Error
+	if isolated {
+		db = db.Where("account_id = ?", tenantID)
+	}
+	err = db.Where("id in (?)", keys).Delete(&EmailORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&AddressORM{})).(AddressORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&EmailORM{})).(EmailORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type AddressORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*Address, *gorm.DB) (*gorm.DB, error)
+type EmailORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Email, *gorm.DB) (*gorm.DB, error)
 }
-type AddressORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*Address, *gorm.DB) error
+type EmailORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Email, *gorm.DB) error
 }
 
-// DefaultStrictUpdateAddress clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
+// DefaultStrictUpdateEmail clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultStrictUpdateEmail", "Email")
+	defer span.End()
+	r0, err := defaultStrictUpdateEmail(ctx, in, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultStrictUpdateEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateAddress")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateEmail")
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	accountID, err := auth.GetAccountID(ctx, nil)
+	tenantID, isolated, err := tenant.ID(ctx, "Email")
 	if err != nil {
 		return nil, err
 	}
-	db = db.Where(map[string]interface{}{"account_id": accountID})
+	if isolated {
+		db = db.Where(map[string]interface{}{"account_id": tenantID})
+	}
 	var count int64
-	lockedRow := &AddressORM{}
+	lockedRow := &EmailORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1937,7 +2436,7 @@ func DefaultStrictUpdateAddress(ctx context.Context, in *Address, db *gorm.DB) (
 	if err = db.Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1952,51 +2451,58 @@ func DefaultStrictUpdateAddress(ctx context.Context, in *Address, db *gorm.DB) (
 	return &pbResponse, err
 }
 
-type AddressORMWithBeforeStrictUpdateCleanup interface {
+type EmailORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type AddressORMWithBeforeStrictUpdateSave interface {
+type EmailORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type AddressORMWithAfterStrictUpdateSave interface {
+type EmailORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchAddress executes a basic gorm update call with patch behavior
-func DefaultPatchAddress(ctx context.Context, in *Address, updateMask *field_mask.FieldMask, db *gorm.DB) (*Address, error) {
+// DefaultPatchEmail executes a basic gorm update call with patch behavior
+func DefaultPatchEmail(ctx context.Context, in *Email, updateMask *field_mask.FieldMask, db *gorm.DB) (*Email, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultPatchEmail", "Email")
+	defer span.End()
+	r0, err := defaultPatchEmail(ctx, in, updateMask, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultPatchEmail(ctx context.Context, in *Email, updateMask *field_mask.FieldMask, db *gorm.DB) (*Email, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj Address
+	var pbObj Email
 	var err error
-	if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadAddress(ctx, &Address{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadEmail(ctx, &Email{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskAddress(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskEmail(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateAddress(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateEmail(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(AddressWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(EmailWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -2004,28 +2510,35 @@ func DefaultPatchAddress(ctx context.Context, in *Address, updateMask *field_mas
 	return pbResponse, nil
 }
 
-type AddressWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type EmailWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type AddressWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type EmailWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type AddressWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type EmailWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type AddressWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) error
+type EmailWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetAddress executes a bulk gorm update call with patch behavior
-func DefaultPatchSetAddress(ctx context.Context, objects []*Address, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Address, error) {
+// DefaultPatchSetEmail executes a bulk gorm update call with patch behavior
+func DefaultPatchSetEmail(ctx context.Context, objects []*Email, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Email, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultPatchSetEmail", "Email")
+	defer span.End()
+	r0, err := defaultPatchSetEmail(ctx, objects, updateMasks, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultPatchSetEmail(ctx context.Context, objects []*Email, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Email, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*Address, 0, len(objects))
+	results := make([]*Email, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchAddress(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchEmail(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -2036,8 +2549,15 @@ func DefaultPatchSetAddress(ctx context.Context, objects []*Address, updateMasks
 	return results, nil
 }
 
-// DefaultApplyFieldMaskAddress patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskAddress(ctx context.Context, patchee *Address, patcher *Address, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Address, error) {
+// DefaultApplyFieldMaskEmail patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskEmail(ctx context.Context, patchee *Email, patcher *Email, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Email, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultApplyFieldMaskEmail", "Email")
+	defer span.End()
+	r0, err := defaultApplyFieldMaskEmail(ctx, patchee, patcher, updateMask, prefix, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultApplyFieldMaskEmail(ctx context.Context, patchee *Email, patcher *Email, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Email, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -2049,24 +2569,20 @@ func DefaultApplyFieldMaskAddress(ctx context.Context, patchee *Address, patcher
 			patchee.Id = patcher.Id
 			continue
 		}
-		if f == prefix+"Address_1" {
-			patchee.Address_1 = patcher.Address_1
-			continue
-		}
-		if f == prefix+"Address_2" {
-			patchee.Address_2 = patcher.Address_2
+		if f == prefix+"Email" {
+			patchee.Email = patcher.Email
 			continue
 		}
-		if f == prefix+"Post" {
-			patchee.Post = patcher.Post
+		if f == prefix+"Subscribed" {
+			patchee.Subscribed = patcher.Subscribed
 			continue
 		}
-		if f == prefix+"External" {
-			patchee.External = patcher.External
+		if f == prefix+"UserId" {
+			patchee.UserId = patcher.UserId
 			continue
 		}
-		if f == prefix+"ImplicitFk" {
-			patchee.ImplicitFk = patcher.ImplicitFk
+		if f == prefix+"ExternalNotNull" {
+			patchee.ExternalNotNull = patcher.ExternalNotNull
 			continue
 		}
 	}
@@ -2076,39 +2592,46 @@ func DefaultApplyFieldMaskAddress(ctx context.Context, patchee *Address, patcher
 	return patchee, nil
 }
 
-// DefaultListAddress executes a gorm list call
-func DefaultListAddress(ctx context.Context, db *gorm.DB) ([]*Address, error) {
-	in := Address{}
+// DefaultListEmail executes a gorm list call
+func DefaultListEmail(ctx context.Context, db *gorm.DB) ([]*Email, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultListEmail", "Email")
+	defer span.End()
+	r0, err := defaultListEmail(ctx, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultListEmail(ctx context.Context, db *gorm.DB) ([]*Email, error) {
+	in := Email{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &AddressORM{}, &Address{}, nil, nil, nil, nil)
+	db, err = collection.Apply(db, &EmailORM{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeListFind); ok {
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	db = db.Where(&ormObj)
 	db = db.Order("id")
-	ormResponse := []AddressORM{}
+	ormResponse := []EmailORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*Address{}
+	pbResponse := []*Email{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -2119,97 +2642,382 @@ func DefaultListAddress(ctx context.Context, db *gorm.DB) ([]*Address, error) {
 	return pbResponse, nil
 }
 
-type AddressORMWithBeforeListApplyQuery interface {
+type EmailORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type AddressORMWithBeforeListFind interface {
+type EmailORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type AddressORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]AddressORM) error
+type EmailORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]EmailORM) error
 }
 
-// DefaultCreateLanguage executes a basic gorm create call
-func DefaultCreateLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
+// DefaultCountEmail returns the number of rows DefaultListEmail pages through
+func DefaultCountEmail(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultCountEmail", "Email")
+	defer span.End()
+	r0, err := defaultCountEmail(ctx, tracing.WithContext(ctx, db), strategy)
+	return r0, tracing.Error(span, err)
+}
+
+func defaultCountEmail(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := Email{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
 		}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	return paging.Count(db.Where(&ormObj), &EmailORM{}, strategy)
 }
 
-type LanguageORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+type EmailORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type LanguageORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+
+// EmailConflictTargets maps the conflict targets accepted by DefaultUpsertEmail to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var EmailConflictTargets = map[string][]string{
+	"id": {"id"},
 }
 
-func DefaultReadLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
+// DefaultUpsertEmail inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertEmail(ctx context.Context, in *Email, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*Email, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultUpsertEmail", "Email")
+	defer span.End()
+	r0, err := defaultUpsertEmail(ctx, in, target, updateMask, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultUpsertEmail(ctx context.Context, in *Email, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*Email, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
+	if err := in.Validate(); err != nil {
 		return nil, err
 	}
-	if ormObj.Id == 0 {
-		return nil, errors.EmptyIdError
+	conflict := insert.OnConflict{
+		Columns:   EmailConflictTargets[target],
+		UpdateAll: updateMask == nil,
+		Scope:     "account_id",
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeReadApplyQuery); ok {
-		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for Email", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertEmailColumns(updateMask)
+		if err != nil {
 			return nil, err
 		}
+		conflict.Update = columns
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &LanguageORM{}); err != nil {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeReadFind); ok {
-		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := LanguageORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(LanguageORMWithAfterReadFind); ok {
-		if err = hook.AfterReadFind(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := ormResponse.ToPB(ctx)
+	pbResponse, err := ormObj.ToPB(ctx)
 	return &pbResponse, err
 }
 
-type LanguageORMWithBeforeReadApplyQuery interface {
-	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+type EmailORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type LanguageORMWithBeforeReadFind interface {
-	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+type EmailORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
 }
-type LanguageORMWithAfterReadFind interface {
-	AfterReadFind(context.Context, *gorm.DB) error
+
+// upsertEmailColumns returns the columns of the fields of updateMask the upserts of
+// Email overwrite, the immutable fields and the paths of no column being rejected
+func upsertEmailColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		case "Email":
+			columns = append(columns, "email")
+		case "Subscribed":
+			columns = append(columns, "subscribed")
+		case "UserId":
+			columns = append(columns, "user_id")
+		case "ExternalNotNull":
+			columns = append(columns, "external_not_null")
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
+		}
+	}
+	return columns, nil
 }
 
-func DefaultDeleteLanguage(ctx context.Context, in *Language, db *gorm.DB) error {
+// EmailRepository runs the Default operations of Email, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type EmailRepository interface {
+	Create(ctx context.Context, in *Email) (*Email, error)
+	CreateSet(ctx context.Context, in []*Email, batchSize int) ([]*Email, error)
+	Read(ctx context.Context, in *Email, fs *query.FieldSelection) (*Email, error)
+	StrictUpdate(ctx context.Context, in *Email) (*Email, error)
+	Patch(ctx context.Context, in *Email, updateMask *field_mask.FieldMask) (*Email, error)
+	PatchSet(ctx context.Context, objects []*Email, updateMasks []*field_mask.FieldMask) ([]*Email, error)
+	Delete(ctx context.Context, in *Email) error
+	DeleteSet(ctx context.Context, in []*Email) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Email, error)
+	PageToken(ctx context.Context, last *Email, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *Email, target string, updateMask *field_mask.FieldMask) (*Email, error)
+}
+
+// GormEmailRepository is the EmailRepository calling the Default handlers with DB
+type GormEmailRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormEmailRepository returns the EmailRepository running the operations with db
+func NewGormEmailRepository(db *gorm.DB) *GormEmailRepository {
+	return &GormEmailRepository{DB: db}
+}
+
+func (r *GormEmailRepository) Create(ctx context.Context, in *Email) (*Email, error) {
+	return DefaultCreateEmail(ctx, in, r.DB)
+}
+
+func (r *GormEmailRepository) CreateSet(ctx context.Context, in []*Email, batchSize int) ([]*Email, error) {
+	return DefaultCreateEmailSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormEmailRepository) Read(ctx context.Context, in *Email, fs *query.FieldSelection) (*Email, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of Email doesn't support field selection")
+	}
+	return DefaultReadEmail(ctx, in, r.DB)
+}
+
+func (r *GormEmailRepository) StrictUpdate(ctx context.Context, in *Email) (*Email, error) {
+	return DefaultStrictUpdateEmail(ctx, in, r.DB)
+}
+
+func (r *GormEmailRepository) Patch(ctx context.Context, in *Email, updateMask *field_mask.FieldMask) (*Email, error) {
+	return DefaultPatchEmail(ctx, in, updateMask, r.DB)
+}
+
+func (r *GormEmailRepository) PatchSet(ctx context.Context, objects []*Email, updateMasks []*field_mask.FieldMask) ([]*Email, error) {
+	return DefaultPatchSetEmail(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormEmailRepository) Delete(ctx context.Context, in *Email) error {
+	return DefaultDeleteEmail(ctx, in, r.DB)
+}
+
+func (r *GormEmailRepository) DeleteSet(ctx context.Context, in []*Email) error {
+	return DefaultDeleteEmailSet(ctx, in, r.DB)
+}
+
+func (r *GormEmailRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Email, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Email doesn't support filtering")
+	}
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Email doesn't support sorting")
+	}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Email doesn't support pagination")
+	}
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Email doesn't support field selection")
+	}
+	return DefaultListEmail(ctx, r.DB)
+}
+
+func (r *GormEmailRepository) PageToken(ctx context.Context, last *Email, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	keyset, err := paging.NewKeyset(r.DB, &EmailORM{}, s, f)
+	if err != nil {
+		return "", err
+	}
+	return keyset.Token(&ormObj)
+}
+
+func (r *GormEmailRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of Email doesn't support filtering")
+	}
+	return DefaultCountEmail(ctx, r.DB, strategy)
+}
+
+func (r *GormEmailRepository) Upsert(ctx context.Context, in *Email, target string, updateMask *field_mask.FieldMask) (*Email, error) {
+	return DefaultUpsertEmail(ctx, in, target, updateMask, r.DB)
+}
+
+// MemoryEmailRepository is the EmailRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryEmailRepository struct {
+	table memory.Table
+}
+
+// NewMemoryEmailRepository returns an empty MemoryEmailRepository
+func NewMemoryEmailRepository() *MemoryEmailRepository {
+	return &MemoryEmailRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryEmailRepository) row(ctx context.Context, in, stored *Email) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: memory.Tenant(ormObj.AccountID), Key: ormObj.Id, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryEmailRepository) tenant(ctx context.Context) (interface{}, error) {
+	ormObj, err := (&Email{}).ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return memory.Tenant(ormObj.AccountID), nil
+}
+
+func (r *MemoryEmailRepository) Create(ctx context.Context, in *Email) (*Email, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*Email), nil
+}
+
+func (r *MemoryEmailRepository) CreateSet(ctx context.Context, in []*Email, batchSize int) ([]*Email, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*Email, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*Email))
+	}
+	if err := r.table.Insert(rows...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *MemoryEmailRepository) Read(ctx context.Context, in *Email, fs *query.FieldSelection) (*Email, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	out, ok := r.table.Get(memory.Tenant(ormObj.AccountID), ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*Email), nil
+}
+
+func (r *MemoryEmailRepository) StrictUpdate(ctx context.Context, in *Email) (*Email, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.table.Save(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*Email), nil
+}
+
+func (r *MemoryEmailRepository) Patch(ctx context.Context, in *Email, updateMask *field_mask.FieldMask) (*Email, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	patchee, err := r.Read(ctx, &Email{Id: in.GetId()}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if patchee, err = DefaultApplyFieldMaskEmail(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
+	}
+	return r.StrictUpdate(ctx, patchee)
+}
+
+func (r *MemoryEmailRepository) PatchSet(ctx context.Context, objects []*Email, updateMasks []*field_mask.FieldMask) ([]*Email, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	out := make([]*Email, 0, len(objects))
+	for i, patcher := range objects {
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, patched)
+	}
+	return out, nil
+}
+
+func (r *MemoryEmailRepository) Delete(ctx context.Context, in *Email) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -2217,292 +3025,2179 @@ func DefaultDeleteLanguage(ctx context.Context, in *Language, db *gorm.DB) error
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == 0 {
+	if ormObj.Id == "" {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeDelete_); ok {
-		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+	r.table.Delete(memory.Tenant(ormObj.AccountID), ormObj.Id)
+	return nil
+}
+
+func (r *MemoryEmailRepository) DeleteSet(ctx context.Context, in []*Email) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
 			return err
 		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
 	}
-	err = db.Where(&ormObj).Delete(&LanguageORM{}).Error
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryEmailRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Email, error) {
+	tenant, err := r.tenant(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterDelete_); ok {
-		err = hook.AfterDelete_(ctx, db)
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
 	}
-	return err
+	out := make([]*Email, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*Email))
+	}
+	return out, nil
 }
 
-type LanguageORMWithBeforeDelete_ interface {
-	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+func (r *MemoryEmailRepository) PageToken(ctx context.Context, last *Email, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	return memory.PageToken(ormObj.Id), nil
 }
-type LanguageORMWithAfterDelete_ interface {
-	AfterDelete_(context.Context, *gorm.DB) error
+
+func (r *MemoryEmailRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
 }
 
-func DefaultDeleteLanguageSet(ctx context.Context, in []*Language, db *gorm.DB) error {
+func (r *MemoryEmailRepository) Upsert(ctx context.Context, in *Email, target string, updateMask *field_mask.FieldMask) (*Email, error) {
 	if in == nil {
-		return errors.NilArgumentError
+		return nil, errors.NilArgumentError
 	}
-	var err error
-	keys := []int64{}
-	for _, obj := range in {
-		ormObj, err := obj.ToORM(ctx)
+	columns := EmailConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for Email", target)
+	}
+	if _, err := upsertEmailColumns(updateMask); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found, ok, err := r.table.Conflicting(memory.Tenant(ormObj.AccountID), func(m proto.Message) bool {
+		other, err := m.(*Email).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r.Create(ctx, in)
+	}
+	existing := found.(*Email)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskEmail(ctx, existing, in, updateMask, "", nil)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		if ormObj.Id == 0 {
-			return errors.EmptyIdError
+		return r.StrictUpdate(ctx, patched)
+	}
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*Email)
+	updated.Id = existing.Id
+	return r.StrictUpdate(ctx, updated)
+}
+
+// EmailFixtureOption customizes the object returned by NewEmailFixture
+type EmailFixtureOption func(*Email)
+
+// NewEmailFixture returns a Email with random valid values in its fields and
+// its required associations, customized by opts
+func NewEmailFixture(opts ...EmailFixtureOption) *Email {
+	m := &Email{}
+	m.Email = fixture.String(0)
+	m.Subscribed = fixture.Bool()
+	m.ExternalNotNull = fixture.Identifier(nil, fixture.UUID())
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CreateEmailFixture creates the object returned by NewEmailFixture and its
+// associations with DefaultCreateEmail
+func CreateEmailFixture(ctx context.Context, db *gorm.DB, opts ...EmailFixtureOption) (*Email, error) {
+	return DefaultCreateEmail(ctx, NewEmailFixture(opts...), db)
+}
+
+// DefaultCreateAddress executes a basic gorm create call
+func DefaultCreateAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultCreateAddress", "Address")
+	defer span.End()
+	r0, err := defaultCreateAddress(ctx, in, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultCreateAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
 		}
-		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&LanguageORM{})).(LanguageORMWithBeforeDeleteSet); ok {
-		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
-			return err
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
 		}
 	}
-	acctId, err := auth.GetAccountID(ctx, nil)
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type AddressORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateAddressSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateAddressSet(ctx context.Context, in []*Address, db *gorm.DB, batchSize int) ([]*Address, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultCreateAddressSet", "Address")
+	defer span.End()
+	r0, err := defaultCreateAddressSet(ctx, in, tracing.WithContext(ctx, db), batchSize)
+	return r0, tracing.Error(span, err)
+}
+
+func defaultCreateAddressSet(ctx context.Context, in []*Address, db *gorm.DB, batchSize int) ([]*Address, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*AddressORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&AddressORM{})).(AddressORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(AddressORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(AddressORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&AddressORM{})).(AddressORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*Address, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type AddressORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*AddressORM, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*AddressORM, *gorm.DB) error
+}
+
+func DefaultReadAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultReadAddress", "Address")
+	defer span.End()
+	r0, err := defaultReadAddress(ctx, in, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultReadAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = collection.ApplyFieldSelection(db, &AddressORM{}, nil); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := AddressORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(AddressORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type AddressORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteAddress(ctx context.Context, in *Address, db *gorm.DB) error {
+	ctx, span := tracing.StartHandler(ctx, "DefaultDeleteAddress", "Address")
+	defer span.End()
+	return tracing.Error(span, defaultDeleteAddress(ctx, in, tracing.WithContext(ctx, db)))
+}
+
+func defaultDeleteAddress(ctx context.Context, in *Address, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&AddressORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type AddressORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteAddressSet(ctx context.Context, in []*Address, db *gorm.DB) error {
+	ctx, span := tracing.StartHandler(ctx, "DefaultDeleteAddressSet", "Address")
+	defer span.End()
+	return tracing.Error(span, defaultDeleteAddressSet(ctx, in, tracing.WithContext(ctx, db)))
+}
+
+func defaultDeleteAddressSet(ctx context.Context, in []*Address, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&AddressORM{})).(AddressORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	tenantID, isolated, err := tenant.ID(ctx, "Address")
+	if err != nil {
+		return err
+	}
+	if isolated {
+		db = db.Where("account_id = ?", tenantID)
+	}
+	err = db.Where("id in (?)", keys).Delete(&AddressORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&AddressORM{})).(AddressORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type AddressORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Address, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Address, *gorm.DB) error
+}
+
+// DefaultStrictUpdateAddress clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultStrictUpdateAddress", "Address")
+	defer span.End()
+	r0, err := defaultStrictUpdateAddress(ctx, in, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultStrictUpdateAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateAddress")
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, isolated, err := tenant.ID(ctx, "Address")
+	if err != nil {
+		return nil, err
+	}
+	if isolated {
+		db = db.Where(map[string]interface{}{"account_id": tenantID})
+	}
+	var count int64
+	lockedRow := &AddressORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type AddressORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchAddress executes a basic gorm update call with patch behavior
+func DefaultPatchAddress(ctx context.Context, in *Address, updateMask *field_mask.FieldMask, db *gorm.DB) (*Address, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultPatchAddress", "Address")
+	defer span.End()
+	r0, err := defaultPatchAddress(ctx, in, updateMask, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultPatchAddress(ctx context.Context, in *Address, updateMask *field_mask.FieldMask, db *gorm.DB) (*Address, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Address
+	var err error
+	if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadAddress(ctx, &Address{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskAddress(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateAddress(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(AddressWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type AddressWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AddressWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AddressWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AddressWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetAddress executes a bulk gorm update call with patch behavior
+func DefaultPatchSetAddress(ctx context.Context, objects []*Address, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Address, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultPatchSetAddress", "Address")
+	defer span.End()
+	r0, err := defaultPatchSetAddress(ctx, objects, updateMasks, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultPatchSetAddress(ctx context.Context, objects []*Address, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Address, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Address, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchAddress(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskAddress patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskAddress(ctx context.Context, patchee *Address, patcher *Address, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Address, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultApplyFieldMaskAddress", "Address")
+	defer span.End()
+	r0, err := defaultApplyFieldMaskAddress(ctx, patchee, patcher, updateMask, prefix, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultApplyFieldMaskAddress(ctx context.Context, patchee *Address, patcher *Address, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Address, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Address_1" {
+			patchee.Address_1 = patcher.Address_1
+			continue
+		}
+		if f == prefix+"Address_2" {
+			patchee.Address_2 = patcher.Address_2
+			continue
+		}
+		if f == prefix+"Post" {
+			patchee.Post = patcher.Post
+			continue
+		}
+		if f == prefix+"External" {
+			patchee.External = patcher.External
+			continue
+		}
+		if f == prefix+"ImplicitFk" {
+			patchee.ImplicitFk = patcher.ImplicitFk
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListAddress executes a gorm list call
+func DefaultListAddress(ctx context.Context, db *gorm.DB) ([]*Address, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultListAddress", "Address")
+	defer span.End()
+	r0, err := defaultListAddress(ctx, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultListAddress(ctx context.Context, db *gorm.DB) ([]*Address, error) {
+	in := Address{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = collection.Apply(db, &AddressORM{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []AddressORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Address{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type AddressORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]AddressORM) error
+}
+
+// DefaultCountAddress returns the number of rows DefaultListAddress pages through
+func DefaultCountAddress(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultCountAddress", "Address")
+	defer span.End()
+	r0, err := defaultCountAddress(ctx, tracing.WithContext(ctx, db), strategy)
+	return r0, tracing.Error(span, err)
+}
+
+func defaultCountAddress(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := Address{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	return paging.Count(db.Where(&ormObj), &AddressORM{}, strategy)
+}
+
+type AddressORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// AddressConflictTargets maps the conflict targets accepted by DefaultUpsertAddress to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var AddressConflictTargets = map[string][]string{
+	"id": {"id"},
+}
+
+// DefaultUpsertAddress inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertAddress(ctx context.Context, in *Address, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*Address, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultUpsertAddress", "Address")
+	defer span.End()
+	r0, err := defaultUpsertAddress(ctx, in, target, updateMask, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultUpsertAddress(ctx context.Context, in *Address, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*Address, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	conflict := insert.OnConflict{
+		Columns:   AddressConflictTargets[target],
+		UpdateAll: updateMask == nil,
+		Scope:     "account_id",
+	}
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for Address", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertAddressColumns(updateMask)
+		if err != nil {
+			return nil, err
+		}
+		conflict.Update = columns
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type AddressORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// upsertAddressColumns returns the columns of the fields of updateMask the upserts of
+// Address overwrite, the immutable fields and the paths of no column being rejected
+func upsertAddressColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		case "Address_1":
+			columns = append(columns, "address_1")
+		case "Address_2":
+			columns = append(columns, "address_2")
+		case "Post":
+			columns = append(columns, "post")
+		case "External":
+			columns = append(columns, "external")
+		case "ImplicitFk":
+			columns = append(columns, "implicit_fk")
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
+		}
+	}
+	return columns, nil
+}
+
+// AddressRepository runs the Default operations of Address, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type AddressRepository interface {
+	Create(ctx context.Context, in *Address) (*Address, error)
+	CreateSet(ctx context.Context, in []*Address, batchSize int) ([]*Address, error)
+	Read(ctx context.Context, in *Address, fs *query.FieldSelection) (*Address, error)
+	StrictUpdate(ctx context.Context, in *Address) (*Address, error)
+	Patch(ctx context.Context, in *Address, updateMask *field_mask.FieldMask) (*Address, error)
+	PatchSet(ctx context.Context, objects []*Address, updateMasks []*field_mask.FieldMask) ([]*Address, error)
+	Delete(ctx context.Context, in *Address) error
+	DeleteSet(ctx context.Context, in []*Address) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Address, error)
+	PageToken(ctx context.Context, last *Address, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *Address, target string, updateMask *field_mask.FieldMask) (*Address, error)
+}
+
+// GormAddressRepository is the AddressRepository calling the Default handlers with DB
+type GormAddressRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormAddressRepository returns the AddressRepository running the operations with db
+func NewGormAddressRepository(db *gorm.DB) *GormAddressRepository {
+	return &GormAddressRepository{DB: db}
+}
+
+func (r *GormAddressRepository) Create(ctx context.Context, in *Address) (*Address, error) {
+	return DefaultCreateAddress(ctx, in, r.DB)
+}
+
+func (r *GormAddressRepository) CreateSet(ctx context.Context, in []*Address, batchSize int) ([]*Address, error) {
+	return DefaultCreateAddressSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormAddressRepository) Read(ctx context.Context, in *Address, fs *query.FieldSelection) (*Address, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of Address doesn't support field selection")
+	}
+	return DefaultReadAddress(ctx, in, r.DB)
+}
+
+func (r *GormAddressRepository) StrictUpdate(ctx context.Context, in *Address) (*Address, error) {
+	return DefaultStrictUpdateAddress(ctx, in, r.DB)
+}
+
+func (r *GormAddressRepository) Patch(ctx context.Context, in *Address, updateMask *field_mask.FieldMask) (*Address, error) {
+	return DefaultPatchAddress(ctx, in, updateMask, r.DB)
+}
+
+func (r *GormAddressRepository) PatchSet(ctx context.Context, objects []*Address, updateMasks []*field_mask.FieldMask) ([]*Address, error) {
+	return DefaultPatchSetAddress(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormAddressRepository) Delete(ctx context.Context, in *Address) error {
+	return DefaultDeleteAddress(ctx, in, r.DB)
+}
+
+func (r *GormAddressRepository) DeleteSet(ctx context.Context, in []*Address) error {
+	return DefaultDeleteAddressSet(ctx, in, r.DB)
+}
+
+func (r *GormAddressRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Address, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Address doesn't support filtering")
+	}
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Address doesn't support sorting")
+	}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Address doesn't support pagination")
+	}
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Address doesn't support field selection")
+	}
+	return DefaultListAddress(ctx, r.DB)
+}
+
+func (r *GormAddressRepository) PageToken(ctx context.Context, last *Address, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	keyset, err := paging.NewKeyset(r.DB, &AddressORM{}, s, f)
+	if err != nil {
+		return "", err
+	}
+	return keyset.Token(&ormObj)
+}
+
+func (r *GormAddressRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of Address doesn't support filtering")
+	}
+	return DefaultCountAddress(ctx, r.DB, strategy)
+}
+
+func (r *GormAddressRepository) Upsert(ctx context.Context, in *Address, target string, updateMask *field_mask.FieldMask) (*Address, error) {
+	return DefaultUpsertAddress(ctx, in, target, updateMask, r.DB)
+}
+
+// MemoryAddressRepository is the AddressRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryAddressRepository struct {
+	table memory.Table
+}
+
+// NewMemoryAddressRepository returns an empty MemoryAddressRepository
+func NewMemoryAddressRepository() *MemoryAddressRepository {
+	return &MemoryAddressRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryAddressRepository) row(ctx context.Context, in, stored *Address) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: memory.Tenant(ormObj.AccountID), Key: ormObj.Id, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryAddressRepository) tenant(ctx context.Context) (interface{}, error) {
+	ormObj, err := (&Address{}).ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return memory.Tenant(ormObj.AccountID), nil
+}
+
+func (r *MemoryAddressRepository) Create(ctx context.Context, in *Address) (*Address, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*Address), nil
+}
+
+func (r *MemoryAddressRepository) CreateSet(ctx context.Context, in []*Address, batchSize int) ([]*Address, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*Address, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*Address))
+	}
+	if err := r.table.Insert(rows...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *MemoryAddressRepository) Read(ctx context.Context, in *Address, fs *query.FieldSelection) (*Address, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	out, ok := r.table.Get(memory.Tenant(ormObj.AccountID), ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*Address), nil
+}
+
+func (r *MemoryAddressRepository) StrictUpdate(ctx context.Context, in *Address) (*Address, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.table.Save(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*Address), nil
+}
+
+func (r *MemoryAddressRepository) Patch(ctx context.Context, in *Address, updateMask *field_mask.FieldMask) (*Address, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	patchee, err := r.Read(ctx, &Address{Id: in.GetId()}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if patchee, err = DefaultApplyFieldMaskAddress(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
+	}
+	return r.StrictUpdate(ctx, patchee)
+}
+
+func (r *MemoryAddressRepository) PatchSet(ctx context.Context, objects []*Address, updateMasks []*field_mask.FieldMask) ([]*Address, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	out := make([]*Address, 0, len(objects))
+	for i, patcher := range objects {
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, patched)
+	}
+	return out, nil
+}
+
+func (r *MemoryAddressRepository) Delete(ctx context.Context, in *Address) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(memory.Tenant(ormObj.AccountID), ormObj.Id)
+	return nil
+}
+
+func (r *MemoryAddressRepository) DeleteSet(ctx context.Context, in []*Address) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryAddressRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Address, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*Address, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*Address))
+	}
+	return out, nil
+}
+
+func (r *MemoryAddressRepository) PageToken(ctx context.Context, last *Address, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryAddressRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryAddressRepository) Upsert(ctx context.Context, in *Address, target string, updateMask *field_mask.FieldMask) (*Address, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := AddressConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for Address", target)
+	}
+	if _, err := upsertAddressColumns(updateMask); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found, ok, err := r.table.Conflicting(memory.Tenant(ormObj.AccountID), func(m proto.Message) bool {
+		other, err := m.(*Address).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r.Create(ctx, in)
+	}
+	existing := found.(*Address)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskAddress(ctx, existing, in, updateMask, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		return r.StrictUpdate(ctx, patched)
+	}
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*Address)
+	updated.Id = existing.Id
+	return r.StrictUpdate(ctx, updated)
+}
+
+// AddressFixtureOption customizes the object returned by NewAddressFixture
+type AddressFixtureOption func(*Address)
+
+// NewAddressFixture returns a Address with random valid values in its fields and
+// its required associations, customized by opts
+func NewAddressFixture(opts ...AddressFixtureOption) *Address {
+	m := &Address{}
+	m.Address_1 = fixture.String(0)
+	m.Address_2 = fixture.String(0)
+	m.Post = fixture.String(0)
+	m.External = fixture.Identifier(nil, fixture.JSON())
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CreateAddressFixture creates the object returned by NewAddressFixture and its
+// associations with DefaultCreateAddress
+func CreateAddressFixture(ctx context.Context, db *gorm.DB, opts ...AddressFixtureOption) (*Address, error) {
+	return DefaultCreateAddress(ctx, NewAddressFixture(opts...), db)
+}
+
+// DefaultCreateLanguage executes a basic gorm create call
+func DefaultCreateLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultCreateLanguage", "Language")
+	defer span.End()
+	r0, err := defaultCreateLanguage(ctx, in, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultCreateLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type LanguageORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateLanguageSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateLanguageSet(ctx context.Context, in []*Language, db *gorm.DB, batchSize int) ([]*Language, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultCreateLanguageSet", "Language")
+	defer span.End()
+	r0, err := defaultCreateLanguageSet(ctx, in, tracing.WithContext(ctx, db), batchSize)
+	return r0, tracing.Error(span, err)
+}
+
+func defaultCreateLanguageSet(ctx context.Context, in []*Language, db *gorm.DB, batchSize int) ([]*Language, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*LanguageORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&LanguageORM{})).(LanguageORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(LanguageORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(LanguageORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&LanguageORM{})).(LanguageORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*Language, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type LanguageORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*LanguageORM, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*LanguageORM, *gorm.DB) error
+}
+
+func DefaultReadLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultReadLanguage", "Language")
+	defer span.End()
+	r0, err := defaultReadLanguage(ctx, in, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultReadLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = collection.ApplyFieldSelection(db, &LanguageORM{}, nil); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := LanguageORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(LanguageORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type LanguageORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteLanguage(ctx context.Context, in *Language, db *gorm.DB) error {
+	ctx, span := tracing.StartHandler(ctx, "DefaultDeleteLanguage", "Language")
+	defer span.End()
+	return tracing.Error(span, defaultDeleteLanguage(ctx, in, tracing.WithContext(ctx, db)))
+}
+
+func defaultDeleteLanguage(ctx context.Context, in *Language, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&LanguageORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type LanguageORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteLanguageSet(ctx context.Context, in []*Language, db *gorm.DB) error {
+	ctx, span := tracing.StartHandler(ctx, "DefaultDeleteLanguageSet", "Language")
+	defer span.End()
+	return tracing.Error(span, defaultDeleteLanguageSet(ctx, in, tracing.WithContext(ctx, db)))
+}
+
+func defaultDeleteLanguageSet(ctx context.Context, in []*Language, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&LanguageORM{})).(LanguageORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	tenantID, isolated, err := tenant.ID(ctx, "Language")
+	if err != nil {
+		return err
+	}
+	if isolated {
+		db = db.Where("account_id = ?", tenantID)
+	}
+	err = db.Where("id in (?)", keys).Delete(&LanguageORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&LanguageORM{})).(LanguageORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type LanguageORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Language, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Language, *gorm.DB) error
+}
+
+// DefaultStrictUpdateLanguage clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultStrictUpdateLanguage", "Language")
+	defer span.End()
+	r0, err := defaultStrictUpdateLanguage(ctx, in, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultStrictUpdateLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateLanguage")
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, isolated, err := tenant.ID(ctx, "Language")
+	if err != nil {
+		return nil, err
+	}
+	if isolated {
+		db = db.Where(map[string]interface{}{"account_id": tenantID})
+	}
+	var count int64
+	lockedRow := &LanguageORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type LanguageORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchLanguage executes a basic gorm update call with patch behavior
+func DefaultPatchLanguage(ctx context.Context, in *Language, updateMask *field_mask.FieldMask, db *gorm.DB) (*Language, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultPatchLanguage", "Language")
+	defer span.End()
+	r0, err := defaultPatchLanguage(ctx, in, updateMask, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultPatchLanguage(ctx context.Context, in *Language, updateMask *field_mask.FieldMask, db *gorm.DB) (*Language, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Language
+	var err error
+	if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadLanguage(ctx, &Language{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskLanguage(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateLanguage(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(LanguageWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type LanguageWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetLanguage executes a bulk gorm update call with patch behavior
+func DefaultPatchSetLanguage(ctx context.Context, objects []*Language, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Language, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultPatchSetLanguage", "Language")
+	defer span.End()
+	r0, err := defaultPatchSetLanguage(ctx, objects, updateMasks, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultPatchSetLanguage(ctx context.Context, objects []*Language, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Language, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Language, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchLanguage(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskLanguage patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskLanguage(ctx context.Context, patchee *Language, patcher *Language, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Language, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultApplyFieldMaskLanguage", "Language")
+	defer span.End()
+	r0, err := defaultApplyFieldMaskLanguage(ctx, patchee, patcher, updateMask, prefix, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultApplyFieldMaskLanguage(ctx context.Context, patchee *Language, patcher *Language, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Language, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Code" {
+			patchee.Code = patcher.Code
+			continue
+		}
+		if f == prefix+"ExternalInt" {
+			patchee.ExternalInt = patcher.ExternalInt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListLanguage executes a gorm list call
+func DefaultListLanguage(ctx context.Context, db *gorm.DB) ([]*Language, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultListLanguage", "Language")
+	defer span.End()
+	r0, err := defaultListLanguage(ctx, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultListLanguage(ctx context.Context, db *gorm.DB) ([]*Language, error) {
+	in := Language{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = collection.Apply(db, &LanguageORM{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []LanguageORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Language{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type LanguageORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]LanguageORM) error
+}
+
+// DefaultCountLanguage returns the number of rows DefaultListLanguage pages through
+func DefaultCountLanguage(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultCountLanguage", "Language")
+	defer span.End()
+	r0, err := defaultCountLanguage(ctx, tracing.WithContext(ctx, db), strategy)
+	return r0, tracing.Error(span, err)
+}
+
+func defaultCountLanguage(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := Language{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	return paging.Count(db.Where(&ormObj), &LanguageORM{}, strategy)
+}
+
+type LanguageORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// LanguageConflictTargets maps the conflict targets accepted by DefaultUpsertLanguage to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var LanguageConflictTargets = map[string][]string{
+	"id": {"id"},
+}
+
+// DefaultUpsertLanguage inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertLanguage(ctx context.Context, in *Language, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*Language, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultUpsertLanguage", "Language")
+	defer span.End()
+	r0, err := defaultUpsertLanguage(ctx, in, target, updateMask, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultUpsertLanguage(ctx context.Context, in *Language, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*Language, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	conflict := insert.OnConflict{
+		Columns:   LanguageConflictTargets[target],
+		UpdateAll: updateMask == nil,
+		Scope:     "account_id",
+	}
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for Language", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertLanguageColumns(updateMask)
+		if err != nil {
+			return nil, err
+		}
+		conflict.Update = columns
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type LanguageORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// upsertLanguageColumns returns the columns of the fields of updateMask the upserts of
+// Language overwrite, the immutable fields and the paths of no column being rejected
+func upsertLanguageColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		case "Name":
+			columns = append(columns, "name")
+		case "Code":
+			columns = append(columns, "code")
+		case "ExternalInt":
+			columns = append(columns, "external_int")
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
+		}
+	}
+	return columns, nil
+}
+
+// LanguageRepository runs the Default operations of Language, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type LanguageRepository interface {
+	Create(ctx context.Context, in *Language) (*Language, error)
+	CreateSet(ctx context.Context, in []*Language, batchSize int) ([]*Language, error)
+	Read(ctx context.Context, in *Language, fs *query.FieldSelection) (*Language, error)
+	StrictUpdate(ctx context.Context, in *Language) (*Language, error)
+	Patch(ctx context.Context, in *Language, updateMask *field_mask.FieldMask) (*Language, error)
+	PatchSet(ctx context.Context, objects []*Language, updateMasks []*field_mask.FieldMask) ([]*Language, error)
+	Delete(ctx context.Context, in *Language) error
+	DeleteSet(ctx context.Context, in []*Language) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Language, error)
+	PageToken(ctx context.Context, last *Language, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *Language, target string, updateMask *field_mask.FieldMask) (*Language, error)
+}
+
+// GormLanguageRepository is the LanguageRepository calling the Default handlers with DB
+type GormLanguageRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormLanguageRepository returns the LanguageRepository running the operations with db
+func NewGormLanguageRepository(db *gorm.DB) *GormLanguageRepository {
+	return &GormLanguageRepository{DB: db}
+}
+
+func (r *GormLanguageRepository) Create(ctx context.Context, in *Language) (*Language, error) {
+	return DefaultCreateLanguage(ctx, in, r.DB)
+}
+
+func (r *GormLanguageRepository) CreateSet(ctx context.Context, in []*Language, batchSize int) ([]*Language, error) {
+	return DefaultCreateLanguageSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormLanguageRepository) Read(ctx context.Context, in *Language, fs *query.FieldSelection) (*Language, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of Language doesn't support field selection")
+	}
+	return DefaultReadLanguage(ctx, in, r.DB)
+}
+
+func (r *GormLanguageRepository) StrictUpdate(ctx context.Context, in *Language) (*Language, error) {
+	return DefaultStrictUpdateLanguage(ctx, in, r.DB)
+}
+
+func (r *GormLanguageRepository) Patch(ctx context.Context, in *Language, updateMask *field_mask.FieldMask) (*Language, error) {
+	return DefaultPatchLanguage(ctx, in, updateMask, r.DB)
+}
+
+func (r *GormLanguageRepository) PatchSet(ctx context.Context, objects []*Language, updateMasks []*field_mask.FieldMask) ([]*Language, error) {
+	return DefaultPatchSetLanguage(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormLanguageRepository) Delete(ctx context.Context, in *Language) error {
+	return DefaultDeleteLanguage(ctx, in, r.DB)
+}
+
+func (r *GormLanguageRepository) DeleteSet(ctx context.Context, in []*Language) error {
+	return DefaultDeleteLanguageSet(ctx, in, r.DB)
+}
+
+func (r *GormLanguageRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Language, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Language doesn't support filtering")
+	}
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Language doesn't support sorting")
+	}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Language doesn't support pagination")
+	}
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Language doesn't support field selection")
+	}
+	return DefaultListLanguage(ctx, r.DB)
+}
+
+func (r *GormLanguageRepository) PageToken(ctx context.Context, last *Language, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	keyset, err := paging.NewKeyset(r.DB, &LanguageORM{}, s, f)
+	if err != nil {
+		return "", err
+	}
+	return keyset.Token(&ormObj)
+}
+
+func (r *GormLanguageRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of Language doesn't support filtering")
+	}
+	return DefaultCountLanguage(ctx, r.DB, strategy)
+}
+
+func (r *GormLanguageRepository) Upsert(ctx context.Context, in *Language, target string, updateMask *field_mask.FieldMask) (*Language, error) {
+	return DefaultUpsertLanguage(ctx, in, target, updateMask, r.DB)
+}
+
+// MemoryLanguageRepository is the LanguageRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryLanguageRepository struct {
+	table memory.Table
+}
+
+// NewMemoryLanguageRepository returns an empty MemoryLanguageRepository
+func NewMemoryLanguageRepository() *MemoryLanguageRepository {
+	return &MemoryLanguageRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryLanguageRepository) row(ctx context.Context, in, stored *Language) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return err
+		return memory.Row{}, err
 	}
-	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&LanguageORM{}).Error
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
 	if err != nil {
-		return err
-	}
-	if hook, ok := (interface{}(&LanguageORM{})).(LanguageORMWithAfterDeleteSet); ok {
-		err = hook.AfterDeleteSet(ctx, in, db)
+		return memory.Row{}, err
 	}
-	return err
+	return memory.Row{Tenant: memory.Tenant(ormObj.AccountID), Key: ormObj.Id, Object: &out}, nil
 }
 
-type LanguageORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*Language, *gorm.DB) (*gorm.DB, error)
-}
-type LanguageORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*Language, *gorm.DB) error
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryLanguageRepository) tenant(ctx context.Context) (interface{}, error) {
+	ormObj, err := (&Language{}).ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return memory.Tenant(ormObj.AccountID), nil
 }
 
-// DefaultStrictUpdateLanguage clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
+func (r *MemoryLanguageRepository) Create(ctx context.Context, in *Language) (*Language, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateLanguage")
+		return nil, errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
+	if err := in.Validate(); err != nil {
 		return nil, err
 	}
-	accountID, err := auth.GetAccountID(ctx, nil)
+	row, err := r.row(ctx, in, nil)
 	if err != nil {
 		return nil, err
 	}
-	db = db.Where(map[string]interface{}{"account_id": accountID})
-	var count int64
-	lockedRow := &LanguageORM{}
-	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeStrictUpdateCleanup); ok {
-		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*Language), nil
+}
+
+func (r *MemoryLanguageRepository) CreateSet(ctx context.Context, in []*Language, batchSize int) ([]*Language, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*Language, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
 			return nil, err
 		}
-	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeStrictUpdateSave); ok {
-		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
 			return nil, err
 		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*Language))
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	if err := r.table.Insert(rows...); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterStrictUpdateSave); ok {
-		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
-			return nil, err
-		}
+	return out, nil
+}
+
+func (r *MemoryLanguageRepository) Read(ctx context.Context, in *Language, fs *query.FieldSelection) (*Language, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
+	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if count == 0 {
-		err = gateway.SetCreated(ctx, "")
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
 	}
-	return &pbResponse, err
-}
-
-type LanguageORMWithBeforeStrictUpdateCleanup interface {
-	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type LanguageORMWithBeforeStrictUpdateSave interface {
-	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type LanguageORMWithAfterStrictUpdateSave interface {
-	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+	out, ok := r.table.Get(memory.Tenant(ormObj.AccountID), ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*Language), nil
 }
 
-// DefaultPatchLanguage executes a basic gorm update call with patch behavior
-func DefaultPatchLanguage(ctx context.Context, in *Language, updateMask *field_mask.FieldMask, db *gorm.DB) (*Language, error) {
+func (r *MemoryLanguageRepository) StrictUpdate(ctx context.Context, in *Language) (*Language, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj Language
-	var err error
-	if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchRead); ok {
-		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	if err := in.Validate(); err != nil {
+		return nil, err
 	}
-	pbReadRes, err := DefaultReadLanguage(ctx, &Language{Id: in.GetId()}, db)
+	row, err := r.row(ctx, in, nil)
 	if err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchApplyFieldMask); ok {
-		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	if _, err := DefaultApplyFieldMaskLanguage(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err = r.table.Save(row); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchSave); ok {
-		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	return row.Object.(*Language), nil
+}
+
+func (r *MemoryLanguageRepository) Patch(ctx context.Context, in *Language, updateMask *field_mask.FieldMask) (*Language, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
 	}
-	pbResponse, err := DefaultStrictUpdateLanguage(ctx, &pbObj, db)
+	patchee, err := r.Read(ctx, &Language{Id: in.GetId()}, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(LanguageWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
+	if patchee, err = DefaultApplyFieldMaskLanguage(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
 	}
-	return pbResponse, nil
-}
-
-type LanguageWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type LanguageWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type LanguageWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type LanguageWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) error
+	return r.StrictUpdate(ctx, patchee)
 }
 
-// DefaultPatchSetLanguage executes a bulk gorm update call with patch behavior
-func DefaultPatchSetLanguage(ctx context.Context, objects []*Language, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Language, error) {
+func (r *MemoryLanguageRepository) PatchSet(ctx context.Context, objects []*Language, updateMasks []*field_mask.FieldMask) ([]*Language, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
-
-	results := make([]*Language, 0, len(objects))
+	out := make([]*Language, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchLanguage(ctx, patcher, updateMasks[i], db)
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
+		out = append(out, patched)
 	}
+	return out, nil
+}
 
-	return results, nil
+func (r *MemoryLanguageRepository) Delete(ctx context.Context, in *Language) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(memory.Tenant(ormObj.AccountID), ormObj.Id)
+	return nil
 }
 
-// DefaultApplyFieldMaskLanguage patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskLanguage(ctx context.Context, patchee *Language, patcher *Language, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Language, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
+func (r *MemoryLanguageRepository) DeleteSet(ctx context.Context, in []*Language) error {
+	if in == nil {
+		return errors.NilArgumentError
 	}
-	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
-		}
-		if f == prefix+"Name" {
-			patchee.Name = patcher.Name
-			continue
-		}
-		if f == prefix+"Code" {
-			patchee.Code = patcher.Code
-			continue
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
 		}
-		if f == prefix+"ExternalInt" {
-			patchee.ExternalInt = patcher.ExternalInt
-			continue
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
 		}
+		keys = append(keys, ormObj.Id)
 	}
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryLanguageRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Language, error) {
+	tenant, err := r.tenant(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return patchee, nil
-}
-
-// DefaultListLanguage executes a gorm list call
-func DefaultListLanguage(ctx context.Context, db *gorm.DB) ([]*Language, error) {
-	in := Language{}
-	ormObj, err := in.ToORM(ctx)
+	objects, err := r.table.List(tenant, f, s, p)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
+	out := make([]*Language, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*Language))
+	}
+	return out, nil
+}
+
+func (r *MemoryLanguageRepository) PageToken(ctx context.Context, last *Language, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &LanguageORM{}, &Language{}, nil, nil, nil, nil)
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryLanguageRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
 	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryLanguageRepository) Upsert(ctx context.Context, in *Language, target string, updateMask *field_mask.FieldMask) (*Language, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := LanguageConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for Language", target)
+	}
+	if _, err := upsertLanguageColumns(updateMask); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
-			return nil, err
-		}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []LanguageORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
+	found, ok, err := r.table.Conflicting(memory.Tenant(ormObj.AccountID), func(m proto.Message) bool {
+		other, err := m.(*Language).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
-			return nil, err
-		}
+	if !ok {
+		return r.Create(ctx, in)
 	}
-	pbResponse := []*Language{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
+	existing := found.(*Language)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskLanguage(ctx, existing, in, updateMask, "", nil)
 		if err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
+		return r.StrictUpdate(ctx, patched)
 	}
-	return pbResponse, nil
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*Language)
+	updated.Id = existing.Id
+	return r.StrictUpdate(ctx, updated)
 }
 
-type LanguageORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type LanguageORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+// LanguageFixtureOption customizes the object returned by NewLanguageFixture
+type LanguageFixtureOption func(*Language)
+
+// NewLanguageFixture returns a Language with random valid values in its fields and
+// its required associations, customized by opts
+func NewLanguageFixture(opts ...LanguageFixtureOption) *Language {
+	m := &Language{}
+	m.Name = fixture.String(0)
+	m.Code = fixture.String(0)
+	m.ExternalInt = fixture.Identifier(nil, fixture.Int(1e6))
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
-type LanguageORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]LanguageORM) error
+
+// CreateLanguageFixture creates the object returned by NewLanguageFixture and its
+// associations with DefaultCreateLanguage
+func CreateLanguageFixture(ctx context.Context, db *gorm.DB, opts ...LanguageFixtureOption) (*Language, error) {
+	return DefaultCreateLanguage(ctx, NewLanguageFixture(opts...), db)
 }
 
 // DefaultCreateCreditCard executes a basic gorm create call
 func DefaultCreateCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*CreditCard, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultCreateCreditCard", "CreditCard")
+	defer span.End()
+	r0, err := defaultCreateCreditCard(ctx, in, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultCreateCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*CreditCard, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
@@ -2512,26 +5207,109 @@ func DefaultCreateCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (
 			return nil, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type CreditCardORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CreditCardORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCreateCreditCardSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateCreditCardSet(ctx context.Context, in []*CreditCard, db *gorm.DB, batchSize int) ([]*CreditCard, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultCreateCreditCardSet", "CreditCard")
+	defer span.End()
+	r0, err := defaultCreateCreditCardSet(ctx, in, tracing.WithContext(ctx, db), batchSize)
+	return r0, tracing.Error(span, err)
+}
+
+func defaultCreateCreditCardSet(ctx context.Context, in []*CreditCard, db *gorm.DB, batchSize int) ([]*CreditCard, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*CreditCardORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&CreditCardORM{})).(CreditCardORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(CreditCardORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(CreditCardORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&CreditCardORM{})).(CreditCardORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
+	pbResponse := make([]*CreditCard, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
 			return nil, err
 		}
+		pbResponse = append(pbResponse, &pbObj)
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	return pbResponse, nil
 }
 
-type CreditCardORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+type CreditCardORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*CreditCardORM, *gorm.DB) (*gorm.DB, error)
 }
-type CreditCardORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+type CreditCardORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*CreditCardORM, *gorm.DB) error
 }
 
 func DefaultReadCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*CreditCard, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultReadCreditCard", "CreditCard")
+	defer span.End()
+	r0, err := defaultReadCreditCard(ctx, in, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultReadCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*CreditCard, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2547,7 +5325,7 @@ func DefaultReadCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*C
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &CreditCardORM{}); err != nil {
+	if db, err = collection.ApplyFieldSelection(db, &CreditCardORM{}, nil); err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeReadFind); ok {
@@ -2579,6 +5357,12 @@ type CreditCardORMWithAfterReadFind interface {
 }
 
 func DefaultDeleteCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) error {
+	ctx, span := tracing.StartHandler(ctx, "DefaultDeleteCreditCard", "CreditCard")
+	defer span.End()
+	return tracing.Error(span, defaultDeleteCreditCard(ctx, in, tracing.WithContext(ctx, db)))
+}
+
+func defaultDeleteCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -2612,6 +5396,12 @@ type CreditCardORMWithAfterDelete_ interface {
 }
 
 func DefaultDeleteCreditCardSet(ctx context.Context, in []*CreditCard, db *gorm.DB) error {
+	ctx, span := tracing.StartHandler(ctx, "DefaultDeleteCreditCardSet", "CreditCard")
+	defer span.End()
+	return tracing.Error(span, defaultDeleteCreditCardSet(ctx, in, tracing.WithContext(ctx, db)))
+}
+
+func defaultDeleteCreditCardSet(ctx context.Context, in []*CreditCard, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -2632,11 +5422,14 @@ func DefaultDeleteCreditCardSet(ctx context.Context, in []*CreditCard, db *gorm.
 			return err
 		}
 	}
-	acctId, err := auth.GetAccountID(ctx, nil)
+	tenantID, isolated, err := tenant.ID(ctx, "CreditCard")
 	if err != nil {
 		return err
 	}
-	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&CreditCardORM{}).Error
+	if isolated {
+		db = db.Where("account_id = ?", tenantID)
+	}
+	err = db.Where("id in (?)", keys).Delete(&CreditCardORM{}).Error
 	if err != nil {
 		return err
 	}
@@ -2655,18 +5448,30 @@ type CreditCardORMWithAfterDeleteSet interface {
 
 // DefaultStrictUpdateCreditCard clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*CreditCard, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultStrictUpdateCreditCard", "CreditCard")
+	defer span.End()
+	r0, err := defaultStrictUpdateCreditCard(ctx, in, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultStrictUpdateCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*CreditCard, error) {
 	if in == nil {
 		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateCreditCard")
 	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	accountID, err := auth.GetAccountID(ctx, nil)
+	tenantID, isolated, err := tenant.ID(ctx, "CreditCard")
 	if err != nil {
 		return nil, err
 	}
-	db = db.Where(map[string]interface{}{"account_id": accountID})
+	if isolated {
+		db = db.Where(map[string]interface{}{"account_id": tenantID})
+	}
 	var count int64
 	lockedRow := &CreditCardORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
@@ -2710,6 +5515,13 @@ type CreditCardORMWithAfterStrictUpdateSave interface {
 
 // DefaultPatchCreditCard executes a basic gorm update call with patch behavior
 func DefaultPatchCreditCard(ctx context.Context, in *CreditCard, updateMask *field_mask.FieldMask, db *gorm.DB) (*CreditCard, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultPatchCreditCard", "CreditCard")
+	defer span.End()
+	r0, err := defaultPatchCreditCard(ctx, in, updateMask, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultPatchCreditCard(ctx context.Context, in *CreditCard, updateMask *field_mask.FieldMask, db *gorm.DB) (*CreditCard, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2765,6 +5577,13 @@ type CreditCardWithAfterPatchSave interface {
 
 // DefaultPatchSetCreditCard executes a bulk gorm update call with patch behavior
 func DefaultPatchSetCreditCard(ctx context.Context, objects []*CreditCard, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*CreditCard, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultPatchSetCreditCard", "CreditCard")
+	defer span.End()
+	r0, err := defaultPatchSetCreditCard(ctx, objects, updateMasks, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultPatchSetCreditCard(ctx context.Context, objects []*CreditCard, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*CreditCard, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
@@ -2784,6 +5603,13 @@ func DefaultPatchSetCreditCard(ctx context.Context, objects []*CreditCard, updat
 
 // DefaultApplyFieldMaskCreditCard patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskCreditCard(ctx context.Context, patchee *CreditCard, patcher *CreditCard, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*CreditCard, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultApplyFieldMaskCreditCard", "CreditCard")
+	defer span.End()
+	r0, err := defaultApplyFieldMaskCreditCard(ctx, patchee, patcher, updateMask, prefix, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultApplyFieldMaskCreditCard(ctx context.Context, patchee *CreditCard, patcher *CreditCard, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*CreditCard, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -2811,7 +5637,7 @@ func DefaultApplyFieldMaskCreditCard(ctx context.Context, patchee *CreditCard, p
 					childMask.Paths = append(childMask.Paths, trimPath)
 				}
 			}
-			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+			if err := collection.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
 				return nil, nil
 			}
 		}
@@ -2834,7 +5660,7 @@ func DefaultApplyFieldMaskCreditCard(ctx context.Context, patchee *CreditCard, p
 					childMask.Paths = append(childMask.Paths, trimPath)
 				}
 			}
-			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+			if err := collection.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
 				return nil, nil
 			}
 		}
@@ -2855,67 +5681,580 @@ func DefaultApplyFieldMaskCreditCard(ctx context.Context, patchee *CreditCard, p
 	if err != nil {
 		return nil, err
 	}
-	return patchee, nil
+	return patchee, nil
+}
+
+// DefaultListCreditCard executes a gorm list call
+func DefaultListCreditCard(ctx context.Context, db *gorm.DB) ([]*CreditCard, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultListCreditCard", "CreditCard")
+	defer span.End()
+	r0, err := defaultListCreditCard(ctx, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultListCreditCard(ctx context.Context, db *gorm.DB) ([]*CreditCard, error) {
+	in := CreditCard{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = collection.Apply(db, &CreditCardORM{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []CreditCardORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*CreditCard{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type CreditCardORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CreditCardORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CreditCardORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]CreditCardORM) error
+}
+
+// DefaultCountCreditCard returns the number of rows DefaultListCreditCard pages through
+func DefaultCountCreditCard(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultCountCreditCard", "CreditCard")
+	defer span.End()
+	r0, err := defaultCountCreditCard(ctx, tracing.WithContext(ctx, db), strategy)
+	return r0, tracing.Error(span, err)
+}
+
+func defaultCountCreditCard(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := CreditCard{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	return paging.Count(db.Where(&ormObj), &CreditCardORM{}, strategy)
+}
+
+type CreditCardORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// CreditCardConflictTargets maps the conflict targets accepted by DefaultUpsertCreditCard to their columns,
+// the primary key and unique fields are named by column and unique indexes by index name
+var CreditCardConflictTargets = map[string][]string{
+	"id": {"id"},
+}
+
+// DefaultUpsertCreditCard inserts the object or updates the row conflicting with it on the target
+// unique constraint, a non nil updateMask limits the fields overwritten
+func DefaultUpsertCreditCard(ctx context.Context, in *CreditCard, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*CreditCard, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultUpsertCreditCard", "CreditCard")
+	defer span.End()
+	r0, err := defaultUpsertCreditCard(ctx, in, target, updateMask, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultUpsertCreditCard(ctx context.Context, in *CreditCard, target string, updateMask *field_mask.FieldMask, db *gorm.DB) (*CreditCard, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	conflict := insert.OnConflict{
+		Columns:   CreditCardConflictTargets[target],
+		UpdateAll: updateMask == nil,
+		Scope:     "account_id",
+	}
+	if len(conflict.Columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for CreditCard", target)
+	}
+	if updateMask != nil {
+		columns, err := upsertCreditCardColumns(updateMask)
+		if err != nil {
+			return nil, err
+		}
+		conflict.Update = columns
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeUpsert); ok {
+		if db, err = hook.BeforeUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = insert.Upsert(db, &ormObj, conflict); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithAfterUpsert); ok {
+		if err = hook.AfterUpsert(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type CreditCardORMWithBeforeUpsert interface {
+	BeforeUpsert(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type CreditCardORMWithAfterUpsert interface {
+	AfterUpsert(context.Context, *gorm.DB) error
+}
+
+// upsertCreditCardColumns returns the columns of the fields of updateMask the upserts of
+// CreditCard overwrite, the immutable fields and the paths of no column being rejected
+func upsertCreditCardColumns(updateMask *field_mask.FieldMask) ([]string, error) {
+	var columns []string
+	for _, f := range updateMask.GetPaths() {
+		switch f {
+		case "CreatedAt":
+			columns = append(columns, "created_at")
+		case "UpdatedAt":
+			columns = append(columns, "updated_at")
+		case "Number":
+			columns = append(columns, "number")
+		case "UserId":
+			columns = append(columns, "user_id")
+		// the primary key, the tenant and the output only fields keep their stored values
+		case "Id":
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown field %q", f)
+		}
+	}
+	return columns, nil
+}
+
+// CreditCardRepository runs the Default operations of CreditCard, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type CreditCardRepository interface {
+	Create(ctx context.Context, in *CreditCard) (*CreditCard, error)
+	CreateSet(ctx context.Context, in []*CreditCard, batchSize int) ([]*CreditCard, error)
+	Read(ctx context.Context, in *CreditCard, fs *query.FieldSelection) (*CreditCard, error)
+	StrictUpdate(ctx context.Context, in *CreditCard) (*CreditCard, error)
+	Patch(ctx context.Context, in *CreditCard, updateMask *field_mask.FieldMask) (*CreditCard, error)
+	PatchSet(ctx context.Context, objects []*CreditCard, updateMasks []*field_mask.FieldMask) ([]*CreditCard, error)
+	Delete(ctx context.Context, in *CreditCard) error
+	DeleteSet(ctx context.Context, in []*CreditCard) error
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*CreditCard, error)
+	PageToken(ctx context.Context, last *CreditCard, s *query.Sorting, f *query.Filtering) (string, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+	Upsert(ctx context.Context, in *CreditCard, target string, updateMask *field_mask.FieldMask) (*CreditCard, error)
+}
+
+// GormCreditCardRepository is the CreditCardRepository calling the Default handlers with DB
+type GormCreditCardRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormCreditCardRepository returns the CreditCardRepository running the operations with db
+func NewGormCreditCardRepository(db *gorm.DB) *GormCreditCardRepository {
+	return &GormCreditCardRepository{DB: db}
+}
+
+func (r *GormCreditCardRepository) Create(ctx context.Context, in *CreditCard) (*CreditCard, error) {
+	return DefaultCreateCreditCard(ctx, in, r.DB)
+}
+
+func (r *GormCreditCardRepository) CreateSet(ctx context.Context, in []*CreditCard, batchSize int) ([]*CreditCard, error) {
+	return DefaultCreateCreditCardSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormCreditCardRepository) Read(ctx context.Context, in *CreditCard, fs *query.FieldSelection) (*CreditCard, error) {
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Read of CreditCard doesn't support field selection")
+	}
+	return DefaultReadCreditCard(ctx, in, r.DB)
+}
+
+func (r *GormCreditCardRepository) StrictUpdate(ctx context.Context, in *CreditCard) (*CreditCard, error) {
+	return DefaultStrictUpdateCreditCard(ctx, in, r.DB)
+}
+
+func (r *GormCreditCardRepository) Patch(ctx context.Context, in *CreditCard, updateMask *field_mask.FieldMask) (*CreditCard, error) {
+	return DefaultPatchCreditCard(ctx, in, updateMask, r.DB)
+}
+
+func (r *GormCreditCardRepository) PatchSet(ctx context.Context, objects []*CreditCard, updateMasks []*field_mask.FieldMask) ([]*CreditCard, error) {
+	return DefaultPatchSetCreditCard(ctx, objects, updateMasks, r.DB)
+}
+
+func (r *GormCreditCardRepository) Delete(ctx context.Context, in *CreditCard) error {
+	return DefaultDeleteCreditCard(ctx, in, r.DB)
+}
+
+func (r *GormCreditCardRepository) DeleteSet(ctx context.Context, in []*CreditCard) error {
+	return DefaultDeleteCreditCardSet(ctx, in, r.DB)
+}
+
+func (r *GormCreditCardRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*CreditCard, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of CreditCard doesn't support filtering")
+	}
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of CreditCard doesn't support sorting")
+	}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of CreditCard doesn't support pagination")
+	}
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of CreditCard doesn't support field selection")
+	}
+	return DefaultListCreditCard(ctx, r.DB)
+}
+
+func (r *GormCreditCardRepository) PageToken(ctx context.Context, last *CreditCard, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	keyset, err := paging.NewKeyset(r.DB, &CreditCardORM{}, s, f)
+	if err != nil {
+		return "", err
+	}
+	return keyset.Token(&ormObj)
+}
+
+func (r *GormCreditCardRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of CreditCard doesn't support filtering")
+	}
+	return DefaultCountCreditCard(ctx, r.DB, strategy)
+}
+
+func (r *GormCreditCardRepository) Upsert(ctx context.Context, in *CreditCard, target string, updateMask *field_mask.FieldMask) (*CreditCard, error) {
+	return DefaultUpsertCreditCard(ctx, in, target, updateMask, r.DB)
+}
+
+// MemoryCreditCardRepository is the CreditCardRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryCreditCardRepository struct {
+	table memory.Table
+}
+
+// NewMemoryCreditCardRepository returns an empty MemoryCreditCardRepository
+func NewMemoryCreditCardRepository() *MemoryCreditCardRepository {
+	return &MemoryCreditCardRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryCreditCardRepository) row(ctx context.Context, in, stored *CreditCard) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	r.table.AssignKey(&ormObj.Id)
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: memory.Tenant(ormObj.AccountID), Key: ormObj.Id, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryCreditCardRepository) tenant(ctx context.Context) (interface{}, error) {
+	ormObj, err := (&CreditCard{}).ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return memory.Tenant(ormObj.AccountID), nil
+}
+
+func (r *MemoryCreditCardRepository) Create(ctx context.Context, in *CreditCard) (*CreditCard, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*CreditCard), nil
+}
+
+func (r *MemoryCreditCardRepository) CreateSet(ctx context.Context, in []*CreditCard, batchSize int) ([]*CreditCard, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*CreditCard, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*CreditCard))
+	}
+	if err := r.table.Insert(rows...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *MemoryCreditCardRepository) Read(ctx context.Context, in *CreditCard, fs *query.FieldSelection) (*CreditCard, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	out, ok := r.table.Get(memory.Tenant(ormObj.AccountID), ormObj.Id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return out.(*CreditCard), nil
+}
+
+func (r *MemoryCreditCardRepository) StrictUpdate(ctx context.Context, in *CreditCard) (*CreditCard, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.table.Save(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*CreditCard), nil
 }
 
-// DefaultListCreditCard executes a gorm list call
-func DefaultListCreditCard(ctx context.Context, db *gorm.DB) ([]*CreditCard, error) {
-	in := CreditCard{}
-	ormObj, err := in.ToORM(ctx)
+func (r *MemoryCreditCardRepository) Patch(ctx context.Context, in *CreditCard, updateMask *field_mask.FieldMask) (*CreditCard, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	patchee, err := r.Read(ctx, &CreditCard{Id: in.GetId()}, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeListApplyQuery); ok {
-		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+	if patchee, err = DefaultApplyFieldMaskCreditCard(ctx, patchee, in, updateMask, "", nil); err != nil {
+		return nil, err
+	}
+	return r.StrictUpdate(ctx, patchee)
+}
+
+func (r *MemoryCreditCardRepository) PatchSet(ctx context.Context, objects []*CreditCard, updateMasks []*field_mask.FieldMask) ([]*CreditCard, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+	out := make([]*CreditCard, 0, len(objects))
+	for i, patcher := range objects {
+		patched, err := r.Patch(ctx, patcher, updateMasks[i])
+		if err != nil {
 			return nil, err
 		}
+		out = append(out, patched)
+	}
+	return out, nil
+}
+
+func (r *MemoryCreditCardRepository) Delete(ctx context.Context, in *CreditCard) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	r.table.Delete(memory.Tenant(ormObj.AccountID), ormObj.Id)
+	return nil
+}
+
+func (r *MemoryCreditCardRepository) DeleteSet(ctx context.Context, in []*CreditCard) error {
+	if in == nil {
+		return errors.NilArgumentError
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &CreditCardORM{}, &CreditCard{}, nil, nil, nil, nil)
+	tenant, err := r.tenant(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
-			return nil, err
+	keys := make([]interface{}, 0, len(in))
+	for _, object := range in {
+		ormObj, err := object.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
 		}
+		keys = append(keys, ormObj.Id)
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []CreditCardORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
+	r.table.Delete(tenant, keys...)
+	return nil
+}
+
+func (r *MemoryCreditCardRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*CreditCard, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
-			return nil, err
-		}
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
 	}
-	pbResponse := []*CreditCard{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
+	out := make([]*CreditCard, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*CreditCard))
+	}
+	return out, nil
+}
+
+func (r *MemoryCreditCardRepository) PageToken(ctx context.Context, last *CreditCard, s *query.Sorting, f *query.Filtering) (string, error) {
+	ormObj, err := last.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	return memory.PageToken(ormObj.Id), nil
+}
+
+func (r *MemoryCreditCardRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+func (r *MemoryCreditCardRepository) Upsert(ctx context.Context, in *CreditCard, target string, updateMask *field_mask.FieldMask) (*CreditCard, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	columns := CreditCardConflictTargets[target]
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("unknown conflict target %q for CreditCard", target)
+	}
+	if _, err := upsertCreditCardColumns(updateMask); err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found, ok, err := r.table.Conflicting(memory.Tenant(ormObj.AccountID), func(m proto.Message) bool {
+		other, err := m.(*CreditCard).ToORM(ctx)
+		return err == nil && memory.SameColumns(&ormObj, &other, columns)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return r.Create(ctx, in)
+	}
+	existing := found.(*CreditCard)
+	if updateMask != nil {
+		patched, err := DefaultApplyFieldMaskCreditCard(ctx, existing, in, updateMask, "", nil)
 		if err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
+		return r.StrictUpdate(ctx, patched)
 	}
-	return pbResponse, nil
+	// the primary key, output only and immutable fields are kept
+	updated := proto.Clone(in).(*CreditCard)
+	updated.Id = existing.Id
+	return r.StrictUpdate(ctx, updated)
 }
 
-type CreditCardORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type CreditCardORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+// CreditCardFixtureOption customizes the object returned by NewCreditCardFixture
+type CreditCardFixtureOption func(*CreditCard)
+
+// NewCreditCardFixture returns a CreditCard with random valid values in its fields and
+// its required associations, customized by opts
+func NewCreditCardFixture(opts ...CreditCardFixtureOption) *CreditCard {
+	m := &CreditCard{}
+	m.CreatedAt = timestamppb.New(fixture.Time())
+	m.UpdatedAt = timestamppb.New(fixture.Time())
+	m.Number = fixture.String(0)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
-type CreditCardORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]CreditCardORM) error
+
+// CreateCreditCardFixture creates the object returned by NewCreditCardFixture and its
+// associations with DefaultCreateCreditCard
+func CreateCreditCardFixture(ctx context.Context, db *gorm.DB, opts ...CreditCardFixtureOption) (*CreditCard, error) {
+	return DefaultCreateCreditCard(ctx, NewCreditCardFixture(opts...), db)
 }
 
 // DefaultCreateTask executes a basic gorm create call
 func DefaultCreateTask(ctx context.Context, in *Task, db *gorm.DB) (*Task, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultCreateTask", "Task")
+	defer span.End()
+	r0, err := defaultCreateTask(ctx, in, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultCreateTask(ctx context.Context, in *Task, db *gorm.DB) (*Task, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
@@ -2944,8 +6283,91 @@ type TaskORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCreateTaskSet executes batched gorm create calls in a single transaction, running
+// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set
+func DefaultCreateTaskSet(ctx context.Context, in []*Task, db *gorm.DB, batchSize int) ([]*Task, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultCreateTaskSet", "Task")
+	defer span.End()
+	r0, err := defaultCreateTaskSet(ctx, in, tracing.WithContext(ctx, db), batchSize)
+	return r0, tracing.Error(span, err)
+}
+
+func defaultCreateTaskSet(ctx context.Context, in []*Task, db *gorm.DB, batchSize int) ([]*Task, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObjs := make([]*TaskORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		if err := obj.Validate(); err != nil {
+			return nil, err
+		}
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ormObjs = append(ormObjs, &ormObj)
+	}
+	err := transaction.Run(db, func(tx *gorm.DB) (err error) {
+		if hook, ok := (interface{}(&TaskORM{})).(TaskORMWithBeforeCreateSet); ok {
+			if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {
+				return err
+			}
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(TaskORMWithBeforeCreate_); ok {
+				if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if err = insert.Batch(tx, ormObjs, batchSize); err != nil {
+			return err
+		}
+		for _, ormObj := range ormObjs {
+			if hook, ok := interface{}(ormObj).(TaskORMWithAfterCreate_); ok {
+				if err = hook.AfterCreate_(ctx, tx); err != nil {
+					return err
+				}
+			}
+		}
+		if hook, ok := (interface{}(&TaskORM{})).(TaskORMWithAfterCreateSet); ok {
+			err = hook.AfterCreateSet(ctx, ormObjs, tx)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	pbResponse := make([]*Task, 0, len(ormObjs))
+	for _, ormObj := range ormObjs {
+		pbObj, err := ormObj.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &pbObj)
+	}
+	return pbResponse, nil
+}
+
+type TaskORMWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, []*TaskORM, *gorm.DB) (*gorm.DB, error)
+}
+type TaskORMWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, []*TaskORM, *gorm.DB) error
+}
+
 // DefaultApplyFieldMaskTask patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskTask(ctx context.Context, patchee *Task, patcher *Task, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Task, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultApplyFieldMaskTask", "Task")
+	defer span.End()
+	r0, err := defaultApplyFieldMaskTask(ctx, patchee, patcher, updateMask, prefix, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultApplyFieldMaskTask(ctx context.Context, patchee *Task, patcher *Task, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Task, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -2974,6 +6396,13 @@ func DefaultApplyFieldMaskTask(ctx context.Context, patchee *Task, patcher *Task
 
 // DefaultListTask executes a gorm list call
 func DefaultListTask(ctx context.Context, db *gorm.DB) ([]*Task, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultListTask", "Task")
+	defer span.End()
+	r0, err := defaultListTask(ctx, tracing.WithContext(ctx, db))
+	return r0, tracing.Error(span, err)
+}
+
+func defaultListTask(ctx context.Context, db *gorm.DB) ([]*Task, error) {
 	in := Task{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
@@ -2984,7 +6413,7 @@ func DefaultListTask(ctx context.Context, db *gorm.DB) ([]*Task, error) {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TaskORM{}, &Task{}, nil, nil, nil, nil)
+	db, err = collection.Apply(db, &TaskORM{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3023,3 +6452,218 @@ type TaskORMWithBeforeListFind interface {
 type TaskORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]TaskORM) error
 }
+
+// DefaultCountTask returns the number of rows DefaultListTask pages through
+func DefaultCountTask(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	ctx, span := tracing.StartHandler(ctx, "DefaultCountTask", "Task")
+	defer span.End()
+	r0, err := defaultCountTask(ctx, tracing.WithContext(ctx, db), strategy)
+	return r0, tracing.Error(span, err)
+}
+
+func defaultCountTask(ctx context.Context, db *gorm.DB, strategy paging.CountStrategy) (int64, error) {
+	in := Task{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeCount); ok {
+		if db, err = hook.BeforeCount(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return 0, err
+		}
+	}
+	return paging.Count(db.Where(&ormObj), &TaskORM{}, strategy)
+}
+
+type TaskORMWithBeforeCount interface {
+	BeforeCount(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// TaskRepository runs the Default operations of Task, the arguments of the collection
+// operators and field selection its services don't declare must be nil
+type TaskRepository interface {
+	Create(ctx context.Context, in *Task) (*Task, error)
+	CreateSet(ctx context.Context, in []*Task, batchSize int) ([]*Task, error)
+	List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Task, error)
+	Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error)
+}
+
+// GormTaskRepository is the TaskRepository calling the Default handlers with DB
+type GormTaskRepository struct {
+	DB *gorm.DB
+}
+
+// NewGormTaskRepository returns the TaskRepository running the operations with db
+func NewGormTaskRepository(db *gorm.DB) *GormTaskRepository {
+	return &GormTaskRepository{DB: db}
+}
+
+func (r *GormTaskRepository) Create(ctx context.Context, in *Task) (*Task, error) {
+	return DefaultCreateTask(ctx, in, r.DB)
+}
+
+func (r *GormTaskRepository) CreateSet(ctx context.Context, in []*Task, batchSize int) ([]*Task, error) {
+	return DefaultCreateTaskSet(ctx, in, r.DB, batchSize)
+}
+
+func (r *GormTaskRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Task, error) {
+	if f != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Task doesn't support filtering")
+	}
+	if s != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Task doesn't support sorting")
+	}
+	if p != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Task doesn't support pagination")
+	}
+	if fs != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "List of Task doesn't support field selection")
+	}
+	return DefaultListTask(ctx, r.DB)
+}
+
+func (r *GormTaskRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	if f != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Count of Task doesn't support filtering")
+	}
+	return DefaultCountTask(ctx, r.DB, strategy)
+}
+
+// MemoryTaskRepository is the TaskRepository keeping the objects in memory, for
+// tests. Field selections are ignored and the history isn't kept.
+type MemoryTaskRepository struct {
+	table memory.Table
+}
+
+// NewMemoryTaskRepository returns an empty MemoryTaskRepository
+func NewMemoryTaskRepository() *MemoryTaskRepository {
+	return &MemoryTaskRepository{}
+}
+
+// row returns the row storing in, with a primary key assigned when it is zero. Like in the
+// Default handlers, the output only and immutable fields keep the values of stored, and the
+// output only fields are blank without it.
+func (r *MemoryTaskRepository) row(ctx context.Context, in, stored *Task) (memory.Row, error) {
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	out, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return memory.Row{}, err
+	}
+	return memory.Row{Tenant: memory.Tenant(ormObj.AccountID), Key: nil, Object: &out}, nil
+}
+
+// tenant returns the tenant whose objects the requests of ctx see, nil for all of them
+func (r *MemoryTaskRepository) tenant(ctx context.Context) (interface{}, error) {
+	ormObj, err := (&Task{}).ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return memory.Tenant(ormObj.AccountID), nil
+}
+
+func (r *MemoryTaskRepository) Create(ctx context.Context, in *Task) (*Task, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+	row, err := r.row(ctx, in, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.table.Insert(row); err != nil {
+		return nil, err
+	}
+	return row.Object.(*Task), nil
+}
+
+func (r *MemoryTaskRepository) CreateSet(ctx context.Context, in []*Task, batchSize int) ([]*Task, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	rows := make([]memory.Row, 0, len(in))
+	out := make([]*Task, 0, len(in))
+	for _, object := range in {
+		if err := object.Validate(); err != nil {
+			return nil, err
+		}
+		row, err := r.row(ctx, object, nil)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+		out = append(out, row.Object.(*Task))
+	}
+	if err := r.table.Insert(rows...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *MemoryTaskRepository) List(ctx context.Context, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*Task, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := r.table.List(tenant, f, s, p)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*Task, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, object.(*Task))
+	}
+	return out, nil
+}
+
+func (r *MemoryTaskRepository) Count(ctx context.Context, f *query.Filtering, strategy paging.CountStrategy) (int64, error) {
+	tenant, err := r.tenant(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return r.table.Count(tenant, f)
+}
+
+// TaskFixtureOption customizes the object returned by NewTaskFixture
+type TaskFixtureOption func(*Task)
+
+// NewTaskFixture returns a Task with random valid values in its fields and
+// its required associations, customized by opts
+func NewTaskFixture(opts ...TaskFixtureOption) *Task {
+	m := &Task{}
+	m.Name = fixture.String(0)
+	m.Description = fixture.String(0)
+	m.Priority = fixture.Int(1000000)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// CreateTaskFixture creates the object returned by NewTaskFixture and its
+// associations as one of the Tasks of the User created by CreateUserFixture, which
+// sets its not null foreign key
+func CreateTaskFixture(ctx context.Context, db *gorm.DB, opts ...TaskFixtureOption) (*Task, error) {
+	m := NewTaskFixture(opts...)
+	parent, err := CreateUserFixture(ctx, db, func(parent *User) {
+		parent.Tasks = append(parent.Tasks, m)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parent.Tasks[len(parent.Tasks)-1], nil
+}
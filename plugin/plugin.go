@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	gorm "github.com/acanseco/protoc-gen-gorm/options"
 	jgorm "github.com/jinzhu/gorm"
 	"github.com/jinzhu/inflection"
+	"google.golang.org/genproto/googleapis/api/annotations"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -20,12 +22,15 @@ import (
 
 const (
 	createService    = "Create"
+	createSetService = "CreateSet"
 	readService      = "Read"
 	updateService    = "Update"
 	updateSetService = "UpdateSet"
 	deleteService    = "Delete"
 	deleteSetService = "DeleteSet"
 	listService      = "List"
+	upsertService    = "Upsert"
+	streamService    = "Stream"
 )
 
 var (
@@ -36,18 +41,35 @@ var (
 	gormImport         = "github.com/jinzhu/gorm"
 	tkgormImport       = "github.com/infobloxopen/atlas-app-toolkit/gorm"
 	uuidImport         = "github.com/satori/go.uuid"
-	authImport         = "github.com/infobloxopen/atlas-app-toolkit/auth"
 	gormpqImport       = "github.com/jinzhu/gorm/dialects/postgres"
 	gtypesImport       = "github.com/acanseco/protoc-gen-gorm/types"
 	resourceImport     = "github.com/infobloxopen/atlas-app-toolkit/gorm/resource"
 	queryImport        = "github.com/infobloxopen/atlas-app-toolkit/query"
 	ocTraceImport      = "go.opencensus.io/trace"
+	tracingImport      = "github.com/acanseco/protoc-gen-gorm/runtime/tracing"
+	metricsImport      = "github.com/acanseco/protoc-gen-gorm/runtime/metrics"
 	gatewayImport      = "github.com/infobloxopen/atlas-app-toolkit/gateway"
 	pqImport           = "github.com/lib/pq"
 	gerrorsImport      = "github.com/acanseco/protoc-gen-gorm/errors"
+	insertImport       = "github.com/acanseco/protoc-gen-gorm/runtime/insert"
+	pagingImport       = "github.com/acanseco/protoc-gen-gorm/runtime/paging"
+	preloadImport      = "github.com/acanseco/protoc-gen-gorm/runtime/preload"
+	collectionImport   = "github.com/acanseco/protoc-gen-gorm/runtime/collection"
+	tenantImport       = "github.com/acanseco/protoc-gen-gorm/runtime/tenant"
+	tenantAtlasImport  = "github.com/acanseco/protoc-gen-gorm/runtime/tenant/atlas"
+	dbresolverImport   = "github.com/acanseco/protoc-gen-gorm/runtime/dbresolver"
+	auditImport        = "github.com/acanseco/protoc-gen-gorm/runtime/audit"
+	outboxImport       = "github.com/acanseco/protoc-gen-gorm/runtime/outbox"
+	retryImport        = "github.com/acanseco/protoc-gen-gorm/runtime/retry"
+	cacheImport        = "github.com/acanseco/protoc-gen-gorm/runtime/cache"
+	memoryImport       = "github.com/acanseco/protoc-gen-gorm/runtime/memory"
+	fixtureImport      = "github.com/acanseco/protoc-gen-gorm/runtime/fixture"
+	transactionImport  = "github.com/acanseco/protoc-gen-gorm/runtime/transaction"
 	timestampImport    = "google.golang.org/protobuf/types/known/timestamppb"
 	wktImport          = "google.golang.org/protobuf/types/known/wrapperspb"
 	fmImport           = "google.golang.org/genproto/protobuf/field_mask"
+	grpcStatusImport   = "google.golang.org/grpc/status"
+	grpcCodesImport    = "google.golang.org/grpc/codes"
 	stdFmtImport       = "fmt"
 	stdCtxImport       = "context"
 	stdStringsImport   = "strings"
@@ -96,6 +118,10 @@ const (
 	protoTimeOnly      = "TimeOnly"
 )
 
+// tenantSetting is the postgres setting holding the tenant of the
+// transaction, see runtime/tenant.Setting
+const tenantSetting = "app.account_id"
+
 // DB Engine Enum
 const (
 	ENGINE_UNSET = iota
@@ -113,6 +139,9 @@ type ORMBuilder struct {
 	stringEnums     bool
 	gateway         bool
 	suppressWarn    bool
+	nativeRuntime   bool
+	otelTracing     bool
+	memory          bool
 }
 
 func New(opts protogen.Options, request *pluginpb.CodeGeneratorRequest) (*ORMBuilder, error) {
@@ -147,6 +176,26 @@ func New(opts protogen.Options, request *pluginpb.CodeGeneratorRequest) (*ORMBui
 		builder.suppressWarn = true
 	}
 
+	if _, ok := params["memory"]; ok {
+		builder.memory = true
+	}
+
+	switch strings.ToLower(params["tracing"]) {
+	case "", "opencensus":
+	case "otel":
+		builder.otelTracing = true
+	default:
+		return nil, fmt.Errorf("unknown tracing %q, expected otel or opencensus", params["tracing"])
+	}
+
+	switch strings.ToLower(params["runtime"]) {
+	case "", "atlas":
+	case "native":
+		builder.nativeRuntime = true
+	default:
+		return nil, fmt.Errorf("unknown runtime %q, expected native or atlas", params["runtime"])
+	}
+
 	return builder, nil
 }
 
@@ -173,6 +222,18 @@ type OrmableType struct {
 	Name       string
 	OriginName string
 	Package    string
+	Tenant     *tenantField
+	Audited    bool
+	Outbox     bool
+	Cached     bool
+	// handlers are the names of the Default handlers generated for the type
+	handlers map[string]bool
+}
+
+// recordsChanges reports whether the write handlers of the type record their
+// changes in its history or in the outbox, in their transaction.
+func (o *OrmableType) recordsChanges() bool {
+	return o.Audited || o.Outbox
 }
 
 func NewOrmableType(originalName string, pkg string, file *protogen.File) *OrmableType {
@@ -182,6 +243,7 @@ func NewOrmableType(originalName string, pkg string, file *protogen.File) *Ormab
 		File:       file,
 		Fields:     make(map[string]*Field),
 		Methods:    make(map[string]*autogenMethod),
+		handlers:   make(map[string]bool),
 	}
 }
 
@@ -204,6 +266,11 @@ type autogenMethod struct {
 	followsConvention bool
 }
 
+// readOnly reports whether the method doesn't write to the database.
+func (m autogenMethod) readOnly() bool {
+	return m.verb == readService || m.verb == listService || m.verb == streamService
+}
+
 type fileImports struct {
 	wktPkgName      string
 	packages        map[string]*pkgImport
@@ -217,6 +284,7 @@ type autogenService struct {
 	ccName            string
 	methods           []autogenMethod
 	usesTxnMiddleware bool
+	transaction       bool
 	autogen           bool
 }
 
@@ -320,7 +388,11 @@ func (b *ORMBuilder) Generate() (*pluginpb.CodeGeneratorResponse, error) {
 			if isOrmable(message) {
 				b.generateOrmable(g, message)
 				b.generateTableNameFunctions(g, message)
+				b.generateHistory(g, message)
+				b.generateOutbox(g, message)
+				b.generateCacheKey(g, message)
 				b.generateConvertFunctions(g, message)
+				b.generateValidate(g, message)
 				b.generateHookInterfaces(g, message)
 			}
 		}
@@ -358,12 +430,22 @@ func (b *ORMBuilder) generateConvertFunctions(g *protogen.GeneratedFile, message
 		ofield := ormable.Fields[camelCase(field.GoName)]
 		b.generateFieldConversion(message, field, true, ofield, g)
 	}
-	if getMessageOptions(message).GetMultiAccount() {
-		g.P("accountID, err := ", generateImport("GetAccountID", authImport, g), "(ctx, nil)")
-		g.P("if err != nil {")
-		g.P("return to, err")
-		g.P("}")
-		g.P("to.AccountID = accountID")
+	if tenant := getTenant(message); tenant != nil {
+		b.generateTenantID(message, "to, ", g)
+		g.P(`if isolated {`)
+		switch tenant.Type {
+		case "uuid":
+			g.P(`if to.`, tenant.Name, `, err = `, generateImport("FromString", uuidImport, g), `(tenantID); err != nil {`)
+			g.P(`return to, err`)
+			g.P(`}`)
+		case "int64":
+			g.P(`if to.`, tenant.Name, `, err = `, generateImport("ParseInt", "strconv", g), `(tenantID, 10, 64); err != nil {`)
+			g.P(`return to, err`)
+			g.P(`}`)
+		default:
+			g.P(`to.`, tenant.Name, ` = tenantID`)
+		}
+		g.P(`}`)
 	}
 	b.setupOrderedHasMany(message, g)
 	g.P(`if posthook, ok := interface{}(m).(`, typeName, `WithAfterToORM); ok {`)
@@ -402,6 +484,179 @@ func (b *ORMBuilder) generateConvertFunctions(g *protogen.GeneratedFile, message
 	g.P(`}`)
 }
 
+// generateValidate generates the Validate method of a message, which checks
+// its fields against the size, not_null and precision of their gorm tags and
+// its enums against their declared values, before they reach the database.
+// Output only fields aren't required, the server sets them.
+func (b *ORMBuilder) generateValidate(g *protogen.GeneratedFile, message *protogen.Message) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	outputOnly, _ := b.getProtectedFields(message)
+	isOutputOnly := make(map[string]bool)
+	for _, fieldName := range outputOnly {
+		isOutputOnly[fieldName] = true
+	}
+
+	g.P(`// Validate checks the fields of the object against the constraints of their gorm tags and`)
+	g.P(`// returns an *errors.ValidationError listing every violation`)
+	g.P(`func (m *`, typeName, `) Validate() error {`)
+	g.P(`if m == nil {`)
+	g.P(`return nil`)
+	g.P(`}`)
+	g.P(`v := &`, generateImport("ValidationError", gerrorsImport, g), `{}`)
+	for _, field := range message.Fields {
+		fieldName := camelCase(string(field.Desc.Name()))
+		ofield, ok := ormable.Fields[fieldName]
+		if !ok {
+			continue
+		}
+		path := string(field.Desc.Name())
+		value := `m.` + field.GoName
+		tag := ofield.GetTag()
+
+		if field.Message != nil && b.isOrmable(getFieldType(field)) {
+			if field.Desc.IsList() {
+				g.P(`for i, e := range `, value, ` {`)
+				g.P(`v.Nest(`, generateImport("Sprintf", "fmt", g), `("`, path, `[%d]", i), e.Validate())`)
+				g.P(`}`)
+			} else {
+				if tag.GetNotNull() && !isOutputOnly[fieldName] {
+					g.P(`if `, value, ` == nil {`)
+					g.P(`v.Add("`, path, `", "is required")`)
+					g.P(`}`)
+				}
+				g.P(`v.Nest("`, path, `", `, value, `.Validate())`)
+			}
+			continue
+		}
+		if field.Desc.IsList() {
+			continue
+		}
+
+		kind := field.Desc.Kind()
+		if field.Enum != nil {
+			g.P(`if `, value, `.Descriptor().Values().ByNumber(`, value, `.Number()) == nil {`)
+			g.P(`v.Add("`, path, `", "is not a valid `, string(field.Enum.Desc.Name()), `")`)
+			g.P(`}`)
+			continue
+		}
+		if field.Message != nil {
+			if tag.GetNotNull() && !isOutputOnly[fieldName] {
+				g.P(`if `, value, ` == nil {`)
+				g.P(`v.Add("`, path, `", "is required")`)
+				g.P(`}`)
+			}
+			if _, ok := wellKnownTypes[getFieldType(field)]; !ok {
+				continue
+			}
+			// the value of a wrapper is checked like a scalar, GetValue
+			// returns the zero value of a nil wrapper
+			kind = field.Message.Fields[0].Desc.Kind()
+			value += `.GetValue()`
+		}
+		cond := b.validationCondition(kind, value, tag, g)
+		if cond == "" {
+			continue
+		}
+		g.P(`if `, cond, ` {`)
+		g.P(`v.Add("`, path, `", "`, validationDescription(kind, tag), `")`)
+		g.P(`}`)
+	}
+	g.P(`return v.Err()`)
+	g.P(`}`)
+	g.P()
+}
+
+// validationCondition returns the condition of a value of kind violating
+// the size or precision of tag, or an empty string when it can't be violated.
+func (b *ORMBuilder) validationCondition(kind protoreflect.Kind, value string, tag *gorm.GormTag, g *protogen.GeneratedFile) string {
+	switch kind {
+	case protoreflect.StringKind:
+		if tag.GetSize() > 0 {
+			return fmt.Sprint(generateImport("RuneCountInString", "unicode/utf8", g), `(`, value, `) > `, tag.GetSize())
+		}
+		return ""
+	case protoreflect.BytesKind:
+		if tag.GetSize() > 0 {
+			return fmt.Sprint(`len(`, value, `) > `, tag.GetSize())
+		}
+		return ""
+	}
+
+	digits, _, ok := numericDigits(tag)
+	if !ok {
+		return ""
+	}
+	switch kind {
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return fmt.Sprint(generateImport("Abs", "math", g), `(float64(`, value, `)) >= 1e`, digits)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if digits >= 18 {
+			return ""
+		}
+		max := strconv.FormatInt(pow10(digits), 10)
+		return fmt.Sprint(value, ` >= `, max, ` || `, value, ` <= -`, max)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if digits >= 18 {
+			return ""
+		}
+		return fmt.Sprint(value, ` >= `, pow10(digits))
+	}
+	return ""
+}
+
+// validationDescription describes the constraint of tag checked by the
+// condition of validationCondition for kind.
+func validationDescription(kind protoreflect.Kind, tag *gorm.GormTag) string {
+	switch kind {
+	case protoreflect.StringKind:
+		return fmt.Sprint("must be at most ", tag.GetSize(), " characters")
+	case protoreflect.BytesKind:
+		return fmt.Sprint("must be at most ", tag.GetSize(), " bytes")
+	}
+	digits, scale, _ := numericDigits(tag)
+	if scale > 0 {
+		return fmt.Sprint("must have at most ", digits, " digits before the decimal point")
+	}
+	return fmt.Sprint("must have at most ", digits, " digits")
+}
+
+var numericTypePattern = regexp.MustCompile(`^(?:numeric|decimal)\s*(?:\(\s*(\d+)\s*(?:,\s*(\d+)\s*)?\))?$`)
+
+// numericDigits returns the number of digits of the integer part of the
+// values of a numeric column, from the precision of tag or the precision and
+// scale of its numeric(p,s) or decimal(p,s) type. ok is false when the
+// precision isn't set or the column isn't numeric.
+func numericDigits(tag *gorm.GormTag) (digits int, scale int, ok bool) {
+	precision := int(tag.GetPrecision())
+	if typ := strings.ToLower(strings.TrimSpace(tag.GetType())); typ != "" {
+		m := numericTypePattern.FindStringSubmatch(typ)
+		if m == nil {
+			return 0, 0, false
+		}
+		if p, err := strconv.Atoi(m[1]); err == nil && precision == 0 {
+			precision = p
+		}
+		scale, _ = strconv.Atoi(m[2])
+	}
+	if precision == 0 {
+		return 0, 0, false
+	}
+	if digits = precision - scale; digits < 0 {
+		digits = 0
+	}
+	return digits, scale, true
+}
+
+func pow10(n int) int64 {
+	p := int64(1)
+	for i := 0; i < n; i++ {
+		p *= 10
+	}
+	return p
+}
+
 func (b *ORMBuilder) generateTableNameFunctions(g *protogen.GeneratedFile, message *protogen.Message) {
 	typeName := string(message.Desc.Name())
 	msgName := string(message.Desc.Name())
@@ -415,6 +670,191 @@ func (b *ORMBuilder) generateTableNameFunctions(g *protogen.GeneratedFile, messa
 	}
 	g.P(`return "`, tableName, `"`)
 	g.P(`}`)
+
+	if tenant := getTenant(message); tenant != nil && b.dbEngine == ENGINE_POSTGRES {
+		// the setting reverts to '' rather than NULL after the transaction of
+		// SET LOCAL, an empty setting must match no row and not fail the cast
+		setting := fmt.Sprintf("NULLIF(current_setting('%s', true), '')", tenantSetting)
+		switch tenant.Type {
+		case "uuid":
+			setting += "::uuid"
+		case "int64":
+			setting += "::bigint"
+		}
+		table := strconv.Quote(tableName)
+		policy := strconv.Quote(tableName + "_tenant_isolation")
+		condition := fmt.Sprintf("%s = %s", strconv.Quote(tenant.Column), setting)
+		g.P()
+		g.P(`// RowLevelSecurity returns the statements enabling row level security on the table, its rows`)
+		g.P(`// are restricted to the tenant set by tenant.SetLocal in the transaction, even for the table owner`)
+		g.P(`func (`, typeName, `ORM) RowLevelSecurity() []string {`)
+		g.P(`return []string{`)
+		for _, stmt := range []string{
+			fmt.Sprintf("ALTER TABLE %s ENABLE ROW LEVEL SECURITY", table),
+			fmt.Sprintf("ALTER TABLE %s FORCE ROW LEVEL SECURITY", table),
+			fmt.Sprintf("DROP POLICY IF EXISTS %s ON %s", policy, table),
+			fmt.Sprintf("CREATE POLICY %s ON %s USING (%s) WITH CHECK (%s)", policy, table, condition, condition),
+		} {
+			g.P("`", stmt, "`,")
+		}
+		g.P(`}`)
+		g.P(`}`)
+	}
+}
+
+// generateHistory generates the ORM type of the history table of an audited
+// message and the function recording its changes, called by the write
+// handlers in their transaction. Associations are left out of the
+// snapshots, they have their own history when they are audited.
+func (b *ORMBuilder) generateHistory(g *protogen.GeneratedFile, message *protogen.Message) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	if !ormable.Audited {
+		return
+	}
+	if !b.hasPrimaryKey(ormable) {
+		panic(fmt.Sprintf("cannot audit %s: it has no primary key", typeName))
+	}
+	pkName, pk := b.findPrimaryKey(ormable)
+	tableName := inflection.Plural(jgorm.ToDBName(typeName))
+	if opts := getMessageOptions(message); len(opts.GetTable()) > 0 {
+		tableName = opts.GetTable()
+	}
+	historyName := typeName + `HistoryORM`
+
+	g.P(`// `, historyName, ` is a row of the history of `, typeName, `, written by its write handlers`)
+	g.P(`type `, historyName, ` struct {`)
+	g.P("Id uint64 `gorm:\"primary_key\"`")
+	if pkType := pk.GetTag().GetType(); pkType != "" {
+		g.P(`ObjectId `, pk.Type, " `gorm:\"type:", pkType, ";index\"`")
+	} else {
+		g.P(`ObjectId `, pk.Type, " `gorm:\"index\"`")
+	}
+	if ormable.Tenant != nil {
+		tenantField := ormable.Fields[ormable.Tenant.Name]
+		g.P(ormable.Tenant.Name, ` `, tenantField.Type, b.renderGormTag(tenantField))
+	}
+	g.P(generateImport("Record", auditImport, g))
+	g.P(`}`)
+	g.P()
+	g.P(`// TableName overrides the default tablename generated by GORM`)
+	g.P(`func (`, historyName, `) TableName() string {`)
+	g.P(`return "`, tableName, `_history"`)
+	g.P(`}`)
+	g.P()
+
+	g.P(`// record`, typeName, `History writes the history row of the operation changing before into after, either may be nil`)
+	g.P(`func record`, typeName, `History(ctx `, generateImport("Context", "context", g), `, db *`, generateImport("DB", gormImport, g),
+		`, operation string, before, after *`, ormable.Name, `) error {`)
+	g.P(`row := &`, historyName, `{}`)
+	g.P(`var snapshots [2]`, generateImport("Message", "google.golang.org/protobuf/proto", g))
+	g.P(`for i, ormObj := range []*`, ormable.Name, `{before, after} {`)
+	g.P(`if ormObj == nil {`)
+	g.P(`continue`)
+	g.P(`}`)
+	g.P(`pbObj, err := ormObj.ToPB(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	b.clearAssociations(message, "pbObj", g)
+	g.P(`snapshots[i] = &pbObj`)
+	g.P(`row.ObjectId = ormObj.`, pkName)
+	if ormable.Tenant != nil {
+		g.P(`row.`, ormable.Tenant.Name, ` = ormObj.`, ormable.Tenant.Name)
+	}
+	g.P(`}`)
+	g.P(`var err error`)
+	g.P(`if row.Record, err = `, generateImport("NewRecord", auditImport, g), `(ctx, operation, snapshots[0], snapshots[1]); err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`return db.Create(row).Error`)
+	g.P(`}`)
+	g.P()
+}
+
+// generateOutbox generates the function inserting the change events of a
+// message with the outbox option in the outbox table, called by the write
+// handlers in their transaction. Associations are left out of the states.
+func (b *ORMBuilder) generateOutbox(g *protogen.GeneratedFile, message *protogen.Message) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	if !ormable.Outbox {
+		return
+	}
+	if !b.hasPrimaryKey(ormable) {
+		panic(fmt.Sprintf("cannot generate the outbox events of %s: it has no primary key", typeName))
+	}
+	pkName, _ := b.findPrimaryKey(ormable)
+
+	g.P(`// record`, typeName, `Event inserts the event of the change of before into after in the outbox, either may be nil`)
+	g.P(`func record`, typeName, `Event(ctx `, generateImport("Context", "context", g), `, db *`, generateImport("DB", gormImport, g),
+		`, eventType string, before, after *`, ormable.Name, `) error {`)
+	g.P(`var states [2]`, generateImport("Message", "google.golang.org/protobuf/proto", g))
+	g.P(`var id interface{}`)
+	g.P(`for i, ormObj := range []*`, ormable.Name, `{before, after} {`)
+	g.P(`if ormObj == nil {`)
+	g.P(`continue`)
+	g.P(`}`)
+	g.P(`pbObj, err := ormObj.ToPB(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	b.clearAssociations(message, "pbObj", g)
+	g.P(`states[i] = &pbObj`)
+	g.P(`id = ormObj.`, pkName)
+	g.P(`}`)
+	g.P(`event, err := `, generateImport("NewEvent", outboxImport, g), `(eventType, "`, typeName, `", id, states[0], states[1])`)
+	g.P(`if err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`return db.Create(event).Error`)
+	g.P(`}`)
+	g.P()
+}
+
+// generateCacheKey generates the method returning the key of the objects
+// of a message with the cached option in the cache of runtime/cache.
+func (b *ORMBuilder) generateCacheKey(g *protogen.GeneratedFile, message *protogen.Message) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	if !ormable.Cached {
+		return
+	}
+	if !b.hasPrimaryKey(ormable) {
+		panic(fmt.Sprintf("cannot cache %s: it has no primary key", typeName))
+	}
+	pkName, _ := b.findPrimaryKey(ormable)
+	tenant := "nil"
+	if ormable.Tenant != nil {
+		tenant = "m." + ormable.Tenant.Name
+	}
+
+	g.P(`// cacheKey returns the key of the object in the cache, empty when it isn't cached`)
+	g.P(`func (m *`, ormable.Name, `) cacheKey() string {`)
+	g.P(`return `, generateImport("Key", cacheImport, g), `("`, typeName, `", `, tenant, `, m.`, pkName, `)`)
+	g.P(`}`)
+	g.P()
+}
+
+// generateCacheInvalidation invalidates the cached objects of keys after a
+// write of a message with the cached option.
+func (b *ORMBuilder) generateCacheInvalidation(ormable *OrmableType, keys, ret string, g *protogen.GeneratedFile) {
+	if !ormable.Cached {
+		return
+	}
+	g.P(`if err = `, generateImport("Invalidate", cacheImport, g), `(ctx, `, keys, `); err != nil {`)
+	g.P(`return `, ret, `err`)
+	g.P(`}`)
+}
+
+// clearAssociations clears the association fields of the message pbObj.
+func (b *ORMBuilder) clearAssociations(message *protogen.Message, pbObj string, g *protogen.GeneratedFile) {
+	ormable := b.getOrmable(string(message.Desc.Name()))
+	for _, field := range message.Fields {
+		if _, ok := ormable.Fields[camelCase(string(field.Desc.Name()))]; ok && field.Message != nil && b.isOrmable(getFieldType(field)) {
+			g.P(pbObj, `.`, field.GoName, ` = nil`)
+		}
+	}
 }
 
 func (b *ORMBuilder) generateOrmable(g *protogen.GeneratedFile, message *protogen.Message) {
@@ -930,11 +1370,29 @@ func (b *ORMBuilder) parseBasicFields(msg *protogen.Message, g *protogen.Generat
 	}
 
 	gormMsgOptions := getMessageOptions(msg)
-	if gormMsgOptions.GetMultiAccount() {
-		if accID, ok := ormable.Fields["AccountID"]; !ok {
-			ormable.Fields["AccountID"] = &Field{Type: "string"}
-		} else if accID.Type != "string" {
-			panic("cannot include AccountID field")
+	ormable.Audited = gormMsgOptions.GetAudited()
+	ormable.Outbox = gormMsgOptions.GetOutbox()
+	ormable.Cached = gormMsgOptions.GetCached()
+	if tenant := getTenant(msg); tenant != nil {
+		ormable.Tenant = tenant
+		f := &Field{Type: tenant.Type, GormFieldOptions: &gorm.GormFieldOptions{}}
+		if tenant.Type == "uuid" {
+			f.Package = uuidImport
+			f.Type = generateImport("UUID", uuidImport, g)
+			if b.dbEngine == ENGINE_POSTGRES {
+				f.Tag = tagWithType(f.Tag, "uuid")
+			}
+		}
+		if tenant.Column != jgorm.ToDBName(tenant.Name) {
+			if f.Tag == nil {
+				f.Tag = &gorm.GormTag{}
+			}
+			f.Tag.Column = tenant.Column
+		}
+		if tenantField, ok := ormable.Fields[tenant.Name]; !ok {
+			ormable.Fields[tenant.Name] = f
+		} else if tenantField.Type != f.Type {
+			panic(fmt.Sprintf("cannot include %s field", tenant.Name))
 		}
 	}
 
@@ -1002,6 +1460,63 @@ func getFieldOptions(options *descriptorpb.FieldOptions) *gorm.GormFieldOptions
 	return opts
 }
 
+// getFieldBehavior reports whether a field is output only and/or immutable, either
+// from the gorm field options or from the google.api.field_behavior annotation
+func getFieldBehavior(field *protogen.Field) (outputOnly bool, immutable bool) {
+	options := field.Desc.Options().(*descriptorpb.FieldOptions)
+	fieldOpts := getFieldOptions(options)
+	outputOnly, immutable = fieldOpts.GetOutputOnly(), fieldOpts.GetImmutable()
+	if options == nil {
+		return outputOnly, immutable
+	}
+
+	behaviors, _ := proto.GetExtension(options, annotations.E_FieldBehavior).([]annotations.FieldBehavior)
+	for _, behavior := range behaviors {
+		switch behavior {
+		case annotations.FieldBehavior_OUTPUT_ONLY:
+			outputOnly = true
+		case annotations.FieldBehavior_IMMUTABLE:
+			immutable = true
+		}
+	}
+
+	return outputOnly, immutable
+}
+
+// tenantField is the field the rows of multi tenant messages are isolated by
+type tenantField struct {
+	Name   string
+	Type   string
+	Column string
+}
+
+// getTenant returns the tenant field of the message, nil when it isn't multi
+// tenant. multi_account messages are isolated by an AccountID string field.
+func getTenant(message *protogen.Message) *tenantField {
+	opts := getMessageOptions(message)
+	if !opts.GetMultiAccount() && opts.GetTenant() == nil {
+		return nil
+	}
+
+	tenant := &tenantField{Name: "AccountID", Type: "string"}
+	if name := opts.GetTenant().GetField(); name != "" {
+		tenant.Name = camelCase(name)
+	}
+	if typ := opts.GetTenant().GetType(); typ != "" {
+		tenant.Type = typ
+	}
+	switch tenant.Type {
+	case "string", "uuid", "int64":
+	default:
+		panic(fmt.Sprintf("unsupported tenant type %q in %s", tenant.Type, message.Desc.Name()))
+	}
+	if tenant.Column = opts.GetTenant().GetColumn(); tenant.Column == "" {
+		tenant.Column = jgorm.ToDBName(tenant.Name)
+	}
+
+	return tenant
+}
+
 // retrieves the GormMessageOptions from a message
 func getMessageOptions(message *protogen.Message) *gorm.GormMessageOptions {
 	options := message.Desc.Options()
@@ -1548,6 +2063,7 @@ func (b *ORMBuilder) generateDefaultHandlers(file *protogen.File, g *protogen.Ge
 	for _, message := range file.Messages {
 		if isOrmable(message) {
 			b.generateCreateHandler(message, g)
+			b.generateCreateSetHandler(message, g)
 			typeName := string(message.Desc.Name())
 			ormable := b.getOrmable(typeName)
 
@@ -1558,65 +2074,1042 @@ func (b *ORMBuilder) generateDefaultHandlers(file *protogen.File, g *protogen.Ge
 				b.generateStrictUpdateHandler(message, g)
 				b.generatePatchHandler(message, g)
 				b.generatePatchSetHandler(message, g)
+				b.generateListHistoryHandler(message, g)
 			}
 
 			b.generateApplyFieldMask(message, g)
 			b.generateListHandler(message, g)
+			b.generateCountHandler(message, g)
+			b.generateStreamHandler(message, g)
+			b.generateUpsertHandler(message, g)
+			b.generateRepository(message, g)
+			if b.memory {
+				b.generateMemoryRepository(message, g)
+			}
+			b.generateFixture(message, g)
 		}
 
 	}
 }
 
-func (b *ORMBuilder) generateCreateHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+// generateRepository prints the Repository interface of the Default handlers
+// of the message, with signatures that don't depend on the collection
+// operators of its services, and its implementation calling the handlers.
+func (b *ORMBuilder) generateRepository(message *protogen.Message, g *protogen.GeneratedFile) {
 	typeName := string(message.Desc.Name())
-	orm := b.getOrmable(typeName)
-	g.P(`// DefaultCreate`, typeName, ` executes a basic gorm create call`)
-	g.P(`func DefaultCreate`, typeName, `(ctx context.Context, in *`,
-		typeName, `, db *`, generateImport("DB", gormImport, g), `) (*`, typeName, `, error) {`)
-	g.P(`if in == nil {`)
-	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
-	g.P(`}`)
-	g.P(`ormObj, err := in.ToORM(ctx)`)
-	g.P(`if err != nil {`)
-	g.P(`return nil, err`)
-	g.P(`}`)
-	create := "Create_"
-	b.generateBeforeHookCall(orm, create, g)
-	g.P(`if err = db.Create(&ormObj).Error; err != nil {`)
-	g.P(`return nil, err`)
-	g.P(`}`)
-	b.generateAfterHookCall(orm, create, g)
-	g.P(`pbResponse, err := ormObj.ToPB(ctx)`)
-	g.P(`return &pbResponse, err`)
-	g.P(`}`)
-	b.generateBeforeHookDef(orm, create, g)
-	b.generateAfterHookDef(orm, create, g)
+	ormable := b.getOrmable(typeName)
+	has := func(handler string) bool {
+		return ormable.handlers[`Default`+handler]
+	}
+	gormDB := generateImport("DB", gormImport, g)
+	fieldMask := generateImport("FieldMask", fmImport, g)
+	filtering := generateImport("Filtering", queryImport, g)
+	sorting := generateImport("Sorting", queryImport, g)
+	pagination := generateImport("Pagination", queryImport, g)
+	fieldSelection := generateImport("FieldSelection", queryImport, g)
+
+	type operation struct {
+		name, params, results, body string
+	}
+	var operations []operation
+	add := func(name, params, results, body string) {
+		operations = append(operations, operation{name, params, results, body})
+	}
+	// unsupported returns the check that the argument arg, which the
+	// handler doesn't take, is nil
+	unsupported := func(arg, what, name, ret string) string {
+		return fmt.Sprint(`if `, arg, ` != nil {
+return `, ret, generateImport("Errorf", grpcStatusImport, g), `(`, generateImport("InvalidArgument", grpcCodesImport, g),
+			`, "`, name, ` of `, typeName, ` doesn't support `, what, `")
+}
+`)
+	}
+
+	add(`Create`, fmt.Sprint(`ctx context.Context, in *`, typeName), fmt.Sprint(`(*`, typeName, `, error)`),
+		fmt.Sprint(`return DefaultCreate`, typeName, `(ctx, in, r.DB)`))
+	add(`CreateSet`, fmt.Sprint(`ctx context.Context, in []*`, typeName, `, batchSize int`), fmt.Sprint(`([]*`, typeName, `, error)`),
+		fmt.Sprint(`return DefaultCreate`, typeName, `Set(ctx, in, r.DB, batchSize)`))
+	if has(`Read` + typeName) {
+		body, args := ``, ``
+		if b.readHasFieldSelection(ormable) {
+			args = `, fs`
+		} else {
+			body = unsupported(`fs`, `field selection`, `Read`, `nil, `)
+		}
+		add(`Read`, fmt.Sprint(`ctx context.Context, in *`, typeName, `, fs *`, fieldSelection), fmt.Sprint(`(*`, typeName, `, error)`),
+			fmt.Sprint(body, `return DefaultRead`, typeName, `(ctx, in, r.DB`, args, `)`))
+	}
+	if has(`StrictUpdate` + typeName) {
+		add(`StrictUpdate`, fmt.Sprint(`ctx context.Context, in *`, typeName), fmt.Sprint(`(*`, typeName, `, error)`),
+			fmt.Sprint(`return DefaultStrictUpdate`, typeName, `(ctx, in, r.DB)`))
+	}
+	if has(`Patch` + typeName) {
+		add(`Patch`, fmt.Sprint(`ctx context.Context, in *`, typeName, `, updateMask *`, fieldMask), fmt.Sprint(`(*`, typeName, `, error)`),
+			fmt.Sprint(`return DefaultPatch`, typeName, `(ctx, in, updateMask, r.DB)`))
+	}
+	if has(`PatchSet` + typeName) {
+		add(`PatchSet`, fmt.Sprint(`ctx context.Context, objects []*`, typeName, `, updateMasks []*`, fieldMask), fmt.Sprint(`([]*`, typeName, `, error)`),
+			fmt.Sprint(`return DefaultPatchSet`, typeName, `(ctx, objects, updateMasks, r.DB)`))
+	}
+	if has(`Delete` + typeName) {
+		add(`Delete`, fmt.Sprint(`ctx context.Context, in *`, typeName), `error`,
+			fmt.Sprint(`return DefaultDelete`, typeName, `(ctx, in, r.DB)`))
+	}
+	if has(`Delete` + typeName + `Set`) {
+		add(`DeleteSet`, fmt.Sprint(`ctx context.Context, in []*`, typeName), `error`,
+			fmt.Sprint(`return DefaultDelete`, typeName, `Set(ctx, in, r.DB)`))
+	}
+	if has(`List` + typeName + `History`) {
+		add(`ListHistory`, fmt.Sprint(`ctx context.Context, in *`, typeName), fmt.Sprint(`([]*`, typeName, `HistoryORM, error)`),
+			fmt.Sprint(`return DefaultList`, typeName, `History(ctx, in, r.DB)`))
+	}
+	var body, args string
+	for _, arg := range []struct {
+		name, what string
+		ok         bool
+	}{
+		{`f`, `filtering`, b.listHasFiltering(ormable)},
+		{`s`, `sorting`, b.listHasSorting(ormable)},
+		{`p`, `pagination`, b.listHasPagination(ormable)},
+		{`fs`, `field selection`, b.listHasFieldSelection(ormable)},
+	} {
+		if arg.ok {
+			args += `, ` + arg.name
+		} else {
+			body += unsupported(arg.name, arg.what, `List`, `nil, `)
+		}
+	}
+	add(`List`, fmt.Sprint(`ctx context.Context, f *`, filtering, `, s *`, sorting, `, p *`, pagination, `, fs *`, fieldSelection),
+		fmt.Sprint(`([]*`, typeName, `, error)`), fmt.Sprint(body, `return DefaultList`, typeName, `(ctx, r.DB`, args, `)`))
+	if b.hasPrimaryKey(ormable) {
+		add(`PageToken`, fmt.Sprint(`ctx context.Context, last *`, typeName, `, s *`, sorting, `, f *`, filtering), `(string, error)`,
+			fmt.Sprint(`ormObj, err := last.ToORM(ctx)
+if err != nil {
+return "", err
 }
-
-func (b *ORMBuilder) generateBeforeHookCall(orm *OrmableType, method string, g *protogen.GeneratedFile) {
-	g.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithBefore`, method, `); ok {`)
-	g.P(`if db, err = hook.Before`, method, `(ctx, db); err != nil {`)
-	g.P(`return nil, err`)
-	g.P(`}`)
-	g.P(`}`)
+keyset, err := `, generateImport("NewKeyset", pagingImport, g), `(r.DB, &`, ormable.Name, `{}, s, f)
+if err != nil {
+return "", err
 }
+return keyset.Token(&ormObj)`))
+	}
+	body, args = ``, ``
+	if b.listHasFiltering(ormable) {
+		args = `, f`
+	} else {
+		body = unsupported(`f`, `filtering`, `Count`, `0, `)
+	}
+	add(`Count`, fmt.Sprint(`ctx context.Context, f *`, filtering, `, strategy `, generateImport("CountStrategy", pagingImport, g)), `(int64, error)`,
+		fmt.Sprint(body, `return DefaultCount`, typeName, `(ctx, r.DB`, args, `, strategy)`))
+	if has(`Stream` + typeName) {
+		stream := ormable.Methods[streamService]
+		body, args = ``, ``
+		for _, arg := range []struct {
+			name, what, field string
+		}{
+			{`f`, `filtering`, b.getFiltering(stream.inType)},
+			{`s`, `sorting`, b.getSorting(stream.inType)},
+			{`p`, `pagination`, b.getPagination(stream.inType)},
+			{`fs`, `field selection`, b.getFieldSelection(stream.inType)},
+		} {
+			if arg.field != "" {
+				args += `, ` + arg.name
+			} else {
+				body += unsupported(arg.name, arg.what, `Stream`, ``)
+			}
+		}
+		add(`Stream`, fmt.Sprint(`ctx context.Context, f *`, filtering, `, s *`, sorting, `, p *`, pagination, `, fs *`, fieldSelection, `, send func(*`, typeName, `) error`), `error`,
+			fmt.Sprint(body, `return DefaultStream`, typeName, `(ctx, r.DB`, args, `, send)`))
+	}
+	if has(`Upsert` + typeName) {
+		add(`Upsert`, fmt.Sprint(`ctx context.Context, in *`, typeName, `, target string, updateMask *`, fieldMask), fmt.Sprint(`(*`, typeName, `, error)`),
+			fmt.Sprint(`return DefaultUpsert`, typeName, `(ctx, in, target, updateMask, r.DB)`))
+	}
 
-func (b *ORMBuilder) generateAfterHookCall(orm *OrmableType, method string, g *protogen.GeneratedFile) {
-	g.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithAfter`, method, `); ok {`)
-	g.P(`if err = hook.After`, method, `(ctx, db); err != nil {`)
-	g.P(`return nil, err`)
+	g.P(`// `, typeName, `Repository runs the Default operations of `, typeName, `, the arguments of the collection`)
+	g.P(`// operators and field selection its services don't declare must be nil`)
+	g.P(`type `, typeName, `Repository interface {`)
+	for _, op := range operations {
+		g.P(op.name, `(`, op.params, `) `, op.results)
+	}
+	g.P(`}`)
+	g.P()
+	g.P(`// Gorm`, typeName, `Repository is the `, typeName, `Repository calling the Default handlers with DB`)
+	g.P(`type Gorm`, typeName, `Repository struct {`)
+	g.P(`DB *`, gormDB)
 	g.P(`}`)
+	g.P()
+	g.P(`// NewGorm`, typeName, `Repository returns the `, typeName, `Repository running the operations with db`)
+	g.P(`func NewGorm`, typeName, `Repository(db *`, gormDB, `) *Gorm`, typeName, `Repository {`)
+	g.P(`return &Gorm`, typeName, `Repository{DB: db}`)
 	g.P(`}`)
+	for _, op := range operations {
+		g.P()
+		g.P(`func (r *Gorm`, typeName, `Repository) `, op.name, `(`, op.params, `) `, op.results, ` {`)
+		g.P(op.body)
+		g.P(`}`)
+	}
 }
 
-func (b *ORMBuilder) generateHookInterfaces(g *protogen.GeneratedFile, message *protogen.Message) {
+// generateMemoryRepository prints the in-memory implementation of the
+// Repository of the message, generated with the memory parameter.
+func (b *ORMBuilder) generateMemoryRepository(message *protogen.Message, g *protogen.GeneratedFile) {
 	typeName := string(message.Desc.Name())
-	g.P(`// The following are interfaces you can implement for special behavior during ORM/PB conversions`)
-	g.P(`// of type `, typeName, ` the arg will be the target, the caller the one being converted from`)
-	g.P()
-	for _, desc := range [][]string{
-		{"BeforeToORM", typeName + "ORM", " called before default ToORM code"},
-		{"AfterToORM", typeName + "ORM", " called after default ToORM code"},
+	ormable := b.getOrmable(typeName)
+	has := func(handler string) bool {
+		return ormable.handlers[`Default`+handler]
+	}
+	repository := `Memory` + typeName + `Repository`
+	table := generateImport("Table", memoryImport, g)
+	row := generateImport("Row", memoryImport, g)
+	nilArgument := generateImport("NilArgumentError", gerrorsImport, g)
+	tenant := "nil"
+	if ormable.Tenant != nil {
+		tenant = fmt.Sprint(generateImport("Tenant", memoryImport, g), `(ormObj.`, ormable.Tenant.Name, `)`)
+	}
+	pkName, key := "", "nil"
+	var pk *Field
+	if b.hasPrimaryKey(ormable) {
+		pkName, pk = b.findPrimaryKey(ormable)
+		key = "ormObj." + pkName
+	}
+	// emptyID prints the check that the primary key of ormObj is set
+	emptyID := func(ret string) {
+		if strings.Contains(pk.Type, "*") {
+			g.P(`if ormObj.`, pkName, ` == nil || *ormObj.`, pkName, ` == `, b.guessZeroValue(pk.Type, g), ` {`)
+		} else {
+			g.P(`if ormObj.`, pkName, ` == `, b.guessZeroValue(pk.Type, g), ` {`)
+		}
+		g.P(`return `, ret, generateImport("EmptyIdError", gerrorsImport, g))
+		g.P(`}`)
+	}
+	toORM := func(in, ret string) {
+		g.P(`ormObj, err := `, in, `.ToORM(ctx)`)
+		g.P(`if err != nil {`)
+		g.P(`return `, ret, `err`)
+		g.P(`}`)
+	}
+	results := func(objects string) {
+		g.P(`out := make([]*`, typeName, `, 0, len(`, objects, `))`)
+		g.P(`for _, object := range `, objects, ` {`)
+		g.P(`out = append(out, object.(*`, typeName, `))`)
+		g.P(`}`)
+	}
+	method := func(name, params, results string) {
+		g.P()
+		g.P(`func (r *`, repository, `) `, name, `(`, params, `) `, results, ` {`)
+	}
+	collection := fmt.Sprint(`f *`, generateImport("Filtering", queryImport, g), `, s *`, generateImport("Sorting", queryImport, g),
+		`, p *`, generateImport("Pagination", queryImport, g))
+
+	g.P(`// `, repository, ` is the `, typeName, `Repository keeping the objects in memory, for`)
+	g.P(`// tests. Field selections are ignored and the history isn't kept.`)
+	g.P(`type `, repository, ` struct {`)
+	g.P(`table `, table)
+	g.P(`}`)
+	g.P()
+	g.P(`// New`, repository, ` returns an empty `, repository)
+	g.P(`func New`, repository, `() *`, repository, ` {`)
+	g.P(`return &`, repository, `{}`)
+	g.P(`}`)
+	g.P()
+	outputOnly, immutable := b.getProtectedFields(message)
+	protected := append(outputOnly, immutable...)
+	g.P(`// row returns the row storing in, with a primary key assigned when it is zero. Like in the`)
+	g.P(`// Default handlers, the output only and immutable fields keep the values of stored, and the`)
+	g.P(`// output only fields are blank without it.`)
+	g.P(`func (r *`, repository, `) row(ctx context.Context, in, stored *`, typeName, `) (`, row, `, error) {`)
+	toORM(`in`, row+`{}, `)
+	if len(protected) > 0 {
+		g.P(`if stored != nil {`)
+		g.P(`storedObj, err := stored.ToORM(ctx)`)
+		g.P(`if err != nil {`)
+		g.P(`return `, row, `{}, err`)
+		g.P(`}`)
+		for _, fieldName := range protected {
+			if fieldName != pkName {
+				g.P(`ormObj.`, fieldName, ` = storedObj.`, fieldName)
+			}
+		}
+		if len(outputOnly) > 0 {
+			g.P(`} else {`)
+			g.P(`blank := `, ormable.Name, `{}`)
+			for _, fieldName := range outputOnly {
+				g.P(`ormObj.`, fieldName, ` = blank.`, fieldName)
+			}
+		}
+		g.P(`}`)
+	}
+	if pk != nil {
+		g.P(`r.table.AssignKey(&ormObj.`, pkName, `)`)
+	}
+	g.P(`out, err := ormObj.ToPB(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return `, row, `{}, err`)
+	g.P(`}`)
+	g.P(`return `, row, `{Tenant: `, tenant, `, Key: `, key, `, Object: &out}, nil`)
+	g.P(`}`)
+	g.P()
+	g.P(`// tenant returns the tenant whose objects the requests of ctx see, nil for all of them`)
+	g.P(`func (r *`, repository, `) tenant(ctx context.Context) (interface{}, error) {`)
+	if ormable.Tenant != nil {
+		toORM(`(&`+typeName+`{})`, `nil, `)
+		g.P(`return `, tenant, `, nil`)
+	} else {
+		g.P(`_, err := (&`, typeName, `{}).ToORM(ctx)`)
+		g.P(`return nil, err`)
+	}
+	g.P(`}`)
+
+	method(`Create`, fmt.Sprint(`ctx context.Context, in *`, typeName), fmt.Sprint(`(*`, typeName, `, error)`))
+	g.P(`if in == nil {`)
+	g.P(`return nil, `, nilArgument)
+	g.P(`}`)
+	g.P(`if err := in.Validate(); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`row, err := r.row(ctx, in, nil)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`if err = r.table.Insert(row); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`return row.Object.(*`, typeName, `), nil`)
+	g.P(`}`)
+
+	method(`CreateSet`, fmt.Sprint(`ctx context.Context, in []*`, typeName, `, batchSize int`), fmt.Sprint(`([]*`, typeName, `, error)`))
+	g.P(`if in == nil {`)
+	g.P(`return nil, `, nilArgument)
+	g.P(`}`)
+	g.P(`rows := make([]`, row, `, 0, len(in))`)
+	g.P(`out := make([]*`, typeName, `, 0, len(in))`)
+	g.P(`for _, object := range in {`)
+	g.P(`if err := object.Validate(); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`row, err := r.row(ctx, object, nil)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`rows = append(rows, row)`)
+	g.P(`out = append(out, row.Object.(*`, typeName, `))`)
+	g.P(`}`)
+	g.P(`if err := r.table.Insert(rows...); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`return out, nil`)
+	g.P(`}`)
+
+	if has(`Read` + typeName) {
+		method(`Read`, fmt.Sprint(`ctx context.Context, in *`, typeName, `, fs *`, generateImport("FieldSelection", queryImport, g)), fmt.Sprint(`(*`, typeName, `, error)`))
+		g.P(`if in == nil {`)
+		g.P(`return nil, `, nilArgument)
+		g.P(`}`)
+		toORM(`in`, `nil, `)
+		emptyID(`nil, `)
+		g.P(`out, ok := r.table.Get(`, tenant, `, `, key, `)`)
+		g.P(`if !ok {`)
+		g.P(`return nil, `, generateImport("ErrRecordNotFound", gormImport, g))
+		g.P(`}`)
+		g.P(`return out.(*`, typeName, `), nil`)
+		g.P(`}`)
+	}
+	if has(`StrictUpdate` + typeName) {
+		method(`StrictUpdate`, fmt.Sprint(`ctx context.Context, in *`, typeName), fmt.Sprint(`(*`, typeName, `, error)`))
+		g.P(`if in == nil {`)
+		g.P(`return nil, `, nilArgument)
+		g.P(`}`)
+		g.P(`if err := in.Validate(); err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		if len(protected) > 0 {
+			toORM(`in`, `nil, `)
+			g.P(`var stored *`, typeName)
+			g.P(`if object, ok := r.table.Get(`, tenant, `, `, key, `); ok {`)
+			g.P(`stored = object.(*`, typeName, `)`)
+			g.P(`}`)
+			g.P(`row, err := r.row(ctx, in, stored)`)
+		} else {
+			g.P(`row, err := r.row(ctx, in, nil)`)
+		}
+		g.P(`if err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		g.P(`if _, err = r.table.Save(row); err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		g.P(`return row.Object.(*`, typeName, `), nil`)
+		g.P(`}`)
+	}
+	if has(`Patch` + typeName) {
+		method(`Patch`, fmt.Sprint(`ctx context.Context, in *`, typeName, `, updateMask *`, generateImport("FieldMask", fmImport, g)), fmt.Sprint(`(*`, typeName, `, error)`))
+		g.P(`if in == nil {`)
+		g.P(`return nil, `, nilArgument)
+		g.P(`}`)
+		// like DefaultPatch, the object is patched from scratch without id
+		if b.hasIDField(message) {
+			g.P(`patchee, err := r.Read(ctx, &`, typeName, `{Id: in.GetId()}, nil)`)
+			g.P(`if err != nil {`)
+			g.P(`return nil, err`)
+			g.P(`}`)
+		} else {
+			g.P(`patchee := &`, typeName, `{}`)
+			g.P(`var err error`)
+		}
+		g.P(`if patchee, err = DefaultApplyFieldMask`, typeName, `(ctx, patchee, in, updateMask, "", nil); err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		g.P(`return r.StrictUpdate(ctx, patchee)`)
+		g.P(`}`)
+	}
+	if has(`PatchSet` + typeName) {
+		method(`PatchSet`, fmt.Sprint(`ctx context.Context, objects []*`, typeName, `, updateMasks []*`, generateImport("FieldMask", fmImport, g)), fmt.Sprint(`([]*`, typeName, `, error)`))
+		g.P(`if len(objects) != len(updateMasks) {`)
+		g.P(`return nil, `, generateImport("Errorf", stdFmtImport, g), `(`, generateImport("BadRepeatedFieldMaskTpl", gerrorsImport, g), `, len(updateMasks), len(objects))`)
+		g.P(`}`)
+		g.P(`out := make([]*`, typeName, `, 0, len(objects))`)
+		g.P(`for i, patcher := range objects {`)
+		g.P(`patched, err := r.Patch(ctx, patcher, updateMasks[i])`)
+		g.P(`if err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		g.P(`out = append(out, patched)`)
+		g.P(`}`)
+		g.P(`return out, nil`)
+		g.P(`}`)
+	}
+	if has(`Delete` + typeName) {
+		method(`Delete`, fmt.Sprint(`ctx context.Context, in *`, typeName), `error`)
+		g.P(`if in == nil {`)
+		g.P(`return `, nilArgument)
+		g.P(`}`)
+		toORM(`in`, ``)
+		emptyID(``)
+		g.P(`r.table.Delete(`, tenant, `, `, key, `)`)
+		g.P(`return nil`)
+		g.P(`}`)
+	}
+	if has(`Delete` + typeName + `Set`) {
+		method(`DeleteSet`, fmt.Sprint(`ctx context.Context, in []*`, typeName), `error`)
+		g.P(`if in == nil {`)
+		g.P(`return `, nilArgument)
+		g.P(`}`)
+		g.P(`tenant, err := r.tenant(ctx)`)
+		g.P(`if err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		g.P(`keys := make([]interface{}, 0, len(in))`)
+		g.P(`for _, object := range in {`)
+		toORM(`object`, ``)
+		emptyID(``)
+		g.P(`keys = append(keys, `, key, `)`)
+		g.P(`}`)
+		g.P(`r.table.Delete(tenant, keys...)`)
+		g.P(`return nil`)
+		g.P(`}`)
+	}
+	if has(`List` + typeName + `History`) {
+		method(`ListHistory`, fmt.Sprint(`ctx context.Context, in *`, typeName), fmt.Sprint(`([]*`, typeName, `HistoryORM, error)`))
+		g.P(`return nil, `, generateImport("Error", grpcStatusImport, g), `(`, generateImport("Unimplemented", grpcCodesImport, g), `, "the history of `, typeName, ` isn't kept in memory")`)
+		g.P(`}`)
+	}
+
+	method(`List`, fmt.Sprint(`ctx context.Context, `, collection, `, fs *`, generateImport("FieldSelection", queryImport, g)), fmt.Sprint(`([]*`, typeName, `, error)`))
+	g.P(`tenant, err := r.tenant(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`objects, err := r.table.List(tenant, f, s, p)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	results(`objects`)
+	g.P(`return out, nil`)
+	g.P(`}`)
+
+	if pk != nil {
+		method(`PageToken`, fmt.Sprint(`ctx context.Context, last *`, typeName, `, s *`, generateImport("Sorting", queryImport, g),
+			`, f *`, generateImport("Filtering", queryImport, g)), `(string, error)`)
+		toORM(`last`, `"", `)
+		g.P(`return `, generateImport("PageToken", memoryImport, g), `(`, key, `), nil`)
+		g.P(`}`)
+	}
+
+	method(`Count`, fmt.Sprint(`ctx context.Context, f *`, generateImport("Filtering", queryImport, g), `, strategy `, generateImport("CountStrategy", pagingImport, g)), `(int64, error)`)
+	g.P(`tenant, err := r.tenant(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return 0, err`)
+	g.P(`}`)
+	g.P(`return r.table.Count(tenant, f)`)
+	g.P(`}`)
+
+	if has(`Stream` + typeName) {
+		method(`Stream`, fmt.Sprint(`ctx context.Context, `, collection, `, fs *`, generateImport("FieldSelection", queryImport, g), `, send func(*`, typeName, `) error`), `error`)
+		g.P(`objects, err := r.List(ctx, f, s, p, fs)`)
+		g.P(`if err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		g.P(`for _, object := range objects {`)
+		g.P(`if err = send(object); err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		g.P(`}`)
+		g.P(`return nil`)
+		g.P(`}`)
+	}
+	if has(`Upsert` + typeName) {
+		method(`Upsert`, fmt.Sprint(`ctx context.Context, in *`, typeName, `, target string, updateMask *`, generateImport("FieldMask", fmImport, g)), fmt.Sprint(`(*`, typeName, `, error)`))
+		b.generateMemoryUpsert(message, tenant, g)
+		g.P(`}`)
+	}
+}
+
+// generateMemoryUpsert prints the body of the Upsert method of the in-memory
+// repository of the message, which updates the object with the columns of
+// the conflict target of in like DefaultUpsert does.
+func (b *ORMBuilder) generateMemoryUpsert(message *protogen.Message, tenant string, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	if !ormable.handlers[`DefaultStrictUpdate`+typeName] {
+		g.P(`return nil, `, generateImport("Error", grpcStatusImport, g), `(`, generateImport("Unimplemented", grpcCodesImport, g), `, "`, typeName, ` has no primary key to upsert in memory")`)
+		return
+	}
+	pkName, _ := b.findPrimaryKey(ormable)
+	outputOnly, immutable := b.getProtectedFields(message)
+	kept := map[string]bool{pkName: true}
+	for _, fieldName := range append(outputOnly, immutable...) {
+		kept[fieldName] = true
+	}
+
+	g.P(`if in == nil {`)
+	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`columns := `, typeName, `ConflictTargets[target]`)
+	g.P(`if len(columns) == 0 {`)
+	g.P(`return nil, `, generateImport("Errorf", stdFmtImport, g), `("unknown conflict target %q for `, typeName, `", target)`)
+	g.P(`}`)
+	g.P(`if _, err := upsert`, typeName, `Columns(updateMask); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`found, ok, err := r.table.Conflicting(`, tenant, `, func(m `, generateImport("Message", "google.golang.org/protobuf/proto", g), `) bool {`)
+	g.P(`other, err := m.(*`, typeName, `).ToORM(ctx)`)
+	g.P(`return err == nil && `, generateImport("SameColumns", memoryImport, g), `(&ormObj, &other, columns)`)
+	g.P(`})`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`if !ok {`)
+	g.P(`return r.Create(ctx, in)`)
+	g.P(`}`)
+	g.P(`existing := found.(*`, typeName, `)`)
+	g.P(`if updateMask != nil {`)
+	g.P(`patched, err := DefaultApplyFieldMask`, typeName, `(ctx, existing, in, updateMask, "", nil)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`return r.StrictUpdate(ctx, patched)`)
+	g.P(`}`)
+	g.P(`// the primary key, output only and immutable fields are kept`)
+	g.P(`updated := `, generateImport("Clone", "google.golang.org/protobuf/proto", g), `(in).(*`, typeName, `)`)
+	for _, field := range message.Fields {
+		if kept[camelCase(string(field.Desc.Name()))] {
+			g.P(`updated.`, field.GoName, ` = existing.`, field.GoName)
+		}
+	}
+	g.P(`return r.StrictUpdate(ctx, updated)`)
+}
+
+// generateHandlerSignature prints the signature of the Default handler name
+// of typeName, taking params and returning results. With tracing=otel it
+// prints a wrapper recording the span of the handler first, and the write
+// handlers of audited types are wrapped in a transaction, the signature
+// printed is then the one of the unexported handler they call.
+// generateFixture prints New<Type>Fixture, returning an object of the message
+// with random valid values in its mapped fields and its required associations,
+// and Create<Type>Fixture, creating it with its associations by DefaultCreate.
+// The primary key, the tenant and the foreign keys are left to the database,
+// the conversions and the associations like in a create request.
+func (b *ORMBuilder) generateFixture(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	option := typeName + `FixtureOption`
+
+	g.P(`// `, option, ` customizes the object returned by New`, typeName, `Fixture`)
+	g.P(`type `, option, ` func(*`, typeName, `)`)
+	g.P()
+	g.P(`// New`, typeName, `Fixture returns a `, typeName, ` with random valid values in its fields and`)
+	g.P(`// its required associations, customized by opts`)
+	g.P(`func New`, typeName, `Fixture(opts ...`, option, `) *`, typeName, ` {`)
+	g.P(`m := &`, typeName, `{}`)
+	for _, field := range message.Fields {
+		fieldName := camelCase(string(field.Desc.Name()))
+		ofield, ok := ormable.Fields[fieldName]
+		// the keys are set by the database and the associations, and the
+		// objects with a DeletedAt are soft deleted for gorm
+		if !ok || ofield.ParentOrigName != "" || fieldName == "DeletedAt" {
+			continue
+		}
+		if ormable.Tenant != nil && fieldName == ormable.Tenant.Name {
+			continue
+		}
+		value := `m.` + field.GoName
+		if field.Message != nil && b.isOrmable(getFieldType(field)) {
+			if b.requiresAssociation(field) && !b.buildsFixture(field.Message, typeName, map[string]bool{}) {
+				ident := protogen.GoIdent{GoName: `New` + getFieldType(field) + `Fixture`, GoImportPath: field.Message.GoIdent.GoImportPath}
+				g.P(value, ` = `, b.typeName(ident, g), `()`)
+			}
+			continue
+		}
+		if expr := b.fixtureValue(field, ofield, g); expr != "" {
+			g.P(value, ` = `, expr)
+		}
+	}
+	g.P(`for _, opt := range opts {`)
+	g.P(`opt(m)`)
+	g.P(`}`)
+	g.P(`return m`)
+	g.P(`}`)
+	g.P()
+
+	if !ormable.handlers[`DefaultCreate`+typeName] {
+		return
+	}
+	parent, field := b.fixtureParent(message)
+	if parent == nil {
+		g.P(`// Create`, typeName, `Fixture creates the object returned by New`, typeName, `Fixture and its`)
+		g.P(`// associations with DefaultCreate`, typeName)
+	} else {
+		parentName := string(parent.Desc.Name())
+		as := `the `
+		if field.Desc.IsList() {
+			as = `one of the `
+		}
+		g.P(`// Create`, typeName, `Fixture creates the object returned by New`, typeName, `Fixture and its`)
+		g.P(`// associations as `, as, field.GoName, ` of the `, parentName, ` created by Create`, parentName, `Fixture, which`)
+		g.P(`// sets its not null foreign key`)
+	}
+	g.P(`func Create`, typeName, `Fixture(ctx `, generateImport("Context", stdCtxImport, g), `, db *`, generateImport("DB", gormImport, g),
+		`, opts ...`, option, `) (*`, typeName, `, error) {`)
+	if parent == nil {
+		g.P(`return DefaultCreate`, typeName, `(ctx, New`, typeName, `Fixture(opts...), db)`)
+		g.P(`}`)
+		g.P()
+		return
+	}
+	parentName := string(parent.Desc.Name())
+	g.P(`m := New`, typeName, `Fixture(opts...)`)
+	g.P(`parent, err := Create`, parentName, `Fixture(ctx, db, func(parent *`, parentName, `) {`)
+	if field.Desc.IsList() {
+		g.P(`parent.`, field.GoName, ` = append(parent.`, field.GoName, `, m)`)
+	} else {
+		g.P(`parent.`, field.GoName, ` = m`)
+	}
+	g.P(`})`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	if field.Desc.IsList() {
+		g.P(`return parent.`, field.GoName, `[len(parent.`, field.GoName, `)-1], nil`)
+	} else {
+		g.P(`return parent.`, field.GoName, `, nil`)
+	}
+	g.P(`}`)
+	g.P()
+}
+
+// fixtureParent returns the ormable message of the package of message, and
+// its has_one or has_many field, whose association sets a not null foreign
+// key in the table of message. It returns nil when there is none.
+func (b *ORMBuilder) fixtureParent(message *protogen.Message) (*protogen.Message, *protogen.Field) {
+	child := b.getOrmable(string(message.Desc.Name()))
+	for _, file := range b.plugin.Files {
+		for _, parent := range file.Messages {
+			if parent == message || !isOrmable(parent) || parent.GoIdent.GoImportPath != message.GoIdent.GoImportPath {
+				continue
+			}
+			for _, field := range parent.Fields {
+				if field.Message == nil || field.Message.Desc.FullName() != message.Desc.FullName() {
+					continue
+				}
+				var foreignKey string
+				var tag *gorm.GormTag
+				fieldOpts := getFieldOptions(field.Desc.Options().(*descriptorpb.FieldOptions))
+				if hasOne := fieldOpts.GetHasOne(); hasOne != nil {
+					foreignKey, tag = hasOne.GetForeignkey(), hasOne.GetForeignkeyTag()
+				} else if hasMany := fieldOpts.GetHasMany(); hasMany != nil {
+					foreignKey, tag = hasMany.GetForeignkey(), hasMany.GetForeignkeyTag()
+				} else {
+					continue
+				}
+				if key, ok := child.Fields[camelCase(foreignKey)]; tag.GetNotNull() || (ok && key.GetTag().GetNotNull()) {
+					return parent, field
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// requiresAssociation reports whether the ormable field is an association
+// built by the fixtures: a belongs_to one, the parent being created first, or
+// a not null one.
+func (b *ORMBuilder) requiresAssociation(field *protogen.Field) bool {
+	if field.Desc.IsList() {
+		return false
+	}
+	fieldOpts := getFieldOptions(field.Desc.Options().(*descriptorpb.FieldOptions))
+	return fieldOpts.GetBelongsTo() != nil || fieldOpts.GetTag().GetNotNull()
+}
+
+// buildsFixture reports whether the fixture of message builds one of
+// typeName through its required associations, which would never end.
+func (b *ORMBuilder) buildsFixture(message *protogen.Message, typeName string, seen map[string]bool) bool {
+	name := string(message.Desc.Name())
+	if name == typeName {
+		return true
+	}
+	if seen[name] {
+		return false
+	}
+	seen[name] = true
+	for _, field := range message.Fields {
+		if getFieldOptions(field.Desc.Options().(*descriptorpb.FieldOptions)).GetDrop() {
+			continue
+		}
+		if field.Message != nil && b.isOrmable(getFieldType(field)) && b.requiresAssociation(field) &&
+			b.buildsFixture(field.Message, typeName, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// fixtureValue returns a random value of the field valid for the constraints
+// checked by Validate, or an empty string when the fixtures leave it unset.
+func (b *ORMBuilder) fixtureValue(field *protogen.Field, ofield *Field, g *protogen.GeneratedFile) string {
+	if field.Desc.IsList() {
+		return ""
+	}
+	fixture := func(name string) string {
+		return generateImport(name, fixtureImport, g)
+	}
+	tag := ofield.GetTag()
+	if field.Enum != nil {
+		enum := b.typeName(field.Enum.GoIdent, g)
+		return fmt.Sprint(enum, `(`, fixture("Enum"), `(`, enum, `(0).Descriptor()))`)
+	}
+	if field.Message == nil {
+		return b.fixtureScalar(field.Desc.Kind(), tag, g)
+	}
+	switch fieldType := getFieldType(field); fieldType {
+	case protoTypeUUID, protoTypeUUIDValue:
+		return fmt.Sprint(`&`, generateImport(fieldType, gtypesImport, g), `{Value: `, fixture("UUID"), `()}`)
+	case protoTypeInet:
+		return fmt.Sprint(`&`, generateImport(fieldType, gtypesImport, g), `{Value: `, fixture("CIDR"), `()}`)
+	case protoTimeOnly:
+		return fmt.Sprint(`&`, generateImport(fieldType, gtypesImport, g), `{Value: `, fixture("TimeOnly"), `()}`)
+	case protoTypeJSON:
+		return fmt.Sprint(`&`, generateImport(fieldType, gtypesImport, g), `{Value: `, fixture("JSON"), `()}`)
+	case protoTypeTimestamp:
+		return fmt.Sprint(generateImport("New", timestampImport, g), `(`, fixture("Time"), `())`)
+	case protoTypeResource:
+		var value string
+		switch ttype := strings.ToLower(tag.GetType()); {
+		case ttype == "uuid":
+			value = fixture("UUID") + `()`
+		case ttype == "text" || strings.Contains(ttype, "char"):
+			value = fmt.Sprint(fixture("String"), `(`, tag.GetSize(), `)`)
+		case ttype == "jsonb":
+			value = fixture("JSON") + `()`
+		case inList(ttype, []string{"smallint", "integer", "bigint", "numeric", "smallserial", "serial", "bigserial"}):
+			value = fixture("Int") + `(1e6)`
+		default:
+			return ""
+		}
+		return fmt.Sprint(fixture("Identifier"), `(nil, `, value, `)`)
+	default:
+		if _, ok := wellKnownTypes[fieldType]; !ok {
+			return ""
+		}
+		value := b.fixtureScalar(field.Message.Fields[0].Desc.Kind(), tag, g)
+		return fmt.Sprint(`&`, generateImport(fieldType, wktImport, g), `{Value: `, value, `}`)
+	}
+}
+
+// fixtureScalar returns a random value of kind within the size or precision
+// of tag.
+func (b *ORMBuilder) fixtureScalar(kind protoreflect.Kind, tag *gorm.GormTag, g *protogen.GeneratedFile) string {
+	fixture := func(name string) string {
+		return generateImport(name, fixtureImport, g)
+	}
+	max := int64(1e6)
+	if digits, _, ok := numericDigits(tag); ok && digits < 6 {
+		max = pow10(digits)
+	}
+	switch kind {
+	case protoreflect.BoolKind:
+		return fixture("Bool") + `()`
+	case protoreflect.StringKind:
+		return fmt.Sprint(fixture("String"), `(`, tag.GetSize(), `)`)
+	case protoreflect.BytesKind:
+		return fmt.Sprint(fixture("Bytes"), `(`, tag.GetSize(), `)`)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return fmt.Sprint(`int32(`, fixture("Int"), `(`, max, `))`)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return fmt.Sprint(fixture("Int"), `(`, max, `)`)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return fmt.Sprint(`uint32(`, fixture("Uint"), `(`, max, `))`)
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return fmt.Sprint(fixture("Uint"), `(`, max, `)`)
+	case protoreflect.FloatKind:
+		return fmt.Sprint(`float32(`, fixture("Float"), `(`, max, `))`)
+	case protoreflect.DoubleKind:
+		return fmt.Sprint(fixture("Float"), `(`, max, `)`)
+	}
+	return ""
+}
+
+func (b *ORMBuilder) generateHandlerSignature(typeName, name, params, results string, g *protogen.GeneratedFile) {
+	b.getOrmable(typeName).handlers[name] = true
+	transactional := b.getOrmable(typeName).recordsChanges() && (name == `DefaultCreate`+typeName ||
+		name == `DefaultStrictUpdate`+typeName || name == `DefaultPatch`+typeName ||
+		name == `DefaultDelete`+typeName || name == `DefaultDelete`+typeName+`Set` || name == `DefaultUpsert`+typeName)
+	if b.otelTracing {
+		name = b.generateTracingWrapper(typeName, name, params, results, g)
+	}
+	if transactional {
+		name = b.generateTransactionWrapper(b.getOrmable(typeName), name, params, results, g)
+	}
+	g.P(`func `, name, `(`, params, `) `, results, ` {`)
+}
+
+// handlerCall returns the call of the handler inner with the params of its
+// wrapper, db being replaced by dbArg.
+func handlerCall(inner, params, dbArg string) string {
+	var args []string
+	for _, param := range strings.Split(params, ",") {
+		arg := strings.Fields(param)[0]
+		if arg == "db" {
+			arg = dbArg
+		}
+		args = append(args, arg)
+	}
+	return fmt.Sprint(inner, `(`, strings.Join(args, ", "), `)`)
+}
+
+// generateTracingWrapper prints the handler name recording its span and
+// returns the name of the handler it calls.
+func (b *ORMBuilder) generateTracingWrapper(typeName, name, params, results string, g *protogen.GeneratedFile) string {
+	inner := strings.ToLower(name[:1]) + name[1:]
+	call := handlerCall(inner, params, fmt.Sprint(generateImport("WithContext", tracingImport, g), `(ctx, db)`))
+
+	g.P(`func `, name, `(`, params, `) `, results, ` {`)
+	g.P(`ctx, span := `, generateImport("StartHandler", tracingImport, g), `(ctx, "`, name, `", "`, typeName, `")`)
+	g.P(`defer span.End()`)
+	if results == "error" {
+		g.P(`return `, generateImport("Error", tracingImport, g), `(span, `, call, `)`)
+	} else {
+		var vars []string
+		for i := 1; i < len(strings.Split(results, ",")); i++ {
+			vars = append(vars, fmt.Sprint(`r`, i-1))
+		}
+		g.P(strings.Join(vars, ", "), `, err := `, call)
+		g.P(`return `, strings.Join(vars, ", "), `, `, generateImport("Error", tracingImport, g), `(span, err)`)
+	}
+	g.P(`}`)
+	g.P()
+	return inner
+}
+
+// generateTransactionWrapper prints the handler name running in a
+// transaction of its db, or in db when it already is one, and returns the
+// name of the handler it calls. The cache invalidations of the messages with
+// the cached option are deferred until the commit.
+func (b *ORMBuilder) generateTransactionWrapper(ormable *OrmableType, name, params, results string, g *protogen.GeneratedFile) string {
+	inner := strings.ToLower(name[:1]) + name[1:] + `InTransaction`
+	call := handlerCall(inner, params, `tx`)
+	gormDB := generateImport("DB", gormImport, g)
+	var run string
+	if ormable.Cached {
+		run = fmt.Sprint(generateImport("Run", cacheImport, g), `(ctx, db, func(ctx context.Context, tx *`, gormDB, `)`)
+	} else {
+		run = fmt.Sprint(generateImport("Run", transactionImport, g), `(db, func(tx *`, gormDB, `)`)
+	}
+
+	g.P(`func `, name, `(`, params, `) `, results, ` {`)
+	if results == "error" {
+		g.P(`return `, run, ` error {`)
+		g.P(`return `, call)
+		g.P(`})`)
+	} else {
+		types := strings.Split(strings.Trim(results, "()"), ",")
+		var vars []string
+		for i, typ := range types[:len(types)-1] {
+			vars = append(vars, fmt.Sprint(`r`, i))
+			g.P(`var r`, i, ` `, strings.TrimSpace(typ))
+		}
+		g.P(`err := `, run, ` (err error) {`)
+		g.P(strings.Join(vars, ", "), `, err = `, call)
+		g.P(`return err`)
+		g.P(`})`)
+		g.P(`return `, strings.Join(vars, ", "), `, err`)
+	}
+	g.P(`}`)
+	g.P()
+	return inner
+}
+
+func (b *ORMBuilder) generateCreateHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	orm := b.getOrmable(typeName)
+	g.P(`// DefaultCreate`, typeName, ` executes a basic gorm create call`)
+	b.generateHandlerSignature(typeName, `DefaultCreate`+typeName, fmt.Sprint(`ctx context.Context, in *`,
+		typeName, `, db *`, generateImport("DB", gormImport, g)), fmt.Sprint(`(*`, typeName, `, error)`), g)
+	g.P(`if in == nil {`)
+	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`if err := in.Validate(); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	if outputOnly, _ := b.getProtectedFields(message); len(outputOnly) > 0 {
+		g.P(`// output only fields are managed by the server`)
+		g.P(`blank := `, orm.Name, `{}`)
+		for _, fieldName := range outputOnly {
+			g.P(`ormObj.`, fieldName, ` = blank.`, fieldName)
+		}
+	}
+	create := "Create_"
+	b.generateBeforeHookCall(orm, create, g)
+	g.P(`if err = db.Create(&ormObj).Error; err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	b.generateChangeRecord(orm, "Create", "db", "nil", "&ormObj", "nil, ", g)
+	b.generateAfterHookCall(orm, create, g)
+	g.P(`pbResponse, err := ormObj.ToPB(ctx)`)
+	g.P(`return &pbResponse, err`)
+	g.P(`}`)
+	b.generateBeforeHookDef(orm, create, g)
+	b.generateAfterHookDef(orm, create, g)
+}
+
+func (b *ORMBuilder) generateCreateSetHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	orm := b.getOrmable(typeName)
+	gormDB := generateImport("DB", gormImport, g)
+
+	g.P(`// DefaultCreate`, typeName, `Set executes batched gorm create calls in a single transaction, running`)
+	g.P(`// the BeforeCreate_ and AfterCreate_ hooks of each object within the ones of the set`)
+	b.generateHandlerSignature(typeName, `DefaultCreate`+typeName+`Set`, fmt.Sprint(`ctx context.Context, in []*`,
+		typeName, `, db *`, gormDB, `, batchSize int`), fmt.Sprint(`([]*`, typeName, `, error)`), g)
+	g.P(`if in == nil {`)
+	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`ormObjs := make([]*`, orm.Name, `, 0, len(in))`)
+	g.P(`for _, obj := range in {`)
+	g.P(`if obj == nil {`)
+	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`if err := obj.Validate(); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`ormObj, err := obj.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	if outputOnly, _ := b.getProtectedFields(message); len(outputOnly) > 0 {
+		g.P(`// output only fields are managed by the server`)
+		g.P(`blank := `, orm.Name, `{}`)
+		for _, fieldName := range outputOnly {
+			g.P(`ormObj.`, fieldName, ` = blank.`, fieldName)
+		}
+	}
+	g.P(`ormObjs = append(ormObjs, &ormObj)`)
+	g.P(`}`)
+	g.P(`err := `, generateImport("Run", transactionImport, g), `(db, func(tx *`, gormDB, `) (err error) {`)
+	g.P(`if hook, ok := (interface{}(&`, orm.Name, `{})).(`, orm.Name, `WithBeforeCreateSet); ok {`)
+	g.P(`if tx, err = hook.BeforeCreateSet(ctx, ormObjs, tx); err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`for _, ormObj := range ormObjs {`)
+	g.P(`if hook, ok := interface{}(ormObj).(`, orm.Name, `WithBeforeCreate_); ok {`)
+	g.P(`if tx, err = hook.BeforeCreate_(ctx, tx); err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`if err = `, generateImport("Batch", insertImport, g), `(tx, ormObjs, batchSize); err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`for _, ormObj := range ormObjs {`)
+	if orm.recordsChanges() {
+		b.generateChangeRecord(orm, "Create", "tx", "nil", "ormObj", "", g)
+	}
+	g.P(`if hook, ok := interface{}(ormObj).(`, orm.Name, `WithAfterCreate_); ok {`)
+	g.P(`if err = hook.AfterCreate_(ctx, tx); err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`if hook, ok := (interface{}(&`, orm.Name, `{})).(`, orm.Name, `WithAfterCreateSet); ok {`)
+	g.P(`err = hook.AfterCreateSet(ctx, ormObjs, tx)`)
+	g.P(`}`)
+	g.P(`return err`)
+	g.P(`})`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`pbResponse := make([]*`, typeName, `, 0, len(ormObjs))`)
+	g.P(`for _, ormObj := range ormObjs {`)
+	g.P(`pbObj, err := ormObj.ToPB(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`pbResponse = append(pbResponse, &pbObj)`)
+	g.P(`}`)
+	g.P(`return pbResponse, nil`)
+	g.P(`}`)
+	g.P(`type `, orm.Name, `WithBeforeCreateSet interface {`)
+	g.P(`BeforeCreateSet(context.Context, []*`, orm.Name, `, *`, gormDB, `) (*`, gormDB, `, error)`)
+	g.P(`}`)
+	g.P(`type `, orm.Name, `WithAfterCreateSet interface {`)
+	g.P(`AfterCreateSet(context.Context, []*`, orm.Name, `, *`, gormDB, `) error`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateBeforeHookCall(orm *OrmableType, method string, g *protogen.GeneratedFile) {
+	g.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithBefore`, method, `); ok {`)
+	g.P(`if db, err = hook.Before`, method, `(ctx, db); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateAfterHookCall(orm *OrmableType, method string, g *protogen.GeneratedFile) {
+	g.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithAfter`, method, `); ok {`)
+	g.P(`if err = hook.After`, method, `(ctx, db); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateHookInterfaces(g *protogen.GeneratedFile, message *protogen.Message) {
+	typeName := string(message.Desc.Name())
+	g.P(`// The following are interfaces you can implement for special behavior during ORM/PB conversions`)
+	g.P(`// of type `, typeName, ` the arg will be the target, the caller the one being converted from`)
+	g.P()
+	for _, desc := range [][]string{
+		{"BeforeToORM", typeName + "ORM", " called before default ToORM code"},
+		{"AfterToORM", typeName + "ORM", " called after default ToORM code"},
 		{"BeforeToPB", typeName, " called before default ToPB code"},
 		{"AfterToPB", typeName, " called after default ToPB code"},
 	} {
@@ -1633,11 +3126,11 @@ func (b *ORMBuilder) generateReadHandler(message *protogen.Message, g *protogen.
 	ormable := b.getOrmable(typeName)
 
 	if b.readHasFieldSelection(ormable) {
-		g.P(`func DefaultRead`, typeName, `(ctx context.Context, in *`,
-			typeName, `, db *`, generateImport("DB", gormImport, g), `, fs *`, generateImport("FieldSelection", queryImport, g), `) (*`, typeName, `, error) {`)
+		b.generateHandlerSignature(typeName, `DefaultRead`+typeName, fmt.Sprint(`ctx context.Context, in *`,
+			typeName, `, db *`, generateImport("DB", gormImport, g), `, fs *`, generateImport("FieldSelection", queryImport, g)), fmt.Sprint(`(*`, typeName, `, error)`), g)
 	} else {
-		g.P(`func DefaultRead`, typeName, `(ctx context.Context, in *`,
-			typeName, `, db *`, "gorm", `.DB) (*`, typeName, `, error) {`)
+		b.generateHandlerSignature(typeName, `DefaultRead`+typeName, fmt.Sprint(`ctx context.Context, in *`,
+			typeName, `, db *`, "gorm", `.DB`), fmt.Sprint(`(*`, typeName, `, error)`), g)
 	}
 	g.P(`if in == nil {`)
 	g.P(`return nil, `, "errors", `.NilArgumentError`)
@@ -1663,9 +3156,31 @@ func (b *ORMBuilder) generateReadHandler(message *protogen.Message, g *protogen.
 	} else {
 		fs = "nil"
 	}
+	// objects read with a field selection, or by hooks or scopes which the
+	// cached objects ignore, aren't cached
+	if ormable.Cached {
+		g.P(`cacheKey := ormObj.cacheKey()`)
+		cacheable := fmt.Sprint(generateImport("Unfiltered", cacheImport, g), `(db, &`, ormable.Name, `{})`)
+		if fs == "fs" {
+			cacheable = `fs == nil && ` + cacheable
+		}
+		g.P(`cacheable := `, cacheable)
+		g.P(`switch interface{}(&ormObj).(type) {`)
+		g.P(`case `, ormable.Name, `WithBeforeReadApplyQuery, `, ormable.Name, `WithBeforeReadFind, `, ormable.Name, `WithAfterReadFind:`)
+		g.P(`cacheable = false`)
+		g.P(`}`)
+		g.P(`if cached := (&`, typeName, `{}); cacheable && `, generateImport("Get", cacheImport, g), `(ctx, cacheKey, cached) {`)
+		g.P(`return cached, nil`)
+		g.P(`}`)
+	}
 
 	b.generateBeforeReadHookCall(ormable, "ApplyQuery", g)
-	g.P(`if db, err = `, generateImport("ApplyFieldSelection", tkgormImport, g), `(ctx, db, `, fs, `, &`, ormable.Name, `{}); err != nil {`)
+	if b.nativeRuntime {
+		args := append([]string{"db", "&" + ormable.Name + "{}", fs}, b.preloadArgs(message)...)
+		g.P(`if db, err = `, generateImport("ApplyFieldSelection", collectionImport, g), `(`, strings.Join(args, ", "), `); err != nil {`)
+	} else {
+		g.P(`if db, err = `, generateImport("ApplyFieldSelectionEx", tkgormImport, g), `(ctx, db, `, fs, `, &`, ormable.Name, `{}, `, b.preloadConverter(message, g), `); err != nil {`)
+	}
 	g.P(`return nil, err`)
 	g.P(`}`)
 
@@ -1677,6 +3192,15 @@ func (b *ORMBuilder) generateReadHandler(message *protogen.Message, g *protogen.
 
 	b.generateAfterReadHookCall(ormable, g)
 	g.P(`pbResponse, err := ormResponse.ToPB(ctx)`)
+	if ormable.Cached {
+		g.P(`if err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		// the rows of replicas may lag behind the writes invalidating them
+		g.P(`if cacheable && !`, generateImport("IsReplica", dbresolverImport, g), `(db) {`)
+		g.P(generateImport("Set", cacheImport, g), `(ctx, cacheKey, &pbResponse)`)
+		g.P(`}`)
+	}
 	g.P(`return &pbResponse, err`)
 	g.P(`}`)
 
@@ -1686,6 +3210,78 @@ func (b *ORMBuilder) generateReadHandler(message *protogen.Message, g *protogen.
 
 }
 
+// preloadConverter returns the collection operators converter of the message,
+// which preloads its associations flagged with the preload option when no
+// field is selected
+func (b *ORMBuilder) preloadConverter(message *protogen.Message, g *protogen.GeneratedFile) string {
+	typeName := string(message.Desc.Name())
+	args := append([]string{"&" + typeName + "{}"}, b.preloadArgs(message)...)
+
+	return fmt.Sprint(generateImport("NewConverter", preloadImport, g), `(`, strings.Join(args, ", "), `)`)
+}
+
+// preloadArgs returns the quoted default preloads of message.
+func (b *ORMBuilder) preloadArgs(message *protogen.Message) []string {
+	var args []string
+	for _, preload := range b.getPreloads(b.getOrmable(string(message.Desc.Name())), nil) {
+		args = append(args, strconv.Quote(preload))
+	}
+
+	return args
+}
+
+// collectionOperators returns the call applying the collection operators
+// f, s, p and fs of a List request with the selected runtime.
+func (b *ORMBuilder) collectionOperators(message *protogen.Message, f, s, p, fs string, g *protogen.GeneratedFile) string {
+	ormable := b.getOrmable(string(message.Desc.Name()))
+	if b.nativeRuntime {
+		args := append([]string{"db", "&" + ormable.Name + "{}", f, s, p, fs}, b.preloadArgs(message)...)
+		return fmt.Sprint(generateImport("Apply", collectionImport, g), `(`, strings.Join(args, ", "), `)`)
+	}
+
+	return fmt.Sprint(generateImport("ApplyCollectionOperatorsEx", tkgormImport, g), `(ctx, db, &`, ormable.Name, `{}, `,
+		b.preloadConverter(message, g), `, `, f, `, `, s, `, `, p, `, `, fs, `)`)
+}
+
+// getPreloads returns the associations of the ormable type flagged with the
+// preload option, followed by their own preloads as dotted paths. Cycles
+// stop at the first association back to a type of the path.
+func (b *ORMBuilder) getPreloads(ormable *OrmableType, path []*OrmableType) []string {
+	var names []string
+	for name := range ormable.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	path = append(path, ormable)
+	var preloads []string
+	for _, name := range names {
+		field := ormable.Fields[name]
+		if !field.GetHasOne().GetPreload() && !field.GetBelongsTo().GetPreload() &&
+			!field.GetHasMany().GetPreload() && !field.GetManyToMany().GetPreload() {
+			continue
+		}
+		assoc, err := GetOrmable(b.ormableTypes, field.Type)
+		if err != nil {
+			continue
+		}
+		preloads = append(preloads, name)
+
+		cycle := false
+		for _, t := range path {
+			cycle = cycle || t == assoc
+		}
+		if cycle {
+			continue
+		}
+		for _, preload := range b.getPreloads(assoc, path) {
+			preloads = append(preloads, name+"."+preload)
+		}
+	}
+
+	return preloads
+}
+
 func (b *ORMBuilder) readHasFieldSelection(ormable *OrmableType) bool {
 	if read, ok := ormable.Methods[readService]; ok {
 		if s := b.getFieldSelection(read.inType); s != "" {
@@ -1770,8 +3366,8 @@ func (b *ORMBuilder) generateAfterReadHookDef(orm *OrmableType, g *protogen.Gene
 func (b *ORMBuilder) generateDeleteHandler(message *protogen.Message, g *protogen.GeneratedFile) {
 	typeName := string(message.Desc.Name())
 
-	g.P(`func DefaultDelete`, typeName, `(ctx context.Context, in *`,
-		typeName, `, db *`, generateImport("DB", gormImport, g), `) error {`)
+	b.generateHandlerSignature(typeName, `DefaultDelete`+typeName, fmt.Sprint(`ctx context.Context, in *`,
+		typeName, `, db *`, generateImport("DB", gormImport, g)), `error`, g)
 	g.P(`if in == nil {`)
 	g.P(`return `, generateImport("NilArgumentError", gerrorsImport, g))
 	g.P(`}`)
@@ -1791,10 +3387,18 @@ func (b *ORMBuilder) generateDeleteHandler(message *protogen.Message, g *protoge
 	g.P(`}`)
 
 	b.generateBeforeDeleteHookCall(ormable, g)
+	if ormable.recordsChanges() {
+		g.P(`deleted := []*`, ormable.Name, `{}`)
+		g.P(`if err = db.Set("gorm:query_option", "FOR UPDATE").Where(&ormObj).Find(&deleted).Error; err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+	}
 	g.P(`err = db.Where(&ormObj).Delete(&`, ormable.Name, `{}).Error`)
 	g.P(`if err != nil {`)
 	g.P(`return err`)
 	g.P(`}`)
+	b.generateDeletedRecords(ormable, g)
+	b.generateCacheInvalidation(ormable, "ormObj.cacheKey()", "", g)
 
 	b.generateAfterDeleteHookCall(ormable, g)
 	g.P(`return err`)
@@ -1804,6 +3408,72 @@ func (b *ORMBuilder) generateDeleteHandler(message *protogen.Message, g *protoge
 	b.generateAfterHookDef(ormable, delete, g)
 }
 
+// generateDeletedRecords records the deletion of the rows loaded in deleted.
+func (b *ORMBuilder) generateDeletedRecords(ormable *OrmableType, g *protogen.GeneratedFile) {
+	if !ormable.recordsChanges() {
+		return
+	}
+	g.P(`for _, deletedObj := range deleted {`)
+	b.generateChangeRecord(ormable, "Delete", "db", "deletedObj", "nil", "", g)
+	g.P(`}`)
+}
+
+// generateChangeRecord records the operation (Create, Update or Delete)
+// changing before into after with db, in the history of audited types and
+// in the outbox of the outbox ones. It returns ret and the error on failure.
+func (b *ORMBuilder) generateChangeRecord(ormable *OrmableType, operation, db, before, after, ret string, g *protogen.GeneratedFile) {
+	if ormable.Audited {
+		g.P(`if err = record`, ormable.OriginName, `History(ctx, `, db, `, `, generateImport(operation, auditImport, g), `, `, before, `, `, after, `); err != nil {`)
+		g.P(`return `, ret, `err`)
+		g.P(`}`)
+	}
+	if ormable.Outbox {
+		eventType := ormable.OriginName + map[string]string{"Create": "Created", "Update": "Updated", "Delete": "Deleted"}[operation]
+		g.P(`if err = record`, ormable.OriginName, `Event(ctx, `, db, `, "`, eventType, `", `, before, `, `, after, `); err != nil {`)
+		g.P(`return `, ret, `err`)
+		g.P(`}`)
+	}
+}
+
+func (b *ORMBuilder) generateListHistoryHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	if !ormable.Audited {
+		return
+	}
+
+	g.P(`// DefaultList`, typeName, `History returns the history of the object, oldest change first`)
+	b.generateHandlerSignature(typeName, `DefaultList`+typeName+`History`, fmt.Sprint(`ctx context.Context, in *`,
+		typeName, `, db *`, generateImport("DB", gormImport, g)), fmt.Sprint(`([]*`, typeName, `HistoryORM, error)`), g)
+	g.P(`if in == nil {`)
+	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	pkName, pk := b.findPrimaryKey(ormable)
+	if strings.Contains(pk.Type, "*") {
+		g.P(`if ormObj.`, pkName, ` == nil || *ormObj.`, pkName, ` == `, b.guessZeroValue(pk.Type, g), ` {`)
+	} else {
+		g.P(`if ormObj.`, pkName, ` == `, b.guessZeroValue(pk.Type, g), ` {`)
+	}
+	g.P(`return nil, `, generateImport("EmptyIdError", gerrorsImport, g))
+	g.P(`}`)
+	if tenant := getTenant(message); tenant != nil {
+		b.generateTenantID(message, "nil, ", g)
+		g.P(`if isolated {`)
+		g.P(`db = db.Where(map[string]interface{}{"`, tenant.Column, `": tenantID})`)
+		g.P(`}`)
+	}
+	g.P(`history := []*`, typeName, `HistoryORM{}`)
+	g.P(`if err := db.Where("object_id = ?", ormObj.`, pkName, `).Order("changed_at, id").Find(&history).Error; err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`return history, nil`)
+	g.P(`}`)
+}
+
 func (b *ORMBuilder) generateBeforeDeleteHookCall(orm *OrmableType, g *protogen.GeneratedFile) {
 	g.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithBeforeDelete_); ok {`)
 	g.P(`if db, err = hook.BeforeDelete_(ctx, db); err != nil {`)
@@ -1835,8 +3505,8 @@ func (b *ORMBuilder) generateDeleteSetHandler(message *protogen.Message, g *prot
 	typeName := string(message.Desc.Name())
 	gormDB := generateImport("DB", gormImport, g)
 
-	g.P(`func DefaultDelete`, typeName, `Set(ctx context.Context, in []*`,
-		typeName, `, db *`, gormDB, `) error {`)
+	b.generateHandlerSignature(typeName, `DefaultDelete`+typeName+`Set`, fmt.Sprint(`ctx context.Context, in []*`,
+		typeName, `, db *`, gormDB), `error`, g)
 	g.P(`if in == nil {`)
 	g.P(`return `, generateImport("NilArgumentError", gerrorsImport, g))
 	g.P(`}`)
@@ -1844,6 +3514,9 @@ func (b *ORMBuilder) generateDeleteSetHandler(message *protogen.Message, g *prot
 	ormable := b.getOrmable(typeName)
 	pkName, pk := b.findPrimaryKey(ormable)
 	g.P(`keys := []`, pk.Type, `{}`)
+	if ormable.Cached {
+		g.P(`cacheKeys := []string{}`)
+	}
 	g.P(`for _, obj := range in {`)
 	g.P(`ormObj, err := obj.ToORM(ctx)`)
 	g.P(`if err != nil {`)
@@ -1857,20 +3530,29 @@ func (b *ORMBuilder) generateDeleteSetHandler(message *protogen.Message, g *prot
 	g.P(`return `, generateImport("EmptyIdError", gerrorsImport, g))
 	g.P(`}`)
 	g.P(`keys = append(keys, ormObj.`, pkName, `)`)
+	if ormable.Cached {
+		g.P(`cacheKeys = append(cacheKeys, ormObj.cacheKey())`)
+	}
 	g.P(`}`)
 	b.generateBeforeDeleteSetHookCall(ormable, g)
-	if getMessageOptions(message).GetMultiAccount() {
-		g.P(`acctId, err := `, generateImport("GetAccountID", authImport, g), `(ctx, nil)`)
-		g.P(`if err != nil {`)
+	if tenant := getTenant(message); tenant != nil {
+		b.generateTenantID(message, "", g)
+		g.P(`if isolated {`)
+		g.P(`db = db.Where("`, tenant.Column, ` = ?", tenantID)`)
+		g.P(`}`)
+	}
+	if ormable.recordsChanges() {
+		g.P(`deleted := []*`, ormable.Name, `{}`)
+		g.P(`if err = db.Set("gorm:query_option", "FOR UPDATE").Where("`, jgorm.ToDBName(pkName), ` in (?)", keys).Find(&deleted).Error; err != nil {`)
 		g.P(`return err`)
 		g.P(`}`)
-		g.P(`err = db.Where("account_id = ? AND `, jgorm.ToDBName(pkName), ` in (?)", acctId, keys).Delete(&`, ormable.Name, `{}).Error`)
-	} else {
-		g.P(`err = db.Where("`, jgorm.ToDBName(pkName), ` in (?)", keys).Delete(&`, ormable.Name, `{}).Error`)
 	}
+	g.P(`err = db.Where("`, jgorm.ToDBName(pkName), ` in (?)", keys).Delete(&`, ormable.Name, `{}).Error`)
 	g.P(`if err != nil {`)
 	g.P(`return err`)
 	g.P(`}`)
+	b.generateDeletedRecords(ormable, g)
+	b.generateCacheInvalidation(ormable, "cacheKeys...", "", g)
 	b.generateAfterDeleteSetHookCall(ormable, g)
 	g.P(`return err`)
 	g.P(`}`)
@@ -1901,22 +3583,30 @@ func (b *ORMBuilder) generateStrictUpdateHandler(message *protogen.Message, g *p
 	typeName := string(message.Desc.Name())
 
 	g.P(`// DefaultStrictUpdate`, typeName, ` clears / replaces / appends first level 1:many children and then executes a gorm update call`)
-	g.P(`func DefaultStrictUpdate`, typeName, `(ctx context.Context, in *`,
-		typeName, `, db *`, generateImport("DB", gormImport, g), `) (*`, typeName, `, error) {`)
+	b.generateHandlerSignature(typeName, `DefaultStrictUpdate`+typeName, fmt.Sprint(`ctx context.Context, in *`,
+		typeName, `, db *`, generateImport("DB", gormImport, g)), fmt.Sprint(`(*`, typeName, `, error)`), g)
 	g.P(`if in == nil {`)
 	g.P(`return nil, fmt.Errorf("Nil argument to DefaultStrictUpdate`, typeName, `")`)
 	g.P(`}`)
+	g.P(`if err := in.Validate(); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
 	g.P(`ormObj, err := in.ToORM(ctx)`)
 	g.P(`if err != nil {`)
 	g.P(`return nil, err`)
 	g.P(`}`)
 
-	if getMessageOptions(message).GetMultiAccount() {
-		b.generateAccountIdWhereClause(g)
+	if tenant := getTenant(message); tenant != nil {
+		b.generateTenantID(message, "nil, ", g)
+		g.P(`if isolated {`)
+		g.P(`db = db.Where(map[string]interface{}{"`, tenant.Column, `": tenantID})`)
+		g.P(`}`)
 	}
 
 	ormable := b.getOrmable(typeName)
-	if b.gateway {
+	outputOnly, immutable := b.getProtectedFields(message)
+	protected := append(outputOnly, immutable...)
+	if b.gateway || len(protected) > 0 || ormable.recordsChanges() {
 		g.P(`var count int64`)
 	}
 
@@ -1929,11 +3619,28 @@ func (b *ORMBuilder) generateStrictUpdateHandler(message *protogen.Message, g *p
 		g.P(`lockedRow := &`, typeName, `ORM{}`)
 		var count string
 		var rowsAffected string
-		if b.gateway {
+		if b.gateway || len(protected) > 0 || ormable.recordsChanges() {
 			count = `count = `
 			rowsAffected = `.RowsAffected`
 		}
 		g.P(count+`db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("`, column, `=?", ormObj.`, pkName, `).First(lockedRow)`+rowsAffected)
+		if len(protected) > 0 {
+			g.P(`if count > 0 {`)
+			g.P(`// output only and immutable fields keep their stored values`)
+			for _, fieldName := range protected {
+				if fieldName != pkName {
+					g.P(`ormObj.`, fieldName, ` = lockedRow.`, fieldName)
+				}
+			}
+			if len(outputOnly) > 0 {
+				g.P(`} else {`)
+				g.P(`blank := `, ormable.Name, `{}`)
+				for _, fieldName := range outputOnly {
+					g.P(`ormObj.`, fieldName, ` = blank.`, fieldName)
+				}
+			}
+			g.P(`}`)
+		}
 	}
 	b.generateBeforeHookCall(ormable, "StrictUpdateCleanup", g)
 	b.handleChildAssociations(message, g)
@@ -1941,6 +3648,14 @@ func (b *ORMBuilder) generateStrictUpdateHandler(message *protogen.Message, g *p
 	g.P(`if err = db.Save(&ormObj).Error; err != nil {`)
 	g.P(`return nil, err`)
 	g.P(`}`)
+	if ormable.recordsChanges() {
+		g.P(`if count > 0 {`)
+		b.generateChangeRecord(ormable, "Update", "db", "lockedRow", "&ormObj", "nil, ", g)
+		g.P(`} else {`)
+		b.generateChangeRecord(ormable, "Create", "db", "nil", "&ormObj", "nil, ", g)
+		g.P(`}`)
+	}
+	b.generateCacheInvalidation(ormable, "ormObj.cacheKey()", "nil, ", g)
 	b.generateAfterHookCall(ormable, "StrictUpdateSave", g)
 	g.P(`pbResponse, err := ormObj.ToPB(ctx)`)
 	g.P(`if err != nil {`)
@@ -1961,12 +3676,23 @@ func (b *ORMBuilder) generateStrictUpdateHandler(message *protogen.Message, g *p
 	b.generateAfterHookDef(ormable, "StrictUpdateSave", g)
 }
 
-func (b *ORMBuilder) generateAccountIdWhereClause(g *protogen.GeneratedFile) {
-	g.P(`accountID, err := `, generateImport("GetAccountID", authImport, g), `(ctx, nil)`)
+// generateTenantID resolves the tenant of the request into tenantID,
+// isolated is false when the request bypasses tenant isolation
+func (b *ORMBuilder) generateTenantID(message *protogen.Message, ret string, g *protogen.GeneratedFile) {
+	g.P(`tenantID, isolated, err := `, b.tenantFunc("ID", g), `(ctx, "`, string(message.Desc.Name()), `")`)
 	g.P(`if err != nil {`)
-	g.P(`return nil, err`)
+	g.P(`return `, ret, `err`)
 	g.P(`}`)
-	g.P(`db = db.Where(map[string]interface{}{"account_id": accountID})`)
+}
+
+// tenantFunc returns the runtime/tenant function name. With the atlas runtime
+// the file also imports the resolver reading the account id of the toolkit.
+func (b *ORMBuilder) tenantFunc(name string, g *protogen.GeneratedFile) string {
+	if !b.nativeRuntime {
+		g.Import(protogen.GoImportPath(tenantAtlasImport))
+	}
+
+	return generateImport(name, tenantImport, g)
 }
 
 func (b *ORMBuilder) handleChildAssociations(message *protogen.Message, g *protogen.GeneratedFile) {
@@ -2100,65 +3826,291 @@ func (b *ORMBuilder) generatePatchHandler(message *protogen.Message, g *protogen
 	typeName := string(message.Desc.Name())
 	ormable := b.getOrmable(typeName)
 
-	if getMessageOptions(message).GetMultiAccount() {
-		isMultiAccount = true
+	if getTenant(message) != nil {
+		isMultiAccount = true
+	}
+
+	if isMultiAccount && !b.hasIDField(message) {
+		g.P(fmt.Sprintf("// Cannot autogen DefaultPatch%s: this is a multi-account table without an \"id\" field in the message.\n", typeName))
+		return
+	}
+
+	g.P(`// DefaultPatch`, typeName, ` executes a basic gorm update call with patch behavior`)
+	b.generateHandlerSignature(typeName, `DefaultPatch`+typeName, fmt.Sprint(`ctx context.Context, in *`,
+		typeName, `, updateMask *`, generateImport("FieldMask", fmImport, g), `, db *`, generateImport("DB", gormImport, g)), fmt.Sprint(`(*`, typeName, `, error)`), g)
+
+	g.P(`if in == nil {`)
+	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`var pbObj `, typeName)
+	g.P(`var err error`)
+	b.generateBeforePatchHookCall(ormable, "Read", g)
+
+	// TODO: not in original code, but it don't make a lot of sense to generate code with id if message doesn't have it
+	if b.hasIDField(message) {
+		if b.readHasFieldSelection(ormable) {
+			g.P(`pbReadRes, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db, nil)`)
+		} else {
+			g.P(`pbReadRes, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db)`)
+		}
+
+		g.P(`if err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		g.P(`pbObj = *pbReadRes`)
+	}
+
+	b.generateBeforePatchHookCall(ormable, "ApplyFieldMask", g)
+	g.P(`if _, err := DefaultApplyFieldMask`, typeName, `(ctx, &pbObj, in, updateMask, "", db); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+
+	// the patched object is validated by DefaultStrictUpdate
+	b.generateBeforePatchHookCall(ormable, "Save", g)
+	g.P(`pbResponse, err := DefaultStrictUpdate`, typeName, `(ctx, &pbObj, db)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	b.generateAfterPatchHookCall(ormable, "Save", g)
+
+	g.P(`return pbResponse, nil`)
+	g.P(`}`)
+
+	b.generateBeforePatchHookDef(ormable, "Read", g)
+	b.generateBeforePatchHookDef(ormable, "ApplyFieldMask", g)
+	b.generateBeforePatchHookDef(ormable, "Save", g)
+	b.generateAfterPatchHookDef(ormable, "Save", g)
+
+}
+
+func (b *ORMBuilder) hasIDField(message *protogen.Message) bool {
+	for _, field := range message.Fields {
+		if strings.ToLower(field.GoName) == "id" { // TODO: not sure
+			return true
+		}
+	}
+
+	return false
+}
+
+// getProtectedFields returns the ORM names of the output only and immutable
+// fields of a message, associations are not taken into account
+func (b *ORMBuilder) getProtectedFields(message *protogen.Message) (outputOnly []string, immutable []string) {
+	ormable := b.getOrmable(string(message.Desc.Name()))
+	for _, field := range message.Fields {
+		fieldName := camelCase(string(field.Desc.Name()))
+		if _, ok := ormable.Fields[fieldName]; !ok {
+			continue
+		}
+		if field.Message != nil && b.isOrmable(getFieldType(field)) {
+			continue
+		}
+
+		isOutputOnly, isImmutable := getFieldBehavior(field)
+		if isOutputOnly {
+			outputOnly = append(outputOnly, fieldName)
+		} else if isImmutable {
+			immutable = append(immutable, fieldName)
+		}
+	}
+
+	return outputOnly, immutable
+}
+
+func (b *ORMBuilder) generateUpsertHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	targets := b.getConflictTargets(ormable)
+	if len(targets) == 0 {
+		return
+	}
+
+	var names []string
+	for name := range targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	g.P(`// `, typeName, `ConflictTargets maps the conflict targets accepted by DefaultUpsert`, typeName, ` to their columns,`)
+	g.P(`// the primary key and unique fields are named by column and unique indexes by index name`)
+	g.P(`var `, typeName, `ConflictTargets = map[string][]string{`)
+	for _, name := range names {
+		g.P(`"`, name, `": {"`, strings.Join(targets[name], `", "`), `"},`)
+	}
+	g.P(`}`)
+	g.P()
+
+	outputOnly, immutable := b.getProtectedFields(message)
+	var keep []string
+	for _, fieldName := range append(outputOnly, immutable...) {
+		keep = append(keep, ormColumnName(fieldName, ormable.Fields[fieldName]))
 	}
-
-	if isMultiAccount && !b.hasIDField(message) {
-		g.P(fmt.Sprintf("// Cannot autogen DefaultPatch%s: this is a multi-account table without an \"id\" field in the message.\n", typeName))
-		return
+	tenant := getTenant(message)
+	pkName := ""
+	if b.hasPrimaryKey(ormable) {
+		pkName, _ = b.findPrimaryKey(ormable)
 	}
 
-	g.P(`// DefaultPatch`, typeName, ` executes a basic gorm update call with patch behavior`)
-	g.P(`func DefaultPatch`, typeName, `(ctx context.Context, in *`,
-		typeName, `, updateMask *`, generateImport("FieldMask", fmImport, g), `, db *`, generateImport("DB", gormImport, g), `) (*`, typeName, `, error) {`)
-
+	g.P(`// DefaultUpsert`, typeName, ` inserts the object or updates the row conflicting with it on the target`)
+	g.P(`// unique constraint, a non nil updateMask limits the fields overwritten`)
+	b.generateHandlerSignature(typeName, `DefaultUpsert`+typeName, fmt.Sprint(`ctx context.Context, in *`, typeName, `, target string, updateMask *`,
+		generateImport("FieldMask", fmImport, g), `, db *`, generateImport("DB", gormImport, g)), fmt.Sprint(`(*`, typeName, `, error)`), g)
 	g.P(`if in == nil {`)
 	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
 	g.P(`}`)
-	g.P(`var pbObj `, typeName)
-	g.P(`var err error`)
-	b.generateBeforePatchHookCall(ormable, "Read", g)
-
-	// TODO: not in original code, but it don't make a lot of sense to generate code with id if message doesn't have it
-	if b.hasIDField(message) {
-		if b.readHasFieldSelection(ormable) {
-			g.P(`pbReadRes, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db, nil)`)
-		} else {
-			g.P(`pbReadRes, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db)`)
+	g.P(`if err := in.Validate(); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`conflict := `, generateImport("OnConflict", insertImport, g), `{`)
+	g.P(`Columns: `, typeName, `ConflictTargets[target],`)
+	g.P(`UpdateAll: updateMask == nil,`)
+	if len(keep) > 0 {
+		g.P(`Keep: []string{"`, strings.Join(keep, `", "`), `"},`)
+	}
+	if tenant != nil {
+		g.P(`Scope: "`, tenant.Column, `",`)
+	}
+	g.P(`}`)
+	g.P(`if len(conflict.Columns) == 0 {`)
+	g.P(`return nil, `, generateImport("Errorf", stdFmtImport, g), `("unknown conflict target %q for `, typeName, `", target)`)
+	g.P(`}`)
+	g.P(`if updateMask != nil {`)
+	g.P(`columns, err := upsert`, typeName, `Columns(updateMask)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`conflict.Update = columns`)
+	g.P(`}`)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	if len(outputOnly) > 0 {
+		g.P(`// output only fields are managed by the server`)
+		g.P(`blank := `, ormable.Name, `{}`)
+		for _, fieldName := range outputOnly {
+			g.P(`ormObj.`, fieldName, ` = blank.`, fieldName)
 		}
-
+	}
+	b.generateBeforeHookCall(ormable, upsertService, g)
+	records := ormable.recordsChanges() && pkName != ""
+	if records {
+		g.P(`lockedRow := &`, ormable.Name, `{}`)
+		g.P(`locked, err := `, generateImport("LockConflicting", insertImport, g), `(db, &ormObj, conflict.Columns, lockedRow)`)
 		g.P(`if err != nil {`)
 		g.P(`return nil, err`)
 		g.P(`}`)
-		g.P(`pbObj = *pbReadRes`)
 	}
-
-	b.generateBeforePatchHookCall(ormable, "ApplyFieldMask", g)
-	g.P(`if _, err := DefaultApplyFieldMask`, typeName, `(ctx, &pbObj, in, updateMask, "", db); err != nil {`)
+	g.P(`if err = `, generateImport("Upsert", insertImport, g), `(db, &ormObj, conflict); err != nil {`)
 	g.P(`return nil, err`)
 	g.P(`}`)
-
-	b.generateBeforePatchHookCall(ormable, "Save", g)
-	g.P(`pbResponse, err := DefaultStrictUpdate`, typeName, `(ctx, &pbObj, db)`)
-	g.P(`if err != nil {`)
-	g.P(`return nil, err`)
+	if records {
+		_, pk := b.findPrimaryKey(ormable)
+		g.P(`if locked {`)
+		g.P(`// the update mask and the kept columns leave stored values in the row`)
+		g.P(`storedRow := &`, ormable.Name, `{}`)
+		g.P(`if err = db.Where("`, ormColumnName(pkName, pk), ` = ?", ormObj.`, pkName, `).First(storedRow).Error; err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		b.generateChangeRecord(ormable, "Update", "db", "lockedRow", "storedRow", "nil, ", g)
+		g.P(`} else {`)
+		b.generateChangeRecord(ormable, "Create", "db", "nil", "&ormObj", "nil, ", g)
+		g.P(`}`)
+	}
+	b.generateCacheInvalidation(ormable, "ormObj.cacheKey()", "nil, ", g)
+	b.generateAfterHookCall(ormable, upsertService, g)
+	g.P(`pbResponse, err := ormObj.ToPB(ctx)`)
+	g.P(`return &pbResponse, err`)
 	g.P(`}`)
-	b.generateAfterPatchHookCall(ormable, "Save", g)
+	b.generateBeforeHookDef(ormable, upsertService, g)
+	b.generateAfterHookDef(ormable, upsertService, g)
+	g.P()
 
-	g.P(`return pbResponse, nil`)
+	g.P(`// upsert`, typeName, `Columns returns the columns of the fields of updateMask the upserts of`)
+	g.P(`// `, typeName, ` overwrite, the immutable fields and the paths of no column being rejected`)
+	g.P(`func upsert`, typeName, `Columns(updateMask *`, generateImport("FieldMask", fmImport, g), `) ([]string, error) {`)
+	g.P(`var columns []string`)
+	g.P(`for _, f := range updateMask.GetPaths() {`)
+	g.P(`switch f {`)
+	var kept []string
+	for _, field := range message.Fields {
+		fieldName := camelCase(string(field.Desc.Name()))
+		ormField, ok := ormable.Fields[fieldName]
+		if !ok || !isOrmColumn(ormField) {
+			continue
+		}
+		if fieldName == pkName || inList(fieldName, outputOnly) || (tenant != nil && fieldName == tenant.Name) {
+			kept = append(kept, fieldName)
+			continue
+		}
+		g.P(`case "`, fieldName, `":`)
+		if inList(fieldName, immutable) {
+			g.P(`return nil, `, generateImport("Errorf", grpcStatusImport, g), `(`, generateImport("InvalidArgument", grpcCodesImport, g), `, "field %q is immutable", f)`)
+		} else {
+			g.P(`columns = append(columns, "`, ormColumnName(fieldName, ormField), `")`)
+		}
+	}
+	if len(kept) > 0 {
+		g.P(`// the primary key, the tenant and the output only fields keep their stored values`)
+		g.P(`case "`, strings.Join(kept, `", "`), `":`)
+	}
+	g.P(`default:`)
+	g.P(`return nil, `, generateImport("Errorf", grpcStatusImport, g), `(`, generateImport("InvalidArgument", grpcCodesImport, g), `, "unknown field %q", f)`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`return columns, nil`)
 	g.P(`}`)
+}
 
-	b.generateBeforePatchHookDef(ormable, "Read", g)
-	b.generateBeforePatchHookDef(ormable, "ApplyFieldMask", g)
-	b.generateBeforePatchHookDef(ormable, "Save", g)
-	b.generateAfterPatchHookDef(ormable, "Save", g)
+// getConflictTargets returns the columns of the primary key, unique fields
+// and unique indexes of the ormable type by conflict target name.
+func (b *ORMBuilder) getConflictTargets(ormable *OrmableType) map[string][]string {
+	var names []string
+	for name := range ormable.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	targets := make(map[string][]string)
+	if b.hasPrimaryKey(ormable) {
+		pkName, pk := b.findPrimaryKey(ormable)
+		column := ormColumnName(pkName, pk)
+		targets[column] = []string{column}
+	}
+	for _, name := range names {
+		field := ormable.Fields[name]
+		if !isOrmColumn(field) {
+			continue
+		}
+		column := ormColumnName(name, field)
+		if field.GetTag().GetUnique() {
+			targets[column] = []string{column}
+		}
+		for _, index := range strings.Split(field.GetTag().GetUniqueIndex(), ",") {
+			if index = strings.TrimSpace(index); index != "" {
+				targets[index] = append(targets[index], column)
+			}
+		}
+	}
 
+	return targets
 }
 
-func (b *ORMBuilder) hasIDField(message *protogen.Message) bool {
-	for _, field := range message.Fields {
-		if strings.ToLower(field.GoName) == "id" { // TODO: not sure
+func ormColumnName(fieldName string, field *Field) string {
+	if column := field.GetTag().GetColumn(); column != "" {
+		return column
+	}
+
+	return jgorm.ToDBName(fieldName)
+}
+
+func isOrmColumn(field *Field) bool {
+	return field.GetAssociation() == nil && !field.GetTag().GetIgnore()
+}
+
+func inList(s string, list []string) bool {
+	for _, item := range list {
+		if item == s {
 			return true
 		}
 	}
@@ -2200,7 +4152,7 @@ func (b *ORMBuilder) generatePatchSetHandler(message *protogen.Message, g *proto
 	var isMultiAccount bool
 
 	typeName := string(message.Desc.Name())
-	if getMessageOptions(message).GetMultiAccount() {
+	if getTenant(message) != nil {
 		isMultiAccount = true
 	}
 
@@ -2211,8 +4163,8 @@ func (b *ORMBuilder) generatePatchSetHandler(message *protogen.Message, g *proto
 
 	_ = generateImport("", "fmt", g)
 	g.P(`// DefaultPatchSet`, typeName, ` executes a bulk gorm update call with patch behavior`)
-	g.P(`func DefaultPatchSet`, typeName, `(ctx context.Context, objects []*`,
-		typeName, `, updateMasks []*`, generateImport("FieldMask", fmImport, g), `, db *`, generateImport("DB", gormImport, g), `) ([]*`, typeName, `, error) {`)
+	b.generateHandlerSignature(typeName, `DefaultPatchSet`+typeName, fmt.Sprint(`ctx context.Context, objects []*`,
+		typeName, `, updateMasks []*`, generateImport("FieldMask", fmImport, g), `, db *`, generateImport("DB", gormImport, g)), fmt.Sprint(`([]*`, typeName, `, error)`), g)
 	g.P(`if len(objects) != len(updateMasks) {`)
 	g.P(`return nil, fmt.Errorf(`, generateImport("BadRepeatedFieldMaskTpl", gerrorsImport, g), `, len(updateMasks), len(objects))`)
 	g.P(`}`)
@@ -2235,9 +4187,9 @@ func (b *ORMBuilder) generatePatchSetHandler(message *protogen.Message, g *proto
 func (b *ORMBuilder) generateApplyFieldMask(message *protogen.Message, g *protogen.GeneratedFile) {
 	typeName := string(message.Desc.Name())
 	g.P(`// DefaultApplyFieldMask`, typeName, ` patches an pbObject with patcher according to a field mask.`)
-	g.P(`func DefaultApplyFieldMask`, typeName, `(ctx context.Context, patchee *`,
+	b.generateHandlerSignature(typeName, `DefaultApplyFieldMask`+typeName, fmt.Sprint(`ctx context.Context, patchee *`,
 		typeName, `, patcher *`, typeName, `, updateMask *`, generateImport("FieldMask", fmImport, g),
-		`, prefix string, db *`, generateImport("DB", gormImport, g), `) (*`, typeName, `, error) {`)
+		`, prefix string, db *`, generateImport("DB", gormImport, g)), fmt.Sprint(`(*`, typeName, `, error)`), g)
 
 	g.P(`if patcher == nil {`)
 	g.P(`return nil, nil`)
@@ -2249,6 +4201,9 @@ func (b *ORMBuilder) generateApplyFieldMask(message *protogen.Message, g *protog
 	hasNested := false
 	for _, field := range message.Fields {
 		fieldType := getFieldType(field)
+		if outputOnly, immutable := getFieldBehavior(field); outputOnly || immutable {
+			continue
+		}
 
 		if field.Message != nil && !isSpecialType(fieldType) && field.Desc.Cardinality() != protoreflect.Repeated {
 			g.P(`var updated`, camelCase(field.GoName), ` bool`)
@@ -2268,6 +4223,18 @@ func (b *ORMBuilder) generateApplyFieldMask(message *protogen.Message, g *protog
 		ccName := camelCase(field.GoName)
 
 		fieldType := getFieldType(field)
+		if outputOnly, immutable := getFieldBehavior(field); outputOnly || immutable {
+			_ = generateImport("", stdStringsImport, g)
+			g.P(`if f == prefix+"`, ccName, `" || strings.HasPrefix(f, prefix+"`, ccName, `.") {`)
+			if outputOnly {
+				g.P(`// output only fields are ignored`)
+				g.P(`continue`)
+			} else {
+				g.P(`return nil, `, generateImport("Errorf", grpcStatusImport, g), `(`, generateImport("InvalidArgument", grpcCodesImport, g), `, "field %q is immutable", f)`)
+			}
+			g.P(`}`)
+			continue
+		}
 		//  for ormable message, do recursive patching
 		if field.Message != nil && b.isOrmable(fieldType) && field.Desc.Cardinality() != protoreflect.Repeated {
 			if field.Message != nil {
@@ -2320,7 +4287,11 @@ func (b *ORMBuilder) generateApplyFieldMask(message *protogen.Message, g *protog
 			g.P(`childMask.Paths = append(childMask.Paths, trimPath)`)
 			g.P(`}`)
 			g.P(`}`)
-			g.P(`if err := `, generateImport("MergeWithMask", tkgormImport, g), `(patcher.`, ccName, `, patchee.`, ccName, `, childMask); err != nil {`)
+			mergeImport := tkgormImport
+			if b.nativeRuntime {
+				mergeImport = collectionImport
+			}
+			g.P(`if err := `, generateImport("MergeWithMask", mergeImport, g), `(patcher.`, ccName, `, patchee.`, ccName, `, childMask); err != nil {`)
 			g.P(`return nil, nil`)
 			g.P(`}`)
 			g.P(`}`)
@@ -2366,7 +4337,7 @@ func (b *ORMBuilder) generateListHandler(message *protogen.Message, g *protogen.
 	ormable := b.getOrmable(typeName)
 
 	g.P(`// DefaultList`, typeName, ` executes a gorm list call`)
-	listSign := fmt.Sprint(`func DefaultList`, typeName, `(ctx context.Context, db *`, generateImport("DB", gormImport, g))
+	listSign := fmt.Sprint(`ctx context.Context, db *`, generateImport("DB", gormImport, g))
 	var f, s, pg, fs string
 	if b.listHasFiltering(ormable) {
 		listSign += fmt.Sprint(`, f `, `*`, generateImport("Filtering", queryImport, g))
@@ -2392,23 +4363,39 @@ func (b *ORMBuilder) generateListHandler(message *protogen.Message, g *protogen.
 	} else {
 		fs = "nil"
 	}
-	listSign += fmt.Sprint(`) ([]*`, typeName, `, error) {`)
-	g.P(listSign)
+	b.generateHandlerSignature(typeName, `DefaultList`+typeName, listSign, fmt.Sprint(`([]*`, typeName, `, error)`), g)
 	g.P(`in := `, typeName, `{}`)
 	g.P(`ormObj, err := in.ToORM(ctx)`)
 	g.P(`if err != nil {`)
 	g.P(`return nil, err`)
 	g.P(`}`)
 	b.generateBeforeListHookCall(ormable, "ApplyQuery", g)
-	g.P(`db, err = `, generateImport("ApplyCollectionOperators", tkgormImport, g), `(ctx, db, &`, ormable.Name, `{}, &`, typeName, `{}, `, f, `,`, s, `,`, pg, `,`, fs, `)`)
+	keyset := b.listHasKeysetPagination(ormable)
+	if keyset {
+		// sorting and pagination are applied by the keyset
+		g.P(`db, err = `, b.collectionOperators(message, f, "nil", "nil", fs, g))
+	} else {
+		g.P(`db, err = `, b.collectionOperators(message, f, s, pg, fs, g))
+	}
 	g.P(`if err != nil {`)
 	g.P(`return nil, err`)
 	g.P(`}`)
 	b.generateBeforeListHookCall(ormable, "Find", g)
 	g.P(`db = db.Where(&ormObj)`)
 
-	// add default ordering by primary key
-	if b.hasPrimaryKey(ormable) {
+	if keyset {
+		g.P(`keyset, err := `, generateImport("NewKeyset", pagingImport, g), `(db, &`, ormable.Name, `{}, `, s, `, `, f, `)`)
+		g.P(`if err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		g.P(`if db, err = keyset.Apply(db, `, pg, `.GetPageToken()); err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		g.P(`if `, pg, `.GetLimit() > 0 {`)
+		g.P(`db = db.Limit(`, pg, `.GetLimit())`)
+		g.P(`}`)
+	} else if b.hasPrimaryKey(ormable) {
+		// add default ordering by primary key
 		pkName, pk := b.findPrimaryKey(ormable)
 		column := pk.GetTag().GetColumn()
 		if len(column) == 0 {
@@ -2437,24 +4424,181 @@ func (b *ORMBuilder) generateListHandler(message *protogen.Message, g *protogen.
 	b.generateAfterListHookDef(ormable, g)
 }
 
+func (b *ORMBuilder) generateCountHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	gormDB := generateImport("DB", gormImport, g)
+
+	var f, hookArg string
+	if b.listHasFiltering(ormable) {
+		f = fmt.Sprint(`, f *`, generateImport("Filtering", queryImport, g))
+		hookArg = fmt.Sprint(`, *`, generateImport("Filtering", queryImport, g))
+	}
+	g.P(`// DefaultCount`, typeName, ` returns the number of rows DefaultList`, typeName, ` pages through`)
+	b.generateHandlerSignature(typeName, `DefaultCount`+typeName, fmt.Sprint(`ctx context.Context, db *`, gormDB, f, `, strategy `,
+		generateImport("CountStrategy", pagingImport, g)), `(int64, error)`, g)
+	g.P(`in := `, typeName, `{}`)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return 0, err`)
+	g.P(`}`)
+	g.P(`if hook, ok := interface{}(&ormObj).(`, ormable.Name, `WithBeforeCount); ok {`)
+	if f != "" {
+		g.P(`if db, err = hook.BeforeCount(ctx, db, f); err != nil {`)
+	} else {
+		g.P(`if db, err = hook.BeforeCount(ctx, db); err != nil {`)
+	}
+	g.P(`return 0, err`)
+	g.P(`}`)
+	g.P(`}`)
+	// the list hooks scope the count like the list, without its sorting,
+	// pagination and field selection
+	b.generateBeforeListHookCallWith(ormable, "ApplyQuery", "0", "nil", "nil", "nil", g)
+	if f != "" {
+		if b.nativeRuntime {
+			g.P(`db, err = `, generateImport("ApplyFiltering", collectionImport, g), `(db, &`, ormable.Name, `{}, f)`)
+		} else {
+			g.P(`db, err = `, generateImport("ApplyCollectionOperators", tkgormImport, g), `(ctx, db, &`, ormable.Name, `{}, &`, typeName, `{}, f, nil, nil, nil)`)
+		}
+		g.P(`if err != nil {`)
+		g.P(`return 0, err`)
+		g.P(`}`)
+	}
+	b.generateBeforeListHookCallWith(ormable, "Find", "0", "nil", "nil", "nil", g)
+	g.P(`return `, generateImport("Count", pagingImport, g), `(db.Where(&ormObj), &`, ormable.Name, `{}, strategy)`)
+	g.P(`}`)
+	g.P(`type `, ormable.Name, `WithBeforeCount interface {`)
+	g.P(`BeforeCount(context.Context, *`, gormDB, hookArg, `) (*`, gormDB, `, error)`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateStreamHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	stream, ok := ormable.Methods[streamService]
+	if !ok || !stream.followsConvention {
+		return
+	}
+
+	gormDB := generateImport("DB", gormImport, g)
+	var params, args, hookParams string
+	f, s, pg := "nil", "nil", "nil"
+	if b.getFiltering(stream.inType) != "" {
+		params += fmt.Sprint(`, f *`, generateImport("Filtering", queryImport, g))
+		hookParams += fmt.Sprint(`, *`, generateImport("Filtering", queryImport, g))
+		args += `, f`
+		f = "f"
+	}
+	if b.getSorting(stream.inType) != "" {
+		params += fmt.Sprint(`, s *`, generateImport("Sorting", queryImport, g))
+		hookParams += fmt.Sprint(`, *`, generateImport("Sorting", queryImport, g))
+		args += `, s`
+		s = "s"
+	}
+	if b.getPagination(stream.inType) != "" {
+		params += fmt.Sprint(`, p *`, generateImport("Pagination", queryImport, g))
+		hookParams += fmt.Sprint(`, *`, generateImport("Pagination", queryImport, g))
+		args += `, p`
+		pg = "p"
+	}
+	fs := "nil"
+	if b.getFieldSelection(stream.inType) != "" {
+		params += fmt.Sprint(`, fs *`, generateImport("FieldSelection", queryImport, g))
+		hookParams += fmt.Sprint(`, *`, generateImport("FieldSelection", queryImport, g))
+		args += `, fs`
+		fs = "fs"
+	}
+
+	g.P(`// DefaultStream`, typeName, ` executes gorm list calls of preload.BatchSize rows and sends the rows one by`)
+	g.P(`// one. Each batch is read and its cursor closed before its associations are loaded, the drivers`)
+	g.P(`// not running a query while a cursor is open in a transaction, so that the rows written by`)
+	g.P(`// others between batches may be skipped or sent twice outside a repeatable read transaction`)
+	b.generateHandlerSignature(typeName, `DefaultStream`+typeName, fmt.Sprint(`ctx context.Context, db *`, gormDB, params, `, send func(*`, typeName, `) error`), `error`, g)
+	g.P(`in := `, typeName, `{}`)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`if hook, ok := interface{}(&ormObj).(`, ormable.Name, `WithBeforeStream); ok {`)
+	g.P(`if db, err = hook.BeforeStream(ctx, db`, args, `); err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`}`)
+	// the pagination is applied by the batches
+	g.P(`db, err = `, b.collectionOperators(message, f, s, "nil", fs, g))
+	g.P(`if err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`db = db.Where(&ormObj)`)
+	if b.hasPrimaryKey(ormable) {
+		pkName, pk := b.findPrimaryKey(ormable)
+		g.P(`db = db.Order("`, ormColumnName(pkName, pk), `")`)
+	}
+	if pg == "p" {
+		g.P(`offset, limit := int(p.GetOffset()), int(p.GetLimit())`)
+	} else {
+		g.P(`offset, limit := 0, 0`)
+	}
+	g.P(`for {`)
+	g.P(`if err := ctx.Err(); err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`size := `, generateImport("BatchSize", preloadImport, g))
+	g.P(`if limit > 0 && limit < size {`)
+	g.P(`size = limit`)
+	g.P(`}`)
+	g.P(`batch := make([]`, ormable.Name, `, 0, size)`)
+	g.P(`if err := db.Offset(offset).Limit(size).Find(&batch).Error; err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`for _, row := range batch {`)
+	g.P(`pbRow, err := row.ToPB(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`if err := send(&pbRow); err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`if len(batch) < size || limit == size {`)
+	g.P(`return nil`)
+	g.P(`}`)
+	g.P(`offset += size`)
+	g.P(`if limit > 0 {`)
+	g.P(`limit -= size`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`type `, ormable.Name, `WithBeforeStream interface {`)
+	g.P(`BeforeStream(context.Context, *`, gormDB, hookParams, `) (*`, gormDB, `, error)`)
+	g.P(`}`)
+}
+
 func (b *ORMBuilder) generateBeforeListHookCall(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
+	b.generateBeforeListHookCallWith(orm, suffix, "nil", "s", "p", "fs", g)
+}
+
+// generateBeforeListHookCallWith calls a BeforeList hook with the sorting,
+// pagination and field selection arguments s, p and fs, returning ret and
+// the error of the hook.
+func (b *ORMBuilder) generateBeforeListHookCallWith(orm *OrmableType, suffix, ret, s, p, fs string, g *protogen.GeneratedFile) {
 	g.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithBeforeList`, suffix, `); ok {`)
 	hookCall := fmt.Sprint(`if db, err = hook.BeforeList`, suffix, `(ctx, db`)
 	if b.listHasFiltering(orm) {
 		hookCall += `,f`
 	}
 	if b.listHasSorting(orm) {
-		hookCall += `,s`
+		hookCall += `,` + s
 	}
 	if b.listHasPagination(orm) {
-		hookCall += `,p`
+		hookCall += `,` + p
 	}
 	if b.listHasFieldSelection(orm) {
-		hookCall += `,fs`
+		hookCall += `,` + fs
 	}
 	hookCall += `); err != nil {`
 	g.P(hookCall)
-	g.P(`return nil, err`)
+	g.P(`return `, ret, `, err`)
 	g.P(`}`)
 	g.P(`}`)
 }
@@ -2558,6 +4702,16 @@ func (b *ORMBuilder) listHasPagination(ormable *OrmableType) bool {
 	return false
 }
 
+// listHasKeysetPagination reports whether the List method of the ormable
+// type pages with keyset pagination instead of offsets.
+func (b *ORMBuilder) listHasKeysetPagination(ormable *OrmableType) bool {
+	if list, ok := ormable.Methods[listService]; ok {
+		return b.listHasPagination(ormable) && b.hasPrimaryKey(ormable) && getMethodOptions(list.Method).GetKeysetPagination()
+	}
+
+	return false
+}
+
 func (b *ORMBuilder) listHasFieldSelection(ormable *OrmableType) bool {
 	if read, ok := ormable.Methods[listService]; ok {
 		if s := b.getFieldSelection(read.inType); s != "" {
@@ -2579,6 +4733,7 @@ func (b *ORMBuilder) parseServices(file *protogen.File) {
 		if opts := getServiceOptions(service); opts != nil {
 			genSvc.autogen = opts.GetAutogen()
 			genSvc.usesTxnMiddleware = opts.GetTxnMiddleware()
+			genSvc.transaction = opts.GetTransaction() && !genSvc.usesTxnMiddleware
 		}
 
 		if !genSvc.autogen {
@@ -2592,7 +4747,16 @@ func (b *ORMBuilder) parseServices(file *protogen.File) {
 			var verb, fmName, baseType string
 			var follows bool
 
-			if strings.HasPrefix(methodName, createService) {
+			if method.Desc.IsStreamingClient() || method.Desc.IsStreamingServer() {
+				// only server streaming List methods are generated
+				if !method.Desc.IsStreamingClient() && (strings.HasPrefix(methodName, listService) || strings.HasPrefix(methodName, streamService)) {
+					verb = streamService
+					follows, baseType = b.followsStreamConventions(input, output, methodName)
+				}
+			} else if strings.HasPrefix(methodName, createSetService) {
+				verb = createSetService
+				follows, baseType = b.followsCreateSetConventions(input, output, createSetService)
+			} else if strings.HasPrefix(methodName, createService) {
 				verb = createService
 				follows, baseType = b.followsCreateConventions(input, output, createService)
 			} else if strings.HasPrefix(methodName, readService) {
@@ -2613,6 +4777,9 @@ func (b *ORMBuilder) parseServices(file *protogen.File) {
 			} else if strings.HasPrefix(methodName, listService) {
 				verb = listService
 				follows, baseType = b.followsListConventions(input, output, listService)
+			} else if strings.HasPrefix(methodName, upsertService) {
+				verb = upsertService
+				follows, baseType, fmName = b.followsUpsertConventions(input, output, method)
 			}
 
 			genMethod := autogenMethod{
@@ -2649,23 +4816,114 @@ func (b *ORMBuilder) followsCreateConventions(inType *protogen.Message, outType
 			}
 		}
 	}
-	if !typeOrmable {
-		fmt.Fprintf(os.Stderr, "stub will be generated for %s since %s incoming message doesn't have \"payload\" field of ormable type.\n", methodName, inType.Desc.Name())
-		return false, ""
+	if !typeOrmable {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since %s incoming message doesn't have \"payload\" field of ormable type.\n", methodName, inType.Desc.Name())
+		return false, ""
+	}
+	var outTypeName string
+	for _, field := range outType.Fields {
+		if string(field.Desc.Name()) == "result" {
+			gType := string(field.Desc.Message().Name())
+			outTypeName = strings.TrimPrefix(gType, "*")
+		}
+	}
+	if inTypeName != outTypeName {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since \"payload\" field type of %s incoming message type doesn't match \"result\" field type of %s outcoming message.\n", methodName,
+			inType.Desc.Name(), outType.Desc.Name())
+		return false, ""
+	}
+	return true, inTypeName
+}
+
+func (b *ORMBuilder) followsCreateSetConventions(inType *protogen.Message, outType *protogen.Message, methodName string) (bool, string) {
+	var inEntity, outEntity *protogen.Field
+	for _, field := range inType.Fields {
+		if string(field.Desc.Name()) == "objects" {
+			inEntity = field
+		}
+	}
+	for _, field := range outType.Fields {
+		if string(field.Desc.Name()) == "results" {
+			outEntity = field
+		}
+	}
+
+	if inEntity == nil || outEntity == nil || inEntity.Message == nil || outEntity.Message == nil {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since %s incoming message doesn't have \"objects\" field or %s outcoming message doesn't have \"results\" field.\n", methodName,
+			inType.Desc.Name(), outType.Desc.Name())
+		return false, ""
+	}
+
+	if inEntity.Desc.Cardinality() != protoreflect.Repeated || outEntity.Desc.Cardinality() != protoreflect.Repeated {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since \"objects\" field in request and \"results\" field in response should be repeated.\n", methodName)
+		return false, ""
+	}
+
+	inTypeName, outTypeName := string(inEntity.Message.Desc.Name()), string(outEntity.Message.Desc.Name())
+	if !b.isOrmable(inTypeName) {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since %s is not an ormable type.\n", methodName, inTypeName)
+		return false, ""
+	}
+
+	if inTypeName != outTypeName {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since \"objects\" field type of %s incoming message doesn't match \"results\" field type of %s outcoming message.\n", methodName,
+			inType.Desc.Name(), outType.Desc.Name())
+		return false, ""
+	}
+
+	return true, inTypeName
+}
+
+func (b *ORMBuilder) followsUpsertConventions(inType *protogen.Message, outType *protogen.Message, method *protogen.Method) (bool, string, string) {
+	methodName := string(method.Desc.Name())
+	follows, typeName := b.followsCreateConventions(inType, outType, methodName)
+	if !follows {
+		return false, "", ""
+	}
+
+	var updateMask string
+	for _, field := range inType.Fields {
+		if field.Message != nil && string(field.Message.Desc.FullName()) == "google.protobuf.FieldMask" {
+			// More than one mask in request is not allowed.
+			if updateMask != "" {
+				fmt.Fprintf(os.Stderr, "stub will be generated for %s since %s incoming message has more than one field mask.\n", methodName, inType.Desc.Name())
+				return false, "", ""
+			}
+			updateMask = string(field.Desc.Name())
+		}
+	}
+
+	if _, ok := b.getConflictTargets(b.getOrmable(typeName))[b.getUpsertConflictTarget(method, typeName)]; !ok {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since (gorm.method).conflict_target doesn't name a primary key or unique constraint of %s.\n", methodName, typeName)
+		return false, "", ""
 	}
-	var outTypeName string
-	for _, field := range outType.Fields {
-		if string(field.Desc.Name()) == "result" {
-			gType := string(field.Desc.Message().Name())
-			outTypeName = strings.TrimPrefix(gType, "*")
-		}
+
+	return true, typeName, camelCase(updateMask)
+}
+
+// getUpsertConflictTarget returns the (gorm.method).conflict_target option
+// of the method, the primary key column of the type by default.
+func (b *ORMBuilder) getUpsertConflictTarget(method *protogen.Method, typeName string) string {
+	if target := getMethodOptions(method).GetConflictTarget(); target != "" {
+		return target
 	}
-	if inTypeName != outTypeName {
-		fmt.Fprintf(os.Stderr, "stub will be generated for %s since \"payload\" field type of %s incoming message type doesn't match \"result\" field type of %s outcoming message.\n", methodName,
-			inType.Desc.Name(), outType.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	if !b.hasPrimaryKey(ormable) {
+		return ""
+	}
+	pkName, pk := b.findPrimaryKey(ormable)
+
+	return ormColumnName(pkName, pk)
+}
+
+func (b *ORMBuilder) followsStreamConventions(inType *protogen.Message, outType *protogen.Message, methodName string) (bool, string) {
+	outTypeName := string(outType.Desc.Name())
+	if !b.isOrmable(outTypeName) {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since the %s streamed message is not an ormable type.\n", methodName, outTypeName)
 		return false, ""
 	}
-	return true, inTypeName
+
+	return true, outTypeName
 }
 
 func (b *ORMBuilder) followsReadConventions(inType *protogen.Message, outType *protogen.Message, methodName string) (bool, string) {
@@ -2944,12 +5202,42 @@ func (b *ORMBuilder) generateDefaultServer(file *protogen.File, g *protogen.Gene
 		g.P(`type `, service.ccName, `DefaultServer struct {`)
 		if !service.usesTxnMiddleware {
 			g.P(`DB *`, generateImport("DB", gormImport, g))
+			g.P(`// ReaderDB is used by the read methods when it is set, a replica of DB for instance`)
+			g.P(`ReaderDB *`, generateImport("DB", gormImport, g))
+			g.P(`// DBResolver picks the database of each request, DB or ReaderDB are used when it is nil`)
+			g.P(`DBResolver `, generateImport("Resolver", dbresolverImport, g))
+		}
+		for _, method := range service.methods {
+			if b.retriesTransaction(service, method) {
+				g.P(`// Retry is the policy of the retries of the transactions aborted by a serialization failure or a deadlock, retry.DefaultPolicy when nil`)
+				g.P(`Retry *`, generateImport("Policy", retryImport, g))
+				break
+			}
+		}
+		if getServiceOptions(service.Service).GetWithMetrics() {
+			g.P(`// Metrics records the requests, nothing is recorded when it is nil`)
+			g.P(`Metrics `, generateImport("Metrics", metricsImport, g))
+		}
+		baseTypes := repositoryTypes(service)
+		for _, typeName := range baseTypes {
+			g.P(`// New`, typeName, `Repository returns the repository the methods run the operations on `, typeName, ` with,`)
+			g.P(`// NewGorm`, typeName, `Repository when it is nil`)
+			g.P(`New`, typeName, `Repository func(*`, generateImport("DB", gormImport, g), `) `, typeName, `Repository`)
 		}
 		g.P(`}`)
+		for _, typeName := range baseTypes {
+			g.P()
+			g.P(`func (m *`, service.ccName, `DefaultServer) `, repositoryName(typeName), `(db *`, generateImport("DB", gormImport, g), `) `, typeName, `Repository {`)
+			g.P(`if m.New`, typeName, `Repository != nil {`)
+			g.P(`return m.New`, typeName, `Repository(db)`)
+			g.P(`}`)
+			g.P(`return NewGorm`, typeName, `Repository(db)`)
+			g.P(`}`)
+		}
 
 		withSpan := getServiceOptions(service.Service).WithTracing
 
-		if withSpan {
+		if withSpan && !b.otelTracing {
 			b.generateSpanInstantiationMethod(service, g)
 			b.generateSpanErrorMethod(service, g)
 			b.generateSpanResultMethod(service, g)
@@ -2957,9 +5245,14 @@ func (b *ORMBuilder) generateDefaultServer(file *protogen.File, g *protogen.Gene
 
 		for _, method := range service.methods {
 			_ = generateImport("", "context", g)
+			if b.retriesTransaction(service, method) {
+				b.generateRetryWrapper(service, method, g)
+			}
 			switch method.verb {
 			case createService:
 				b.generateCreateServerMethod(service, method, g)
+			case createSetService:
+				b.generateCreateSetServerMethod(service, method, g)
 			case readService:
 				b.generateReadServerMethod(service, method, g)
 			case updateService:
@@ -2972,6 +5265,10 @@ func (b *ORMBuilder) generateDefaultServer(file *protogen.File, g *protogen.Gene
 				b.generateDeleteSetServerMethod(service, method, g)
 			case listService:
 				b.generateListServerMethod(service, method, g)
+			case upsertService:
+				b.generateUpsertServerMethod(service, method, g)
+			case streamService:
+				b.generateStreamServerMethod(service, method, g)
 			default:
 				b.generateMethodStub(service, method, g)
 			}
@@ -2979,6 +5276,28 @@ func (b *ORMBuilder) generateDefaultServer(file *protogen.File, g *protogen.Gene
 	}
 }
 
+// repositoryTypes returns the base types of the methods of the service
+// following the conventions, whose operations run on their repository.
+func repositoryTypes(service autogenService) []string {
+	var typeNames []string
+	seen := make(map[string]bool)
+	for _, method := range service.methods {
+		typeName := strings.TrimPrefix(method.baseType, "[]*")
+		if !method.followsConvention || seen[typeName] {
+			continue
+		}
+		seen[typeName] = true
+		typeNames = append(typeNames, typeName)
+	}
+	return typeNames
+}
+
+// repositoryName returns the name of the method of the servers returning the
+// repository of typeName.
+func repositoryName(typeName string) string {
+	return strings.ToLower(typeName[:1]) + typeName[1:] + "Repository"
+}
+
 func (b *ORMBuilder) generateSpanInstantiationMethod(service autogenService, g *protogen.GeneratedFile) {
 	serviceName := service.GoName
 	_ = generateImport("", "fmt", g)
@@ -3019,21 +5338,80 @@ func (b *ORMBuilder) generateSpanResultMethod(service autogenService, g *protoge
 func (b *ORMBuilder) generateCreateServerMethod(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
 	b.generateMethodSignature(service, method, g)
 	if method.followsConvention {
-		b.generateDBSetup(service, g)
+		b.generateDBSetup(service, method, g)
 		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
-		g.P(`res, err := DefaultCreate`, method.baseType, `(ctx, in.GetPayload(), db)`)
+		g.P(`res, err := m.`, repositoryName(method.baseType), `(db).Create(ctx, in.GetPayload())`)
 		g.P(`if err != nil {`)
-		g.P(`return nil, `, b.wrapSpanError(service, "err"))
+		g.P(`return nil, `, b.wrapSpanError(service, "err", g))
 		g.P(`}`)
 		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{Result: res}`)
-		if b.gateway {
+		if b.setsCreated(service, method) {
+			g.P(`err = `, generateImport("SetCreated", gatewayImport, g), `(ctx, "")`)
+			g.P(`if err != nil {`)
+			g.P(`return nil, `, b.wrapSpanError(service, "err", g))
+			g.P(`}`)
+		}
+
+		b.generatePostserviceCall(service, method.baseType, method.ccName, g)
+		b.generateTransactionCommit(service, method, g)
+		b.spanResultHandling(service, g)
+		g.P(`return out, nil`)
+		g.P(`}`)
+		b.generatePreserviceHook(service.ccName, method.baseType, method.ccName, g)
+		b.generatePostserviceHook(service.ccName, method.baseType, b.typeName(method.outType.GoIdent, g), method.ccName, g)
+	} else {
+		b.generateEmptyBody(service, method.outType, g)
+	}
+}
+
+func (b *ORMBuilder) generateCreateSetServerMethod(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+	b.generateMethodSignature(service, method, g)
+	if method.followsConvention {
+		g.P(`if in == nil {`)
+		g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
+		g.P(`}`)
+		b.generateDBSetup(service, method, g)
+		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
+		g.P(`res, err := m.`, repositoryName(method.baseType), `(db).CreateSet(ctx, in.GetObjects(), `, getMethodOptions(method.Method).GetBatchSize(), `)`)
+		g.P(`if err != nil {`)
+		g.P(`return nil, `, b.wrapSpanError(service, "err", g))
+		g.P(`}`)
+		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{Results: res}`)
+		if b.setsCreated(service, method) {
 			g.P(`err = `, generateImport("SetCreated", gatewayImport, g), `(ctx, "")`)
 			g.P(`if err != nil {`)
-			g.P(`return nil, `, b.wrapSpanError(service, "err"))
+			g.P(`return nil, `, b.wrapSpanError(service, "err", g))
 			g.P(`}`)
 		}
 
 		b.generatePostserviceCall(service, method.baseType, method.ccName, g)
+		b.generateTransactionCommit(service, method, g)
+		b.spanResultHandling(service, g)
+		g.P(`return out, nil`)
+		g.P(`}`)
+		b.generatePreserviceHook(service.ccName, method.baseType, method.ccName, g)
+		b.generatePostserviceHook(service.ccName, method.baseType, b.typeName(method.outType.GoIdent, g), method.ccName, g)
+	} else {
+		b.generateEmptyBody(service, method.outType, g)
+	}
+}
+
+func (b *ORMBuilder) generateUpsertServerMethod(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+	b.generateMethodSignature(service, method, g)
+	if method.followsConvention {
+		b.generateDBSetup(service, method, g)
+		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
+		updateMask := "nil"
+		if method.fieldMaskName != "" {
+			updateMask = fmt.Sprint(`in.Get`, method.fieldMaskName, `()`)
+		}
+		g.P(`res, err := m.`, repositoryName(method.baseType), `(db).Upsert(ctx, in.GetPayload(), "`, b.getUpsertConflictTarget(method.Method, method.baseType), `", `, updateMask, `)`)
+		g.P(`if err != nil {`)
+		g.P(`return nil, `, b.wrapSpanError(service, "err", g))
+		g.P(`}`)
+		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{Result: res}`)
+		b.generatePostserviceCall(service, method.baseType, method.ccName, g)
+		b.generateTransactionCommit(service, method, g)
 		b.spanResultHandling(service, g)
 		g.P(`return out, nil`)
 		g.P(`}`)
@@ -3044,15 +5422,109 @@ func (b *ORMBuilder) generateCreateServerMethod(service autogenService, method a
 	}
 }
 
+func (b *ORMBuilder) generateStreamServerMethod(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+	// the transaction middleware doesn't apply to streams
+	if !method.followsConvention || service.usesTxnMiddleware {
+		b.generateMethodStub(service, method, g)
+		return
+	}
+
+	b.generateStreamMethodSignature(service, method, true, g)
+	b.generateDBSetup(service, method, g)
+	g.P(`if custom, ok := interface{}(in).(`, service.ccName, method.baseType, `WithBefore`, method.ccName, `); ok {`)
+	g.P(`var err error`)
+	g.P(`if db, err = custom.Before`, method.ccName, `(ctx, db); err != nil {`)
+	g.P(`return `, b.wrapSpanError(service, "err", g))
+	g.P(`}`)
+	g.P(`}`)
+	handlerCall := fmt.Sprint(`err := m.`, repositoryName(method.baseType), `(db).Stream(ctx`)
+	for _, field := range []string{b.getFiltering(method.inType), b.getSorting(method.inType), b.getPagination(method.inType), b.getFieldSelection(method.inType)} {
+		if field != "" {
+			handlerCall += fmt.Sprint(`, in.`, field)
+		} else {
+			handlerCall += `, nil`
+		}
+	}
+	if getServiceOptions(service.Service).GetWithMetrics() {
+		g.P(handlerCall, `, func(out *`, method.baseType, `) error {`)
+		g.P(`rows++`)
+		g.P(`return stream.Send(out)`)
+		g.P(`})`)
+	} else {
+		g.P(handlerCall, `, stream.Send)`)
+	}
+	g.P(`if err != nil {`)
+	g.P(`return `, b.wrapSpanError(service, "err", g))
+	g.P(`}`)
+	g.P(`if custom, ok := interface{}(in).(`, service.ccName, method.baseType, `WithAfter`, method.ccName, `); ok {`)
+	g.P(`if err = custom.After`, method.ccName, `(ctx, db); err != nil {`)
+	g.P(`return `, b.wrapSpanError(service, "err", g))
+	g.P(`}`)
+	g.P(`}`)
+	b.generateTransactionCommit(service, method, g)
+	g.P(`return nil`)
+	g.P(`}`)
+	b.generatePreserviceHookOf(service.ccName, method.baseType, method.ccName, `DefaultStream`+method.baseType, g)
+	g.P(`// `, service.ccName, method.baseType, `WithAfter`, method.ccName, ` called after DefaultStream`, method.baseType, ` in the default `, method.ccName, ` handler`)
+	g.P(`type `, service.ccName, method.baseType, `WithAfter`, method.ccName, ` interface {`)
+	g.P(`After`, method.ccName, `(context.Context, *`, generateImport("DB", gormImport, g), `) error`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateStreamMethodSignature(service autogenService, method autogenMethod, withCtx bool, g *protogen.GeneratedFile) {
+	stream := fmt.Sprint(service.GoName, `_`, method.GoName, `Server`)
+	g.P(`// `, method.ccName, ` ...`)
+	if method.Desc.IsStreamingClient() {
+		g.P(`func (m *`, service.GoName, `DefaultServer) `, method.ccName, ` (stream `, stream, `) error {`)
+		return
+	}
+	withMetrics := getServiceOptions(service.Service).GetWithMetrics() && method.followsConvention
+	if withMetrics {
+		g.P(`func (m *`, service.GoName, `DefaultServer) `, method.ccName, ` (in *`, b.typeName(method.inType.GoIdent, g), `, stream `, stream, `) (resultErr error) {`)
+	} else {
+		g.P(`func (m *`, service.GoName, `DefaultServer) `, method.ccName, ` (in *`, b.typeName(method.inType.GoIdent, g), `, stream `, stream, `) error {`)
+	}
+	withSpan := getServiceOptions(service.Service).WithTracing
+	if withCtx || withSpan || withMetrics {
+		g.P(`ctx := stream.Context()`)
+	}
+	if withMetrics {
+		b.generateMetricsObservation(service, method, g)
+	}
+	if withSpan && b.otelTracing {
+		b.generateMethodSpan(service, method, g)
+	} else if withSpan {
+		g.P(`span, errSpanCreate := m.spanCreate(ctx, in, "`, method.ccName, `")`)
+		g.P(`if errSpanCreate != nil {`)
+		g.P(`return errSpanCreate`)
+		g.P(`}`)
+		g.P(`defer span.End()`)
+	}
+}
+
 func (b *ORMBuilder) generateMethodSignature(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
 	in := b.typeName(method.inType.GoIdent, g)
 	out := b.typeName(method.outType.GoIdent, g)
 
-	g.P(`// `, method.ccName, ` ...`)
-	g.P(`func (m *`, service.GoName, `DefaultServer) `, method.ccName, ` (ctx context.Context, in *`,
-		in, `) (*`, out, `, error) {`)
+	name := method.ccName
+	if b.retriesTransaction(service, method) {
+		name = retriedMethodName(method)
+		g.P(`// `, name, ` runs `, method.ccName, ` once, in a transaction`)
+	} else {
+		g.P(`// `, name, ` ...`)
+	}
+	if getServiceOptions(service.Service).GetWithMetrics() && method.followsConvention {
+		g.P(`func (m *`, service.GoName, `DefaultServer) `, name, ` (ctx context.Context, in *`,
+			in, `) (result *`, out, `, resultErr error) {`)
+		b.generateMetricsObservation(service, method, g)
+	} else {
+		g.P(`func (m *`, service.GoName, `DefaultServer) `, name, ` (ctx context.Context, in *`,
+			in, `) (*`, out, `, error) {`)
+	}
 	withSpan := getServiceOptions(service.Service).WithTracing
-	if withSpan {
+	if withSpan && b.otelTracing {
+		b.generateMethodSpan(service, method, g)
+	} else if withSpan {
 		g.P(`span, errSpanCreate := m.spanCreate(ctx, in, "`, method.ccName, `")`)
 		g.P(`if errSpanCreate != nil {`)
 		g.P(`return nil, errSpanCreate`)
@@ -3061,6 +5533,49 @@ func (b *ORMBuilder) generateMethodSignature(service autogenService, method auto
 	}
 }
 
+// generateMetricsObservation reports the request of a server method to the
+// Metrics of the server when it returns. The method has named results.
+func (b *ORMBuilder) generateMetricsObservation(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+	g.P(`start := `, generateImport("Now", stdTimeImport, g), `()`)
+	if method.verb == streamService {
+		g.P(`rows := 0`)
+	}
+	g.P(`defer func() {`)
+	g.P(`if m.Metrics == nil {`)
+	g.P(`return`)
+	g.P(`}`)
+	switch method.verb {
+	case streamService:
+	case createSetService, updateSetService, listService:
+		g.P(`rows := len(result.GetResults())`)
+	case deleteSetService:
+		g.P(`rows := 0`)
+		g.P(`if resultErr == nil {`)
+		g.P(`rows = len(in.GetIds())`)
+		g.P(`}`)
+	default:
+		g.P(`rows := 0`)
+		g.P(`if resultErr == nil {`)
+		g.P(`rows = 1`)
+		g.P(`}`)
+	}
+	g.P(`m.Metrics.Observe(ctx, `, generateImport("Observation", metricsImport, g), `{`)
+	g.P(`FullMethod: "/`, string(service.Desc.FullName()), `/`, string(method.Desc.Name()), `",`)
+	g.P(`Verb: "`, method.verb, `",`)
+	g.P(`Type: "`, method.baseType, `",`)
+	g.P(`Latency: `, generateImport("Since", stdTimeImport, g), `(start),`)
+	g.P(`Rows: rows,`)
+	g.P(`ErrorClass: `, generateImport("ErrorClass", metricsImport, g), `(resultErr),`)
+	g.P(`})`)
+	g.P(`}()`)
+}
+
+// generateMethodSpan starts the OpenTelemetry span of a server method.
+func (b *ORMBuilder) generateMethodSpan(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+	g.P(`ctx, span := `, generateImport("StartMethod", tracingImport, g), `(ctx, "`, string(service.Desc.FullName()), `", "`, string(method.Desc.Name()), `", in)`)
+	g.P(`defer span.End()`)
+}
+
 func (b ORMBuilder) generateEmptyBody(service autogenService, outType *protogen.Message, g *protogen.GeneratedFile) {
 	g.P(`out:= &`, b.typeName(outType.GoIdent, g), `{}`)
 	b.spanResultHandling(service, g)
@@ -3070,23 +5585,31 @@ func (b ORMBuilder) generateEmptyBody(service autogenService, outType *protogen.
 
 func (b *ORMBuilder) spanResultHandling(service autogenService, g *protogen.GeneratedFile) {
 	withSpan := getServiceOptions(service.Service).WithTracing
-	if withSpan {
+	if withSpan && b.otelTracing {
+		g.P(generateImport("Payload", tracingImport, g), `(span, "out", out)`)
+	} else if withSpan {
 		g.P(`errSpanResult := m.spanResult(span, out)`)
 		g.P(`if errSpanResult != nil {`)
-		g.P(`return nil, `, b.wrapSpanError(service, "errSpanResult"))
+		g.P(`return nil, `, b.wrapSpanError(service, "errSpanResult", g))
 		g.P(`}`)
 	}
 }
 
-func (b *ORMBuilder) wrapSpanError(service autogenService, errVarName string) string {
+// wrapSpanError returns the expression of the error returned by a server
+// method for errVarName: the error translated to a gRPC status by
+// errors.Translate, recorded in the span of the method when it is traced.
+func (b *ORMBuilder) wrapSpanError(service autogenService, errVarName string, g *protogen.GeneratedFile) string {
+	errExpr := fmt.Sprint(generateImport("Translate", gerrorsImport, g), `(`, errVarName, `)`)
 	withSpan := getServiceOptions(service.Service).WithTracing
-	if withSpan {
-		return fmt.Sprint(`m.spanError(span, `, errVarName, `)`)
+	if withSpan && b.otelTracing {
+		return fmt.Sprint(`tracing.Error(span, `, errExpr, `)`)
+	} else if withSpan {
+		return fmt.Sprint(`m.spanError(span, `, errExpr, `)`)
 	}
-	return errVarName
+	return errExpr
 }
 
-func (b *ORMBuilder) generateDBSetup(service autogenService, g *protogen.GeneratedFile) error {
+func (b *ORMBuilder) generateDBSetup(service autogenService, method autogenMethod, g *protogen.GeneratedFile) error {
 	if service.usesTxnMiddleware {
 		g.P(`txn, ok := `, generateImport("FromContext", tkgormImport, g), `(ctx)`)
 		g.P(`if !ok {`)
@@ -3096,17 +5619,197 @@ func (b *ORMBuilder) generateDBSetup(service autogenService, g *protogen.Generat
 		g.P(`if db.Error != nil {`)
 		g.P(`return nil, db.Error`)
 		g.P(`}`)
+		if b.dbEngine == ENGINE_POSTGRES && b.getOrmable(method.baseType).Tenant != nil {
+			g.P(`if err := `, b.tenantFunc("SetLocal", g), `(ctx, db, "/`, string(service.Desc.FullName()), `/`, string(method.Desc.Name()), `"); err != nil {`)
+			g.P(`return nil, err`)
+			g.P(`}`)
+		}
+		if opts := getMethodOptions(method.Method); opts.GetStatementTimeoutMs() > 0 || opts.GetLockTimeoutMs() > 0 {
+			g.P(`if err := `, generateImport("SetTimeouts", transactionImport, g), `(db, `, b.transactionOptions(method, g), `); err != nil {`)
+			g.P(`return nil, err`)
+			g.P(`}`)
+		}
 	} else {
+		ret := "nil, "
+		if method.verb == streamService {
+			ret = ""
+		}
+		primary := "true"
 		g.P(`db := m.DB`)
+		if method.readOnly() && !getMethodOptions(method.Method).GetPrimary() {
+			primary = generateImport("UsePrimary", dbresolverImport, g) + "(ctx)"
+			g.P(`if m.ReaderDB != nil && !`, primary, ` {`)
+			g.P(`db = `, generateImport("Replica", dbresolverImport, g), `(m.ReaderDB)`)
+			g.P(`}`)
+		}
+		g.P(`if m.DBResolver != nil {`)
+		g.P(`var err error`)
+		g.P(`if db, err = `, generateImport("Resolve", dbresolverImport, g), `(ctx, m.DBResolver, `, generateImport("Info", dbresolverImport, g), `{FullMethod: "/`,
+			string(service.Desc.FullName()), `/`, string(method.Desc.Name()), `", ReadOnly: `, method.readOnly(), `, Primary: `, primary, `}); err != nil {`)
+		g.P(`return `, ret, b.wrapSpanError(service, "err", g))
+		g.P(`}`)
+		g.P(`}`)
+		if method.followsConvention {
+			typeName := strings.TrimPrefix(method.baseType, "[]*")
+			g.P(`if db == nil && m.New`, typeName, `Repository == nil {`)
+			g.P(`return `, ret, b.wrapSpanError(service, generateImport("NoDBError", gerrorsImport, g), g))
+			g.P(`}`)
+		}
+		if b.opensTransaction(service, method) {
+			b.generateTransactionBegin(service, method, g)
+		}
 	}
 	return nil
 }
 
+// opensTransaction reports whether a server method runs in a transaction it
+// begins: the writing methods of services with the transaction option, the
+// methods of the multi tenant types of postgres and the methods with
+// transaction options, unless the transaction middleware provides it.
+func (b *ORMBuilder) opensTransaction(service autogenService, method autogenMethod) bool {
+	if service.usesTxnMiddleware {
+		return false
+	}
+	opts := getMethodOptions(method.Method)
+	// the methods of multi tenant types need the transaction of
+	// tenant.SetLocal to see rows through the row level security policies,
+	// with or without the transaction option
+	return (service.transaction && !method.readOnly()) || b.setsTenant(method) ||
+		opts.GetIsolation() != gorm.IsolationLevel_DEFAULT_ISOLATION ||
+		opts.GetReadOnly() || opts.GetStatementTimeoutMs() > 0 || opts.GetLockTimeoutMs() > 0
+}
+
+// setsTenant reports whether the transactions of a server method set the
+// tenant of the row level security policies of its type.
+func (b *ORMBuilder) setsTenant(method autogenMethod) bool {
+	if b.dbEngine != ENGINE_POSTGRES || !method.followsConvention {
+		return false
+	}
+	ormable, err := GetOrmable(b.ormableTypes, method.baseType)
+	return err == nil && ormable.Tenant != nil
+}
+
+// retriesTransaction reports whether a server method re-runs its transaction
+// when it is aborted by a serialization failure or a deadlock, which is done
+// for the non streaming methods beginning a transaction.
+func (b *ORMBuilder) retriesTransaction(service autogenService, method autogenMethod) bool {
+	return method.followsConvention && method.verb != streamService && b.opensTransaction(service, method)
+}
+
+func retriedMethodName(method autogenMethod) string {
+	return strings.ToLower(method.ccName[:1]) + method.ccName[1:] + `InTransaction`
+}
+
+// generateRetryWrapper generates a server method running the method named
+// by retriedMethodName with the Retry policy of the server.
+func (b *ORMBuilder) generateRetryWrapper(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+	out := b.typeName(method.outType.GoIdent, g)
+	g.P(`// `, method.ccName, ` ...`)
+	g.P(`func (m *`, service.GoName, `DefaultServer) `, method.ccName, ` (ctx context.Context, in *`,
+		b.typeName(method.inType.GoIdent, g), `) (*`, out, `, error) {`)
+	g.P(`var out *`, out)
+	g.P(`err := `, generateImport("Do", retryImport, g), `(ctx, m.Retry, func(ctx context.Context) (err error) {`)
+	g.P(`out, err = m.`, retriedMethodName(method), `(ctx, in)`)
+	g.P(`return err`)
+	g.P(`})`)
+	if b.gateway && (method.verb == createService || method.verb == createSetService) {
+		g.P(`if err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		g.P(`// the status is set once, after the attempt committed`)
+		g.P(`if err = `, generateImport("SetCreated", gatewayImport, g), `(ctx, ""); err != nil {`)
+		g.P(`return nil, `, generateImport("Translate", gerrorsImport, g), `(err)`)
+		g.P(`}`)
+	}
+	g.P(`return out, err`)
+	g.P(`}`)
+	g.P()
+}
+
+// setsCreated reports whether a create server method sets the Created status
+// itself, rather than its retry wrapper.
+func (b *ORMBuilder) setsCreated(service autogenService, method autogenMethod) bool {
+	return b.gateway && !b.retriesTransaction(service, method)
+}
+
+// transactionOptions returns the transaction.Options literal of the
+// transaction options of a method.
+func (b *ORMBuilder) transactionOptions(method autogenMethod, g *protogen.GeneratedFile) string {
+	opts := getMethodOptions(method.Method)
+	var fields []string
+	if level, ok := map[gorm.IsolationLevel]string{
+		gorm.IsolationLevel_READ_COMMITTED:  "LevelReadCommitted",
+		gorm.IsolationLevel_REPEATABLE_READ: "LevelRepeatableRead",
+		gorm.IsolationLevel_SERIALIZABLE:    "LevelSerializable",
+	}[opts.GetIsolation()]; ok {
+		fields = append(fields, "Isolation: "+generateImport(level, "database/sql", g))
+	}
+	if opts.GetReadOnly() || (method.readOnly() && b.setsTenant(method)) {
+		fields = append(fields, "ReadOnly: true")
+	}
+	if ms := opts.GetStatementTimeoutMs(); ms > 0 {
+		fields = append(fields, fmt.Sprint("StatementTimeout: ", ms, " * ", generateImport("Millisecond", stdTimeImport, g)))
+	}
+	if ms := opts.GetLockTimeoutMs(); ms > 0 {
+		fields = append(fields, fmt.Sprint("LockTimeout: ", ms, " * ", generateImport("Millisecond", stdTimeImport, g)))
+	}
+
+	return generateImport("Options", transactionImport, g) + "{" + strings.Join(fields, ", ") + "}"
+}
+
+// generateTransactionBegin begins the transaction of a server method, with
+// its transaction options, rolled back when the method returns before
+// generateTransactionCommit, with an error or a panic.
+func (b *ORMBuilder) generateTransactionBegin(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+	ret := "nil, "
+	if method.verb == streamService {
+		ret = ""
+	}
+	if b.getOrmable(method.baseType).Cached && !method.readOnly() {
+		g.P(`ctx = `, generateImport("Defer", cacheImport, g), `(ctx)`)
+	}
+	g.P(`var tx *`, generateImport("DB", gormImport, g))
+	g.P(`// the repositories without database run without transaction`)
+	g.P(`if db != nil {`)
+	g.P(`tx = `, generateImport("Begin", transactionImport, g), `(ctx, db, `, b.transactionOptions(method, g), `)`)
+	g.P(`if tx.Error != nil {`)
+	g.P(`return `, ret, b.wrapSpanError(service, "tx.Error", g))
+	g.P(`}`)
+	g.P(`defer tx.Rollback()`)
+	g.P(`db = tx`)
+	if b.setsTenant(method) {
+		g.P(`if err := `, b.tenantFunc("SetLocal", g), `(ctx, db, "/`, string(service.Desc.FullName()), `/`, string(method.Desc.Name()), `"); err != nil {`)
+		g.P(`return `, ret, b.wrapSpanError(service, "err", g))
+		g.P(`}`)
+	}
+	g.P(`}`)
+}
+
+// generateTransactionCommit commits the transaction begun by
+// generateTransactionBegin, after the post service hook.
+func (b *ORMBuilder) generateTransactionCommit(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+	if !b.opensTransaction(service, method) {
+		return
+	}
+	ret := "nil, "
+	if method.verb == streamService {
+		ret = ""
+	}
+	g.P(`if tx != nil {`)
+	g.P(`if err := tx.Commit().Error; err != nil {`)
+	g.P(`return `, ret, b.wrapSpanError(service, "err", g))
+	g.P(`}`)
+	g.P(`}`)
+	if b.getOrmable(method.baseType).Cached && !method.readOnly() {
+		g.P(generateImport("Flush", cacheImport, g), `(ctx)`)
+	}
+}
+
 func (b *ORMBuilder) generatePreserviceCall(service autogenService, typeName, method string, g *protogen.GeneratedFile) {
 	g.P(`if custom, ok := interface{}(in).(`, service.ccName, typeName, `WithBefore`, method, `); ok {`)
 	g.P(`var err error`)
 	g.P(`if db, err = custom.Before`, method, `(ctx, db); err != nil {`)
-	g.P(`return nil, `, b.wrapSpanError(service, "err"))
+	g.P(`return nil, `, b.wrapSpanError(service, "err", g))
 	g.P(`}`)
 	g.P(`}`)
 }
@@ -3115,13 +5818,19 @@ func (b *ORMBuilder) generatePostserviceCall(service autogenService, typeName, m
 	g.P(`if custom, ok := interface{}(in).(`, service.ccName, typeName, `WithAfter`, method, `); ok {`)
 	g.P(`var err error`)
 	g.P(`if err = custom.After`, method, `(ctx, out, db); err != nil {`)
-	g.P(`return nil, `, b.wrapSpanError(service, "err"))
+	g.P(`return nil, `, b.wrapSpanError(service, "err", g))
 	g.P(`}`)
 	g.P(`}`)
 }
 
 func (b *ORMBuilder) generatePreserviceHook(svc, typeName, method string, g *protogen.GeneratedFile) {
-	g.P(`// `, svc, typeName, `WithBefore`, method, ` called before Default`, method, typeName, ` in the default `, method, ` handler`)
+	b.generatePreserviceHookOf(svc, typeName, method, `Default`+method+typeName, g)
+}
+
+// generatePreserviceHookOf generates the hook called before handler in the
+// default method handler.
+func (b *ORMBuilder) generatePreserviceHookOf(svc, typeName, method, handler string, g *protogen.GeneratedFile) {
+	g.P(`// `, svc, typeName, `WithBefore`, method, ` called before `, handler, ` in the default `, method, ` handler`)
 	g.P(`type `, svc, typeName, `WithBefore`, method, ` interface {`)
 	g.P(`Before`, method, `(context.Context, *`, generateImport("DB", gormImport, g), `) (*`, generateImport("DB", gormImport, g), `, error)`)
 	g.P(`}`)
@@ -3137,19 +5846,20 @@ func (b *ORMBuilder) generatePostserviceHook(svc, typeName, outTypeName, method
 func (b *ORMBuilder) generateReadServerMethod(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
 	b.generateMethodSignature(service, method, g)
 	if method.followsConvention {
-		b.generateDBSetup(service, g)
+		b.generateDBSetup(service, method, g)
 		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
 		typeName := method.baseType
+		fs := "nil"
 		if fields := b.getFieldSelection(method.inType); fields != "" {
-			g.P(`res, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db, in.`, fields, `)`)
-		} else {
-			g.P(`res, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db)`)
+			fs = "in." + fields
 		}
+		g.P(`res, err := m.`, repositoryName(typeName), `(db).Read(ctx, &`, typeName, `{Id: in.GetId()}, `, fs, `)`)
 		g.P(`if err != nil {`)
-		g.P(`return nil, `, b.wrapSpanError(service, "err"))
+		g.P(`return nil, `, b.wrapSpanError(service, "err", g))
 		g.P(`}`)
 		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{Result: res}`)
 		b.generatePostserviceCall(service, method.baseType, method.ccName, g)
+		b.generateTransactionCommit(service, method, g)
 		b.spanResultHandling(service, g)
 		g.P(`return out, nil`)
 		g.P(`}`)
@@ -3180,6 +5890,17 @@ func (b *ORMBuilder) getPageInfo(message *protogen.Message) string {
 	return b.getFieldOfType(message, "PageInfo")
 }
 
+// getNextPageToken returns the name of the string next_page_token field of
+// a List response.
+func (b *ORMBuilder) getNextPageToken(message *protogen.Message) string {
+	for _, field := range message.Fields {
+		if string(field.Desc.Name()) == "next_page_token" && field.Desc.Kind() == protoreflect.StringKind {
+			return field.GoName
+		}
+	}
+	return ""
+}
+
 func (b *ORMBuilder) getFieldOfType(message *protogen.Message, fieldType string) string {
 	for _, field := range message.Fields {
 		if field.Desc.Message() != nil {
@@ -3202,22 +5923,23 @@ func (b *ORMBuilder) generateUpdateServerMethod(service autogenService, method a
 		g.P(`var err error`)
 		typeName := method.baseType
 		g.P(`var res *`, typeName)
-		b.generateDBSetup(service, g)
+		b.generateDBSetup(service, method, g)
 		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
 		if method.fieldMaskName != "" {
 			g.P(`if in.Get`, method.fieldMaskName, `() == nil {`)
-			g.P(`res, err = DefaultStrictUpdate`, typeName, `(ctx, in.GetPayload(), db)`)
+			g.P(`res, err = m.`, repositoryName(typeName), `(db).StrictUpdate(ctx, in.GetPayload())`)
 			g.P(`} else {`)
-			g.P(`res, err = DefaultPatch`, typeName, `(ctx, in.GetPayload(), in.Get`, method.fieldMaskName, `(), db)`)
+			g.P(`res, err = m.`, repositoryName(typeName), `(db).Patch(ctx, in.GetPayload(), in.Get`, method.fieldMaskName, `())`)
 			g.P(`}`)
 		} else {
-			g.P(`res, err = DefaultStrictUpdate`, typeName, `(ctx, in.GetPayload(), db)`)
+			g.P(`res, err = m.`, repositoryName(typeName), `(db).StrictUpdate(ctx, in.GetPayload())`)
 		}
 		g.P(`if err != nil {`)
-		g.P(`return nil, `, b.wrapSpanError(service, "err"))
+		g.P(`return nil, `, b.wrapSpanError(service, "err", g))
 		g.P(`}`)
 		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{Result: res}`)
 		b.generatePostserviceCall(service, method.baseType, method.ccName, g)
+		b.generateTransactionCommit(service, method, g)
 		b.spanResultHandling(service, g)
 		g.P(`return out, nil`)
 		g.P(`}`)
@@ -3237,28 +5959,23 @@ func (b *ORMBuilder) generateUpdateSetServerMethod(service autogenService, metho
 		g.P(`return nil,`, generateImport("NilArgumentError", gerrorsImport, g))
 		g.P(`}`)
 		g.P(``)
-		b.generateDBSetup(service, g)
+		b.generateDBSetup(service, method, g)
 		g.P(``)
 		b.generatePreserviceCall(service, typeName, method.ccName, g)
 
 		g.P(``)
-		g.P(`res, err := DefaultPatchSet`, typeName, `(ctx, in.GetObjects(), in.Get`, method.fieldMaskName, `(), db)`)
+		g.P(`res, err := m.`, repositoryName(typeName), `(db).PatchSet(ctx, in.GetObjects(), in.Get`, method.fieldMaskName, `())`)
 		g.P(`if err != nil {`)
-		g.P(`return nil, `, b.wrapSpanError(service, "err"))
+		g.P(`return nil, `, b.wrapSpanError(service, "err", g))
 		g.P(`}`)
 		g.P(``)
 		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{Results: res}`)
 
 		g.P(``)
 		b.generatePostserviceCall(service, typeName, method.ccName, g)
+		b.generateTransactionCommit(service, method, g)
 		g.P(``)
-		withSpan := getServiceOptions(service.Service).WithTracing
-		if withSpan {
-			g.P(`err = m.spanResult(span, out)`)
-			g.P(`if err != nil {`)
-			g.P(`return nil,`, b.wrapSpanError(service, "err"))
-			g.P(`}`)
-		}
+		b.spanResultHandling(service, g)
 		g.P(`return out, nil`)
 		g.P(`}`)
 
@@ -3273,14 +5990,15 @@ func (b *ORMBuilder) generateDeleteServerMethod(service autogenService, method a
 	b.generateMethodSignature(service, method, g)
 	if method.followsConvention {
 		typeName := method.baseType
-		b.generateDBSetup(service, g)
+		b.generateDBSetup(service, method, g)
 		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
-		g.P(`err := DefaultDelete`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db)`)
+		g.P(`err := m.`, repositoryName(typeName), `(db).Delete(ctx, &`, typeName, `{Id: in.GetId()})`)
 		g.P(`if err != nil {`)
-		g.P(`return nil, `, b.wrapSpanError(service, "err"))
+		g.P(`return nil, `, b.wrapSpanError(service, "err", g))
 		g.P(`}`)
 		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{}`)
 		b.generatePostserviceCall(service, method.baseType, method.ccName, g)
+		b.generateTransactionCommit(service, method, g)
 		b.spanResultHandling(service, g)
 		g.P(`return out, nil`)
 		g.P(`}`)
@@ -3295,18 +6013,19 @@ func (b *ORMBuilder) generateDeleteSetServerMethod(service autogenService, metho
 	b.generateMethodSignature(service, method, g)
 	if method.followsConvention {
 		typeName := method.baseType
-		b.generateDBSetup(service, g)
+		b.generateDBSetup(service, method, g)
 		g.P(`objs := []*`, typeName, `{}`)
 		g.P(`for _, id := range in.Ids {`)
 		g.P(`objs = append(objs, &`, typeName, `{Id: id})`)
 		g.P(`}`)
 		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
-		g.P(`err := DefaultDelete`, typeName, `Set(ctx, objs, db)`)
+		g.P(`err := m.`, repositoryName(typeName), `(db).DeleteSet(ctx, objs)`)
 		g.P(`if err != nil {`)
-		g.P(`return nil, `, b.wrapSpanError(service, "err"))
+		g.P(`return nil, `, b.wrapSpanError(service, "err", g))
 		g.P(`}`)
 		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{}`)
 		b.generatePostserviceCall(service, method.baseType, method.ccName, g)
+		b.generateTransactionCommit(service, method, g)
 		b.spanResultHandling(service, g)
 		g.P(`return out, nil`)
 		g.P(`}`)
@@ -3320,38 +6039,55 @@ func (b *ORMBuilder) generateDeleteSetServerMethod(service autogenService, metho
 func (b *ORMBuilder) generateListServerMethod(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
 	b.generateMethodSignature(service, method, g)
 	if method.followsConvention {
-		b.generateDBSetup(service, g)
+		b.generateDBSetup(service, method, g)
 		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
 		pg := b.getPagination(method.inType)
 		pi := b.getPageInfo(method.outType)
-		if pg != "" && pi != "" {
+		nextPageToken := b.getNextPageToken(method.outType)
+		keyset := b.listHasKeysetPagination(b.getOrmable(method.baseType)) && (pi != "" || nextPageToken != "")
+		paged := pg != "" && (pi != "" || keyset)
+		if paged {
 			b.generatePagedRequestSetup(pg, g)
 		}
-		handlerCall := fmt.Sprint(`res, err := DefaultList`, method.baseType, `(ctx, db`)
-		if f := b.getFiltering(method.inType); f != "" {
-			handlerCall += fmt.Sprint(",in.", f)
-		}
-		if s := b.getSorting(method.inType); s != "" {
-			handlerCall += fmt.Sprint(",in.", s)
-		}
-		if pg != "" {
-			handlerCall += fmt.Sprint(",in.", pg)
-		}
-		if fs := b.getFieldSelection(method.inType); fs != "" {
-			handlerCall += fmt.Sprint(",in.", fs)
+		handlerCall := fmt.Sprint(`res, err := m.`, repositoryName(method.baseType), `(db).List(ctx`)
+		for _, field := range []string{b.getFiltering(method.inType), b.getSorting(method.inType), pg, b.getFieldSelection(method.inType)} {
+			if field == pg && paged {
+				handlerCall += `, page`
+			} else if field != "" {
+				handlerCall += fmt.Sprint(`, in.`, field)
+			} else {
+				handlerCall += `, nil`
+			}
 		}
 		handlerCall += ")"
 		g.P(handlerCall)
 		g.P(`if err != nil {`)
-		g.P(`return nil, `, b.wrapSpanError(service, "err"))
+		g.P(`return nil, `, b.wrapSpanError(service, "err", g))
 		g.P(`}`)
 		var pageInfoIfExist string
-		if pg != "" && pi != "" {
+		pagingDeclared := keyset || (pg != "" && pi != "")
+		if keyset {
+			b.generateKeysetPagedRequestHandling(service, method, pg, g)
+			if pi != "" {
+				pageInfoIfExist += ", " + pi + ": resPaging"
+			}
+			if nextPageToken != "" {
+				pageInfoIfExist += ", " + nextPageToken + ": resPaging.GetPageToken()"
+			}
+		} else if pg != "" && pi != "" {
 			b.generatePagedRequestHandling(pg, g)
 			pageInfoIfExist = ", " + pi + ": resPaging"
 		}
+		if count := getMethodOptions(method.Method).GetCount(); count != gorm.CountStrategy_NO_COUNT && pi != "" {
+			if !pagingDeclared {
+				g.P(fmt.Sprintf(`var resPaging *%s`, generateImport("PageInfo", queryImport, g)))
+				pageInfoIfExist = ", " + pi + ": resPaging"
+			}
+			b.generateCountHandling(service, method, count, g)
+		}
 		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{Results: res`, pageInfoIfExist, ` }`)
 		b.generatePostserviceCall(service, method.baseType, method.ccName, g)
+		b.generateTransactionCommit(service, method, g)
 		b.spanResultHandling(service, g)
 		g.P(`return out, nil`)
 		g.P(`}`)
@@ -3364,9 +6100,12 @@ func (b *ORMBuilder) generateListServerMethod(service autogenService, method aut
 
 func (b *ORMBuilder) generatePagedRequestSetup(pg string, g *protogen.GeneratedFile) {
 	g.P(`pagedRequest := false`)
-	g.P(fmt.Sprintf(`if in.Get%s().GetLimit()>=1 {`, pg))
-	g.P(fmt.Sprintf(`in.%s.Limit ++`, pg))
-	g.P(`pagedRequest=true`)
+	g.P(fmt.Sprintf(`page := in.Get%s()`, pg))
+	g.P(`if page.GetLimit() >= 1 {`)
+	g.P(`// one more row tells whether there is a next page, the request is`)
+	g.P(`// left unchanged for the retries of the transaction`)
+	g.P(`page = &`, generateImport("Pagination", queryImport, g), `{PageToken: page.GetPageToken(), Offset: page.GetOffset(), Limit: page.GetLimit() + 1}`)
+	g.P(`pagedRequest = true`)
 	g.P(`}`)
 }
 
@@ -3375,7 +6114,7 @@ func (b *ORMBuilder) generatePagedRequestHandling(pg string, g *protogen.Generat
 	g.P(`if pagedRequest {`)
 	g.P(`var offset int32`)
 	g.P(`var size int32 = int32(len(res))`)
-	g.P(fmt.Sprintf(`if size == in.Get%s().GetLimit(){`, pg))
+	g.P(`if size == page.GetLimit() {`)
 	g.P(`size--`)
 	g.P(`res=res[:size]`)
 	g.P(fmt.Sprintf(`offset=in.Get%s().GetOffset()+size`, pg))
@@ -3384,7 +6123,59 @@ func (b *ORMBuilder) generatePagedRequestHandling(pg string, g *protogen.Generat
 	g.P(`}`)
 }
 
+func (b *ORMBuilder) generateCountHandling(service autogenService, method autogenMethod, count gorm.CountStrategy, g *protogen.GeneratedFile) {
+	strategy := generateImport("ExactCount", pagingImport, g)
+	if count == gorm.CountStrategy_ESTIMATED_COUNT {
+		strategy = generateImport("EstimatedCount", pagingImport, g)
+	}
+	countCall := fmt.Sprint(`count, err := m.`, repositoryName(method.baseType), `(db).Count(ctx`)
+	if f := b.getFiltering(method.inType); f != "" && b.listHasFiltering(b.getOrmable(method.baseType)) {
+		countCall += fmt.Sprint(`, in.`, f)
+	} else {
+		countCall += `, nil`
+	}
+	g.P(countCall, `, `, strategy, `)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, `, b.wrapSpanError(service, "err", g))
+	g.P(`}`)
+	g.P(`if count > `, generateImport("MaxInt32", "math", g), ` {`)
+	g.P(`count = `, generateImport("MaxInt32", "math", g))
+	g.P(`}`)
+	g.P(`if resPaging == nil {`)
+	g.P(fmt.Sprintf(`resPaging = &%s{}`, generateImport("PageInfo", queryImport, g)))
+	g.P(`}`)
+	g.P(`resPaging.Size = int32(count)`)
+}
+
+func (b *ORMBuilder) generateKeysetPagedRequestHandling(service autogenService, method autogenMethod, pg string, g *protogen.GeneratedFile) {
+	ormable := b.getOrmable(method.baseType)
+	s := "nil"
+	if sorting := b.getSorting(method.inType); sorting != "" {
+		s = fmt.Sprint(`in.Get`, sorting, `()`)
+	}
+	f := "nil"
+	if filtering := b.getFiltering(method.inType); filtering != "" && b.listHasFiltering(ormable) {
+		f = fmt.Sprint(`in.Get`, filtering, `()`)
+	}
+	g.P(fmt.Sprintf(`var resPaging *%s`, generateImport("PageInfo", queryImport, g)))
+	g.P(`if pagedRequest {`)
+	g.P(fmt.Sprintf(`resPaging = &%s{}`, generateImport("PageInfo", queryImport, g)))
+	g.P(`if size := int32(len(res)); size == page.GetLimit() {`)
+	g.P(`res = res[:size-1]`)
+	g.P(`if resPaging.PageToken, err = m.`, repositoryName(method.baseType), `(db).PageToken(ctx, res[size-2], `, s, `, `, f, `); err != nil {`)
+	g.P(`return nil, `, b.wrapSpanError(service, "err", g))
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`}`)
+}
+
 func (b *ORMBuilder) generateMethodStub(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+	if method.Desc.IsStreamingClient() || method.Desc.IsStreamingServer() {
+		b.generateStreamMethodSignature(service, method, false, g)
+		g.P(`return `, generateImport("Error", grpcStatusImport, g), `(`, generateImport("Unimplemented", grpcCodesImport, g), `, "`, method.ccName, ` isn't implemented")`)
+		g.P(`}`)
+		return
+	}
 	b.generateMethodSignature(service, method, g)
 	b.generateEmptyBody(service, method.outType, g)
 }
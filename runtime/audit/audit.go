@@ -0,0 +1,139 @@
+// Package audit records the history of the messages with the audited
+// option: the generated write handlers add a Record to the history table of
+// the message in their transaction.
+package audit
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Operations of the history records.
+const (
+	Create = "CREATE"
+	Update = "UPDATE"
+	Delete = "DELETE"
+)
+
+// Record is the audit data of a row of a history table, embedded in the
+// generated history ORM types.
+type Record struct {
+	// Operation is Create, Update or Delete.
+	Operation string
+	// Actor is who made the change, as returned by the registered Actor.
+	Actor string
+	// ChangedAt is the time of the change.
+	ChangedAt time.Time `gorm:"index"`
+	// ChangedFields are the comma separated names of the fields whose value
+	// changed, or which are set for creations and deletions.
+	ChangedFields string `gorm:"type:text"`
+	// Before and After are the protojson snapshots of the object before and
+	// after the change, Before is nil for creations and After for deletions.
+	Before *string `gorm:"type:text"`
+	After  *string `gorm:"type:text"`
+}
+
+// Actor returns who makes the changes of a request.
+type Actor interface {
+	Actor(ctx context.Context) string
+}
+
+// ActorFunc adapts a function to the Actor interface.
+type ActorFunc func(ctx context.Context) string
+
+// Actor calls f(ctx).
+func (f ActorFunc) Actor(ctx context.Context) string {
+	return f(ctx)
+}
+
+var actor Actor = ActorFunc(func(ctx context.Context) string { return "" })
+
+// SetActor registers the actor recorded in the history rows, which is empty
+// by default. It is meant to be called once during initialization.
+func SetActor(a Actor) {
+	actor = a
+}
+
+// NewRecord returns the record of operation changing before into after, of
+// the same message type. before is nil for creations and after for
+// deletions.
+func NewRecord(ctx context.Context, operation string, before, after proto.Message) (Record, error) {
+	r := Record{
+		Operation: operation,
+		Actor:     actor.Actor(ctx),
+		ChangedAt: time.Now().UTC(),
+	}
+	var err error
+	if r.Before, err = snapshot(before); err != nil {
+		return r, err
+	}
+	if r.After, err = snapshot(after); err != nil {
+		return r, err
+	}
+	r.ChangedFields = strings.Join(ChangedFields(before, after), ",")
+
+	return r, nil
+}
+
+// ChangedFields returns the names of the fields of the messages before and
+// after whose values differ, either may be nil.
+func ChangedFields(before, after proto.Message) []string {
+	var b, a protoreflect.Message
+	switch {
+	case before != nil && after != nil:
+		b, a = before.ProtoReflect(), after.ProtoReflect()
+	case before != nil:
+		b = before.ProtoReflect()
+		a = b.Type().Zero()
+	case after != nil:
+		a = after.ProtoReflect()
+		b = a.Type().Zero()
+	default:
+		return nil
+	}
+
+	var changed []string
+	fields := b.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !equal(fd, b, a) {
+			changed = append(changed, string(fd.Name()))
+		}
+	}
+
+	return changed
+}
+
+func equal(fd protoreflect.FieldDescriptor, b, a protoreflect.Message) bool {
+	if b.Has(fd) != a.Has(fd) {
+		return false
+	}
+	if !b.Has(fd) {
+		return true
+	}
+	// Comparing messages holding only the field compares lists, maps and
+	// messages by value.
+	bf, af := b.Type().New(), a.Type().New()
+	bf.Set(fd, b.Get(fd))
+	af.Set(fd, a.Get(fd))
+
+	return proto.Equal(bf.Interface(), af.Interface())
+}
+
+func snapshot(m proto.Message) (*string, error) {
+	if m == nil {
+		return nil, nil
+	}
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	s := string(data)
+
+	return &s, nil
+}
@@ -0,0 +1,47 @@
+package audit
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestChangedFields(t *testing.T) {
+	before := &descriptorpb.FieldDescriptorProto{Name: proto.String("id"), Number: proto.Int32(1),
+		Options: &descriptorpb.FieldOptions{Packed: proto.Bool(true)}}
+	after := &descriptorpb.FieldDescriptorProto{Name: proto.String("id"), Number: proto.Int32(2),
+		Options: &descriptorpb.FieldOptions{Packed: proto.Bool(false)}, JsonName: proto.String("id")}
+
+	if got, want := ChangedFields(before, after), []string{"number", "json_name", "options"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+	if got, want := ChangedFields(nil, before), []string{"name", "number", "options"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+	if got := ChangedFields(before, proto.Clone(before)); len(got) != 0 {
+		t.Errorf("got %v; want no change", got)
+	}
+}
+
+func TestNewRecord(t *testing.T) {
+	SetActor(ActorFunc(func(ctx context.Context) string { return "alice" }))
+	defer SetActor(ActorFunc(func(ctx context.Context) string { return "" }))
+
+	before := &descriptorpb.FieldDescriptorProto{Name: proto.String("id")}
+	r, err := NewRecord(context.Background(), Delete, before, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.Operation != Delete || r.Actor != "alice" || r.ChangedAt.IsZero() || r.ChangedFields != "name" ||
+		r.Before == nil || r.After != nil {
+		t.Fatalf("unexpected record %+v", r)
+	}
+	snapshot := &descriptorpb.FieldDescriptorProto{}
+	if err := protojson.Unmarshal([]byte(*r.Before), snapshot); err != nil || !proto.Equal(snapshot, before) {
+		t.Errorf("got snapshot %s; want %v", *r.Before, before)
+	}
+}
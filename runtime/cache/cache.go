@@ -0,0 +1,182 @@
+// Package cache is the read-through cache of the messages with the cached
+// option: DefaultRead looks the objects up in the registered Cache before
+// the database, and the write handlers invalidate the objects they change.
+package cache
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"sync"
+
+	"github.com/acanseco/protoc-gen-gorm/runtime/transaction"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/proto"
+)
+
+// Cache stores the protobuf encoded objects by key.
+type Cache interface {
+	// Get returns the value of key, and whether it was found.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set stores the value of key.
+	Set(ctx context.Context, key string, value []byte) error
+	// Invalidate removes keys.
+	Invalidate(ctx context.Context, keys ...string) error
+}
+
+var cache Cache
+
+// SetCache registers the cache of the generated handlers, which don't cache
+// objects by default. It is meant to be called once during initialization.
+func SetCache(c Cache) {
+	cache = c
+}
+
+// Key returns the key of the object of type typeName with the primary key
+// pk, owned by tenant for multi tenant types and nil otherwise. It is empty,
+// and the object isn't cached, when pk or tenant is zero: the requests
+// bypassing tenant isolation don't use the cache.
+func Key(typeName string, tenant, pk interface{}) string {
+	if isZero(pk) || (tenant != nil && isZero(tenant)) {
+		return ""
+	}
+	if tenant == nil {
+		return fmt.Sprintf("%s/%v", typeName, indirect(pk))
+	}
+
+	return fmt.Sprintf("%s/%v/%v", typeName, indirect(tenant), indirect(pk))
+}
+
+// Get unmarshals the cached object of key into m, and reports whether it was
+// found. Errors of the cache are logged and count as misses.
+func Get(ctx context.Context, key string, m proto.Message) bool {
+	if cache == nil || key == "" {
+		return false
+	}
+	value, ok, err := cache.Get(ctx, key)
+	if err == nil && ok {
+		err = proto.Unmarshal(value, m)
+	}
+	if err != nil {
+		log.Printf("cache: get %s: %v", key, err)
+		return false
+	}
+
+	return ok
+}
+
+// Unfiltered reports whether db has no conditions on the table of model,
+// such as the Where clauses of the hooks or of the services: the objects
+// cached by key ignore them, so DefaultRead only uses the cache without.
+func Unfiltered(db *gorm.DB, model interface{}) bool {
+	return db.NewScope(model).CombinedConditionSql() == db.New().NewScope(model).CombinedConditionSql()
+}
+
+// Set caches m with key. Errors of the cache are logged.
+func Set(ctx context.Context, key string, m proto.Message) {
+	if cache == nil || key == "" {
+		return
+	}
+	value, err := proto.Marshal(m)
+	if err == nil {
+		err = cache.Set(ctx, key, value)
+	}
+	if err != nil {
+		log.Printf("cache: set %s: %v", key, err)
+	}
+}
+
+type deferredKey struct{}
+
+// deferred are the keys invalidated by a transaction.
+type deferred struct {
+	mu   sync.Mutex
+	keys []string
+}
+
+// Defer returns a context whose invalidations are deferred until Flush, to
+// be called after the commit of the transaction of the request so that the
+// objects aren't cached again before.
+func Defer(ctx context.Context) context.Context {
+	return context.WithValue(ctx, deferredKey{}, &deferred{})
+}
+
+// Invalidate removes the objects of keys from the cache, or records them
+// for Flush when ctx is returned by Defer. The callers writing in their own
+// transaction use Defer, and Flush after the commit, as the generated
+// servers do, or the objects may be cached again before the commit.
+func Invalidate(ctx context.Context, keys ...string) error {
+	if cache == nil {
+		return nil
+	}
+	var nonEmpty []string
+	for _, key := range keys {
+		if key != "" {
+			nonEmpty = append(nonEmpty, key)
+		}
+	}
+	if len(nonEmpty) == 0 {
+		return nil
+	}
+	if d, ok := ctx.Value(deferredKey{}).(*deferred); ok {
+		d.mu.Lock()
+		d.keys = append(d.keys, nonEmpty...)
+		d.mu.Unlock()
+		return nil
+	}
+
+	return cache.Invalidate(ctx, nonEmpty...)
+}
+
+// Flush invalidates the keys recorded by the invalidations of ctx, returned
+// by Defer. Errors of the cache are logged, the transaction being committed.
+func Flush(ctx context.Context) {
+	d, ok := ctx.Value(deferredKey{}).(*deferred)
+	if !ok || cache == nil {
+		return
+	}
+	d.mu.Lock()
+	keys := d.keys
+	d.keys = nil
+	d.mu.Unlock()
+	if len(keys) == 0 {
+		return
+	}
+	if err := cache.Invalidate(ctx, keys...); err != nil {
+		log.Printf("cache: invalidate %v: %v", keys, err)
+	}
+}
+
+// Run is transaction.Run deferring the invalidations of f until the commit
+// of the transaction it begins. When db already is a transaction, or ctx
+// already defers them, the caller flushes them after its commit.
+func Run(ctx context.Context, db *gorm.DB, f func(ctx context.Context, tx *gorm.DB) error) error {
+	if _, ok := ctx.Value(deferredKey{}).(*deferred); ok || transaction.InTransaction(db) {
+		return transaction.Run(db, func(tx *gorm.DB) error {
+			return f(ctx, tx)
+		})
+	}
+
+	ctx = Defer(ctx)
+	err := transaction.Run(db, func(tx *gorm.DB) error {
+		return f(ctx, tx)
+	})
+	if err == nil {
+		Flush(ctx)
+	}
+
+	return err
+}
+
+func isZero(v interface{}) bool {
+	return v == nil || reflect.ValueOf(v).IsZero()
+}
+
+func indirect(v interface{}) interface{} {
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr {
+		return rv.Elem().Interface()
+	}
+
+	return v
+}
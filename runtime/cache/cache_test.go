@@ -0,0 +1,155 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestKey(t *testing.T) {
+	id := uint64(7)
+	for _, test := range []struct {
+		tenant, pk interface{}
+		want       string
+	}{
+		{nil, uint64(7), "Widget/7"},
+		{nil, &id, "Widget/7"},
+		{"acme", uint64(7), "Widget/acme/7"},
+		{"", uint64(7), ""},
+		{nil, uint64(0), ""},
+	} {
+		if got := Key("Widget", test.tenant, test.pk); got != test.want {
+			t.Errorf("Key(%v, %v) = %q; want %q", test.tenant, test.pk, got, test.want)
+		}
+	}
+}
+
+func TestLRU(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRU(2)
+	c.Set(ctx, "a", []byte("1"))
+	c.Set(ctx, "b", []byte("2"))
+	c.Get(ctx, "a")
+	c.Set(ctx, "c", []byte("3"))
+
+	if _, ok, _ := c.Get(ctx, "b"); ok {
+		t.Error("expected the least recently used key to be evicted")
+	}
+	if v, ok, _ := c.Get(ctx, "a"); !ok || string(v) != "1" {
+		t.Errorf("got %q, %v; want the value of a", v, ok)
+	}
+	c.Invalidate(ctx, "a", "missing")
+	if c.Len() != 1 {
+		t.Errorf("got %d objects; want 1", c.Len())
+	}
+}
+
+func TestReadThrough(t *testing.T) {
+	ctx := context.Background()
+	lru := NewLRU(10)
+	SetCache(lru)
+	defer SetCache(nil)
+
+	Set(ctx, "Widget/1", wrapperspb.String("dune"))
+	got := &wrapperspb.StringValue{}
+	if !Get(ctx, "Widget/1", got) || !proto.Equal(got, wrapperspb.String("dune")) {
+		t.Fatalf("got %v; want the cached value", got)
+	}
+	if Get(ctx, "", got) {
+		t.Error("expected a miss for an empty key")
+	}
+
+	deferred := Defer(ctx)
+	if err := Invalidate(deferred, "Widget/1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if lru.Len() != 1 {
+		t.Error("expected the invalidation to be deferred")
+	}
+	Flush(deferred)
+	if lru.Len() != 0 {
+		t.Error("expected the invalidation to be flushed")
+	}
+}
+
+func open(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+	return db, mock
+}
+
+type widget struct {
+	ID        uint64
+	DeletedAt *string
+}
+
+func TestUnfiltered(t *testing.T) {
+	db, _ := open(t)
+
+	if !Unfiltered(db, &widget{}) || !Unfiltered(db.Set("k", "v"), &widget{}) {
+		t.Error("expected the database without conditions to be unfiltered")
+	}
+	if Unfiltered(db.Where("owner = ?", "acme"), &widget{}) {
+		t.Error("expected a Where clause to filter")
+	}
+	if Unfiltered(db.Unscoped(), &widget{}) {
+		t.Error("expected the soft deleted objects to filter")
+	}
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	lru := NewLRU(10)
+	SetCache(lru)
+	defer SetCache(nil)
+	db, mock := open(t)
+
+	lru.Set(ctx, "Widget/1", []byte("1"))
+	mock.ExpectBegin()
+	mock.ExpectCommit()
+	err := Run(ctx, db, func(ctx context.Context, tx *gorm.DB) error {
+		if err := Invalidate(ctx, "Widget/1"); err != nil {
+			return err
+		}
+		if lru.Len() != 1 {
+			t.Error("expected the invalidation to be deferred until the commit")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if lru.Len() != 0 {
+		t.Error("expected the invalidation to be flushed after the commit")
+	}
+
+	lru.Set(ctx, "Widget/1", []byte("1"))
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	failure := errors.New("failure")
+	if err := Run(ctx, db, func(ctx context.Context, tx *gorm.DB) error {
+		Invalidate(ctx, "Widget/1")
+		return failure
+	}); err != failure {
+		t.Errorf("got error %v; want %v", err, failure)
+	}
+	if lru.Len() != 1 {
+		t.Error("expected the rolled back invalidation to be dropped")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+// LRU is an in-memory Cache holding up to a number of objects, evicting the
+// least recently used ones.
+type LRU struct {
+	mu      sync.Mutex
+	size    int
+	entries *list.List
+	keys    map[string]*list.Element
+}
+
+type entry struct {
+	key   string
+	value []byte
+}
+
+// NewLRU returns an LRU holding up to size objects.
+func NewLRU(size int) *LRU {
+	return &LRU{size: size, entries: list.New(), keys: make(map[string]*list.Element)}
+}
+
+// Get returns the value of key.
+func (c *LRU) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.keys[key]
+	if !ok {
+		return nil, false, nil
+	}
+	c.entries.MoveToFront(e)
+
+	return e.Value.(*entry).value, true, nil
+}
+
+// Set stores the value of key, evicting the least recently used object when
+// the cache is full.
+func (c *LRU) Set(ctx context.Context, key string, value []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.keys[key]; ok {
+		e.Value.(*entry).value = value
+		c.entries.MoveToFront(e)
+		return nil
+	}
+	c.keys[key] = c.entries.PushFront(&entry{key: key, value: value})
+	for c.entries.Len() > c.size {
+		oldest := c.entries.Back()
+		c.entries.Remove(oldest)
+		delete(c.keys, oldest.Value.(*entry).key)
+	}
+
+	return nil
+}
+
+// Invalidate removes keys.
+func (c *LRU) Invalidate(ctx context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		if e, ok := c.keys[key]; ok {
+			c.entries.Remove(e)
+			delete(c.keys, key)
+		}
+	}
+
+	return nil
+}
+
+// Len returns the number of cached objects.
+func (c *LRU) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.entries.Len()
+}
@@ -0,0 +1,185 @@
+// Package collection applies the atlas-app-toolkit collection operators of
+// List requests (filtering, sorting, pagination and field selection) to gorm
+// queries, without depending on the rest of the toolkit. It is used by the
+// code generated with runtime=native.
+package collection
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Apply applies the filtering f, sorting s, pagination p and field
+// selection fs to db for obj, a pointer to a gorm model. preloads are the
+// associations preloaded when no field is selected.
+func Apply(db *gorm.DB, obj interface{}, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection, preloads ...string) (*gorm.DB, error) {
+	m := newModel(db, obj)
+	where, args, err := m.filtering(f)
+	if err != nil {
+		return nil, err
+	}
+	order, err := m.sorting(s)
+	if err != nil {
+		return nil, err
+	}
+
+	db = m.join(db)
+	if where != "" {
+		db = db.Where(where, args...)
+	}
+	if order != "" {
+		db = db.Order(order)
+	}
+	db = ApplyPagination(db, p)
+
+	return ApplyFieldSelection(db, obj, fs, preloads...)
+}
+
+// ApplyFiltering restricts db to the rows of obj, a pointer to a gorm model,
+// matching f.
+func ApplyFiltering(db *gorm.DB, obj interface{}, f *query.Filtering) (*gorm.DB, error) {
+	return Apply(db, obj, f, nil, nil, nil)
+}
+
+// ApplySorting orders db, a query of obj, by the criteria of s.
+func ApplySorting(db *gorm.DB, obj interface{}, s *query.Sorting) (*gorm.DB, error) {
+	return Apply(db, obj, nil, s, nil, nil)
+}
+
+// ApplyPagination applies the offset and limit of p to db.
+func ApplyPagination(db *gorm.DB, p *query.Pagination) *gorm.DB {
+	if p.GetOffset() > 0 {
+		db = db.Offset(p.GetOffset())
+	}
+	if p.GetLimit() > 0 {
+		db = db.Limit(p.GetLimit())
+	}
+
+	return db
+}
+
+// model resolves the field paths of collection operators to the columns
+// of a gorm model, and collects the has one and belongs to associations
+// they go through.
+type model struct {
+	scope *gorm.Scope
+	joins []string
+	seen  map[string]bool
+}
+
+func newModel(db *gorm.DB, obj interface{}) *model {
+	return &model{scope: db.NewScope(obj), seen: make(map[string]bool)}
+}
+
+// join adds the joins of the associations to db, selecting only the columns
+// of the model.
+func (m *model) join(db *gorm.DB) *gorm.DB {
+	if len(m.joins) != 0 {
+		db = db.Select(m.scope.QuotedTableName() + ".*")
+	}
+	for _, join := range m.joins {
+		db = db.Joins(join)
+	}
+
+	return db
+}
+
+// column returns the quoted column of path, the names of a field of the model
+// or of a field of one of its has one or belongs to associations.
+func (m *model) column(path []string) (string, error) {
+	if len(path) == 0 || len(path) > 2 {
+		return "", status.Errorf(codes.InvalidArgument, "unsupported field path %q", strings.Join(path, "."))
+	}
+	field, ok := fieldByName(m.scope, path[0])
+	if !ok {
+		return "", status.Errorf(codes.InvalidArgument, "unknown field %q", path[0])
+	}
+	if len(path) == 1 {
+		if !field.IsNormal {
+			return "", status.Errorf(codes.InvalidArgument, "%q is not a column", path[0])
+		}
+		return m.scope.QuotedTableName() + "." + m.scope.Quote(field.DBName), nil
+	}
+
+	rel := field.Relationship
+	if rel == nil || (rel.Kind != "has_one" && rel.Kind != "belongs_to") {
+		return "", status.Errorf(codes.InvalidArgument, "%q is not a has one or belongs to association", path[0])
+	}
+	assoc := m.scope.New(reflect.New(indirectType(field.Struct.Type)).Interface())
+	assocField, ok := fieldByName(assoc, path[1])
+	if !ok || !assocField.IsNormal {
+		return "", status.Errorf(codes.InvalidArgument, "unknown field %q", strings.Join(path, "."))
+	}
+
+	alias := m.scope.Quote(gorm.ToDBName(field.Name))
+	if !m.seen[alias] {
+		m.seen[alias] = true
+		var on []string
+		for i := range rel.ForeignDBNames {
+			if rel.Kind == "belongs_to" {
+				on = append(on, fmt.Sprintf("%s.%s = %s.%s", alias, m.scope.Quote(rel.AssociationForeignDBNames[i]),
+					m.scope.QuotedTableName(), m.scope.Quote(rel.ForeignDBNames[i])))
+			} else {
+				on = append(on, fmt.Sprintf("%s.%s = %s.%s", alias, m.scope.Quote(rel.ForeignDBNames[i]),
+					m.scope.QuotedTableName(), m.scope.Quote(rel.AssociationForeignDBNames[i])))
+			}
+		}
+		m.joins = append(m.joins, fmt.Sprintf("LEFT JOIN %s %s ON %s", assoc.QuotedTableName(), alias, strings.Join(on, " AND ")))
+	}
+
+	return alias + "." + m.scope.Quote(assocField.DBName), nil
+}
+
+func (m *model) sorting(s *query.Sorting) (string, error) {
+	var order []string
+	for _, cr := range s.GetCriterias() {
+		column, err := m.column(strings.Split(cr.GetTag(), "."))
+		if err != nil {
+			return "", err
+		}
+		if cr.GetOrder() == query.SortCriteria_DESC {
+			column += " DESC"
+		}
+		order = append(order, column)
+	}
+
+	return strings.Join(order, ","), nil
+}
+
+// fieldByName returns the field of scope named name in the request, either
+// its Go name or its protobuf (snake case or lower camel case) name.
+func fieldByName(scope *gorm.Scope, name string) (*gorm.Field, bool) {
+	goName := camelCase(name)
+	for _, field := range scope.Fields() {
+		if field.Name == goName || field.DBName == name {
+			return field, true
+		}
+	}
+
+	return nil, false
+}
+
+func camelCase(name string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		if part != "" {
+			b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+
+	return b.String()
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+
+	return t
+}
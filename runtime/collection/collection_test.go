@@ -0,0 +1,174 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type book struct {
+	Id          uint64 `gorm:"primary_key"`
+	Title       string
+	Pages       int64
+	PublisherId uint64
+	Publisher   *publisher
+	Cover       *cover
+	Chapters    []*chapter
+}
+
+type publisher struct {
+	Id   uint64 `gorm:"primary_key"`
+	Name string
+}
+
+type cover struct {
+	Id     uint64 `gorm:"primary_key"`
+	BookId uint64
+	Color  string
+}
+
+type chapter struct {
+	Id     uint64 `gorm:"primary_key"`
+	BookId uint64
+	Title  string
+}
+
+func open(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+	return db, mock
+}
+
+func TestApply(t *testing.T) {
+	db, mock := open(t)
+	f, err := query.ParseFiltering(`title == "Dune" and not pages > 400 or publisher.name ieq "ace"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := query.ParseSorting("cover.color, title desc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectQuery(`SELECT "books".\* FROM "books" ` +
+		`LEFT JOIN "publishers" "publisher" ON "publisher"."id" = "books"."publisher_id" ` +
+		`LEFT JOIN "covers" "cover" ON "cover"."book_id" = "books"."id" ` +
+		`WHERE \(\(\("books"."title" = \$1 AND NOT\("books"."pages" > \$2\)\) OR lower\("publisher"."name"\) = lower\(\$3\)\)\) ` +
+		`ORDER BY "cover"."color","books"."title" DESC LIMIT 10 OFFSET 20`).
+		WithArgs("Dune", 400.0, "ace").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	db, err = Apply(db, &book{}, f, s, &query.Pagination{Offset: 20, Limit: 10}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var books []*book
+	if err := db.Find(&books).Error; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestApplyInvalidField(t *testing.T) {
+	db, _ := open(t)
+
+	for _, filter := range []string{
+		`missing == "x"`,
+		`title; DROP TABLE books == "x"`,
+		`chapters.title == "x"`,
+		`publisher == "x"`,
+		`publisher.id.name == "x"`,
+	} {
+		f, err := query.ParseFiltering(filter)
+		if err != nil {
+			// The parser rejects the filter before it reaches the
+			// database, which is fine too.
+			continue
+		}
+		if _, err := ApplyFiltering(db, &book{}, f); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: got error %v; want InvalidArgument", filter, err)
+		}
+	}
+
+	s := &query.Sorting{Criterias: []*query.SortCriteria{{Tag: `title; DROP TABLE books`}}}
+	if _, err := ApplySorting(db, &book{}, s); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("got error %v; want InvalidArgument", err)
+	}
+}
+
+func TestApplyFieldSelection(t *testing.T) {
+	db, mock := open(t)
+
+	mock.ExpectQuery(`SELECT \* FROM "books"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "publisher_id"}).AddRow(1, 7))
+	mock.ExpectQuery(`SELECT \* FROM "chapters" WHERE \("book_id" IN \(\$1\)\)`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "book_id"}).AddRow(10, 1))
+	mock.ExpectQuery(`SELECT \* FROM "publishers" WHERE \("id" IN \(\$1\)\)`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(7, "Ace"))
+
+	fs := query.ParseFieldSelection("title,chapters,publisher.name")
+	db, err := ApplyFieldSelection(db, &book{}, fs, "Cover")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var books []*book
+	if err := db.Find(&books).Error; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+	if len(books) != 1 || len(books[0].Chapters) != 1 || books[0].Publisher.GetName() != "Ace" || books[0].Cover != nil {
+		t.Errorf("wrong associations preloaded: %+v", books)
+	}
+}
+
+func (p *publisher) GetName() string {
+	if p == nil {
+		return ""
+	}
+	return p.Name
+}
+
+func TestMergeWithMask(t *testing.T) {
+	source := &book{Title: "Dune", Pages: 412, Cover: &cover{Color: "orange"}}
+	dest := &book{Id: 1, Title: "Dun", Pages: 1, Publisher: &publisher{Name: "Ace"}}
+
+	mask := &field_mask.FieldMask{Paths: []string{"Title", "Cover.Color", "Publisher"}}
+	if err := MergeWithMask(source, dest, mask); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dest.Id != 1 || dest.Title != "Dune" || dest.Pages != 1 || dest.Cover.GetColor() != "orange" || dest.Publisher != nil {
+		t.Errorf("unexpected merge result: %+v", dest)
+	}
+
+	if err := MergeWithMask(source, dest, &field_mask.FieldMask{Paths: []string{"Missing"}}); err == nil {
+		t.Error("expected an error for an unknown path")
+	}
+	if err := MergeWithMask(source, &cover{}, mask); err == nil {
+		t.Error("expected an error for mismatching types")
+	}
+}
+
+func (c *cover) GetColor() string {
+	if c == nil {
+		return ""
+	}
+	return c.Color
+}
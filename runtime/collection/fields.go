@@ -0,0 +1,49 @@
+package collection
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/jinzhu/gorm"
+)
+
+// ApplyFieldSelection preloads the associations of obj, a pointer to a gorm
+// model, selected by fs, or preloads when no field is selected. Selected
+// fields which aren't associations are loaded anyway and are ignored.
+func ApplyFieldSelection(db *gorm.DB, obj interface{}, fs *query.FieldSelection, preloads ...string) (*gorm.DB, error) {
+	if len(fs.GetFields()) != 0 {
+		preloads = selectedPreloads(db.NewScope(obj), "", fs.GetFields())
+	}
+	for _, preload := range preloads {
+		db = db.Preload(preload)
+	}
+
+	return db, nil
+}
+
+// selectedPreloads returns the dotted paths of the associations of scope
+// selected by fields, parents first.
+func selectedPreloads(scope *gorm.Scope, prefix string, fields map[string]*query.Field) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var preloads []string
+	for _, name := range names {
+		field, ok := fieldByName(scope, name)
+		if !ok || field.Relationship == nil || field.IsNormal {
+			continue
+		}
+		path := prefix + field.Name
+		preloads = append(preloads, path)
+		if subs := fields[name].GetSubs(); len(subs) != 0 {
+			assoc := scope.New(reflect.New(indirectType(field.Struct.Type)).Interface())
+			preloads = append(preloads, selectedPreloads(assoc, path+".", subs)...)
+		}
+	}
+
+	return preloads
+}
@@ -0,0 +1,179 @@
+package collection
+
+import (
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (m *model) filtering(f *query.Filtering) (string, []interface{}, error) {
+	switch root := f.GetRoot().(type) {
+	case nil:
+		return "", nil, nil
+	case *query.Filtering_Operator:
+		return m.expression(root.Operator)
+	case *query.Filtering_StringCondition:
+		return m.expression(root.StringCondition)
+	case *query.Filtering_NumberCondition:
+		return m.expression(root.NumberCondition)
+	case *query.Filtering_NullCondition:
+		return m.expression(root.NullCondition)
+	case *query.Filtering_StringArrayCondition:
+		return m.expression(root.StringArrayCondition)
+	case *query.Filtering_NumberArrayCondition:
+		return m.expression(root.NumberArrayCondition)
+	default:
+		return "", nil, status.Errorf(codes.InvalidArgument, "unsupported filtering expression %T", root)
+	}
+}
+
+// leftOf returns the left operand of lop.
+func leftOf(lop *query.LogicalOperator) interface{} {
+	switch left := lop.GetLeft().(type) {
+	case *query.LogicalOperator_LeftOperator:
+		return left.LeftOperator
+	case *query.LogicalOperator_LeftStringCondition:
+		return left.LeftStringCondition
+	case *query.LogicalOperator_LeftNumberCondition:
+		return left.LeftNumberCondition
+	case *query.LogicalOperator_LeftNullCondition:
+		return left.LeftNullCondition
+	case *query.LogicalOperator_LeftStringArrayCondition:
+		return left.LeftStringArrayCondition
+	case *query.LogicalOperator_LeftNumberArrayCondition:
+		return left.LeftNumberArrayCondition
+	default:
+		return left
+	}
+}
+
+// rightOf returns the right operand of lop.
+func rightOf(lop *query.LogicalOperator) interface{} {
+	switch right := lop.GetRight().(type) {
+	case *query.LogicalOperator_RightOperator:
+		return right.RightOperator
+	case *query.LogicalOperator_RightStringCondition:
+		return right.RightStringCondition
+	case *query.LogicalOperator_RightNumberCondition:
+		return right.RightNumberCondition
+	case *query.LogicalOperator_RightNullCondition:
+		return right.RightNullCondition
+	case *query.LogicalOperator_RightStringArrayCondition:
+		return right.RightStringArrayCondition
+	case *query.LogicalOperator_RightNumberArrayCondition:
+		return right.RightNumberArrayCondition
+	default:
+		return right
+	}
+}
+
+// expression returns the SQL condition of a filtering expression and its
+// arguments.
+func (m *model) expression(expr interface{}) (string, []interface{}, error) {
+	switch expr := expr.(type) {
+	case *query.LogicalOperator:
+		left, leftArgs, err := m.expression(leftOf(expr))
+		if err != nil {
+			return "", nil, err
+		}
+		right, rightArgs, err := m.expression(rightOf(expr))
+		if err != nil {
+			return "", nil, err
+		}
+		op := " AND "
+		if expr.GetType() == query.LogicalOperator_OR {
+			op = " OR "
+		}
+		return negate(expr.GetIsNegative(), "("+left+op+right+")"), append(leftArgs, rightArgs...), nil
+
+	case *query.StringCondition:
+		column, err := m.column(expr.GetFieldPath())
+		if err != nil {
+			return "", nil, err
+		}
+		var cond string
+		switch expr.GetType() {
+		case query.StringCondition_EQ:
+			cond = column + " = ?"
+		case query.StringCondition_IEQ:
+			cond = "lower(" + column + ") = lower(?)"
+		case query.StringCondition_MATCH:
+			cond = column + " " + m.match() + " ?"
+		case query.StringCondition_GT:
+			cond = column + " > ?"
+		case query.StringCondition_GE:
+			cond = column + " >= ?"
+		case query.StringCondition_LT:
+			cond = column + " < ?"
+		case query.StringCondition_LE:
+			cond = column + " <= ?"
+		default:
+			return "", nil, status.Errorf(codes.InvalidArgument, "unsupported string condition %s", expr.GetType())
+		}
+		return negate(expr.GetIsNegative(), cond), []interface{}{expr.GetValue()}, nil
+
+	case *query.NumberCondition:
+		column, err := m.column(expr.GetFieldPath())
+		if err != nil {
+			return "", nil, err
+		}
+		var cond string
+		switch expr.GetType() {
+		case query.NumberCondition_EQ:
+			cond = column + " = ?"
+		case query.NumberCondition_GT:
+			cond = column + " > ?"
+		case query.NumberCondition_GE:
+			cond = column + " >= ?"
+		case query.NumberCondition_LT:
+			cond = column + " < ?"
+		case query.NumberCondition_LE:
+			cond = column + " <= ?"
+		default:
+			return "", nil, status.Errorf(codes.InvalidArgument, "unsupported number condition %s", expr.GetType())
+		}
+		return negate(expr.GetIsNegative(), cond), []interface{}{expr.GetValue()}, nil
+
+	case *query.NullCondition:
+		column, err := m.column(expr.GetFieldPath())
+		if err != nil {
+			return "", nil, err
+		}
+		return negate(expr.GetIsNegative(), column+" IS NULL"), nil, nil
+
+	case *query.StringArrayCondition:
+		column, err := m.column(expr.GetFieldPath())
+		if err != nil {
+			return "", nil, err
+		}
+		return negate(expr.GetIsNegative(), column+" IN (?)"), []interface{}{expr.GetValues()}, nil
+
+	case *query.NumberArrayCondition:
+		column, err := m.column(expr.GetFieldPath())
+		if err != nil {
+			return "", nil, err
+		}
+		return negate(expr.GetIsNegative(), column+" IN (?)"), []interface{}{expr.GetValues()}, nil
+
+	default:
+		return "", nil, status.Errorf(codes.InvalidArgument, "unsupported filtering expression %T", expr)
+	}
+}
+
+// match returns the regular expression operator of the dialect.
+func (m *model) match() string {
+	switch m.scope.Dialect().GetName() {
+	case "mysql", "sqlite3":
+		return "REGEXP"
+	default:
+		return "~"
+	}
+}
+
+func negate(neg bool, cond string) string {
+	if neg {
+		return "NOT(" + cond + ")"
+	}
+
+	return cond
+}
@@ -0,0 +1,55 @@
+package collection
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+// MergeWithMask copies the fields of source named by the paths of mask to
+// dest, allocating the nil pointers of dest along the way. source and dest
+// must be pointers to structs of the same type, and the paths are dotted Go
+// field names.
+func MergeWithMask(source, dest interface{}, mask *field_mask.FieldMask) error {
+	if len(mask.GetPaths()) == 0 {
+		return nil
+	}
+	srcRoot, dstRoot := reflect.ValueOf(source), reflect.ValueOf(dest)
+	if srcRoot.Kind() != reflect.Ptr || srcRoot.IsNil() || dstRoot.Kind() != reflect.Ptr || dstRoot.IsNil() {
+		return fmt.Errorf("collection: cannot merge %T into %T", source, dest)
+	}
+	if srcRoot.Type() != dstRoot.Type() {
+		return fmt.Errorf("collection: cannot merge %T into %T", source, dest)
+	}
+
+paths:
+	for _, path := range mask.GetPaths() {
+		src, dst := srcRoot.Elem(), dstRoot.Elem()
+		for _, name := range strings.Split(path, ".") {
+			if dst.Kind() != reflect.Struct {
+				return fmt.Errorf("collection: field path %q doesn't exist in %T", path, source)
+			}
+			src, dst = src.FieldByName(name), dst.FieldByName(name)
+			if !dst.IsValid() {
+				return fmt.Errorf("collection: field path %q doesn't exist in %T", path, source)
+			}
+			for dst.Kind() == reflect.Ptr && dst.Type().Elem().Kind() == reflect.Struct {
+				if src.IsNil() {
+					// Nothing to copy below a nil source, the
+					// destination is cleared instead.
+					dst.Set(reflect.Zero(dst.Type()))
+					continue paths
+				}
+				if dst.IsNil() {
+					dst.Set(reflect.New(dst.Type().Elem()))
+				}
+				src, dst = src.Elem(), dst.Elem()
+			}
+		}
+		dst.Set(src)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+// Package dbresolver lets the generated servers pick the database of each
+// request.
+package dbresolver
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/acanseco/protoc-gen-gorm/runtime/tenant"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrNoDB is returned by Resolve when the resolver returns no database.
+var ErrNoDB = status.Error(codes.Internal, "dbresolver: no database resolved for the request")
+
+// Info describes the request a database is resolved for.
+type Info struct {
+	// FullMethod is the full RPC method name, /package.service/method.
+	FullMethod string
+	// ReadOnly is true for the methods which don't write to the database.
+	ReadOnly bool
+	// Primary is true for the methods which must use the primary database
+	// rather than a replica: the writing methods, the methods with the
+	// primary option, and the requests whose context is marked by
+	// WithPrimary.
+	Primary bool
+}
+
+type primaryKey struct{}
+
+// WithPrimary marks ctx to read from the primary database, for example to
+// read your writes right after a write despite the replication lag.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// UsePrimary reports whether ctx is marked by WithPrimary.
+func UsePrimary(ctx context.Context) bool {
+	primary, _ := ctx.Value(primaryKey{}).(bool)
+	return primary
+}
+
+const replicaSetting = "dbresolver:replica"
+
+// Replica marks db as a replica, whose rows may lag behind the primary:
+// DefaultRead doesn't cache the objects read from it. Custom resolvers mark
+// the replicas they return.
+func Replica(db *gorm.DB) *gorm.DB {
+	return db.Set(replicaSetting, true)
+}
+
+// IsReplica reports whether db, or the database it derives from, is marked
+// by Replica.
+func IsReplica(db *gorm.DB) bool {
+	replica, _ := db.Get(replicaSetting)
+	return replica == true
+}
+
+// Resolver picks the database of a request from its context, for example
+// by tenant or region, or a replica for read only methods.
+type Resolver interface {
+	ResolveDB(ctx context.Context, info Info) (*gorm.DB, error)
+}
+
+// ResolverFunc adapts a function to the Resolver interface.
+type ResolverFunc func(ctx context.Context, info Info) (*gorm.DB, error)
+
+// ResolveDB calls f(ctx, info).
+func (f ResolverFunc) ResolveDB(ctx context.Context, info Info) (*gorm.DB, error) {
+	return f(ctx, info)
+}
+
+// Resolve returns the database r resolves for the request of ctx, or
+// ErrNoDB when r returns neither a database nor an error.
+func Resolve(ctx context.Context, r Resolver, info Info) (*gorm.DB, error) {
+	db, err := r.ResolveDB(ctx, info)
+	if err == nil && db == nil {
+		return nil, ErrNoDB
+	}
+
+	return db, err
+}
+
+// Tenants resolves requests to the dedicated database of their tenant, as
+// returned by tenant.ID, and to Default for the other tenants and requests
+// bypassing tenant isolation.
+type Tenants struct {
+	Default *gorm.DB
+	DBs     map[string]*gorm.DB
+}
+
+// ResolveDB returns the database of the tenant of ctx.
+func (t *Tenants) ResolveDB(ctx context.Context, info Info) (*gorm.DB, error) {
+	// the bypass is audited by the handler using the database
+	if tenant.Bypassed(ctx) {
+		return t.Default, nil
+	}
+	id, _, err := tenant.ID(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	if db, ok := t.DBs[id]; ok {
+		return db, nil
+	}
+
+	return t.Default, nil
+}
+
+// Replicas resolves the requests which may use a replica to one of Readers,
+// taken in turn and marked by Replica, and the other requests to Primary.
+type Replicas struct {
+	Primary *gorm.DB
+	Readers []*gorm.DB
+	next    uint32
+}
+
+// ResolveDB returns Primary or one of Readers.
+func (r *Replicas) ResolveDB(ctx context.Context, info Info) (*gorm.DB, error) {
+	if !info.ReadOnly || info.Primary || len(r.Readers) == 0 {
+		return r.Primary, nil
+	}
+	n := atomic.AddUint32(&r.next, 1)
+
+	return Replica(r.Readers[int(n%uint32(len(r.Readers)))]), nil
+}
@@ -0,0 +1,112 @@
+package dbresolver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/acanseco/protoc-gen-gorm/runtime/tenant"
+	"github.com/jinzhu/gorm"
+)
+
+func open(t *testing.T) *gorm.DB {
+	t.Helper()
+	sqlDB, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+	return db
+}
+
+type orgKey struct{}
+
+func TestTenants(t *testing.T) {
+	tenant.SetResolver(tenant.ResolverFunc(func(ctx context.Context) (string, error) {
+		org, _ := ctx.Value(orgKey{}).(string)
+		return org, nil
+	}))
+	audits := 0
+	tenant.SetAuditor(tenant.AuditorFunc(func(ctx context.Context, resource, reason string) {
+		audits++
+	}))
+
+	shared, dedicated := &gorm.DB{}, &gorm.DB{}
+	resolver := &Tenants{Default: shared, DBs: map[string]*gorm.DB{"big": dedicated}}
+	info := Info{FullMethod: "/example.Service/Read", ReadOnly: true}
+
+	for name, test := range map[string]struct {
+		ctx  context.Context
+		want *gorm.DB
+	}{
+		"dedicated": {context.WithValue(context.Background(), orgKey{}, "big"), dedicated},
+		"shared":    {context.WithValue(context.Background(), orgKey{}, "small"), shared},
+		"bypass":    {tenant.Bypass(context.WithValue(context.Background(), orgKey{}, "big"), "migration"), shared},
+	} {
+		db, err := resolver.ResolveDB(test.ctx, info)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if db != test.want {
+			t.Errorf("%s: resolved the wrong database", name)
+		}
+	}
+
+	if audits != 0 {
+		t.Errorf("got %d audits; want 0", audits)
+	}
+
+	if _, err := resolver.ResolveDB(context.Background(), info); err == nil {
+		t.Error("expected an error for a request without tenant")
+	}
+}
+
+func TestResolve(t *testing.T) {
+	db := &gorm.DB{}
+	info := Info{FullMethod: "/example.Service/Read", ReadOnly: true}
+	if got, err := Resolve(context.Background(), ResolverFunc(func(ctx context.Context, info Info) (*gorm.DB, error) {
+		return db, nil
+	}), info); got != db || err != nil {
+		t.Errorf("got %v, %v; want the resolved database", got, err)
+	}
+	if _, err := Resolve(context.Background(), ResolverFunc(func(ctx context.Context, info Info) (*gorm.DB, error) {
+		return nil, nil
+	}), info); err != ErrNoDB {
+		t.Errorf("got error %v; want ErrNoDB", err)
+	}
+}
+
+func TestReplicas(t *testing.T) {
+	primary, reader1, reader2 := open(t), open(t), open(t)
+	resolver := &Replicas{Primary: primary, Readers: []*gorm.DB{reader1, reader2}}
+	ctx := context.Background()
+
+	for name, test := range map[string]struct {
+		info Info
+		want *gorm.DB
+	}{
+		"write":  {Info{FullMethod: "/example.Service/Create", Primary: true}, primary},
+		"pinned": {Info{FullMethod: "/example.Service/Read", ReadOnly: true, Primary: true}, primary},
+	} {
+		if db, _ := resolver.ResolveDB(ctx, test.info); db != test.want {
+			t.Errorf("%s: resolved the wrong database", name)
+		}
+	}
+
+	read := Info{FullMethod: "/example.Service/Read", ReadOnly: true}
+	first, _ := resolver.ResolveDB(ctx, read)
+	second, _ := resolver.ResolveDB(ctx, read)
+	if first.CommonDB() == primary.CommonDB() || second.CommonDB() == primary.CommonDB() || first.CommonDB() == second.CommonDB() {
+		t.Error("expected the reads to alternate between the readers")
+	}
+	if !IsReplica(first) || !IsReplica(second.Where("id = ?", 1)) || IsReplica(primary) {
+		t.Error("expected only the readers to be marked as replicas")
+	}
+
+	if UsePrimary(ctx) || !UsePrimary(WithPrimary(ctx)) {
+		t.Error("expected only the marked context to use the primary")
+	}
+}
@@ -0,0 +1,168 @@
+// Package fixture returns the random values of the New<Type>Fixture
+// functions generated for the ormable types. The values are valid for the
+// conversions and the Validate methods of the generated code, and the
+// strings are random enough to not collide with each other in a test.
+package fixture
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	resourcepb "github.com/infobloxopen/atlas-app-toolkit/atlas/resource"
+	"github.com/infobloxopen/atlas-app-toolkit/gorm/resource"
+	uuid "github.com/satori/go.uuid"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DefaultSize is the length of the strings without size limit.
+const DefaultSize = 16
+
+// MinSize is the least number of random letters of the strings. The strings
+// limited to fewer letters are numbered in sequence from a random start
+// instead, so that they don't collide before all their values are used.
+const MinSize = 8
+
+const letters = "abcdefghijklmnopqrstuvwxyz"
+
+// random is seeded at startup, so that the tests of several processes
+// sharing a database don't get the same values.
+var random = rand.New(&lockedSource{src: rand.NewSource(time.Now().UnixNano()).(rand.Source64)})
+
+// sequence numbers the strings of fewer than MinSize letters.
+var sequence = random.Uint64()
+
+// lockedSource is a rand.Source safe for concurrent use.
+type lockedSource struct {
+	mu  sync.Mutex
+	src rand.Source64
+}
+
+func (s *lockedSource) Int63() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.src.Int63()
+}
+
+func (s *lockedSource) Uint64() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.src.Uint64()
+}
+
+func (s *lockedSource) Seed(seed int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.src.Seed(seed)
+}
+
+// String returns a random string of lowercase letters, of DefaultSize
+// letters or of size letters when it is lower. Below MinSize letters the
+// consecutive strings are distinct until the 26^size of them are used.
+func String(size int) string {
+	if size <= 0 || size > DefaultSize {
+		size = DefaultSize
+	}
+	b := make([]byte, size)
+	if size < MinSize {
+		n := atomic.AddUint64(&sequence, 1)
+		for i := size - 1; i >= 0; i-- {
+			b[i] = letters[n%uint64(len(letters))]
+			n /= uint64(len(letters))
+		}
+		return string(b)
+	}
+	for i := range b {
+		b[i] = letters[random.Intn(len(letters))]
+	}
+	return string(b)
+}
+
+// Bytes returns random bytes, DefaultSize of them or size when it is lower.
+func Bytes(size int) []byte {
+	if size <= 0 || size > DefaultSize {
+		size = DefaultSize
+	}
+	b := make([]byte, size)
+	for i := range b {
+		b[i] = byte(random.Intn(256))
+	}
+	return b
+}
+
+// Int returns a random integer in [1, max), 0 when max is 1 or lower.
+func Int(max int64) int64 {
+	if max <= 1 {
+		return 0
+	}
+	return 1 + random.Int63n(max-1)
+}
+
+// Uint returns a random unsigned integer in [1, max), 0 when max is 1 or
+// lower.
+func Uint(max uint64) uint64 {
+	return uint64(Int(int64(max)))
+}
+
+// Float returns a random float in [0, max).
+func Float(max float64) float64 {
+	return random.Float64() * max
+}
+
+// Bool returns a random boolean.
+func Bool() bool {
+	return random.Intn(2) == 1
+}
+
+// Time returns a random time of the past year in UTC, truncated to the
+// microsecond like the timestamps of Postgres.
+func Time() time.Time {
+	year := int64(365 * 24 * time.Hour)
+	return time.Now().UTC().Add(-time.Duration(random.Int63n(year))).Truncate(time.Microsecond)
+}
+
+// TimeOnly returns a random number of seconds in a day, the value of a
+// valid types.TimeOnly.
+func TimeOnly() uint32 {
+	return uint32(random.Intn(24 * 60 * 60))
+}
+
+// UUID returns a random UUID.
+func UUID() string {
+	return uuid.NewV4().String()
+}
+
+// CIDR returns a random /24 network of the 10.0.0.0/8 private range.
+func CIDR() string {
+	return fmt.Sprintf("10.%d.%d.0/24", random.Intn(256), random.Intn(256))
+}
+
+// JSON returns a JSON object with a random string.
+func JSON() string {
+	return fmt.Sprintf(`{"name": %q}`, String(0))
+}
+
+// Enum returns a random value of the enum described by desc. The first
+// value, by convention the unspecified one, is only returned when it is the
+// only value.
+func Enum(desc protoreflect.EnumDescriptor) protoreflect.EnumNumber {
+	values := desc.Values()
+	if values.Len() == 1 {
+		return values.Get(0).Number()
+	}
+	return values.Get(1 + random.Intn(values.Len()-1)).Number()
+}
+
+// Identifier returns the identifier of the resource pb encoding value. It
+// panics when the codec of pb doesn't support the type of value.
+func Identifier(pb proto.Message, value driver.Value) *resourcepb.Identifier {
+	id, err := resource.Encode(pb, value)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
@@ -0,0 +1,62 @@
+package fixture
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/infobloxopen/atlas-app-toolkit/gorm/resource"
+	"google.golang.org/protobuf/types/known/typepb"
+)
+
+func TestValues(t *testing.T) {
+	if s := String(4); utf8.RuneCountInString(s) != 4 {
+		t.Errorf("got %q; want 4 letters", s)
+	}
+	if s := String(0); len(s) != DefaultSize {
+		t.Errorf("got %q; want %d letters", s, DefaultSize)
+	}
+	if n := Int(10); n < 1 || n >= 10 {
+		t.Errorf("got %d; want a value in [1, 10)", n)
+	}
+	if n := Int(1); n != 0 {
+		t.Errorf("got %d; want 0", n)
+	}
+	if _, _, err := net.ParseCIDR(CIDR()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if s := TimeOnly(); s >= 24*60*60 {
+		t.Errorf("got %d seconds; want less than a day", s)
+	}
+	if !json.Valid([]byte(JSON())) {
+		t.Errorf("got invalid JSON %s", JSON())
+	}
+}
+
+func TestEnum(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		if v := Enum(typepb.Syntax(0).Descriptor()); v != 1 {
+			t.Fatalf("got %v; want the only value after the unspecified one", v)
+		}
+	}
+}
+
+func TestIdentifier(t *testing.T) {
+	id := UUID()
+	v, err := resource.Decode(nil, Identifier(nil, id))
+	if err != nil || v != id {
+		t.Errorf("got %v, %v; want %s", v, err, id)
+	}
+}
+
+func TestShortStrings(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 26*26; i++ {
+		s := String(2)
+		if seen[s] {
+			t.Fatalf("got %q twice after %d strings; want %d distinct strings", s, i, 26*26)
+		}
+		seen[s] = true
+	}
+}
@@ -0,0 +1,225 @@
+package insert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+)
+
+// DefaultBatchSize is the number of rows inserted by a single statement when
+// Batch is called without a positive batch size.
+const DefaultBatchSize = 100
+
+// Batch inserts objects, a slice of pointers to gorm models of the same type,
+// with one multi-row INSERT statement per batchSize objects. Primary keys and
+// columns left to their database default are set back on the objects.
+//
+// gorm BeforeSave, BeforeCreate, AfterCreate and AfterSave methods are called
+// for every object. Objects with associations to save, and all the objects on
+// dialects other than postgres and mysql, are inserted one by one with
+// db.Create.
+func Batch(db *gorm.DB, objects interface{}, batchSize int) error {
+	if db.Error != nil {
+		return db.Error
+	}
+	values := reflect.Indirect(reflect.ValueOf(objects))
+	if values.Kind() != reflect.Slice {
+		return fmt.Errorf("insert: objects must be a slice, got %T", objects)
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	for start := 0; start < values.Len(); start += batchSize {
+		end := start + batchSize
+		if end > values.Len() {
+			end = values.Len()
+		}
+
+		var rows []*gorm.Scope
+		for i := start; i < end; i++ {
+			obj := values.Index(i)
+			if obj.Kind() != reflect.Ptr {
+				obj = obj.Addr()
+			}
+
+			row := db.NewScope(obj.Interface())
+			if !supportsMultiRow(db) || hasAssociations(row) {
+				if err := db.Create(obj.Interface()).Error; err != nil {
+					return err
+				}
+				continue
+			}
+			rows = append(rows, row)
+		}
+
+		if err := insertRows(db, rows); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func supportsMultiRow(db *gorm.DB) bool {
+	switch db.Dialect().GetName() {
+	case "postgres", "mysql":
+		return true
+	default:
+		return false
+	}
+}
+
+func hasAssociations(scope *gorm.Scope) bool {
+	for _, field := range scope.Fields() {
+		if field.Relationship != nil && !field.IsBlank {
+			return true
+		}
+	}
+
+	return false
+}
+
+func insertRows(db *gorm.DB, rows []*gorm.Scope) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	now := gorm.NowFunc()
+	for _, row := range rows {
+		row.CallMethod("BeforeSave")
+		row.CallMethod("BeforeCreate")
+		if row.HasError() {
+			return row.DB().Error
+		}
+
+		for _, name := range []string{"CreatedAt", "UpdatedAt"} {
+			if field, ok := row.FieldByName(name); ok && field.IsBlank {
+				if err := field.Set(now); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	stmt := db.NewScope(rows[0].Value)
+	var names, columns []string
+	for _, field := range stmt.Fields() {
+		if field.IsNormal && !field.IsIgnored {
+			names = append(names, field.Name)
+			columns = append(columns, stmt.Quote(field.DBName))
+		}
+	}
+
+	defaulted := make(map[string]bool)
+	tuples := make([]string, 0, len(rows))
+	for _, row := range rows {
+		values := make([]string, 0, len(names))
+		for _, name := range names {
+			field, _ := row.FieldByName(name)
+			if field.IsBlank && (field.IsPrimaryKey || field.HasDefaultValue) {
+				values = append(values, "DEFAULT")
+				defaulted[name] = true
+			} else {
+				values = append(values, stmt.AddToVars(field.Field.Interface()))
+			}
+		}
+		tuples = append(tuples, "("+strings.Join(values, ",")+")")
+	}
+
+	sql := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s", stmt.QuotedTableName(), strings.Join(columns, ","), strings.Join(tuples, ","))
+	if option, ok := db.Get("gorm:insert_option"); ok {
+		sql += fmt.Sprint(" ", option)
+	}
+	sql = stmt.Raw(sql).SQL
+
+	if db.Dialect().GetName() == "postgres" {
+		err := insertReturning(stmt, sql, rows, names, defaulted)
+		if err == nil {
+			err = afterCreate(rows)
+		}
+		return err
+	}
+
+	result, err := stmt.SQLDB().Exec(sql, stmt.SQLVars...)
+	if err != nil {
+		return err
+	}
+	if primaryField := stmt.PrimaryField(); primaryField != nil && defaulted[primaryField.Name] {
+		// the ids generated by a single statement are consecutive
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if field := row.PrimaryField(); field.IsBlank {
+				if err := field.Set(id); err != nil {
+					return err
+				}
+				id++
+			}
+		}
+	}
+
+	return afterCreate(rows)
+}
+
+// insertReturning reads back the primary key and the defaulted columns of
+// the inserted rows, postgres returns them in the order of the VALUES list.
+func insertReturning(stmt *gorm.Scope, sql string, rows []*gorm.Scope, names []string, defaulted map[string]bool) error {
+	var returned []string
+	if primaryField := stmt.PrimaryField(); primaryField != nil {
+		returned = append(returned, primaryField.Name)
+	}
+	for _, name := range names {
+		if defaulted[name] && (len(returned) == 0 || name != returned[0]) {
+			returned = append(returned, name)
+		}
+	}
+	if len(returned) == 0 {
+		_, err := stmt.SQLDB().Exec(sql, stmt.SQLVars...)
+		return err
+	}
+
+	columns := make([]string, 0, len(returned))
+	for _, name := range returned {
+		field, _ := stmt.FieldByName(name)
+		columns = append(columns, stmt.Quote(field.DBName))
+	}
+
+	result, err := stmt.SQLDB().Query(sql+" RETURNING "+strings.Join(columns, ","), stmt.SQLVars...)
+	if err != nil {
+		return err
+	}
+	defer result.Close()
+
+	for _, row := range rows {
+		if !result.Next() {
+			break
+		}
+		dest := make([]interface{}, 0, len(returned))
+		for _, name := range returned {
+			field, _ := row.FieldByName(name)
+			dest = append(dest, field.Field.Addr().Interface())
+		}
+		if err := result.Scan(dest...); err != nil {
+			return err
+		}
+	}
+
+	return result.Err()
+}
+
+func afterCreate(rows []*gorm.Scope) error {
+	for _, row := range rows {
+		row.CallMethod("AfterCreate")
+		row.CallMethod("AfterSave")
+		if row.HasError() {
+			return row.DB().Error
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package insert
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm"
+)
+
+type point struct {
+	Id    uint64 `gorm:"primary_key"`
+	X     int32
+	Calls int `gorm:"-"`
+}
+
+func (p *point) BeforeCreate() error {
+	p.Calls++
+	return nil
+}
+
+func (p *point) AfterCreate() error {
+	p.Calls++
+	return nil
+}
+
+func open(t *testing.T, dialect string) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open(dialect, sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+	return db, mock
+}
+
+func TestBatchPostgres(t *testing.T) {
+	db, mock := open(t, "postgres")
+	objects := []*point{{X: 1}, {X: 2}, {X: 3}}
+
+	mock.ExpectQuery(`INSERT INTO "points" \("id","x"\) VALUES \(DEFAULT,\$1\),\(DEFAULT,\$2\) RETURNING "id"`).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10).AddRow(11))
+	mock.ExpectQuery(`INSERT INTO "points" \("id","x"\) VALUES \(DEFAULT,\$1\) RETURNING "id"`).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(12))
+
+	if err := Batch(db, objects, 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+	for i, obj := range objects {
+		if want := uint64(10 + i); obj.Id != want {
+			t.Errorf("objects[%d].Id = %d; want %d", i, obj.Id, want)
+		}
+		if obj.Calls != 2 {
+			t.Errorf("objects[%d] hooks called %d times; want 2", i, obj.Calls)
+		}
+	}
+}
+
+func TestBatchMySQL(t *testing.T) {
+	db, mock := open(t, "mysql")
+	objects := []*point{{X: 1}, {X: 2}}
+
+	mock.ExpectExec("INSERT INTO `points` \\(`id`,`x`\\) VALUES \\(DEFAULT,\\?\\),\\(DEFAULT,\\?\\)").
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(7, 2))
+
+	if err := Batch(db, objects, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+	if objects[0].Id != 7 || objects[1].Id != 8 {
+		t.Errorf("ids = %d, %d; want 7, 8", objects[0].Id, objects[1].Id)
+	}
+}
+
+func TestBatchNotSlice(t *testing.T) {
+	db, _ := open(t, "postgres")
+	if err := Batch(db, &point{}, 0); err == nil {
+		t.Error("expected an error for a non slice argument")
+	}
+}
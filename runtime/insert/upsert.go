@@ -0,0 +1,209 @@
+package insert
+
+import (
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/acanseco/protoc-gen-gorm/errors"
+	"github.com/jinzhu/gorm"
+)
+
+// ErrOtherScope is wrapped by the error of Upsert when the conflicting row
+// has another Scope than the inserted one.
+var ErrOtherScope = stderrors.New("insert: the conflicting row belongs to another scope")
+
+// OnConflict describes how Upsert resolves a conflict with an existing row.
+type OnConflict struct {
+	// Columns is the conflict target, the columns of a primary key or unique
+	// index. mysql ignores it and resolves conflicts on any unique index.
+	Columns []string
+	// Update lists the columns overwritten with the inserted values.
+	Update []string
+	// UpdateAll overwrites every column but the conflict target, the primary
+	// key, created_at and the Keep columns.
+	UpdateAll bool
+	// Keep lists the columns UpdateAll never overwrites.
+	Keep []string
+	// Scope is a column the conflicting row must share with the inserted
+	// one to be updated, typically account_id.
+	Scope string
+}
+
+// Upsert inserts obj, a pointer to a gorm model, or updates the row it
+// conflicts with. The primary key of the updated row is set back on obj.
+// When Scope differs between the rows nothing is updated and the call fails
+// with an *errors.AlreadyExistsError wrapping ErrOtherScope, on every
+// dialect: postgres and sqlite3 affect no row then, and mysql, which also
+// affects no row when the updated one keeps its values, has the scope of
+// the conflicting row read again by the conflict target, or by the primary
+// key of obj without one.
+func Upsert(db *gorm.DB, obj interface{}, conflict OnConflict) error {
+	scope := db.NewScope(obj)
+	clause, err := onConflictClause(scope, conflict)
+	if err != nil {
+		return err
+	}
+
+	blank := scope.PrimaryKeyZero()
+	created := db.Set("gorm:insert_option", clause).Create(obj)
+	if conflict.Scope == "" {
+		return created.Error
+	}
+	if stderrors.Is(created.Error, sql.ErrNoRows) {
+		return &errors.AlreadyExistsError{Err: ErrOtherScope}
+	}
+	if created.Error != nil || created.RowsAffected > 0 {
+		return created.Error
+	}
+	if scope.Dialect().GetName() != "mysql" {
+		return &errors.AlreadyExistsError{Err: ErrOtherScope}
+	}
+	// LAST_INSERT_ID sets the blank primary key of obj in the same scope only
+	if blank {
+		if db.NewScope(obj).PrimaryKeyZero() {
+			return &errors.AlreadyExistsError{Err: ErrOtherScope}
+		}
+		return nil
+	}
+
+	columns := conflict.Columns
+	if len(columns) == 0 && scope.PrimaryField() != nil {
+		columns = []string{scope.PrimaryField().DBName}
+	}
+	columns = append(columns[:len(columns):len(columns)], conflict.Scope)
+	where, values, ok, err := conflictConditions(scope, columns)
+	if err != nil || !ok {
+		return err
+	}
+	var count int
+	if err := db.New().Table(scope.TableName()).Where(where, values...).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return &errors.AlreadyExistsError{Err: ErrOtherScope}
+	}
+	return nil
+}
+
+// LockConflicting reads into row, a pointer to a gorm model, the row obj
+// would conflict with on columns, and locks it with FOR UPDATE until the end
+// of the transaction of db. It returns false when there is no such row.
+func LockConflicting(db *gorm.DB, obj interface{}, columns []string, row interface{}) (bool, error) {
+	scope := db.NewScope(obj)
+	where, values, ok, err := conflictConditions(scope, columns)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	err = db.Set("gorm:query_option", "FOR UPDATE").Where(where, values...).First(row).Error
+	if gorm.IsRecordNotFoundError(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// conflictConditions returns the conditions matching the row obj of scope
+// conflicts with on columns, and false when obj has a NULL among them.
+func conflictConditions(scope *gorm.Scope, columns []string) (string, []interface{}, bool, error) {
+	var conditions []string
+	var values []interface{}
+	for _, column := range columns {
+		field, ok := scope.FieldByName(column)
+		if !ok {
+			return "", nil, false, fmt.Errorf("insert: unknown column %s of %s", column, scope.TableName())
+		}
+		// NULLs never conflict
+		value := reflect.Indirect(field.Field)
+		if !value.IsValid() {
+			return "", nil, false, nil
+		}
+		conditions = append(conditions, fmt.Sprintf("%s.%s = ?", scope.QuotedTableName(), scope.Quote(column)))
+		values = append(values, value.Interface())
+	}
+	return strings.Join(conditions, " AND "), values, true, nil
+}
+
+func onConflictClause(scope *gorm.Scope, conflict OnConflict) (string, error) {
+	dialect := scope.Dialect().GetName()
+	if dialect != "mysql" && len(conflict.Columns) == 0 {
+		return "", fmt.Errorf("insert: missing conflict target for %s", scope.TableName())
+	}
+
+	update := conflict.Update
+	if conflict.UpdateAll {
+		update = updatableColumns(scope, conflict)
+	}
+
+	quote := func(columns []string) []string {
+		quoted := make([]string, len(columns))
+		for i, column := range columns {
+			quoted[i] = scope.Quote(column)
+		}
+		return quoted
+	}
+
+	switch dialect {
+	case "postgres", "sqlite3":
+		// a no-op update rather than DO NOTHING keeps the conflicting row returned
+		if len(update) == 0 {
+			update = conflict.Columns[:1]
+		}
+		var set []string
+		for _, column := range quote(update) {
+			set = append(set, fmt.Sprintf("%s = EXCLUDED.%s", column, column))
+		}
+		clause := fmt.Sprintf("ON CONFLICT (%s) DO UPDATE SET %s", strings.Join(quote(conflict.Columns), ","), strings.Join(set, ","))
+		if conflict.Scope != "" {
+			column := scope.Quote(conflict.Scope)
+			clause += fmt.Sprintf(" WHERE %s.%s = EXCLUDED.%s", scope.QuotedTableName(), column, column)
+		}
+		return clause, nil
+	case "mysql":
+		var set []string
+		if field := scope.PrimaryField(); field != nil {
+			// LAST_INSERT_ID(expr) makes the id of the updated row available,
+			// of the same scope only, the primary key of obj stays blank
+			// otherwise
+			column := scope.Quote(field.DBName)
+			if conflict.Scope != "" {
+				scopeColumn := scope.Quote(conflict.Scope)
+				set = append(set, fmt.Sprintf("%s = IF(%s = VALUES(%s), LAST_INSERT_ID(%s), %s)", column, scopeColumn, scopeColumn, column, column))
+			} else {
+				set = append(set, fmt.Sprintf("%s = LAST_INSERT_ID(%s)", column, column))
+			}
+		}
+		for _, column := range quote(update) {
+			if conflict.Scope != "" {
+				scopeColumn := scope.Quote(conflict.Scope)
+				set = append(set, fmt.Sprintf("%s = IF(%s = VALUES(%s), VALUES(%s), %s)", column, scopeColumn, scopeColumn, column, column))
+			} else {
+				set = append(set, fmt.Sprintf("%s = VALUES(%s)", column, column))
+			}
+		}
+		if len(set) == 0 {
+			return "", fmt.Errorf("insert: nothing to update on conflict for %s", scope.TableName())
+		}
+		return "ON DUPLICATE KEY UPDATE " + strings.Join(set, ","), nil
+	default:
+		return "", fmt.Errorf("insert: upsert is not supported by the %s dialect", dialect)
+	}
+}
+
+func updatableColumns(scope *gorm.Scope, conflict OnConflict) []string {
+	skip := map[string]bool{"created_at": true, conflict.Scope: true}
+	for _, column := range append(conflict.Columns, conflict.Keep...) {
+		skip[column] = true
+	}
+
+	var columns []string
+	for _, field := range scope.Fields() {
+		if field.IsNormal && !field.IsIgnored && !field.IsPrimaryKey && !skip[field.DBName] {
+			columns = append(columns, field.DBName)
+		}
+	}
+
+	return columns
+}
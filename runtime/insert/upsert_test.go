@@ -0,0 +1,208 @@
+package insert
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type contact struct {
+	Id         uint64 `gorm:"primary_key"`
+	ExternalId string
+	Name       string
+	Notes      string
+	AccountId  string
+}
+
+func TestUpsertPostgres(t *testing.T) {
+	db, mock := open(t, "postgres")
+	obj := &contact{ExternalId: "ext", Name: "name", Notes: "notes", AccountId: "acc"}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "contacts" \("external_id","name","notes","account_id"\) VALUES \(\$1,\$2,\$3,\$4\) ` +
+		`ON CONFLICT \("external_id"\) DO UPDATE SET "name" = EXCLUDED."name" ` +
+		`WHERE "contacts"."account_id" = EXCLUDED."account_id" RETURNING "contacts"."id"`).
+		WithArgs("ext", "name", "notes", "acc").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+	mock.ExpectCommit()
+
+	err := Upsert(db, obj, OnConflict{Columns: []string{"external_id"}, Update: []string{"name"}, Scope: "account_id"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+	if obj.Id != 4 {
+		t.Errorf("obj.Id = %d; want 4", obj.Id)
+	}
+}
+
+func TestUpsertPostgresNothingToUpdate(t *testing.T) {
+	db, mock := open(t, "postgres")
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`ON CONFLICT \("external_id"\) DO UPDATE SET "external_id" = EXCLUDED."external_id" RETURNING`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+	mock.ExpectCommit()
+
+	if err := Upsert(db, &contact{ExternalId: "ext"}, OnConflict{Columns: []string{"external_id"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpsertMySQLUpdateAll(t *testing.T) {
+	db, mock := open(t, "mysql")
+	obj := &contact{ExternalId: "ext", Name: "name"}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("ON DUPLICATE KEY UPDATE `id` = LAST_INSERT_ID\\(`id`\\),`name` = VALUES\\(`name`\\),`account_id` = VALUES\\(`account_id`\\)$").
+		WillReturnResult(sqlmock.NewResult(9, 2))
+	mock.ExpectCommit()
+
+	err := Upsert(db, obj, OnConflict{Columns: []string{"external_id"}, UpdateAll: true, Keep: []string{"notes"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+	if obj.Id != 9 {
+		t.Errorf("obj.Id = %d; want 9", obj.Id)
+	}
+}
+
+func TestUpsertOtherScope(t *testing.T) {
+	db, mock := open(t, "postgres")
+	mock.ExpectBegin()
+	mock.ExpectQuery(`WHERE "contacts"."account_id" = EXCLUDED."account_id" RETURNING`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+	err := Upsert(db, &contact{ExternalId: "ext", AccountId: "acc"}, OnConflict{Columns: []string{"external_id"}, UpdateAll: true, Scope: "account_id"})
+	if !errors.Is(err, ErrOtherScope) || status.Code(err) != codes.AlreadyExists {
+		t.Errorf("got error %v; want AlreadyExists", err)
+	}
+
+	db, mock = open(t, "mysql")
+	mock.ExpectBegin()
+	mock.ExpectExec("ON DUPLICATE KEY UPDATE `id` = IF\\(`account_id` = VALUES\\(`account_id`\\), LAST_INSERT_ID\\(`id`\\), `id`\\),").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	obj := &contact{ExternalId: "ext", AccountId: "acc"}
+	err = Upsert(db, obj, OnConflict{Columns: []string{"external_id"}, UpdateAll: true, Scope: "account_id"})
+	if !errors.Is(err, ErrOtherScope) || obj.Id != 0 {
+		t.Errorf("got error %v and id %d; want ErrOtherScope and no id", err, obj.Id)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+type label struct {
+	Name      string
+	AccountId string
+}
+
+func TestUpsertOtherScopeSQLite(t *testing.T) {
+	db, mock := open(t, "sqlite3")
+	conflict := OnConflict{Columns: []string{"external_id"}, UpdateAll: true, Scope: "account_id"}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`WHERE "contacts"."account_id" = EXCLUDED."account_id"$`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	if err := Upsert(db, &contact{ExternalId: "ext", AccountId: "acc"}, conflict); !errors.Is(err, ErrOtherScope) {
+		t.Errorf("got error %v; want ErrOtherScope", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`WHERE "contacts"."account_id" = EXCLUDED."account_id"$`).
+		WillReturnResult(sqlmock.NewResult(4, 1))
+	mock.ExpectCommit()
+	if err := Upsert(db, &contact{ExternalId: "ext", AccountId: "acc"}, conflict); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpsertOtherScopePostgresWithoutPrimaryKey(t *testing.T) {
+	db, mock := open(t, "postgres")
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`ON CONFLICT \("name"\) DO UPDATE SET "name" = EXCLUDED."name" WHERE "labels"."account_id" = EXCLUDED."account_id" RETURNING`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	err := Upsert(db, &label{Name: "name", AccountId: "acc"}, OnConflict{Columns: []string{"name"}, Scope: "account_id"})
+	if !errors.Is(err, ErrOtherScope) {
+		t.Errorf("got error %v; want ErrOtherScope", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpsertOtherScopeMySQLWithPrimaryKey(t *testing.T) {
+	db, mock := open(t, "mysql")
+	conflict := OnConflict{Columns: []string{"external_id"}, UpdateAll: true, Scope: "account_id"}
+
+	// no row affected, the conflicting row has another scope or kept its values
+	mock.ExpectBegin()
+	mock.ExpectExec("ON DUPLICATE KEY UPDATE").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectQuery("SELECT count\\(\\*\\) FROM `contacts` WHERE \\(`contacts`.`external_id` = \\? AND `contacts`.`account_id` = \\?\\)").
+		WithArgs("ext", "acc").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	if err := Upsert(db, &contact{Id: 4, ExternalId: "ext", AccountId: "acc"}, conflict); !errors.Is(err, ErrOtherScope) {
+		t.Errorf("got error %v; want ErrOtherScope", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("ON DUPLICATE KEY UPDATE").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectQuery("SELECT count").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	if err := Upsert(db, &contact{Id: 4, ExternalId: "ext", AccountId: "acc"}, conflict); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLockConflicting(t *testing.T) {
+	db, mock := open(t, "postgres")
+
+	mock.ExpectQuery(`SELECT \* FROM "contacts" WHERE \("contacts"."external_id" = \$1 AND "contacts"."account_id" = \$2\) ` +
+		`ORDER BY "contacts"."id" ASC LIMIT 1 FOR UPDATE`).
+		WithArgs("ext", "acc").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(4, "old"))
+	mock.ExpectQuery(`SELECT \* FROM "contacts" WHERE \("contacts"."external_id" = \$1\)`).
+		WithArgs("new").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	var row contact
+	locked, err := LockConflicting(db, &contact{ExternalId: "ext", AccountId: "acc"}, []string{"external_id", "account_id"}, &row)
+	if err != nil || !locked || row.Id != 4 || row.Name != "old" {
+		t.Errorf("got %t, %+v, %v; want the conflicting row", locked, row, err)
+	}
+	if locked, err := LockConflicting(db, &contact{ExternalId: "new"}, []string{"external_id"}, &contact{}); err != nil || locked {
+		t.Errorf("got %t, %v; want no conflicting row", locked, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpsertMissingTarget(t *testing.T) {
+	db, _ := open(t, "postgres")
+	if err := Upsert(db, &contact{}, OnConflict{UpdateAll: true}); err == nil {
+		t.Error("expected an error for a missing conflict target")
+	}
+}
@@ -0,0 +1,38 @@
+package memory
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+)
+
+// SameColumns reports whether the ORM objects a and b have the same values
+// in columns, the ones of a conflict target for instance. Columns are named
+// like gorm does, by their column tag or after their field.
+func SameColumns(a, b interface{}, columns []string) bool {
+	va, vb := reflect.Indirect(reflect.ValueOf(a)), reflect.Indirect(reflect.ValueOf(b))
+	for _, column := range columns {
+		i, ok := fieldIndex(va.Type(), column)
+		if !ok || !reflect.DeepEqual(indirect(va.Field(i).Interface()), indirect(vb.Field(i).Interface())) {
+			return false
+		}
+	}
+	return true
+}
+
+func fieldIndex(t reflect.Type, column string) (int, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name := gorm.ToColumnName(sf.Name)
+		for _, setting := range strings.Split(sf.Tag.Get("gorm"), ";") {
+			if kv := strings.SplitN(setting, ":", 2); len(kv) == 2 && strings.EqualFold(strings.TrimSpace(kv[0]), "column") {
+				name = strings.TrimSpace(kv[1])
+			}
+		}
+		if name == column {
+			return i, true
+		}
+	}
+	return 0, false
+}
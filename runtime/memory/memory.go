@@ -0,0 +1,301 @@
+// Package memory holds the objects of the in-memory repositories generated
+// with the memory parameter. They implement the generated repositories
+// without a database, for the tests of the code using them.
+package memory
+
+import (
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/acanseco/protoc-gen-gorm/errors"
+	"github.com/acanseco/protoc-gen-gorm/runtime/insert"
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	uuid "github.com/satori/go.uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Row is an object of a Table.
+type Row struct {
+	// Tenant owns the object, it is nil for the types that aren't multi
+	// tenant
+	Tenant interface{}
+	// Key is the primary key of the object, it is nil for the types without
+	// primary key
+	Key    interface{}
+	Object proto.Message
+}
+
+// Table holds the objects of a type in insertion order. The objects are
+// cloned when they are stored and returned, and the tenants and keys are
+// compared once dereferenced. A nil tenant, the one of the requests
+// bypassing tenant isolation, sees the objects of every tenant.
+type Table struct {
+	mu     sync.Mutex
+	rows   []Row
+	lastID uint64
+}
+
+// Tenant returns the tenant v of an object, nil when it is zero: the
+// conversions only set the tenant of the requests isolated by tenant.
+func Tenant(v interface{}) interface{} {
+	if isZero(v) {
+		return nil
+	}
+	return v
+}
+
+// AssignKey sets the primary key pointed to by key when it is zero: integer
+// keys are numbered after the largest one stored, and UUID keys are random.
+// Other keys are left unchanged.
+func (t *Table) AssignKey(key interface{}) {
+	v := reflect.ValueOf(key).Elem()
+	if v.Kind() == reflect.Ptr {
+		if !v.IsNil() && !v.Elem().IsZero() {
+			return
+		}
+		if v.Type().Elem() != reflect.TypeOf(uuid.UUID{}) {
+			return
+		}
+		id := uuid.NewV4()
+		v.Set(reflect.ValueOf(&id))
+		return
+	}
+	if !v.IsZero() {
+		return
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(t.nextID()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(t.nextID())
+	default:
+		if v.Type() == reflect.TypeOf(uuid.UUID{}) {
+			v.Set(reflect.ValueOf(uuid.NewV4()))
+		}
+	}
+}
+
+func (t *Table) nextID() uint64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.lastID++
+	return t.lastID
+}
+
+// Insert stores rows, none of them when the key of one is already taken.
+func (t *Table) Insert(rows ...Row) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i, row := range rows {
+		if row.Key == nil {
+			continue
+		}
+		if _, ok := t.find(nil, row.Key); ok {
+			return status.Errorf(codes.AlreadyExists, "key %v already exists", indirect(row.Key))
+		}
+		for _, other := range rows[:i] {
+			if other.Key != nil && indirect(other.Key) == indirect(row.Key) {
+				return status.Errorf(codes.AlreadyExists, "key %v already exists", indirect(row.Key))
+			}
+		}
+	}
+	for _, row := range rows {
+		t.insert(row)
+	}
+	return nil
+}
+
+func (t *Table) insert(row Row) {
+	row.Object = proto.Clone(row.Object)
+	t.rows = append(t.rows, row)
+	if id, ok := integer(row.Key); ok && id > t.lastID {
+		t.lastID = id
+	}
+}
+
+// Get returns the object with key visible to tenant.
+func (t *Table) Get(tenant, key interface{}) (proto.Message, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	i, ok := t.find(tenant, key)
+	if !ok {
+		return nil, false
+	}
+	return proto.Clone(t.rows[i].Object), true
+}
+
+// Save replaces the object with the key of row, or stores row when there is
+// none, and reports whether it was stored. It fails when the object belongs
+// to another tenant than the one of row.
+func (t *Table) Save(row Row) (bool, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	i, ok := t.find(nil, row.Key)
+	if !ok {
+		t.insert(row)
+		return true, nil
+	}
+	if !visible(t.rows[i].Tenant, row.Tenant) {
+		return false, status.Errorf(codes.AlreadyExists, "key %v already exists", indirect(row.Key))
+	}
+	if row.Tenant == nil {
+		row.Tenant = t.rows[i].Tenant
+	}
+	row.Object = proto.Clone(row.Object)
+	t.rows[i] = row
+	return false, nil
+}
+
+// Delete removes the objects with keys visible to tenant.
+func (t *Table) Delete(tenant interface{}, keys ...interface{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, key := range keys {
+		if i, ok := t.find(tenant, key); ok {
+			t.rows = append(t.rows[:i], t.rows[i+1:]...)
+		}
+	}
+}
+
+// Conflicting returns the first object matched by match among the objects
+// of every tenant, like the unique indexes of a database. It fails with an
+// *errors.AlreadyExistsError wrapping insert.ErrOtherScope when the object
+// isn't visible to tenant.
+func (t *Table) Conflicting(tenant interface{}, match func(proto.Message) bool) (proto.Message, bool, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, row := range t.rows {
+		if !match(row.Object) {
+			continue
+		}
+		if !visible(row.Tenant, tenant) {
+			return nil, false, &errors.AlreadyExistsError{Err: insert.ErrOtherScope}
+		}
+		return proto.Clone(row.Object), true, nil
+	}
+	return nil, false, nil
+}
+
+// PageToken returns the page token of the objects following the one with
+// key in the order of List, valid for the same sorting and filter.
+func PageToken(key interface{}) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprint(indirect(key))))
+}
+
+// List returns the objects visible to tenant matched by f, in insertion
+// order unless they are sorted by s, and paged by p. The page token of p is
+// one returned by PageToken.
+func (t *Table) List(tenant interface{}, f *query.Filtering, s *query.Sorting, p *query.Pagination) ([]proto.Message, error) {
+	objects, keys, err := t.filter(tenant, f)
+	if err != nil {
+		return nil, err
+	}
+	if err := Sort(objects, s); err != nil {
+		return nil, err
+	}
+	if token := p.GetPageToken(); token != "" {
+		last, err := base64.RawURLEncoding.DecodeString(token)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "malformed page token")
+		}
+		i := 0
+		for i < len(objects) && (keys[objects[i]] == nil || fmt.Sprint(indirect(keys[objects[i]])) != string(last)) {
+			i++
+		}
+		if i == len(objects) {
+			return nil, status.Error(codes.InvalidArgument, "page token doesn't match an object")
+		}
+		objects = objects[i+1:]
+	}
+	if offset := int(p.GetOffset()); offset < len(objects) {
+		objects = objects[offset:]
+	} else {
+		objects = nil
+	}
+	if limit := int(p.GetLimit()); limit > 0 && limit < len(objects) {
+		objects = objects[:limit]
+	}
+	return objects, nil
+}
+
+// Count returns the number of objects visible to tenant matched by f.
+func (t *Table) Count(tenant interface{}, f *query.Filtering) (int64, error) {
+	objects, _, err := t.filter(tenant, f)
+	return int64(len(objects)), err
+}
+
+// filter returns the objects visible to tenant matched by f, and their keys.
+func (t *Table) filter(tenant interface{}, f *query.Filtering) ([]proto.Message, map[proto.Message]interface{}, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var objects []proto.Message
+	keys := make(map[proto.Message]interface{})
+	for _, row := range t.rows {
+		if !visible(row.Tenant, tenant) {
+			continue
+		}
+		if f.GetRoot() != nil {
+			ok, err := f.Filter(row.Object)
+			if err != nil {
+				return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			if !ok {
+				continue
+			}
+		}
+		object := proto.Clone(row.Object)
+		objects = append(objects, object)
+		keys[object] = row.Key
+	}
+	return objects, keys, nil
+}
+
+func (t *Table) find(tenant, key interface{}) (int, bool) {
+	if key == nil {
+		return 0, false
+	}
+	key = indirect(key)
+	for i, row := range t.rows {
+		if row.Key != nil && indirect(row.Key) == key && visible(row.Tenant, tenant) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// visible reports whether an object of owner is visible to tenant.
+func visible(owner, tenant interface{}) bool {
+	return tenant == nil || (owner != nil && indirect(owner) == indirect(tenant))
+}
+
+func integer(key interface{}) (uint64, bool) {
+	if key == nil {
+		return 0, false
+	}
+	switch v := reflect.ValueOf(indirect(key)); v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return uint64(v.Int()), v.Int() > 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint(), true
+	}
+	return 0, false
+}
+
+func isZero(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	return rv.IsZero() || (rv.Kind() == reflect.Ptr && rv.Elem().IsZero())
+}
+
+func indirect(v interface{}) interface{} {
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		return rv.Elem().Interface()
+	}
+	return v
+}
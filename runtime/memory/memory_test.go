@@ -0,0 +1,124 @@
+package memory
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	uuid "github.com/satori/go.uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/sourcecontextpb"
+	"google.golang.org/protobuf/types/known/typepb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestAssignKey(t *testing.T) {
+	table := &Table{}
+	if err := table.Insert(Row{Key: uint32(5), Object: wrapperspb.String("a")}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var id uint32
+	table.AssignKey(&id)
+	if id != 6 {
+		t.Errorf("got id %d; want the one after the largest key", id)
+	}
+	table.AssignKey(&id)
+	if id != 6 {
+		t.Errorf("got id %d; want the id set to be kept", id)
+	}
+
+	var u uuid.UUID
+	table.AssignKey(&u)
+	var p *uuid.UUID
+	table.AssignKey(&p)
+	if u == uuid.Nil || p == nil || *p == uuid.Nil {
+		t.Errorf("got UUIDs %v and %v; want random ones", u, p)
+	}
+}
+
+func TestTenants(t *testing.T) {
+	table := &Table{}
+	err := table.Insert(
+		Row{Tenant: "acme", Key: uint32(1), Object: wrapperspb.String("a")},
+		Row{Tenant: "initech", Key: uint32(2), Object: wrapperspb.String("b")},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := table.Insert(Row{Tenant: "acme", Key: uint32(2), Object: wrapperspb.String("c")}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("got error %v; want AlreadyExists", err)
+	}
+
+	if _, ok := table.Get("acme", uint32(2)); ok {
+		t.Error("expected the object of another tenant to be hidden")
+	}
+	if m, ok := table.Get(nil, uint32(2)); !ok || !proto.Equal(m, wrapperspb.String("b")) {
+		t.Errorf("got %v, %v; want the object when bypassing tenant isolation", m, ok)
+	}
+	if _, err := table.Save(Row{Tenant: "acme", Key: uint32(2), Object: wrapperspb.String("c")}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("got error %v; want AlreadyExists", err)
+	}
+	table.Delete("acme", uint32(1), uint32(2))
+	if n, _ := table.Count(nil, nil); n != 1 {
+		t.Errorf("got %d objects; want only the one of initech left", n)
+	}
+}
+
+func TestList(t *testing.T) {
+	table := &Table{}
+	for i, v := range []int32{3, 1, 2} {
+		table.Insert(Row{Key: uint32(i + 1), Object: wrapperspb.Int32(v)})
+	}
+	f, err := query.ParseFiltering("value > 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := query.ParseSorting("value")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	objects, err := table.List(nil, f, s, &query.Pagination{Limit: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(objects) != 1 || !proto.Equal(objects[0], wrapperspb.Int32(2)) {
+		t.Errorf("got %v; want the lowest value above 1", objects)
+	}
+	if _, err := table.List(nil, nil, &query.Sorting{Criterias: []*query.SortCriteria{{Tag: "missing"}}}, nil); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("got error %v; want InvalidArgument", err)
+	}
+	// the object with key 3 has the value 2
+	objects, err = table.List(nil, f, s, &query.Pagination{PageToken: PageToken(uint32(3))})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(objects) != 1 || !proto.Equal(objects[0], wrapperspb.Int32(3)) {
+		t.Errorf("got %v; want the values following 2", objects)
+	}
+	if _, err := table.List(nil, nil, nil, &query.Pagination{PageToken: "token"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("got error %v; want InvalidArgument", err)
+	}
+}
+
+func TestSortNested(t *testing.T) {
+	objects := []proto.Message{
+		&typepb.Type{Name: "unset"},
+		&typepb.Type{Name: "b", SourceContext: &sourcecontextpb.SourceContext{FileName: "b.proto"}},
+		&typepb.Type{Name: "a", SourceContext: &sourcecontextpb.SourceContext{FileName: "a.proto"}},
+	}
+	for _, tag := range []string{"source_context.file_name", "sourceContext"} {
+		if err := Sort(objects, &query.Sorting{Criterias: []*query.SortCriteria{{Tag: tag}}}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var names []string
+		for _, object := range objects {
+			names = append(names, object.(*typepb.Type).GetName())
+		}
+		if !reflect.DeepEqual(names, []string{"a", "b", "unset"}) {
+			t.Errorf("got %v sorted by %s; want the unset message last", names, tag)
+		}
+	}
+}
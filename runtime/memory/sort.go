@@ -0,0 +1,165 @@
+package memory
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Sort sorts objects by the criteria of s, which name the fields by their
+// protobuf or JSON name and the fields of nested messages by their path
+// separated by dots. Unset messages sort last in ascending order, like NULL
+// values in Postgres.
+func Sort(objects []proto.Message, s *query.Sorting) error {
+	criteria := s.GetCriterias()
+	if len(criteria) == 0 || len(objects) == 0 {
+		return nil
+	}
+	desc := objects[0].ProtoReflect().Descriptor()
+	paths := make([][]protoreflect.FieldDescriptor, len(criteria))
+	for i, c := range criteria {
+		path, err := fieldPath(desc, c.GetTag())
+		if err != nil {
+			return err
+		}
+		paths[i] = path
+	}
+	sort.SliceStable(objects, func(i, j int) bool {
+		for k, c := range criteria {
+			cmp := compare(value(objects[i], paths[k]), value(objects[j], paths[k]))
+			if c.IsDesc() {
+				cmp = -cmp
+			}
+			if cmp != 0 {
+				return cmp < 0
+			}
+		}
+		return false
+	})
+	return nil
+}
+
+func fieldPath(desc protoreflect.MessageDescriptor, tag string) ([]protoreflect.FieldDescriptor, error) {
+	var path []protoreflect.FieldDescriptor
+	for _, name := range strings.Split(tag, ".") {
+		if desc == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot sort by %q", tag)
+		}
+		fd := desc.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			fd = desc.Fields().ByJSONName(name)
+		}
+		if fd == nil || fd.IsList() || fd.IsMap() {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot sort by %q", tag)
+		}
+		path = append(path, fd)
+		desc = fd.Message()
+	}
+	return path, nil
+}
+
+// value returns the value at path of m, invalid when a message of the path
+// is unset.
+func value(m proto.Message, path []protoreflect.FieldDescriptor) protoreflect.Value {
+	msg := m.ProtoReflect()
+	var v protoreflect.Value
+	for _, fd := range path {
+		if fd.Message() != nil && !msg.Has(fd) {
+			return protoreflect.Value{}
+		}
+		v = msg.Get(fd)
+		if fd.Message() != nil {
+			msg = v.Message()
+		}
+	}
+	return v
+}
+
+// compare returns -1, 0 or 1 when a is lower than, equal to or greater than
+// b. Messages are compared field by field, so that timestamps and wrappers
+// are compared by value.
+func compare(a, b protoreflect.Value) int {
+	switch {
+	case !a.IsValid() && !b.IsValid():
+		return 0
+	case !a.IsValid():
+		return 1
+	case !b.IsValid():
+		return -1
+	}
+	switch x := a.Interface().(type) {
+	case bool:
+		return compareBool(x, b.Bool())
+	case int32, int64:
+		return compareInt(a.Int(), b.Int())
+	case uint32, uint64:
+		return compareUint(a.Uint(), b.Uint())
+	case float32, float64:
+		return compareFloat(a.Float(), b.Float())
+	case string:
+		return strings.Compare(x, b.String())
+	case []byte:
+		return bytes.Compare(x, b.Bytes())
+	case protoreflect.EnumNumber:
+		return compareInt(int64(x), int64(b.Enum()))
+	case protoreflect.Message:
+		fields := x.Descriptor().Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if fd.IsList() || fd.IsMap() {
+				continue
+			}
+			path := []protoreflect.FieldDescriptor{fd}
+			if cmp := compare(value(x.Interface(), path), value(b.Message().Interface(), path)); cmp != 0 {
+				return cmp
+			}
+		}
+	}
+	return 0
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case !a:
+		return -1
+	}
+	return 1
+}
+
+func compareInt(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
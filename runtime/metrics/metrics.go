@@ -0,0 +1,62 @@
+// Package metrics defines the instrumentation the generated servers of
+// services with the with_metrics option report their requests to.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Observation describes a request handled by a generated server method.
+type Observation struct {
+	// FullMethod is the full RPC method name, /package.service/method.
+	FullMethod string
+	// Verb is the kind of the method: Create, Read, List...
+	Verb string
+	// Type is the name of the message type the method works on.
+	Type string
+	// Latency is the time spent handling the request.
+	Latency time.Duration
+	// Rows is the number of objects returned, created, updated or
+	// deleted by the request, 0 when it failed.
+	Rows int
+	// ErrorClass is the gRPC code name of the error of the request, OK on
+	// success.
+	ErrorClass string
+}
+
+// Metrics records the observations of the requests of the generated
+// servers.
+type Metrics interface {
+	Observe(ctx context.Context, o Observation)
+}
+
+// MetricsFunc adapts a function to the Metrics interface.
+type MetricsFunc func(ctx context.Context, o Observation)
+
+// Observe calls f(ctx, o).
+func (f MetricsFunc) Observe(ctx context.Context, o Observation) {
+	f(ctx, o)
+}
+
+// ErrorClass returns the gRPC code name of err, NotFound for the missing
+// records of gorm and Unknown for the other errors without status.
+func ErrorClass(err error) string {
+	switch {
+	case err == nil:
+		return codes.OK.String()
+	case gorm.IsRecordNotFoundError(err):
+		return codes.NotFound.String()
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled.String()
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded.String()
+	default:
+		return status.Code(err).String()
+	}
+}
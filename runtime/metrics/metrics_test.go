@@ -0,0 +1,27 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorClass(t *testing.T) {
+	for err, want := range map[error]string{
+		nil:                                      "OK",
+		gorm.ErrRecordNotFound:                   "NotFound",
+		fmt.Errorf("read: %w", context.Canceled): "Canceled",
+		context.DeadlineExceeded:                 "DeadlineExceeded",
+		status.Error(codes.InvalidArgument, "bad"): "InvalidArgument",
+		errors.New("connection refused"):           "Unknown",
+	} {
+		if got := ErrorClass(err); got != want {
+			t.Errorf("ErrorClass(%v) = %s; want %s", err, got, want)
+		}
+	}
+}
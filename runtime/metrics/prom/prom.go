@@ -0,0 +1,51 @@
+// Package prom records the metrics of the generated servers with the
+// Prometheus client.
+package prom
+
+import (
+	"context"
+
+	"github.com/acanseco/protoc-gen-gorm/runtime/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var labels = []string{"method", "verb", "type", "error_class"}
+
+// Metrics is a metrics.Metrics recording the gorm_server_handling_seconds
+// histogram of the latency of the requests and the gorm_server_rows_total
+// counter of their rows, both labelled by method, verb, type and
+// error_class.
+type Metrics struct {
+	latency *prometheus.HistogramVec
+	rows    *prometheus.CounterVec
+}
+
+// New returns the Metrics registered with reg, for example
+// prometheus.DefaultRegisterer.
+func New(reg prometheus.Registerer) (*Metrics, error) {
+	m := &Metrics{
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "gorm_server_handling_seconds",
+			Help:    "Latency of the requests handled by the generated servers.",
+			Buckets: prometheus.DefBuckets,
+		}, labels),
+		rows: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "gorm_server_rows_total",
+			Help: "Objects returned, created, updated or deleted by the generated servers.",
+		}, labels),
+	}
+	for _, c := range []prometheus.Collector{m.latency, m.rows} {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// Observe records o.
+func (m *Metrics) Observe(ctx context.Context, o metrics.Observation) {
+	values := []string{o.FullMethod, o.Verb, o.Type, o.ErrorClass}
+	m.latency.WithLabelValues(values...).Observe(o.Latency.Seconds())
+	m.rows.WithLabelValues(values...).Add(float64(o.Rows))
+}
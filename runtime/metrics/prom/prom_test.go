@@ -0,0 +1,33 @@
+package prom
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/acanseco/protoc-gen-gorm/runtime/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestObserve(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m, err := New(reg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	o := metrics.Observation{FullMethod: "/example.Contacts/List", Verb: "List", Type: "Contact", Latency: 20 * time.Millisecond, Rows: 3, ErrorClass: "OK"}
+	m.Observe(context.Background(), o)
+	m.Observe(context.Background(), o)
+
+	if got := testutil.ToFloat64(m.rows.WithLabelValues(o.FullMethod, o.Verb, o.Type, o.ErrorClass)); got != 6 {
+		t.Errorf("gorm_server_rows_total = %v; want 6", got)
+	}
+	if got := testutil.CollectAndCount(m.latency, "gorm_server_handling_seconds"); got != 1 {
+		t.Errorf("got %d latency series; want 1", got)
+	}
+	if _, err := New(reg); err == nil {
+		t.Error("expected an error registering the metrics twice")
+	}
+}
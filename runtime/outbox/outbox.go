@@ -0,0 +1,72 @@
+// Package outbox holds the change events of the messages with the outbox
+// option: the generated write handlers insert an Event in the outbox table
+// in their transaction, and a relay.Relay publishes them.
+package outbox
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Event is a row of the outbox table.
+type Event struct {
+	Id uint64 `gorm:"primary_key"`
+	// Type is the type of the event, the message name followed by Created,
+	// Updated or Deleted, like WidgetCreated.
+	Type string
+	// AggregateType is the name of the message.
+	AggregateType string
+	// AggregateId is the primary key of the object.
+	AggregateId string `gorm:"index"`
+	// State and OldState are the protobuf encoded object after and before the
+	// change, State is nil for deletions and OldState for creations.
+	State    []byte
+	OldState []byte
+	// CreatedAt is the time of the change.
+	CreatedAt time.Time
+}
+
+// TableName returns the name of the outbox table.
+func (Event) TableName() string {
+	return "outbox"
+}
+
+// NewEvent returns the event of the change of the object of type
+// aggregateType, identified by aggregateID, from before to after. Either
+// may be nil.
+func NewEvent(eventType, aggregateType string, aggregateID interface{}, before, after proto.Message) (*Event, error) {
+	e := &Event{
+		Type:          eventType,
+		AggregateType: aggregateType,
+		AggregateId:   formatID(aggregateID),
+		CreatedAt:     time.Now().UTC(),
+	}
+	var err error
+	if after != nil {
+		if e.State, err = proto.Marshal(after); err != nil {
+			return nil, err
+		}
+	}
+	if before != nil {
+		if e.OldState, err = proto.Marshal(before); err != nil {
+			return nil, err
+		}
+	}
+
+	return e, nil
+}
+
+func formatID(id interface{}) string {
+	v := reflect.ValueOf(id)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+
+	return fmt.Sprint(v.Interface())
+}
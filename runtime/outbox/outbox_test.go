@@ -0,0 +1,35 @@
+package outbox
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestNewEvent(t *testing.T) {
+	id := "w-1"
+	before, after := wrapperspb.String("old"), wrapperspb.String("new")
+	e, err := NewEvent("WidgetUpdated", "Widget", &id, before, after)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e.Type != "WidgetUpdated" || e.AggregateType != "Widget" || e.AggregateId != "w-1" || e.CreatedAt.IsZero() {
+		t.Errorf("unexpected event %+v", e)
+	}
+	state, oldState := &wrapperspb.StringValue{}, &wrapperspb.StringValue{}
+	if err := proto.Unmarshal(e.State, state); err != nil || state.GetValue() != "new" {
+		t.Errorf("got state %v; want new", state)
+	}
+	if err := proto.Unmarshal(e.OldState, oldState); err != nil || oldState.GetValue() != "old" {
+		t.Errorf("got old state %v; want old", oldState)
+	}
+
+	e, err = NewEvent("WidgetCreated", "Widget", uint64(7), nil, after)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e.AggregateId != "7" || e.OldState != nil {
+		t.Errorf("unexpected event %+v", e)
+	}
+}
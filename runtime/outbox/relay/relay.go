@@ -0,0 +1,105 @@
+// Package relay publishes the events of the outbox table.
+package relay
+
+import (
+	"context"
+	"time"
+
+	"github.com/acanseco/protoc-gen-gorm/runtime/outbox"
+	"github.com/acanseco/protoc-gen-gorm/runtime/transaction"
+	"github.com/jinzhu/gorm"
+)
+
+// Publisher publishes the events of the outbox to a message broker.
+type Publisher interface {
+	Publish(ctx context.Context, e *outbox.Event) error
+}
+
+// PublisherFunc adapts a function to the Publisher interface.
+type PublisherFunc func(ctx context.Context, e *outbox.Event) error
+
+// Publish calls f(ctx, e).
+func (f PublisherFunc) Publish(ctx context.Context, e *outbox.Event) error {
+	return f(ctx, e)
+}
+
+// Relay polls the outbox table of DB and hands its events to Publisher by
+// id, deleting them once published. Events are published at least once:
+// they are published again when the relay stops before deleting them.
+// Several relays can poll the same table on Postgres and MySQL, whose rows
+// are locked with SKIP LOCKED, but each one then publishes the batches it
+// locked concurrently with the others: the events are only published in
+// order with a single relay.
+type Relay struct {
+	DB        *gorm.DB
+	Publisher Publisher
+	// Interval is the time between two polls of an empty table, one second
+	// by default.
+	Interval time.Duration
+	// BatchSize is the maximum number of events published per transaction,
+	// 100 by default.
+	BatchSize int
+}
+
+// Run publishes the events until ctx is done or an event can't be
+// published.
+func (r *Relay) Run(ctx context.Context) error {
+	interval := r.Interval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	for {
+		n, err := r.Poll(ctx)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// Poll publishes a batch of events and returns their number. When an event
+// can't be published the events published before it are deleted and the
+// error is returned.
+func (r *Relay) Poll(ctx context.Context) (int, error) {
+	batchSize := r.BatchSize
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	var published []uint64
+	var publishErr error
+	err := transaction.Run(r.DB, func(tx *gorm.DB) error {
+		query := tx.Order("id").Limit(batchSize)
+		switch tx.Dialect().GetName() {
+		case "postgres", "mysql":
+			query = query.Set("gorm:query_option", "FOR UPDATE SKIP LOCKED")
+		}
+		var events []*outbox.Event
+		if err := query.Find(&events).Error; err != nil {
+			return err
+		}
+
+		for _, e := range events {
+			if publishErr = r.Publisher.Publish(ctx, e); publishErr != nil {
+				break
+			}
+			published = append(published, e.Id)
+		}
+		if len(published) == 0 {
+			return nil
+		}
+		return tx.Where("id IN (?)", published).Delete(&outbox.Event{}).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(published), publishErr
+}
@@ -0,0 +1,52 @@
+package relay
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/acanseco/protoc-gen-gorm/runtime/outbox"
+	"github.com/jinzhu/gorm"
+)
+
+func TestPoll(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+
+	unavailable := errors.New("broker unavailable")
+	var types []string
+	r := &Relay{DB: db, BatchSize: 10, Publisher: PublisherFunc(func(ctx context.Context, e *outbox.Event) error {
+		if e.Id == 3 {
+			return unavailable
+		}
+		types = append(types, e.Type)
+		return nil
+	})}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "outbox" ORDER BY "id" LIMIT 10 FOR UPDATE SKIP LOCKED`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "type"}).
+			AddRow(1, "WidgetCreated").AddRow(2, "WidgetUpdated").AddRow(3, "WidgetDeleted"))
+	mock.ExpectExec(`DELETE FROM "outbox" WHERE \(id IN \(\$1,\$2\)\)`).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	n, err := r.Poll(context.Background())
+	if err != unavailable || n != 2 {
+		t.Errorf("got %d, %v; want 2, %v", n, err, unavailable)
+	}
+	if len(types) != 2 || types[0] != "WidgetCreated" || types[1] != "WidgetUpdated" {
+		t.Errorf("unexpected published events %v", types)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,67 @@
+package paging
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jinzhu/gorm"
+)
+
+// CountStrategy selects how Count counts the rows of a query.
+type CountStrategy int
+
+const (
+	// ExactCount runs a COUNT(*) query.
+	ExactCount CountStrategy = iota
+	// EstimatedCount uses the planner row estimate on postgres when it is
+	// above EstimateThreshold, and an exact count otherwise.
+	EstimatedCount
+)
+
+// EstimateThreshold is the planner row estimate above which EstimatedCount
+// doesn't run an exact count.
+var EstimateThreshold int64 = 100000
+
+// Count returns the number of rows of obj, a pointer to a gorm model,
+// matched by the conditions of db. Order, limit and offset are ignored.
+func Count(db *gorm.DB, obj interface{}, strategy CountStrategy) (int64, error) {
+	db = db.Model(obj).Limit(-1).Offset(-1)
+
+	if strategy == EstimatedCount && db.Dialect().GetName() == "postgres" {
+		estimate, err := estimateCount(db)
+		if err != nil {
+			return 0, err
+		}
+		if estimate > EstimateThreshold {
+			return estimate, nil
+		}
+	}
+
+	var count int64
+	if err := db.Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func estimateCount(db *gorm.DB) (int64, error) {
+	var raw string
+	if err := db.New().Raw("EXPLAIN (FORMAT JSON) ?", db.Select("1").QueryExpr()).Row().Scan(&raw); err != nil {
+		return 0, err
+	}
+
+	var plans []struct {
+		Plan struct {
+			Rows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(raw), &plans); err != nil {
+		return 0, err
+	}
+	if len(plans) == 0 {
+		return 0, fmt.Errorf("paging: empty query plan")
+	}
+
+	return int64(plans[0].Plan.Rows), nil
+}
@@ -0,0 +1,65 @@
+package paging
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCountExact(t *testing.T) {
+	db, mock := open(t)
+
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "posts" WHERE \(title = \$1\)$`).
+		WithArgs("a").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+	count, err := Count(db.Where("title = ?", "a").Order("id").Limit(10).Offset(20), &post{}, ExactCount)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count != 3 {
+		t.Errorf("count = %d; want 3", count)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCountEstimated(t *testing.T) {
+	db, mock := open(t)
+
+	mock.ExpectQuery(`EXPLAIN \(FORMAT JSON\) SELECT 1 FROM "posts" WHERE \(title = \$1\)`).
+		WithArgs("a").
+		WillReturnRows(sqlmock.NewRows([]string{"QUERY PLAN"}).AddRow(`[{"Plan": {"Node Type": "Seq Scan", "Plan Rows": 2500000}}]`))
+
+	count, err := Count(db.Where("title = ?", "a"), &post{}, EstimatedCount)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count != 2500000 {
+		t.Errorf("count = %d; want 2500000", count)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCountEstimatedBelowThreshold(t *testing.T) {
+	db, mock := open(t)
+
+	mock.ExpectQuery(`EXPLAIN`).
+		WillReturnRows(sqlmock.NewRows([]string{"QUERY PLAN"}).AddRow(`[{"Plan": {"Plan Rows": 40}}]`))
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "posts"`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(42))
+
+	count, err := Count(db, &post{}, EstimatedCount)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count != 42 {
+		t.Errorf("count = %d; want 42", count)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,260 @@
+package paging
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var tokenKey []byte
+
+// ErrNoTokenKey is returned by NewKeyset until SetTokenKey is called.
+var ErrNoTokenKey = errors.New("paging: the page token key isn't set, call SetTokenKey")
+
+// SetTokenKey sets the key page tokens are signed with, the same in every
+// process serving the API so that any of them reads the tokens of the
+// others. It must be called during initialization, keyset pagination fails
+// with ErrNoTokenKey until then.
+func SetTokenKey(key []byte) {
+	tokenKey = key
+}
+
+type keyColumn struct {
+	goName   string
+	quoted   string
+	typ      reflect.Type
+	desc     bool
+	nullable bool
+}
+
+// Keyset orders a List query by the sort keys followed by the primary key,
+// and pages through it with row value comparisons on these columns.
+// Comparisons are expanded to OR terms when the sort directions differ or a
+// sort key is nullable. NULL values sort last in both directions.
+type Keyset struct {
+	columns []keyColumn
+	order   string
+	filter  []byte
+}
+
+type pageToken struct {
+	Order  string            `json:"o"`
+	Filter []byte            `json:"f"`
+	Values []json.RawMessage `json:"v"`
+}
+
+// NewKeyset returns the keyset of obj, a pointer to a gorm model, ordered
+// by s. Its page tokens are only valid for the same s and filter f. Sorting
+// by associations is not supported.
+func NewKeyset(db *gorm.DB, obj interface{}, s *query.Sorting, f *query.Filtering) (*Keyset, error) {
+	if tokenKey == nil {
+		return nil, ErrNoTokenKey
+	}
+	scope := db.NewScope(obj)
+	k := &Keyset{}
+	if f != nil {
+		filter, err := proto.MarshalOptions{Deterministic: true}.Marshal(f)
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(filter)
+		k.filter = sum[:]
+	}
+	seen := make(map[string]bool)
+	add := func(field *gorm.Field, desc bool) {
+		if seen[field.DBName] {
+			return
+		}
+		seen[field.DBName] = true
+		k.columns = append(k.columns, keyColumn{
+			goName:   field.Name,
+			quoted:   scope.QuotedTableName() + "." + scope.Quote(field.DBName),
+			typ:      field.Struct.Type,
+			desc:     desc,
+			nullable: field.Struct.Type.Kind() == reflect.Ptr,
+		})
+	}
+
+	for _, cr := range s.GetCriterias() {
+		field, ok := scope.FieldByName(gorm.ToDBName(cr.GetTag()))
+		if strings.Contains(cr.GetTag(), ".") || !ok || !field.IsNormal {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot sort by %q with keyset pagination", cr.GetTag())
+		}
+		add(field, cr.IsDesc())
+	}
+	primaryFields := scope.PrimaryFields()
+	if len(primaryFields) == 0 {
+		return nil, fmt.Errorf("paging: %s has no primary key", scope.TableName())
+	}
+	// the primary key breaks ties in the direction of the last sort key
+	desc := len(k.columns) > 0 && k.columns[len(k.columns)-1].desc
+	for _, field := range primaryFields {
+		add(field, desc)
+	}
+
+	var order []string
+	for _, column := range k.columns {
+		if column.nullable {
+			// false sorts first, the NULL values last
+			order = append(order, "("+column.quoted+" IS NULL)")
+		}
+		if column.desc {
+			order = append(order, column.quoted+" DESC")
+		} else {
+			order = append(order, column.quoted)
+		}
+	}
+	k.order = strings.Join(order, ",")
+
+	return k, nil
+}
+
+// Apply orders db by the keyset and, unless token is empty, restricts it to
+// the rows following the page token.
+func (k *Keyset) Apply(db *gorm.DB, token string) (*gorm.DB, error) {
+	db = db.Order(k.order)
+	if token == "" {
+		return db, nil
+	}
+
+	values, err := k.decode(token)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := k.columns[0].desc
+	sameDirection := true
+	for _, column := range k.columns {
+		sameDirection = sameDirection && column.desc == desc && !column.nullable
+	}
+	if sameDirection {
+		var columns, placeholders []string
+		for _, column := range k.columns {
+			columns = append(columns, column.quoted)
+			placeholders = append(placeholders, "?")
+		}
+		op := ">"
+		if desc {
+			op = "<"
+		}
+		return db.Where(fmt.Sprintf("(%s) %s (%s)", strings.Join(columns, ","), op, strings.Join(placeholders, ",")), values...), nil
+	}
+
+	// (a > ?) OR (a = ? AND b < ?) OR ... for mixed sort directions, the
+	// rows following a NULL value being the other NULL ones only
+	var (
+		clauses []string
+		args    []interface{}
+	)
+	for i, column := range k.columns {
+		if values[i] == nil {
+			continue
+		}
+		var terms []string
+		var termArgs []interface{}
+		for j := 0; j < i; j++ {
+			if values[j] == nil {
+				terms = append(terms, k.columns[j].quoted+" IS NULL")
+				continue
+			}
+			terms = append(terms, k.columns[j].quoted+" = ?")
+			termArgs = append(termArgs, values[j])
+		}
+		op := ">"
+		if column.desc {
+			op = "<"
+		}
+		if column.nullable {
+			terms = append(terms, "("+column.quoted+" "+op+" ? OR "+column.quoted+" IS NULL)")
+		} else {
+			terms = append(terms, column.quoted+" "+op+" ?")
+		}
+		args = append(append(args, termArgs...), values[i])
+		clauses = append(clauses, "("+strings.Join(terms, " AND ")+")")
+	}
+
+	return db.Where(strings.Join(clauses, " OR "), args...), nil
+}
+
+// Token returns the page token of the rows following obj, the last row of
+// the current page.
+func (k *Keyset) Token(obj interface{}) (string, error) {
+	value := reflect.Indirect(reflect.ValueOf(obj))
+	token := pageToken{Order: k.order, Filter: k.filter}
+	for _, column := range k.columns {
+		raw, err := json.Marshal(value.FieldByName(column.goName).Interface())
+		if err != nil {
+			return "", err
+		}
+		token.Values = append(token.Values, raw)
+	}
+
+	payload, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(sign(payload)), nil
+}
+
+func (k *Keyset) decode(token string) ([]interface{}, error) {
+	parts := strings.SplitN(token, ".", 2)
+	if len(parts) != 2 {
+		return nil, status.Error(codes.InvalidArgument, "malformed page token")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "malformed page token")
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(mac, sign(payload)) {
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+
+	var decoded pageToken
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "malformed page token")
+	}
+	if decoded.Order != k.order || len(decoded.Values) != len(k.columns) {
+		return nil, status.Error(codes.InvalidArgument, "page token doesn't match the requested order")
+	}
+	if !bytes.Equal(decoded.Filter, k.filter) {
+		return nil, status.Error(codes.InvalidArgument, "page token doesn't match the requested filter")
+	}
+
+	values := make([]interface{}, len(k.columns))
+	for i, column := range k.columns {
+		value := reflect.New(column.typ)
+		if err := json.Unmarshal(decoded.Values[i], value.Interface()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "malformed page token")
+		}
+		value = value.Elem()
+		if value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				continue
+			}
+			value = value.Elem()
+		}
+		values[i] = value.Interface()
+	}
+
+	return values, nil
+}
+
+func sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, tokenKey)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
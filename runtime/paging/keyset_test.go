@@ -0,0 +1,182 @@
+package paging
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type post struct {
+	Id     uint64 `gorm:"primary_key"`
+	Title  string
+	Rating *int32
+}
+
+func init() {
+	SetTokenKey([]byte("secret"))
+}
+
+func open(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+	return db, mock
+}
+
+func sorting(t *testing.T, s string) *query.Sorting {
+	t.Helper()
+	sorting, err := query.ParseSorting(s)
+	if err != nil {
+		t.Fatalf("failed to parse sorting %q: %v", s, err)
+	}
+	return sorting
+}
+
+func TestKeysetSameDirection(t *testing.T) {
+	db, mock := open(t)
+	keyset, err := NewKeyset(db, &post{}, sorting(t, "title desc"), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	token, err := keyset.Token(&post{Id: 7, Title: "b"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mock.ExpectQuery(`SELECT \* FROM "posts" WHERE \(\("posts"."title","posts"."id"\) < \(\$1,\$2\)\) ORDER BY "posts"."title" DESC,"posts"."id" DESC`).
+		WithArgs("b", 7).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	db, err = keyset.Apply(db, token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := db.Find(&[]post{}).Error; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestKeysetMixedDirections(t *testing.T) {
+	db, mock := open(t)
+	rating := int32(3)
+	keyset, err := NewKeyset(db, &post{}, sorting(t, "rating desc,title"), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	token, err := keyset.Token(&post{Id: 7, Title: "b", Rating: &rating})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mock.ExpectQuery(`WHERE \(\(\("posts"."rating" < \$1 OR "posts"."rating" IS NULL\)\) OR \("posts"."rating" = \$2 AND "posts"."title" > \$3\) OR ` +
+		`\("posts"."rating" = \$4 AND "posts"."title" = \$5 AND "posts"."id" > \$6\)\) ` +
+		`ORDER BY \("posts"."rating" IS NULL\),"posts"."rating" DESC,"posts"."title","posts"."id"`).
+		WithArgs(3, 3, "b", 3, "b", 7).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	db, err = keyset.Apply(db, token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := db.Find(&[]post{}).Error; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestKeysetNullSortKey(t *testing.T) {
+	db, mock := open(t)
+	keyset, err := NewKeyset(db, &post{}, sorting(t, "rating"), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	token, err := keyset.Token(&post{Id: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mock.ExpectQuery(`WHERE \(\("posts"."rating" IS NULL AND "posts"."id" > \$1\)\) ORDER BY \("posts"."rating" IS NULL\),"posts"."rating","posts"."id"`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	db, err = keyset.Apply(db, token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := db.Find(&[]post{}).Error; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestKeysetInvalidToken(t *testing.T) {
+	db, _ := open(t)
+	byTitle, err := NewKeyset(db, &post{}, sorting(t, "title"), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	token, err := byTitle.Token(&post{Id: 7, Title: "b"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	byId, err := NewKeyset(db, &post{}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	filter, err := query.ParseFiltering("title == 'b'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	filtered, err := NewKeyset(db, &post{}, sorting(t, "title"), filter)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for name, test := range map[string]struct {
+		keyset *Keyset
+		token  string
+	}{
+		"tampered":  {byTitle, "x" + token},
+		"signature": {byTitle, token[:len(token)-2]},
+		"order":     {byId, token},
+		"filter":    {filtered, token},
+		"malformed": {byTitle, "token"},
+	} {
+		if _, err := test.keyset.Apply(db, test.token); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: got error %v; want InvalidArgument", name, err)
+		}
+	}
+}
+
+func TestKeysetUnknownSortKey(t *testing.T) {
+	db, _ := open(t)
+	if _, err := NewKeyset(db, &post{}, sorting(t, "author.name"), nil); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("got error %v; want InvalidArgument", err)
+	}
+}
+
+func TestKeysetNoTokenKey(t *testing.T) {
+	db, _ := open(t)
+	defer SetTokenKey(tokenKey)
+	SetTokenKey(nil)
+	if _, err := NewKeyset(db, &post{}, nil, nil); err != ErrNoTokenKey {
+		t.Errorf("got error %v; want ErrNoTokenKey", err)
+	}
+}
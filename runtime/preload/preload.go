@@ -0,0 +1,42 @@
+// Package preload selects the associations loaded by the generated Read,
+// List and Stream handlers.
+package preload
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	gorm1 "github.com/infobloxopen/atlas-app-toolkit/gorm"
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+)
+
+// BatchSize is the number of rows the Stream handlers read with each query,
+// their associations being loaded once the rows are read.
+var BatchSize = 100
+
+// Converter is a collection operators converter which preloads the
+// associations requested by a field selection, or its default associations
+// when no field is selected. Preloads are nested with dotted paths, such as
+// "Tasks.Owner", and each association is loaded with a single IN query.
+type Converter struct {
+	gorm1.CollectionOperatorsConverter
+	defaults []string
+}
+
+// NewConverter returns the converter of pb, the protobuf message of the
+// model, which preloads defaults when no field is selected.
+func NewConverter(pb proto.Message, defaults ...string) *Converter {
+	return &Converter{
+		CollectionOperatorsConverter: gorm1.NewDefaultPbToOrmConverter(pb),
+		defaults:                     defaults,
+	}
+}
+
+// FieldSelectionToGorm returns the associations to preload for fs.
+func (c *Converter) FieldSelectionToGorm(ctx context.Context, fs *query.FieldSelection, obj interface{}) ([]string, error) {
+	if len(fs.GetFields()) == 0 {
+		return c.defaults, nil
+	}
+
+	return c.CollectionOperatorsConverter.FieldSelectionToGorm(ctx, fs, obj)
+}
@@ -0,0 +1,105 @@
+package preload
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/protobuf/ptypes/empty"
+	gorm1 "github.com/infobloxopen/atlas-app-toolkit/gorm"
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/jinzhu/gorm"
+)
+
+type author struct {
+	Id    uint64 `gorm:"primary_key"`
+	Agent *agent
+	Books []*book
+}
+
+type agent struct {
+	Id       uint64 `gorm:"primary_key"`
+	AuthorId uint64
+}
+
+type book struct {
+	Id       uint64 `gorm:"primary_key"`
+	AuthorId uint64
+	Title    string
+	Chapters []*chapter
+}
+
+type chapter struct {
+	Id     uint64 `gorm:"primary_key"`
+	BookId uint64
+}
+
+func open(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+	return db, mock
+}
+
+func find(t *testing.T, db *gorm.DB, fs *query.FieldSelection) []*author {
+	t.Helper()
+	db, err := gorm1.ApplyFieldSelectionEx(context.Background(), db, fs, &author{}, NewConverter(&empty.Empty{}, "Books", "Books.Chapters"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var authors []*author
+	if err := db.Find(&authors).Error; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return authors
+}
+
+func TestDefaults(t *testing.T) {
+	db, mock := open(t)
+
+	mock.ExpectQuery(`SELECT \* FROM "authors"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+	mock.ExpectQuery(`SELECT \* FROM "books" WHERE \("author_id" IN \(\$1,\$2\)\)`).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "author_id"}).AddRow(10, 1).AddRow(11, 1).AddRow(12, 2))
+	mock.ExpectQuery(`SELECT \* FROM "chapters" WHERE \("book_id" IN \(\$1,\$2,\$3\)\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "book_id"}).AddRow(100, 12))
+
+	authors := find(t, db, nil)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+	if len(authors) != 2 || len(authors[0].Books) != 2 || len(authors[1].Books) != 1 || len(authors[1].Books[0].Chapters) != 1 {
+		t.Errorf("associations were not preloaded: %+v", authors)
+	}
+	if authors[0].Agent != nil {
+		t.Errorf("agent was preloaded: %+v", authors[0].Agent)
+	}
+}
+
+func TestFieldSelection(t *testing.T) {
+	db, mock := open(t)
+
+	mock.ExpectQuery(`SELECT \* FROM "authors"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`SELECT \* FROM "agents" WHERE \("author_id" IN \(\$1\)\)`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "author_id"}).AddRow(5, 1))
+	mock.ExpectQuery(`SELECT \* FROM "books" WHERE \("author_id" IN \(\$1\)\)`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "author_id"}).AddRow(10, 1))
+
+	authors := find(t, db, query.ParseFieldSelection("agent,books.title"))
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+	if authors[0].Agent == nil || len(authors[0].Books) != 1 {
+		t.Errorf("selected associations were not preloaded: %+v", authors[0])
+	}
+}
@@ -0,0 +1,89 @@
+// Package retry re-runs the transactions of the generated servers aborted by
+// a serialization failure or a deadlock.
+package retry
+
+import (
+	"context"
+	stderrors "errors"
+	"math/rand"
+	"time"
+
+	"github.com/acanseco/protoc-gen-gorm/errors"
+)
+
+// Policy bounds the retries of a transaction.
+type Policy struct {
+	// MaxAttempts is the number of runs of the transaction, 1 disables the
+	// retries.
+	MaxAttempts int
+	// BaseDelay is the maximum wait before the first retry, doubled for each
+	// retry up to MaxDelay. The wait is a random duration below the maximum.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// DefaultPolicy is the policy of the servers without one.
+var DefaultPolicy = Policy{MaxAttempts: 3, BaseDelay: 10 * time.Millisecond, MaxDelay: time.Second}
+
+// Retryable reports whether err aborted the transaction because of a
+// serialization failure or a deadlock, as detected by errors.Translate for
+// each engine.
+func Retryable(err error) bool {
+	var serialization *errors.SerializationError
+	return stderrors.As(errors.Translate(err), &serialization)
+}
+
+type attemptKey struct{}
+
+// attempt is the state of a run of the transaction.
+type attempt struct {
+	stopped bool
+}
+
+// Stop prevents the retry of the run of the transaction of ctx, to be called
+// by hooks after a side effect which mustn't be repeated, like sending an
+// email. It does nothing when ctx isn't the context of a run.
+func Stop(ctx context.Context) {
+	if a, ok := ctx.Value(attemptKey{}).(*attempt); ok {
+		a.stopped = true
+	}
+}
+
+// Do calls f until it returns nil, an error which isn't Retryable, or the
+// attempts of p, DefaultPolicy when nil, are exhausted, and returns its last
+// error. It doesn't retry the runs stopped by Stop, and stops waiting when
+// ctx is done.
+func Do(ctx context.Context, p *Policy, f func(ctx context.Context) error) error {
+	if p == nil {
+		p = &DefaultPolicy
+	}
+	var err error
+	for i := 0; ; i++ {
+		a := &attempt{}
+		err = f(context.WithValue(ctx, attemptKey{}, a))
+		if err == nil || a.stopped || i+1 >= p.MaxAttempts || !Retryable(err) {
+			return err
+		}
+
+		timer := time.NewTimer(p.delay(i))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// delay returns the jittered wait before the retry following the run i.
+func (p *Policy) delay(i int) time.Duration {
+	max := p.BaseDelay << uint(i)
+	if max <= 0 || max > p.MaxDelay {
+		max = p.MaxDelay
+	}
+	if max <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(max)))
+}
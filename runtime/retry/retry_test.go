@@ -0,0 +1,83 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+)
+
+func TestRetryable(t *testing.T) {
+	for err, want := range map[error]bool{
+		&pq.Error{Code: "40001"}:         true,
+		&pq.Error{Code: "40P01"}:         true,
+		&mysql.MySQLError{Number: 1213}:  true,
+		errors.New("database is locked"): true,
+		&pq.Error{Code: "23505"}:         false,
+		errors.New("failure"):            false,
+	} {
+		if got := Retryable(err); got != want {
+			t.Errorf("Retryable(%v) = %v; want %v", err, got, want)
+		}
+	}
+}
+
+func TestDo(t *testing.T) {
+	p := &Policy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	serialization := &pq.Error{Code: "40001"}
+
+	runs := 0
+	err := Do(context.Background(), p, func(ctx context.Context) error {
+		if runs++; runs < 3 {
+			return serialization
+		}
+		return nil
+	})
+	if err != nil || runs != 3 {
+		t.Errorf("got error %v after %d runs; want success after 3", err, runs)
+	}
+
+	runs = 0
+	err = Do(context.Background(), p, func(ctx context.Context) error {
+		runs++
+		return serialization
+	})
+	if err != serialization || runs != 3 {
+		t.Errorf("got error %v after %d runs; want %v after 3", err, runs, serialization)
+	}
+
+	runs = 0
+	failure := errors.New("failure")
+	err = Do(context.Background(), p, func(ctx context.Context) error {
+		runs++
+		return failure
+	})
+	if err != failure || runs != 1 {
+		t.Errorf("got error %v after %d runs; want %v after 1", err, runs, failure)
+	}
+
+	runs = 0
+	err = Do(context.Background(), p, func(ctx context.Context) error {
+		runs++
+		Stop(ctx)
+		return serialization
+	})
+	if err != serialization || runs != 1 {
+		t.Errorf("got error %v after %d runs of a stopped run; want 1", err, runs)
+	}
+}
+
+func TestDelay(t *testing.T) {
+	p := &Policy{BaseDelay: 10 * time.Millisecond, MaxDelay: 50 * time.Millisecond}
+	for i := 0; i < 70; i++ {
+		if d := p.delay(i); d < 0 || d >= 50*time.Millisecond {
+			t.Errorf("delay(%d) = %v; want it below the maximum delay", i, d)
+		}
+	}
+	if d := (&Policy{}).delay(0); d != 0 {
+		t.Errorf("got delay %v without delays; want 0", d)
+	}
+}
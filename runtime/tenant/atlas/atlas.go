@@ -0,0 +1,17 @@
+// Package atlas registers the tenant resolver reading the account id of the
+// atlas-app-toolkit JWT of requests. It is imported by the code generated
+// with the atlas runtime.
+package atlas
+
+import (
+	"context"
+
+	"github.com/acanseco/protoc-gen-gorm/runtime/tenant"
+	"github.com/infobloxopen/atlas-app-toolkit/auth"
+)
+
+func init() {
+	tenant.SetResolver(tenant.ResolverFunc(func(ctx context.Context) (string, error) {
+		return auth.GetAccountID(ctx, nil)
+	}))
+}
@@ -0,0 +1,114 @@
+// Package tenant resolves the tenant the generated code isolates the rows
+// of multi tenant messages by.
+package tenant
+
+import (
+	"context"
+	"log"
+
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Setting is the postgres setting holding the tenant of the transaction,
+// which the generated row level security policies compare rows against.
+const Setting = "app.account_id"
+
+// Resolver returns the tenant of a request from its context.
+type Resolver interface {
+	TenantID(ctx context.Context) (string, error)
+}
+
+// ResolverFunc adapts a function to the Resolver interface.
+type ResolverFunc func(ctx context.Context) (string, error)
+
+// TenantID calls f(ctx).
+func (f ResolverFunc) TenantID(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// Auditor records the accesses made with a context bypassing tenant
+// isolation.
+type Auditor interface {
+	AuditBypass(ctx context.Context, resource, reason string)
+}
+
+// AuditorFunc adapts a function to the Auditor interface.
+type AuditorFunc func(ctx context.Context, resource, reason string)
+
+// AuditBypass calls f(ctx, resource, reason).
+func (f AuditorFunc) AuditBypass(ctx context.Context, resource, reason string) {
+	f(ctx, resource, reason)
+}
+
+var (
+	resolver Resolver
+	auditor  Auditor = AuditorFunc(func(ctx context.Context, resource, reason string) {
+		log.Printf("tenant: isolation of %s bypassed: %s", resource, reason)
+	})
+)
+
+// SetResolver registers the resolver of the generated code. Code generated
+// with the atlas runtime registers the one of runtime/tenant/atlas, which
+// reads the account id of the atlas-app-toolkit JWT. It is meant to be called
+// once during initialization.
+func SetResolver(r Resolver) {
+	resolver = r
+}
+
+// SetAuditor registers the auditor of bypass contexts, which logs them
+// with the standard logger by default. It is meant to be called once during
+// initialization.
+func SetAuditor(a Auditor) {
+	auditor = a
+}
+
+type bypassKey struct{}
+
+// Bypass returns a copy of ctx in which the generated code doesn't isolate
+// tenants, for admin requests. Every access made with it is reported to the
+// registered Auditor along with reason.
+func Bypass(ctx context.Context, reason string) context.Context {
+	return context.WithValue(ctx, bypassKey{}, reason)
+}
+
+// Bypassed reports whether ctx bypasses tenant isolation, without auditing
+// the access.
+func Bypassed(ctx context.Context) bool {
+	_, ok := ctx.Value(bypassKey{}).(string)
+	return ok
+}
+
+// ID returns the tenant of ctx, or false when ctx bypasses tenant isolation
+// in which case the access to resource is audited.
+func ID(ctx context.Context, resource string) (string, bool, error) {
+	if reason, ok := ctx.Value(bypassKey{}).(string); ok {
+		auditor.AuditBypass(ctx, resource, reason)
+		return "", false, nil
+	}
+
+	if resolver == nil {
+		return "", false, status.Error(codes.Internal, "no tenant resolver registered")
+	}
+	id, err := resolver.TenantID(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	if id == "" {
+		return "", false, status.Error(codes.Unauthenticated, "no tenant in request context")
+	}
+
+	return id, true, nil
+}
+
+// SetLocal sets Setting to the tenant of ctx until the end of the
+// transaction tx, like SET LOCAL, unless ctx bypasses tenant isolation.
+func SetLocal(ctx context.Context, tx *gorm.DB, resource string) error {
+	id, isolated, err := ID(ctx, resource)
+	if err != nil || !isolated {
+		return err
+	}
+
+	return tx.Exec("SELECT set_config(?, ?, true)", Setting, id).Error
+}
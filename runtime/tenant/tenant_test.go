@@ -0,0 +1,106 @@
+package tenant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type orgKey struct{}
+
+func register(t *testing.T) *[]string {
+	t.Helper()
+	var audited []string
+	SetResolver(ResolverFunc(func(ctx context.Context) (string, error) {
+		org, _ := ctx.Value(orgKey{}).(string)
+		return org, nil
+	}))
+	SetAuditor(AuditorFunc(func(ctx context.Context, resource, reason string) {
+		audited = append(audited, resource+": "+reason)
+	}))
+	return &audited
+}
+
+func TestID(t *testing.T) {
+	audited := register(t)
+
+	id, isolated, err := ID(context.WithValue(context.Background(), orgKey{}, "org"), "Contact")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != "org" || !isolated {
+		t.Errorf("ID() = %q, %t; want %q, true", id, isolated, "org")
+	}
+	if len(*audited) != 0 {
+		t.Errorf("unexpected audit records: %v", *audited)
+	}
+}
+
+func TestIDWithoutResolver(t *testing.T) {
+	SetResolver(nil)
+
+	if _, _, err := ID(context.Background(), "Contact"); status.Code(err) != codes.Internal {
+		t.Errorf("got error %v; want Internal", err)
+	}
+}
+
+func TestIDWithoutTenant(t *testing.T) {
+	register(t)
+
+	if _, _, err := ID(context.Background(), "Contact"); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("got error %v; want Unauthenticated", err)
+	}
+}
+
+func TestBypass(t *testing.T) {
+	audited := register(t)
+
+	ctx := Bypass(context.WithValue(context.Background(), orgKey{}, "org"), "support ticket 42")
+	if !Bypassed(ctx) || Bypassed(context.Background()) {
+		t.Error("expected only the bypass context to be bypassed")
+	}
+	if len(*audited) != 0 {
+		t.Errorf("audit records = %v; want none before the access", *audited)
+	}
+	id, isolated, err := ID(ctx, "Contact")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != "" || isolated {
+		t.Errorf("ID() = %q, %t; want bypass", id, isolated)
+	}
+	if len(*audited) != 1 || (*audited)[0] != "Contact: support ticket 42" {
+		t.Errorf("audit records = %v; want the bypass of Contact", *audited)
+	}
+}
+
+func TestSetLocal(t *testing.T) {
+	register(t)
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+
+	mock.ExpectExec(`SELECT set_config\(\$1, \$2, true\)`).
+		WithArgs(Setting, "org").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	ctx := context.WithValue(context.Background(), orgKey{}, "org")
+	if err := SetLocal(ctx, db, "Contact"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := SetLocal(Bypass(ctx, "maintenance"), db, "Contact"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
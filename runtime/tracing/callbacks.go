@@ -0,0 +1,87 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const spanKey = "tracing:span"
+
+// RowsAffectedKey is the attribute of database spans holding the number of
+// rows returned or modified by the call.
+const RowsAffectedKey = attribute.Key("db.rows_affected")
+
+// The callbacks are registered on the default gorm callbacks, shared by the
+// databases opened afterwards, and record nothing for the databases not
+// returned by WithContext.
+func init() {
+	c := gorm.DefaultCallback
+	c.Create().Before("gorm:begin_transaction").Register("tracing:before_create", before("INSERT"))
+	c.Create().After("gorm:commit_or_rollback_transaction").Register("tracing:after_create", after)
+	c.Query().Before("gorm:query").Register("tracing:before_query", before("SELECT"))
+	c.Query().After("gorm:after_query").Register("tracing:after_query", after)
+	c.RowQuery().Before("gorm:row_query").Register("tracing:before_row_query", before("SELECT"))
+	c.RowQuery().After("gorm:row_query").Register("tracing:after_row_query", after)
+	c.Update().Before("gorm:begin_transaction").Register("tracing:before_update", before("UPDATE"))
+	c.Update().After("gorm:commit_or_rollback_transaction").Register("tracing:after_update", after)
+	c.Delete().Before("gorm:begin_transaction").Register("tracing:before_delete", before("DELETE"))
+	c.Delete().After("gorm:commit_or_rollback_transaction").Register("tracing:after_delete", after)
+}
+
+func before(operation string) func(*gorm.Scope) {
+	return func(scope *gorm.Scope) {
+		v, ok := scope.Get(dbContextKey)
+		if !ok {
+			return
+		}
+		ctx, ok := v.(context.Context)
+		if !ok {
+			return
+		}
+
+		table := scope.TableName()
+		_, span := tracer().Start(ctx, operation+" "+table, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+			system(scope.Dialect().GetName()),
+			semconv.DBSQLTableKey.String(table),
+			semconv.DBOperationKey.String(operation),
+		))
+		scope.InstanceSet(spanKey, span)
+	}
+}
+
+func after(scope *gorm.Scope) {
+	v, ok := scope.InstanceGet(spanKey)
+	if !ok {
+		return
+	}
+	span := v.(trace.Span)
+	defer span.End()
+
+	span.SetAttributes(
+		semconv.DBStatementKey.String(scope.SQL),
+		RowsAffectedKey.Int64(scope.DB().RowsAffected),
+	)
+	if err := scope.DB().Error; !gorm.IsRecordNotFoundError(err) {
+		Error(span, err)
+	}
+}
+
+// system returns the db.system attribute of the gorm dialect name.
+func system(dialect string) attribute.KeyValue {
+	switch dialect {
+	case "postgres":
+		return semconv.DBSystemPostgreSQL
+	case "mysql":
+		return semconv.DBSystemMySQL
+	case "sqlite3":
+		return semconv.DBSystemSqlite
+	case "mssql":
+		return semconv.DBSystemMSSQL
+	default:
+		return semconv.DBSystemOtherSQL
+	}
+}
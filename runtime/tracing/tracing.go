@@ -0,0 +1,110 @@
+// Package tracing records the OpenTelemetry spans of the code generated with
+// tracing=otel: one span per server method and Default handler, and one per
+// database call made by the handlers.
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/jinzhu/gorm"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// InstrumentationName is the name of the tracer of the generated code.
+const InstrumentationName = "github.com/acanseco/protoc-gen-gorm"
+
+var payloadLimit int64
+
+// SetPayloadLimit enables the capture of the requests and responses of the
+// server methods in the attributes of their spans, truncated to n bytes or
+// less on a rune boundary.
+// Payloads may hold personal data, so they aren't captured by default.
+func SetPayloadLimit(n int) {
+	atomic.StoreInt64(&payloadLimit, int64(n))
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer(InstrumentationName)
+}
+
+// StartMethod starts the span of method of the gRPC service, the full name of
+// the service, and records its request in.
+func StartMethod(ctx context.Context, service, method string, in interface{}) (context.Context, trace.Span) {
+	ctx, span := tracer().Start(ctx, service+"/"+method, trace.WithAttributes(
+		semconv.RPCSystemGRPC,
+		semconv.RPCServiceKey.String(service),
+		semconv.RPCMethodKey.String(method),
+	))
+	Payload(span, "in", in)
+
+	return ctx, span
+}
+
+// StartHandler starts the span of the Default handler name of resource, the
+// name of a message type.
+func StartHandler(ctx context.Context, name, resource string) (context.Context, trace.Span) {
+	return tracer().Start(ctx, name, trace.WithAttributes(
+		semconv.CodeFunctionKey.String(name),
+		attribute.String("gorm.resource", resource),
+	))
+}
+
+// Error records err, if any, on span and returns it.
+func Error(span trace.Span, err error) error {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+// Payload records v, a request or response, in the attribute payload.<key>
+// of span when payloads are captured.
+func Payload(span trace.Span, key string, v interface{}) {
+	limit := int(atomic.LoadInt64(&payloadLimit))
+	if limit <= 0 || !span.IsRecording() {
+		return
+	}
+
+	var raw []byte
+	var err error
+	if m, ok := v.(proto.Message); ok {
+		raw, err = protojson.Marshal(proto.MessageV2(m))
+	} else {
+		raw, err = json.Marshal(v)
+	}
+	if err != nil {
+		return
+	}
+	if len(raw) > limit {
+		// don't split the last rune
+		for limit > 0 && !utf8.RuneStart(raw[limit]) {
+			limit--
+		}
+		raw = raw[:limit]
+	}
+	span.SetAttributes(attribute.String("payload."+key, string(raw)))
+}
+
+// dbContextKey is the gorm setting holding the context of database calls.
+const dbContextKey = "tracing:context"
+
+// WithContext returns db recording the spans of its database calls as
+// children of the span of ctx.
+func WithContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if db == nil {
+		return nil
+	}
+
+	return db.Set(dbContextKey, ctx)
+}
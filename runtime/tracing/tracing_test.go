@@ -0,0 +1,120 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/jinzhu/gorm"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type contact struct {
+	Id   uint64 `gorm:"primary_key"`
+	Name string
+}
+
+func record(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	return recorder
+}
+
+func attributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func TestDBSpans(t *testing.T) {
+	recorder := record(t)
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+
+	mock.ExpectQuery(`SELECT \* FROM "contacts"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a").AddRow(2, "b"))
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "contacts"`).WillReturnError(errors.New("deadlock detected"))
+	mock.ExpectRollback()
+
+	ctx, span := StartHandler(context.Background(), "DefaultListContact", "Contact")
+	var contacts []*contact
+	if err := WithContext(ctx, db).Find(&contacts).Error; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := WithContext(ctx, db).Model(&contact{Id: 1}).Update("name", "c").Error; err == nil {
+		t.Fatal("expected an error")
+	}
+	Error(span, errors.New("deadlock detected"))
+	span.End()
+
+	// the untraced database records nothing
+	mock.ExpectQuery(`SELECT \* FROM "contacts"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	db.Find(&contacts)
+
+	spans := recorder.Ended()
+	if len(spans) != 3 {
+		t.Fatalf("got %d spans; want 3", len(spans))
+	}
+	query, update, handler := spans[0], spans[1], spans[2]
+
+	if query.Name() != "SELECT contacts" || query.Parent().SpanID() != handler.SpanContext().SpanID() {
+		t.Errorf("unexpected query span %q", query.Name())
+	}
+	attrs := attributes(query)
+	if attrs["db.system"].AsString() != "postgresql" || attrs["db.sql.table"].AsString() != "contacts" ||
+		attrs["db.operation"].AsString() != "SELECT" || attrs[RowsAffectedKey].AsInt64() != 2 {
+		t.Errorf("unexpected query span attributes %v", attrs)
+	}
+	if update.Name() != "UPDATE contacts" || update.Status().Code != codes.Error {
+		t.Errorf("unexpected update span %q: %v", update.Name(), update.Status())
+	}
+	if handler.Name() != "DefaultListContact" || handler.Status().Code != codes.Error {
+		t.Errorf("unexpected handler span %q: %v", handler.Name(), handler.Status())
+	}
+}
+
+func TestPayload(t *testing.T) {
+	recorder := record(t)
+	in := &wrappers.StringValue{Value: "a long enough request"}
+
+	_, span := StartMethod(context.Background(), "example.Contacts", "Read", in)
+	span.End()
+	SetPayloadLimit(10)
+	defer SetPayloadLimit(0)
+	_, span = StartMethod(context.Background(), "example.Contacts", "Read", in)
+	span.End()
+
+	spans := recorder.Ended()
+	if _, ok := attributes(spans[0])["payload.in"]; ok {
+		t.Error("payload captured by default")
+	}
+	if got := attributes(spans[1])["payload.in"].AsString(); got != `"a long en` {
+		t.Errorf("payload.in = %q; want the first 10 bytes", got)
+	}
+	if spans[1].Name() != "example.Contacts/Read" {
+		t.Errorf("unexpected span name %q", spans[1].Name())
+	}
+
+	// "é" takes the 10th and 11th bytes
+	_, span = StartMethod(context.Background(), "example.Contacts", "Read", &wrappers.StringValue{Value: "a long xé request"})
+	span.End()
+	if got := attributes(recorder.Ended()[2])["payload.in"].AsString(); got != `"a long x` {
+		t.Errorf("payload.in = %q; want the first 9 bytes", got)
+	}
+}
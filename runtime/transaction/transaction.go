@@ -0,0 +1,102 @@
+// Package transaction runs the generated handlers writing several rows, and
+// the methods of the generated servers, in a database transaction.
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// Options are the settings of the transaction of a server method.
+type Options struct {
+	// Isolation is the isolation level, sql.LevelDefault is the default
+	// level of the database.
+	Isolation sql.IsolationLevel
+	// ReadOnly rejects the writes of the transaction.
+	ReadOnly bool
+	// StatementTimeout bounds the duration of each statement, and
+	// LockTimeout the time a statement waits for a lock, on Postgres. Zero
+	// means the settings of the database.
+	StatementTimeout time.Duration
+	LockTimeout      time.Duration
+}
+
+// Begin begins a transaction on db with opts, rolled back when ctx is done.
+// Like db.Begin, errors are returned in the Error of the transaction.
+func Begin(ctx context.Context, db *gorm.DB, opts Options) *gorm.DB {
+	tx := db.BeginTx(ctx, &sql.TxOptions{Isolation: opts.Isolation, ReadOnly: opts.ReadOnly})
+	if tx.Error != nil {
+		return tx
+	}
+	if err := SetTimeouts(tx, opts); err != nil {
+		tx.Rollback()
+		tx.Error = err
+	}
+
+	return tx
+}
+
+// SetTimeouts applies the statement and lock timeouts of opts until the end
+// of the transaction tx, with SET LOCAL on Postgres. Other databases keep
+// their settings.
+func SetTimeouts(tx *gorm.DB, opts Options) error {
+	if tx.Dialect().GetName() != "postgres" {
+		return nil
+	}
+	settings := []struct {
+		name    string
+		timeout time.Duration
+	}{
+		{"statement_timeout", opts.StatementTimeout},
+		{"lock_timeout", opts.LockTimeout},
+	}
+	for _, setting := range settings {
+		if setting.timeout <= 0 {
+			continue
+		}
+		if err := tx.Exec(fmt.Sprintf("SET LOCAL %s = %d", setting.name, setting.timeout.Milliseconds())).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Run calls f with a transaction of db, which is committed when f returns
+// nil and rolled back when f returns an error or panics. When db already is
+// a transaction f is called with db, and the caller commits it.
+func Run(db *gorm.DB, f func(tx *gorm.DB) error) (err error) {
+	if InTransaction(db) {
+		return f(db)
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		}
+	}()
+	if err = f(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// InTransaction reports whether db is a transaction.
+func InTransaction(db *gorm.DB) bool {
+	_, ok := db.CommonDB().(interface {
+		Commit() error
+		Rollback() error
+	})
+	return ok
+}
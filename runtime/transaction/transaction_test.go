@@ -0,0 +1,103 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm"
+)
+
+func open(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	db, err := gorm.Open("postgres", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm db: %v", err)
+	}
+	return db, mock
+}
+
+func TestRun(t *testing.T) {
+	db, mock := open(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE a").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE b").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	err := Run(db, func(tx *gorm.DB) error {
+		if !InTransaction(tx) {
+			t.Error("expected a transaction")
+		}
+		if err := tx.Exec("UPDATE a").Error; err != nil {
+			return err
+		}
+		// nested runs reuse the transaction
+		return Run(tx, func(tx *gorm.DB) error {
+			return tx.Exec("UPDATE b").Error
+		})
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	failure := errors.New("failure")
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	if err := Run(db, func(tx *gorm.DB) error { return failure }); err != failure {
+		t.Errorf("got error %v; want %v", err, failure)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("got panic %v; want boom", r)
+			}
+		}()
+		Run(db, func(tx *gorm.DB) error { panic("boom") })
+	}()
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBegin(t *testing.T) {
+	db, mock := open(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SET LOCAL statement_timeout = 5000`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`SET LOCAL lock_timeout = 250`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	tx := Begin(context.Background(), db, Options{
+		Isolation:        sql.LevelSerializable,
+		StatementTimeout: 5 * time.Second,
+		LockTimeout:      250 * time.Millisecond,
+	})
+	if tx.Error != nil {
+		t.Fatalf("unexpected error: %v", tx.Error)
+	}
+	if err := tx.Commit().Error; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	failure := errors.New("failure")
+	mock.ExpectBegin()
+	mock.ExpectExec(`SET LOCAL lock_timeout = 1000`).WillReturnError(failure)
+	mock.ExpectRollback()
+	if tx := Begin(context.Background(), db, Options{LockTimeout: time.Second}); tx.Error != failure {
+		t.Errorf("got error %v; want %v", tx.Error, failure)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}